@@ -47,6 +47,28 @@ In addition to all arguments above, the following attributes are exported:
                 <li>
                     <a href="/docs/providers/{{.cloud_mark}}/index.html">{{.cloud_title}} Provider</a>
                 </li>
+                <li>
+                    <a href="#">Provider Functions</a>
+                    <ul class="nav">
+                        <li>
+                            <a href="/docs/providers/{{.cloud_mark}}/functions/parse_resource_id.html">parse_resource_id</a>
+                        </li>
+                        <li>
+                            <a href="/docs/providers/{{.cloud_mark}}/functions/qcs_resource_name.html">qcs_resource_name</a>
+                        </li>
+                        <li>
+                            <a href="/docs/providers/{{.cloud_mark}}/functions/short_region.html">short_region</a>
+                        </li>
+                    </ul>
+                </li>
+                <li>
+                    <a href="#">Provider Ephemeral Resources</a>
+                    <ul class="nav">
+                        <li>
+                            <a href="/docs/providers/{{.cloud_mark}}/ephemeral-resources/sts_temporary_credential.html">tencentcloud_sts_temporary_credential</a>
+                        </li>
+                    </ul>
+                </li>
                 {{range .Products}}
                 <li>
                     <a href="#">{{.Name}}</a>
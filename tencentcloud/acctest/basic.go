@@ -9,7 +9,8 @@ import (
 /*
 ---------------------------------------------------
 If you want to run through the test cases,
-the following must be changed to your resource id.
+the following must be changed to your resource id,
+or use the fixtures of fixture.go instead.
 ---------------------------------------------------
 */
 
@@ -0,0 +1,712 @@
+package acctest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	cam "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cam/v20190116"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	ssl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssl/v20191205"
+	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
+	"github.com/tencentyun/cos-go-sdk-v5"
+
+	tcprovider "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+/*
+---------------------------------------------------
+Fixtures are the `keep-*` baseline resources shared by the acceptance tests. Unlike the hard-coded Default* IDs
+of basic.go, they are looked up by the `keep-fixture` tag in the account of the test credentials and created
+when missing, so the suites can run in any account.
+---------------------------------------------------
+*/
+
+const (
+	// FixtureTagKey tags every fixture, with the fixture name as the value
+	FixtureTagKey = "keep-fixture"
+	// FixtureCacheEnv is the path of the file caching the fixture IDs by account and region across test binaries, which defaults to
+	// `tencentcloud-acc-fixtures.json` in the temp dir
+	FixtureCacheEnv = "TENCENTCLOUD_ACC_FIXTURE_CACHE"
+
+	FixtureNameVpc           = "keep-fixture-vpc"
+	FixtureNameSubnet        = "keep-fixture-subnet"
+	FixtureNameSecurityGroup = "keep-fixture-sg"
+	FixtureNameCertificate   = "keep-fixture-cert"
+	FixtureNameCertificateB  = "keep-fixture-cert-b"
+	FixtureNameCosBucket     = "keep-fixture-bucket"
+	FixtureNameInstance      = "keep-fixture-instance"
+)
+
+const (
+	// fixtureLockStale is how old a lock file left by a killed test binary has to be before it is taken over, longer than
+	// provisioning any fixture takes
+	fixtureLockStale = 30 * time.Minute
+	// fixtureLockTimeout is how long a test binary waits for the lock file before failing the test
+	fixtureLockTimeout = 40 * time.Minute
+)
+
+type fixtureProvisioner func(ctx context.Context, client *connectivity.TencentCloudClient, name string) (string, error)
+
+// fixtureChecker reports whether the fixture with the cached id is still usable
+type fixtureChecker func(ctx context.Context, client *connectivity.TencentCloudClient, id string) (bool, error)
+
+func fixtureOf(name string) (fixtureProvisioner, fixtureChecker) {
+	switch name {
+	case FixtureNameVpc:
+		return provisionFixtureVpc, checkFixtureVpc
+	case FixtureNameSubnet:
+		return provisionFixtureSubnet, checkFixtureSubnet
+	case FixtureNameSecurityGroup:
+		return provisionFixtureSecurityGroup, checkFixtureSecurityGroup
+	case FixtureNameCertificate, FixtureNameCertificateB:
+		return provisionFixtureCertificate, checkFixtureCertificate
+	case FixtureNameCosBucket:
+		return provisionFixtureCosBucket, checkFixtureCosBucket
+	case FixtureNameInstance:
+		return provisionFixtureInstance, checkFixtureInstance
+	}
+	return nil, nil
+}
+
+var (
+	fixtureLock    sync.Mutex
+	fixtureClient  *connectivity.TencentCloudClient
+	fixtureAccount string
+	// fixtureChecked holds the IDs verified by this test binary by cache key, so each cached ID is checked once
+	fixtureChecked = make(map[string]string)
+)
+
+// Fixture returns the ID of the fixture named name in the test account and region, looking it up by tag or creating it
+// on first use. A cached ID is checked against the API once per test binary and provisioned again when it is gone. The
+// lookup and creation hold a lock file next to the cache, since `go test ./...` runs the test binaries of the packages in
+// parallel and each of them would create its own fixture otherwise. The test is skipped when acceptance tests are not enabled, since its configs are built before resource.Test
+// checks `TF_ACC`.
+func Fixture(t *testing.T, name string) string {
+	t.Helper()
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+
+	fixtureLock.Lock()
+	defer fixtureLock.Unlock()
+
+	id, err := fixture(context.Background(), name)
+	if err != nil {
+		t.Fatalf("provision fixture %s failed: %s", name, err.Error())
+	}
+	return id
+}
+
+// FixtureVpcId returns the ID of the fixture VPC, whose CIDR is DefaultVpcCidr.
+func FixtureVpcId(t *testing.T) string {
+	return Fixture(t, FixtureNameVpc)
+}
+
+// FixtureSubnetId returns the ID of the fixture subnet of the fixture VPC, in DefaultAZone with DefaultSubnetCidr.
+func FixtureSubnetId(t *testing.T) string {
+	return Fixture(t, FixtureNameSubnet)
+}
+
+// FixtureSecurityGroupId returns the ID of the fixture security group.
+func FixtureSecurityGroupId(t *testing.T) string {
+	return Fixture(t, FixtureNameSecurityGroup)
+}
+
+// FixtureCertificateId returns the ID of a self-signed server certificate, in place of DefaultSshCertificate.
+func FixtureCertificateId(t *testing.T) string {
+	return Fixture(t, FixtureNameCertificate)
+}
+
+// FixtureCertificateIdB returns the ID of another self-signed server certificate, in place of DefaultSshCertificateB.
+func FixtureCertificateIdB(t *testing.T) string {
+	return Fixture(t, FixtureNameCertificateB)
+}
+
+// FixtureCosBucket returns the name of the fixture COS bucket, which requires `TENCENTCLOUD_APPID`.
+func FixtureCosBucket(t *testing.T) string {
+	return Fixture(t, FixtureNameCosBucket)
+}
+
+// FixtureInstanceId returns the ID of the fixture CVM instance, a running pay-as-you-go instance in the fixture subnet
+// with the TAT agent, in place of DefaultInstanceId.
+func FixtureInstanceId(t *testing.T) string {
+	return Fixture(t, FixtureNameInstance)
+}
+
+// FixtureVpcVariable declares the fixture VPC, subnet and security group as the `vpc_id`, `subnet_id` and `sg_id`
+// variables of DefaultVpcVariable.
+func FixtureVpcVariable(t *testing.T) string {
+	return fmt.Sprintf(`
+variable "availability_zone" {
+  default = "%s"
+}
+
+variable "vpc_id" {
+  default = "%s"
+}
+
+variable "subnet_id" {
+  default = "%s"
+}
+
+variable "sg_id" {
+  default = "%s"
+}
+`, DefaultAZone, FixtureVpcId(t), FixtureSubnetId(t), FixtureSecurityGroupId(t))
+}
+
+// fixture must be called with fixtureLock held.
+func fixture(ctx context.Context, name string) (string, error) {
+	provision, check := fixtureOf(name)
+	if provision == nil {
+		return "", fmt.Errorf("unknown fixture %s", name)
+	}
+
+	client, err := fixtureApiClient()
+	if err != nil {
+		return "", err
+	}
+	account, err := fixtureAccountOf(client)
+	if err != nil {
+		return "", err
+	}
+
+	// the cache file is shared by every account and region the suites run in
+	key := account + "/" + client.Region + "/" + name
+	if id, ok := fixtureChecked[key]; ok {
+		return id, nil
+	}
+
+	unlock, err := lockFixtureCache()
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+
+	// read the cache again under the lock, another test binary may have provisioned the fixture meanwhile
+	cache := loadFixtureCache()
+	if id, ok := cache[key]; ok {
+		exists, err := check(ctx, client, id)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			fixtureChecked[key] = id
+			return id, nil
+		}
+		log.Printf("[WARN] Testing: cached fixture %s in %s is %s, which no longer exists", name, client.Region, id)
+		delete(cache, key)
+	}
+
+	id, err := provision(ctx, client, name)
+	if err != nil {
+		return "", err
+	}
+
+	log.Printf("[INFO] Testing: fixture %s in %s is %s", name, client.Region, id)
+	cache[key] = id
+	fixtureChecked[key] = id
+	saveFixtureCache(cache)
+	return id, nil
+}
+
+func fixtureApiClient() (*connectivity.TencentCloudClient, error) {
+	if fixtureClient != nil {
+		return fixtureClient, nil
+	}
+
+	region := os.Getenv(tcprovider.PROVIDER_REGION)
+	if region == "" {
+		region = DefaultRegion
+	}
+	client, err := SharedClientForRegion(region)
+	if err != nil {
+		return nil, err
+	}
+	fixtureClient = client.(*TencentCloudClient).GetAPIV3Conn()
+	return fixtureClient, nil
+}
+
+// fixtureAccountOf returns the uin of the account owning the resources of the test credentials.
+func fixtureAccountOf(client *connectivity.TencentCloudClient) (string, error) {
+	if fixtureAccount != "" {
+		return fixtureAccount, nil
+	}
+
+	response, err := client.UseCamClient().GetUserAppId(cam.NewGetUserAppIdRequest())
+	if err != nil {
+		return "", err
+	}
+	if response.Response.OwnerUin == nil || *response.Response.OwnerUin == "" {
+		return "", fmt.Errorf("get owner uin of the test credentials failed: empty response")
+	}
+	fixtureAccount = *response.Response.OwnerUin
+	return fixtureAccount, nil
+}
+
+func fixtureCachePath() string {
+	if v := os.Getenv(FixtureCacheEnv); v != "" {
+		return v
+	}
+	return filepath.Join(os.TempDir(), "tencentcloud-acc-fixtures.json")
+}
+
+// lockFixtureCache creates the lock file next to the cache, waiting while another test binary holds it, and returns the
+// function releasing it. A lock file older than fixtureLockStale was left by a killed binary and is taken over.
+func lockFixtureCache() (func(), error) {
+	path := fixtureCachePath() + ".lock"
+	deadline := time.Now().Add(fixtureLockTimeout)
+	for {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			_, _ = fmt.Fprintf(file, "%d\n", os.Getpid())
+			_ = file.Close()
+			return func() {
+				if err := os.Remove(path); err != nil {
+					log.Printf("[WARN] Testing: remove fixture cache lock %s failed: %s", path, err.Error())
+				}
+			}, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > fixtureLockStale {
+			log.Printf("[WARN] Testing: take over fixture cache lock %s left since %s", path, info.ModTime())
+			_ = os.Remove(path)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("wait for fixture cache lock %s timeout, remove it if no test is running", path)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// loadFixtureCache must be called with the lock file held.
+func loadFixtureCache() map[string]string {
+	cache := make(map[string]string)
+	raw, err := os.ReadFile(fixtureCachePath())
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(raw, &cache); err != nil {
+		log.Printf("[WARN] Testing: ignore broken fixture cache %s: %s", fixtureCachePath(), err.Error())
+		return make(map[string]string)
+	}
+	return cache
+}
+
+// saveFixtureCache must be called with the lock file held. It writes a temp file and renames it over the cache, so that a
+// reader never sees a partly written cache.
+func saveFixtureCache(cache map[string]string) {
+	path := fixtureCachePath()
+	raw, _ := json.MarshalIndent(cache, "", "  ")
+
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err == nil {
+		_, err = file.Write(raw)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err == nil {
+			err = os.Rename(file.Name(), path)
+		}
+		if err != nil {
+			_ = os.Remove(file.Name())
+		}
+	}
+	if err != nil {
+		log.Printf("[WARN] Testing: save fixture cache %s failed: %s", path, err.Error())
+	}
+}
+
+func fixtureTagFilter(name string) *vpc.Filter {
+	return &vpc.Filter{
+		Name:   helper.String("tag:" + FixtureTagKey),
+		Values: []*string{helper.String(name)},
+	}
+}
+
+func fixtureVpcTags(name string) []*vpc.Tag {
+	return []*vpc.Tag{{Key: helper.String(FixtureTagKey), Value: helper.String(name)}}
+}
+
+func provisionFixtureVpc(ctx context.Context, client *connectivity.TencentCloudClient, name string) (string, error) {
+	describe := vpc.NewDescribeVpcsRequest()
+	describe.Filters = []*vpc.Filter{fixtureTagFilter(name)}
+	result, err := client.UseVpcClient().DescribeVpcs(describe)
+	if err != nil {
+		return "", err
+	}
+	if len(result.Response.VpcSet) > 0 {
+		return *result.Response.VpcSet[0].VpcId, nil
+	}
+
+	request := vpc.NewCreateVpcRequest()
+	request.VpcName = helper.String(name)
+	request.CidrBlock = helper.String(DefaultVpcCidr)
+	request.Tags = fixtureVpcTags(name)
+	response, err := client.UseVpcClient().CreateVpc(request)
+	if err != nil {
+		return "", err
+	}
+	return *response.Response.Vpc.VpcId, nil
+}
+
+func checkFixtureVpc(ctx context.Context, client *connectivity.TencentCloudClient, id string) (bool, error) {
+	describe := vpc.NewDescribeVpcsRequest()
+	describe.Filters = []*vpc.Filter{{Name: helper.String("vpc-id"), Values: []*string{helper.String(id)}}}
+	result, err := client.UseVpcClient().DescribeVpcs(describe)
+	if err != nil {
+		return false, err
+	}
+	return len(result.Response.VpcSet) > 0, nil
+}
+
+func provisionFixtureSubnet(ctx context.Context, client *connectivity.TencentCloudClient, name string) (string, error) {
+	vpcId, err := fixture(ctx, FixtureNameVpc)
+	if err != nil {
+		return "", err
+	}
+
+	describe := vpc.NewDescribeSubnetsRequest()
+	describe.Filters = []*vpc.Filter{
+		fixtureTagFilter(name),
+		{Name: helper.String("vpc-id"), Values: []*string{helper.String(vpcId)}},
+	}
+	result, err := client.UseVpcClient().DescribeSubnets(describe)
+	if err != nil {
+		return "", err
+	}
+	if len(result.Response.SubnetSet) > 0 {
+		return *result.Response.SubnetSet[0].SubnetId, nil
+	}
+
+	request := vpc.NewCreateSubnetRequest()
+	request.VpcId = helper.String(vpcId)
+	request.SubnetName = helper.String(name)
+	request.CidrBlock = helper.String(DefaultSubnetCidr)
+	request.Zone = helper.String(DefaultAZone)
+	request.Tags = fixtureVpcTags(name)
+	response, err := client.UseVpcClient().CreateSubnet(request)
+	if err != nil {
+		return "", err
+	}
+	return *response.Response.Subnet.SubnetId, nil
+}
+
+func checkFixtureSubnet(ctx context.Context, client *connectivity.TencentCloudClient, id string) (bool, error) {
+	describe := vpc.NewDescribeSubnetsRequest()
+	describe.Filters = []*vpc.Filter{{Name: helper.String("subnet-id"), Values: []*string{helper.String(id)}}}
+	result, err := client.UseVpcClient().DescribeSubnets(describe)
+	if err != nil {
+		return false, err
+	}
+	return len(result.Response.SubnetSet) > 0, nil
+}
+
+func provisionFixtureSecurityGroup(ctx context.Context, client *connectivity.TencentCloudClient, name string) (string, error) {
+	describe := vpc.NewDescribeSecurityGroupsRequest()
+	describe.Filters = []*vpc.Filter{fixtureTagFilter(name)}
+	result, err := client.UseVpcClient().DescribeSecurityGroups(describe)
+	if err != nil {
+		return "", err
+	}
+	if len(result.Response.SecurityGroupSet) > 0 {
+		return *result.Response.SecurityGroupSet[0].SecurityGroupId, nil
+	}
+
+	request := vpc.NewCreateSecurityGroupRequest()
+	request.GroupName = helper.String(name)
+	request.GroupDescription = helper.String("acceptance test fixture, do not remove")
+	request.Tags = fixtureVpcTags(name)
+	response, err := client.UseVpcClient().CreateSecurityGroup(request)
+	if err != nil {
+		return "", err
+	}
+	return *response.Response.SecurityGroup.SecurityGroupId, nil
+}
+
+func checkFixtureSecurityGroup(ctx context.Context, client *connectivity.TencentCloudClient, id string) (bool, error) {
+	describe := vpc.NewDescribeSecurityGroupsRequest()
+	describe.Filters = []*vpc.Filter{{Name: helper.String("security-group-id"), Values: []*string{helper.String(id)}}}
+	result, err := client.UseVpcClient().DescribeSecurityGroups(describe)
+	if err != nil {
+		return false, err
+	}
+	return len(result.Response.SecurityGroupSet) > 0, nil
+}
+
+func provisionFixtureCertificate(ctx context.Context, client *connectivity.TencentCloudClient, name string) (string, error) {
+	describe := ssl.NewDescribeCertificatesRequest()
+	describe.SearchKey = helper.String(name)
+	describe.Tags = []*ssl.Tags{{TagKey: helper.String(FixtureTagKey), TagValue: helper.String(name)}}
+	result, err := client.UseSSLCertificateClient().DescribeCertificates(describe)
+	if err != nil {
+		return "", err
+	}
+	for _, certificate := range result.Response.Certificates {
+		if certificate.Alias != nil && *certificate.Alias == name {
+			return *certificate.CertificateId, nil
+		}
+	}
+
+	publicKey, privateKey, err := selfSignedCertificate(name + ".example.com")
+	if err != nil {
+		return "", err
+	}
+	request := ssl.NewUploadCertificateRequest()
+	request.Alias = helper.String(name)
+	request.CertificateType = helper.String("SVR")
+	request.CertificatePublicKey = helper.String(publicKey)
+	request.CertificatePrivateKey = helper.String(privateKey)
+	request.Tags = describe.Tags
+	response, err := client.UseSSLCertificateClient().UploadCertificate(request)
+	if err != nil {
+		return "", err
+	}
+	return *response.Response.CertificateId, nil
+}
+
+func checkFixtureCertificate(ctx context.Context, client *connectivity.TencentCloudClient, id string) (bool, error) {
+	describe := ssl.NewDescribeCertificatesRequest()
+	describe.SearchKey = helper.String(id)
+	result, err := client.UseSSLCertificateClient().DescribeCertificates(describe)
+	if err != nil {
+		return false, err
+	}
+	for _, certificate := range result.Response.Certificates {
+		if certificate.CertificateId != nil && *certificate.CertificateId == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func provisionFixtureCosBucket(ctx context.Context, client *connectivity.TencentCloudClient, name string) (string, error) {
+	if Appid == "" {
+		return "", fmt.Errorf("TENCENTCLOUD_APPID must be set for the fixture COS bucket")
+	}
+
+	bucket := fmt.Sprintf("%s-%s", name, Appid)
+	cosClient := client.UseTencentCosClient(bucket)
+	_, err := cosClient.Bucket.Head(ctx)
+	if err == nil {
+		return bucket, nil
+	}
+	if !cos.IsNotFoundError(err) {
+		return "", err
+	}
+
+	if _, err := cosClient.Bucket.Put(ctx, nil); err != nil {
+		return "", err
+	}
+	tagging := &cos.BucketPutTaggingOptions{TagSet: []cos.BucketTaggingTag{{Key: FixtureTagKey, Value: name}}}
+	if _, err := cosClient.Bucket.PutTagging(ctx, tagging); err != nil {
+		return "", err
+	}
+	return bucket, nil
+}
+
+func checkFixtureCosBucket(ctx context.Context, client *connectivity.TencentCloudClient, id string) (bool, error) {
+	_, err := client.UseTencentCosClient(id).Bucket.Head(ctx)
+	if err == nil {
+		return true, nil
+	}
+	if cos.IsNotFoundError(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+func provisionFixtureInstance(ctx context.Context, client *connectivity.TencentCloudClient, name string) (string, error) {
+	subnetId, err := fixture(ctx, FixtureNameSubnet)
+	if err != nil {
+		return "", err
+	}
+	vpcId, err := fixture(ctx, FixtureNameVpc)
+	if err != nil {
+		return "", err
+	}
+
+	describe := cvm.NewDescribeInstancesRequest()
+	describe.Filters = []*cvm.Filter{{Name: helper.String("tag:" + FixtureTagKey), Values: []*string{helper.String(name)}}}
+	result, err := client.UseCvmClient().DescribeInstances(describe)
+	if err != nil {
+		return "", err
+	}
+	for _, instance := range result.Response.InstanceSet {
+		switch *instance.InstanceState {
+		case "SHUTDOWN", "TERMINATING":
+			continue
+		case "STOPPED":
+			start := cvm.NewStartInstancesRequest()
+			start.InstanceIds = []*string{instance.InstanceId}
+			if _, err := client.UseCvmClient().StartInstances(start); err != nil {
+				return "", err
+			}
+		}
+		return *instance.InstanceId, waitFixtureInstanceRunning(client, *instance.InstanceId)
+	}
+
+	imageId, err := fixtureInstanceImage(client)
+	if err != nil {
+		return "", err
+	}
+	instanceType, err := fixtureInstanceType(client)
+	if err != nil {
+		return "", err
+	}
+
+	request := cvm.NewRunInstancesRequest()
+	request.InstanceChargeType = helper.String("POSTPAID_BY_HOUR")
+	request.Placement = &cvm.Placement{Zone: helper.String(DefaultAZone)}
+	request.InstanceType = helper.String(instanceType)
+	request.ImageId = helper.String(imageId)
+	request.SystemDisk = &cvm.SystemDisk{DiskType: helper.String("CLOUD_PREMIUM"), DiskSize: helper.IntInt64(50)}
+	request.VirtualPrivateCloud = &cvm.VirtualPrivateCloud{VpcId: helper.String(vpcId), SubnetId: helper.String(subnetId)}
+	request.InstanceName = helper.String(name)
+	request.EnhancedService = &cvm.EnhancedService{AutomationService: &cvm.RunAutomationServiceEnabled{Enabled: helper.Bool(true)}}
+	request.TagSpecification = []*cvm.TagSpecification{{
+		ResourceType: helper.String("instance"),
+		Tags:         []*cvm.Tag{{Key: helper.String(FixtureTagKey), Value: helper.String(name)}},
+	}}
+	response, err := client.UseCvmClient().RunInstances(request)
+	if err != nil {
+		return "", err
+	}
+	if len(response.Response.InstanceIdSet) == 0 {
+		return "", fmt.Errorf("run fixture instance %s failed: empty instance ID", name)
+	}
+	instanceId := *response.Response.InstanceIdSet[0]
+	return instanceId, waitFixtureInstanceRunning(client, instanceId)
+}
+
+func checkFixtureInstance(ctx context.Context, client *connectivity.TencentCloudClient, id string) (bool, error) {
+	state, err := fixtureInstanceState(client, id)
+	if err != nil {
+		return false, err
+	}
+	// a stopped fixture instance is started again by provisionFixtureInstance
+	return state == "RUNNING", nil
+}
+
+func fixtureInstanceState(client *connectivity.TencentCloudClient, id string) (string, error) {
+	describe := cvm.NewDescribeInstancesRequest()
+	describe.Filters = []*cvm.Filter{{Name: helper.String("instance-id"), Values: []*string{helper.String(id)}}}
+	result, err := client.UseCvmClient().DescribeInstances(describe)
+	if err != nil {
+		return "", err
+	}
+	if len(result.Response.InstanceSet) == 0 || result.Response.InstanceSet[0].InstanceState == nil {
+		return "", nil
+	}
+	return *result.Response.InstanceSet[0].InstanceState, nil
+}
+
+func waitFixtureInstanceRunning(client *connectivity.TencentCloudClient, id string) error {
+	return resource.Retry(10*time.Minute, func() *resource.RetryError {
+		state, err := fixtureInstanceState(client, id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if state != "RUNNING" {
+			return resource.RetryableError(fmt.Errorf("fixture instance %s is %s, waiting for RUNNING", id, state))
+		}
+		return nil
+	})
+}
+
+// fixtureInstanceImage returns a public x86_64 TencentOS image, which ships the TAT agent.
+func fixtureInstanceImage(client *connectivity.TencentCloudClient) (string, error) {
+	request := cvm.NewDescribeImagesRequest()
+	request.Filters = []*cvm.Filter{
+		{Name: helper.String("image-type"), Values: []*string{helper.String("PUBLIC_IMAGE")}},
+		{Name: helper.String("platform"), Values: []*string{helper.String("TencentOS")}},
+	}
+	result, err := client.UseCvmClient().DescribeImages(request)
+	if err != nil {
+		return "", err
+	}
+	for _, image := range result.Response.ImageSet {
+		if image.Architecture != nil && *image.Architecture == "x86_64" {
+			return *image.ImageId, nil
+		}
+	}
+	return "", fmt.Errorf("no public TencentOS image found for the fixture instance")
+}
+
+// fixtureInstanceType returns the smallest pay-as-you-go x86 standard instance type on sale in DefaultAZone.
+func fixtureInstanceType(client *connectivity.TencentCloudClient) (string, error) {
+	request := cvm.NewDescribeZoneInstanceConfigInfosRequest()
+	request.Filters = []*cvm.Filter{
+		{Name: helper.String("zone"), Values: []*string{helper.String(DefaultAZone)}},
+		{Name: helper.String("instance-charge-type"), Values: []*string{helper.String("POSTPAID_BY_HOUR")}},
+		{Name: helper.String("instance-family"), Values: helper.Strings([]string{"S5", "S6", "SA2", "SA3"})},
+	}
+	result, err := client.UseCvmClient().DescribeZoneInstanceConfigInfos(request)
+	if err != nil {
+		return "", err
+	}
+
+	var items []*cvm.InstanceTypeQuotaItem
+	for _, item := range result.Response.InstanceTypeQuotaSet {
+		if item.Status != nil && *item.Status == "SELL" && item.Cpu != nil && item.Memory != nil {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return "", fmt.Errorf("no instance type on sale in %s for the fixture instance", DefaultAZone)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if *items[i].Cpu != *items[j].Cpu {
+			return *items[i].Cpu < *items[j].Cpu
+		}
+		return *items[i].Memory < *items[j].Memory
+	})
+	return *items[0].InstanceType, nil
+}
+
+// selfSignedCertificate returns a PEM encoded certificate and RSA private key for domain, valid for ten years.
+func selfSignedCertificate(domain string) (publicKey, privateKey string, err error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: domain},
+		DNSNames:     []string{domain},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(10, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return
+	}
+
+	publicKey = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	privateKey = string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	return
+}
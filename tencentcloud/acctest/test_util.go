@@ -1,12 +1,14 @@
 package acctest
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -20,6 +22,18 @@ import (
 var AccProviders map[string]*schema.Provider
 var AccProvider *schema.Provider
 
+// AccProtoV5ProviderFactories serves the muxed provider, use it instead of AccProviders to test
+// provider-defined functions and the resources of the Plugin Framework provider
+var AccProtoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"tencentcloud": func() (tfprotov5.ProviderServer, error) {
+		serverFactory, err := tcprovider.ProtoV5ProviderServerFactory(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return serverFactory(), nil
+	},
+}
+
 const (
 	ACCOUNT_TYPE_INTERNATIONAL        = "INTERNATIONAL"
 	ACCOUNT_TYPE_PREPAY               = "PREPAY"
@@ -322,8 +322,13 @@ func IsString(data interface{}) bool {
 	return false
 }
 
-// WriteToFile write data to file
-func WriteToFile(filePath string, data interface{}) error {
+// WriteToFile write data to file, as json unless options says otherwise
+func WriteToFile(filePath string, data interface{}, options ...ResultOutputOptions) error {
+	var option ResultOutputOptions
+	if len(options) > 0 {
+		option = options[0]
+	}
+
 	if strings.HasPrefix(filePath, "~") {
 		usr, err := user.Current()
 		if err != nil {
@@ -334,18 +339,24 @@ func WriteToFile(filePath string, data interface{}) error {
 		}
 	}
 
+	var content []byte
+	if IsString(data) {
+		content = []byte(data.(string))
+	} else {
+		var err error
+		content, err = MarshalResultOutput(data, option)
+		if err != nil {
+			return err
+		}
+	}
+
 	fileInfo, err := os.Stat(filePath)
 	if err != nil && !os.IsNotExist(err) {
 		return fmt.Errorf("stat old file error,reason %s", err.Error())
 	}
 
-	if !os.IsNotExist(err) {
-		if fileInfo.IsDir() {
-			return fmt.Errorf("old filepath is a dir,can not delete")
-		}
-		if err := os.Remove(filePath); err != nil {
-			return fmt.Errorf("delete old file error,reason %s", err.Error())
-		}
+	if !os.IsNotExist(err) && fileInfo.IsDir() {
+		return fmt.Errorf("old filepath is a dir,can not delete")
 	}
 
 	dir := filepath.Dir(filePath)
@@ -353,16 +364,17 @@ func WriteToFile(filePath string, data interface{}) error {
 		return fmt.Errorf("create directory error, reason: %s", err.Error())
 	}
 
-	if IsString(data) {
-		return ioutil.WriteFile(filePath, []byte(data.(string)), 0422)
+	if option.Atomic {
+		return writeFileAtomic(filePath, content, 0422)
 	}
 
-	jsonStr, err := json.MarshalIndent(data, "", "\t")
-	if err != nil {
-		return fmt.Errorf("json decode error,reason %s", err.Error())
+	if !os.IsNotExist(err) {
+		if err := os.Remove(filePath); err != nil {
+			return fmt.Errorf("delete old file error,reason %s", err.Error())
+		}
 	}
 
-	return ioutil.WriteFile(filePath, jsonStr, 0422)
+	return ioutil.WriteFile(filePath, content, 0422)
 }
 
 // ReadFromFile return file content
@@ -1,11 +1,19 @@
 package common
 
 import (
+	"bytes"
 	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -13,6 +21,22 @@ const (
 	SweeperNonKeepResourceScanDir = "../../../tmp/non_keep_resource_scan/"
 )
 
+const (
+	RESULT_OUTPUT_FORMAT_JSON = "json"
+	RESULT_OUTPUT_FORMAT_YAML = "yaml"
+	RESULT_OUTPUT_FORMAT_CSV  = "csv"
+)
+
+var ResultOutputFormats = []string{RESULT_OUTPUT_FORMAT_JSON, RESULT_OUTPUT_FORMAT_YAML, RESULT_OUTPUT_FORMAT_CSV}
+
+// ResultOutputOptions controls how WriteToFile writes a data source's `result_output_file`,
+// the zero value writes json in the order of the data
+type ResultOutputOptions struct {
+	Format string
+	Atomic bool
+	Sort   bool
+}
+
 var ResourceScanHeader = []string{"资源类型", "资源名称", "实例ID", "实例名称", "分类", "创建时长(天)", "创建者用户ID", "创建者用户名"}
 var NonKeepResourceScanHeader = []string{"ResourceType", "ResourceName", "InstanceId", "InstanceName", "PrincipalId", "UserName"}
 
@@ -88,3 +112,210 @@ func GenerateCsvFile(filePath string, header []string) error {
 
 	return nil
 }
+
+// AddResultOutputOptions adds `result_output_format`, `result_output_atomic` and `result_output_sort` to a data source
+// supporting `result_output_file`, its read passes them to WriteToFile with GetResultOutputOptions.
+func AddResultOutputOptions(r *schema.Resource) {
+	if _, ok := r.Schema["result_output_file"]; !ok {
+		return
+	}
+
+	r.Schema["result_output_format"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: ValidateAllowedStringValue(ResultOutputFormats),
+		Description:  "Format of `result_output_file`. Valid values: `json`, `yaml`, `csv`. Default value: `json`.",
+	}
+	r.Schema["result_output_atomic"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether to write `result_output_file` to a temporary file and rename it into place, so that concurrent runs never leave a partially written file.",
+	}
+	r.Schema["result_output_sort"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether to sort the top level list of `result_output_file`, so that the file does not change with the order the API returns. The order of the data source is kept by default.",
+	}
+}
+
+// GetResultOutputOptions returns the result output options set on a data source, see AddResultOutputOptions.
+func GetResultOutputOptions(d *schema.ResourceData) ResultOutputOptions {
+	options := ResultOutputOptions{}
+	if v, ok := d.GetOk("result_output_format"); ok {
+		options.Format = v.(string)
+	}
+	if v, ok := d.GetOk("result_output_atomic"); ok {
+		options.Atomic = v.(bool)
+	}
+	if v, ok := d.GetOk("result_output_sort"); ok {
+		options.Sort = v.(bool)
+	}
+	return options
+}
+
+// MarshalResultOutput encodes data as options describes, top level lists are sorted only when options.Sort is set.
+func MarshalResultOutput(data interface{}, options ResultOutputOptions) ([]byte, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("json decode error,reason %s", err.Error())
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("json decode error,reason %s", err.Error())
+	}
+	if list, ok := value.([]interface{}); ok && options.Sort {
+		sortResultOutputList(list)
+	}
+
+	switch options.Format {
+	case RESULT_OUTPUT_FORMAT_YAML:
+		content, err := yaml.Marshal(yamlValue(value))
+		if err != nil {
+			return nil, fmt.Errorf("yaml encode error,reason %s", err.Error())
+		}
+		return content, nil
+	case RESULT_OUTPUT_FORMAT_CSV:
+		return csvContent(value)
+	default:
+		return json.MarshalIndent(value, "", "\t")
+	}
+}
+
+func sortResultOutputList(list []interface{}) {
+	keys := make([]string, len(list))
+	for i, item := range list {
+		b, _ := json.Marshal(item)
+		keys[i] = string(b)
+	}
+	sort.Stable(resultOutputList{items: list, keys: keys})
+}
+
+type resultOutputList struct {
+	items []interface{}
+	keys  []string
+}
+
+func (l resultOutputList) Len() int           { return len(l.items) }
+func (l resultOutputList) Less(i, j int) bool { return l.keys[i] < l.keys[j] }
+func (l resultOutputList) Swap(i, j int) {
+	l.items[i], l.items[j] = l.items[j], l.items[i]
+	l.keys[i], l.keys[j] = l.keys[j], l.keys[i]
+}
+
+// yamlValue turns json numbers back into numbers, yaml would quote them as strings otherwise
+func yamlValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			value[k] = yamlValue(item)
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = yamlValue(item)
+		}
+		return value
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			return i
+		}
+		if f, err := value.Float64(); err == nil {
+			return f
+		}
+		return value.String()
+	default:
+		return value
+	}
+}
+
+// csvContent writes one row per list element and one column per key, nested values are json encoded
+func csvContent(v interface{}) ([]byte, error) {
+	var rows []interface{}
+	switch value := v.(type) {
+	case []interface{}:
+		rows = value
+	default:
+		rows = []interface{}{value}
+	}
+
+	columnSet := make(map[string]bool)
+	for _, row := range rows {
+		if m, ok := row.(map[string]interface{}); ok {
+			for k := range m {
+				columnSet[k] = true
+			}
+		}
+	}
+	columns := make([]string, 0, len(columnSet))
+	for k := range columnSet {
+		columns = append(columns, k)
+	}
+	sort.Strings(columns)
+	if len(columns) == 0 {
+		columns = []string{"value"}
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(columns); err != nil {
+		return nil, fmt.Errorf("csv encode error,reason %s", err.Error())
+	}
+	for _, row := range rows {
+		record := make([]string, len(columns))
+		if m, ok := row.(map[string]interface{}); ok {
+			for i, column := range columns {
+				record[i] = csvCell(m[column])
+			}
+		} else {
+			record[0] = csvCell(row)
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, fmt.Errorf("csv encode error,reason %s", err.Error())
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("csv encode error,reason %s", err.Error())
+	}
+	return buf.Bytes(), nil
+}
+
+func csvCell(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	case bool:
+		return fmt.Sprintf("%t", value)
+	default:
+		b, _ := json.Marshal(value)
+		return string(b)
+	}
+}
+
+// writeFileAtomic writes content to a temporary file next to filePath and renames it into place
+func writeFileAtomic(filePath string, content []byte, perm os.FileMode) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(filePath), "."+filepath.Base(filePath)+".tmp-")
+	if err != nil {
+		return fmt.Errorf("create temporary file error, reason: %s", err.Error())
+	}
+	tmpName := tmp.Name()
+	defer os.Remove(tmpName)
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write temporary file error, reason: %s", err.Error())
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close temporary file error, reason: %s", err.Error())
+	}
+	if err := os.Chmod(tmpName, perm); err != nil {
+		return fmt.Errorf("chmod temporary file error, reason: %s", err.Error())
+	}
+	return os.Rename(tmpName, filePath)
+}
@@ -0,0 +1,112 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+var resultOutputData = []map[string]interface{}{
+	{"zone": "ap-guangzhou-4", "instance_type": "S5.SMALL2", "cpu": 1, "tags": []string{"b"}},
+	{"zone": "ap-guangzhou-3", "instance_type": "S5.MEDIUM4", "cpu": 2, "tags": []string{"a"}},
+}
+
+func TestMarshalResultOutput(t *testing.T) {
+	content, err := MarshalResultOutput(resultOutputData, ResultOutputOptions{Format: RESULT_OUTPUT_FORMAT_JSON})
+	assert.NoError(t, err)
+	assert.Equal(t, `[
+	{
+		"cpu": 1,
+		"instance_type": "S5.SMALL2",
+		"tags": [
+			"b"
+		],
+		"zone": "ap-guangzhou-4"
+	},
+	{
+		"cpu": 2,
+		"instance_type": "S5.MEDIUM4",
+		"tags": [
+			"a"
+		],
+		"zone": "ap-guangzhou-3"
+	}
+]`, string(content))
+
+	content, err = MarshalResultOutput(resultOutputData, ResultOutputOptions{Format: RESULT_OUTPUT_FORMAT_YAML})
+	assert.NoError(t, err)
+	assert.Equal(t, `- cpu: 1
+  instance_type: S5.SMALL2
+  tags:
+  - b
+  zone: ap-guangzhou-4
+- cpu: 2
+  instance_type: S5.MEDIUM4
+  tags:
+  - a
+  zone: ap-guangzhou-3
+`, string(content))
+
+	content, err = MarshalResultOutput(resultOutputData, ResultOutputOptions{Format: RESULT_OUTPUT_FORMAT_CSV})
+	assert.NoError(t, err)
+	assert.Equal(t, `cpu,instance_type,tags,zone
+1,S5.SMALL2,"[""b""]",ap-guangzhou-4
+2,S5.MEDIUM4,"[""a""]",ap-guangzhou-3
+`, string(content))
+
+	content, err = MarshalResultOutput([]string{"ins-2", "ins-1"}, ResultOutputOptions{Format: RESULT_OUTPUT_FORMAT_CSV})
+	assert.NoError(t, err)
+	assert.Equal(t, "value\nins-2\nins-1\n", string(content))
+
+	content, err = MarshalResultOutput([]string{"ins-2", "ins-1"}, ResultOutputOptions{Format: RESULT_OUTPUT_FORMAT_CSV, Sort: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "value\nins-1\nins-2\n", string(content))
+}
+
+func TestWriteToFileResultOutputOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "result_output")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	read := func(d *schema.ResourceData, meta interface{}) error {
+		return WriteToFile(d.Get("result_output_file").(string), resultOutputData, GetResultOutputOptions(d))
+	}
+	r := &schema.Resource{
+		Read: read,
+		Schema: map[string]*schema.Schema{
+			"result_output_file": {Type: schema.TypeString, Optional: true},
+		},
+	}
+	AddResultOutputOptions(r)
+	assert.Contains(t, r.Schema, "result_output_format")
+	assert.Contains(t, r.Schema, "result_output_atomic")
+	assert.Contains(t, r.Schema, "result_output_sort")
+
+	filePath := filepath.Join(dir, "types.out")
+	for i := 0; i < 2; i++ {
+		d := r.TestResourceData()
+		assert.NoError(t, d.Set("result_output_file", filePath))
+		assert.NoError(t, d.Set("result_output_format", RESULT_OUTPUT_FORMAT_CSV))
+		assert.NoError(t, d.Set("result_output_atomic", true))
+		assert.NoError(t, r.Read(d, nil))
+	}
+
+	content, err := ioutil.ReadFile(filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, "cpu,instance_type,tags,zone\n", string(content)[:len("cpu,instance_type,tags,zone\n")])
+
+	files, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	// without options the file is json whatever its extension
+	yamlPath := filepath.Join(dir, "types.yaml")
+	assert.NoError(t, WriteToFile(yamlPath, resultOutputData))
+	content, err = ioutil.ReadFile(yamlPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "[\n\t{\n", string(content)[:len("[\n\t{\n")])
+}
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// OPERATION_TRIGGERS names the map argument of operation resources which runs the operation again when it changes
+	OPERATION_TRIGGERS = "triggers"
+	// OPERATION_WAIT_FOR_COMPLETION names the argument of operation resources which waits for the async task of the operation
+	OPERATION_WAIT_FOR_COMPLETION = "wait_for_completion"
+)
+
+// OperationTriggersSchema is the `triggers` argument shared by operation resources, such as `tencentcloud_cvm_reboot_instance`,
+// which run only once on create. Changing any value replaces the resource and so runs the operation again, without tainting it.
+func OperationTriggersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		ForceNew:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Arbitrary map of values that, when changed, will run the operation again by replacing the resource.",
+	}
+}
+
+// OperationWaitForCompletionSchema is the `wait_for_completion` argument shared by operation resources started as an async task.
+// It has no default so that adding it to an existing resource does not plan a change, unset is treated as `true`.
+// It only controls the wait on create, so changing it is applied in place and does not run the operation again.
+func OperationWaitForCompletionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeBool,
+		Optional:         true,
+		DiffSuppressFunc: operationWaitForCompletionDiffSuppress,
+		Description:      "Whether to poll the async task of the operation until it finishes. Default is `true`, set it to `false` to return as soon as the operation is submitted.",
+	}
+}
+
+// operationWaitForCompletionDiffSuppress ignores changes between unset and `true`, which both wait for the operation.
+func operationWaitForCompletionDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	unsetOrTrue := func(v string) bool {
+		return v == "" || v == "true"
+	}
+	return unsetOrTrue(old) && unsetOrTrue(new)
+}
+
+// OperationWaitForCompletion reports whether Create of an operation resource should wait for the async task of the operation,
+// which it does unless `wait_for_completion` is set to false.
+func OperationWaitForCompletion(d *schema.ResourceData) bool {
+	wait, ok := d.GetOkExists(OPERATION_WAIT_FOR_COMPLETION)
+	return !ok || wait.(bool)
+}
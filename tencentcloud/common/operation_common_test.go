@@ -0,0 +1,36 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationWaitForCompletion(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			OPERATION_TRIGGERS:            OperationTriggersSchema(),
+			OPERATION_WAIT_FOR_COMPLETION: OperationWaitForCompletionSchema(),
+		},
+	}
+	assert.NoError(t, r.InternalValidate(nil, true))
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	assert.True(t, OperationWaitForCompletion(d))
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{OPERATION_WAIT_FOR_COMPLETION: false})
+	assert.False(t, OperationWaitForCompletion(d))
+
+	// resources without the argument never skip waiting
+	d = (&schema.Resource{Schema: map[string]*schema.Schema{}}).TestResourceData()
+	assert.True(t, OperationWaitForCompletion(d))
+}
+
+func TestOperationWaitForCompletionDiffSuppress(t *testing.T) {
+	// state written before the argument existed has no value, which must not replace the resource
+	assert.True(t, operationWaitForCompletionDiffSuppress(OPERATION_WAIT_FOR_COMPLETION, "", "true", nil))
+	assert.True(t, operationWaitForCompletionDiffSuppress(OPERATION_WAIT_FOR_COMPLETION, "true", "", nil))
+	assert.False(t, operationWaitForCompletionDiffSuppress(OPERATION_WAIT_FOR_COMPLETION, "", "false", nil))
+	assert.False(t, operationWaitForCompletionDiffSuppress(OPERATION_WAIT_FOR_COMPLETION, "false", "true", nil))
+}
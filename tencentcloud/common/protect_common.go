@@ -0,0 +1,169 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// PROTECTED_TAG_ANY_VALUE as a `protected_tags` value protects every resource carrying the key
+const PROTECTED_TAG_ANY_VALUE = "*"
+
+// AddProtectedTagsGuard refuses to replace or delete r while its tags match the provider's `protected_tags`.
+// Replacement is caught in CustomizeDiff at plan time, deletion is checked again in Delete as a backstop since
+// CustomizeDiff does not run for destroy plans.
+func AddProtectedTagsGuard(r *schema.Resource) {
+	if _, ok := r.Schema["tags"]; !ok {
+		return
+	}
+
+	guard := func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+		oldTags, _ := d.GetChange("tags")
+		tag := matchProtectedTags(flattenTagsValue(oldTags), protectedTags(meta))
+		if tag == "" {
+			return nil
+		}
+		for _, key := range d.GetChangedKeysPrefix("") {
+			if isForceNewKey(r.Schema, key) {
+				return fmt.Errorf("resource %s is protected by tag `%s`, refusing to replace it because `%s` forces a new resource. Remove the tag first if the replacement is intended", d.Id(), tag, key)
+			}
+		}
+		return nil
+	}
+	if r.CustomizeDiff != nil {
+		r.CustomizeDiff = customdiff.All(r.CustomizeDiff, guard)
+	} else {
+		r.CustomizeDiff = guard
+	}
+
+	check := func(d *schema.ResourceData, meta interface{}) error {
+		tag := matchProtectedTags(flattenTagsValue(d.Get("tags")), protectedTags(meta))
+		if tag == "" {
+			return nil
+		}
+		log.Printf("[CRITAL] refuse to delete resource %s protected by tag %s", d.Id(), tag)
+		return fmt.Errorf("resource %s is protected by tag `%s`, refusing to delete it. Remove the tag first if the deletion is intended", d.Id(), tag)
+	}
+
+	if del := r.Delete; del != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			if err := check(d, meta); err != nil {
+				return err
+			}
+			return del(d, meta)
+		}
+	}
+	if del := r.DeleteContext; del != nil {
+		r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := check(d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+			return del(ctx, d, meta)
+		}
+	}
+	if del := r.DeleteWithoutTimeout; del != nil {
+		r.DeleteWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if err := check(d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+			return del(ctx, d, meta)
+		}
+	}
+}
+
+func protectedTags(meta interface{}) map[string]string {
+	if m, ok := meta.(ProtectedTagsMeta); ok {
+		return m.GetProtectedTags()
+	}
+	return nil
+}
+
+// matchProtectedTags returns the first protected `key=value` found in tags, or empty if none matches
+func matchProtectedTags(tags, protected map[string]string) string {
+	keys := make([]string, 0, len(protected))
+	for k := range protected {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v, ok := tags[k]
+		if !ok {
+			continue
+		}
+		if protected[k] == PROTECTED_TAG_ANY_VALUE || protected[k] == v {
+			return fmt.Sprintf("%s=%s", k, v)
+		}
+	}
+	return ""
+}
+
+// flattenTagsValue reads `tags` as a map, or as a list of `key`/`value` or `tag_key`/`tag_value` blocks
+func flattenTagsValue(v interface{}) map[string]string {
+	tags := make(map[string]string)
+	var items []interface{}
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			if s, ok := item.(string); ok {
+				tags[k] = s
+			}
+		}
+		return tags
+	case []interface{}:
+		items = value
+	case *schema.Set:
+		items = value.List()
+	}
+
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, names := range [][2]string{{"key", "value"}, {"tag_key", "tag_value"}} {
+			key, _ := m[names[0]].(string)
+			value, _ := m[names[1]].(string)
+			if key != "" {
+				tags[key] = value
+			}
+		}
+	}
+	return tags
+}
+
+// isForceNewKey reports whether the flatmap key, such as `data_disks.0.data_disk_size`, falls under a ForceNew attribute
+func isForceNewKey(schemaMap map[string]*schema.Schema, key string) bool {
+	parts := strings.Split(key, ".")
+	for i := 0; i < len(parts); i++ {
+		s, ok := schemaMap[parts[i]]
+		if !ok {
+			return false
+		}
+		if s.ForceNew {
+			return true
+		}
+		elem, ok := s.Elem.(*schema.Resource)
+		if !ok {
+			return false
+		}
+		// skip the list index or set hash
+		if i+1 < len(parts) {
+			if _, err := strconv.Atoi(parts[i+1]); err == nil || parts[i+1] == "#" {
+				i++
+			}
+		}
+		schemaMap = elem.Schema
+	}
+	return false
+}
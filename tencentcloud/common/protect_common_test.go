@@ -0,0 +1,121 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+type protectedTagsMeta map[string]string
+
+func (m protectedTagsMeta) GetProtectedTags() map[string]string { return m }
+
+func newProtectedResource(deleted *bool) *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {Type: schema.TypeString, Optional: true},
+			"zone": {Type: schema.TypeString, Optional: true, ForceNew: true},
+			"disks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {Type: schema.TypeInt, Optional: true},
+						"type": {Type: schema.TypeString, Optional: true, ForceNew: true},
+					},
+				},
+			},
+			"tags": {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			*deleted = true
+			return nil
+		},
+	}
+	AddProtectedTagsGuard(r)
+	return r
+}
+
+func TestProtectedTagsGuardDiff(t *testing.T) {
+	var deleted bool
+	r := newProtectedResource(&deleted)
+	meta := protectedTagsMeta{"env": "prod", "keep": PROTECTED_TAG_ANY_VALUE}
+	state := &terraform.InstanceState{
+		ID: "ins-1",
+		Attributes: map[string]string{
+			"id":           "ins-1",
+			"name":         "a",
+			"zone":         "ap-guangzhou-3",
+			"disks.#":      "1",
+			"disks.0.size": "50",
+			"disks.0.type": "CLOUD_SSD",
+			"tags.%":       "1",
+			"tags.env":     "prod",
+		},
+	}
+	config := func(raw map[string]interface{}) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(raw)
+	}
+	base := func() map[string]interface{} {
+		return map[string]interface{}{
+			"name":  "a",
+			"zone":  "ap-guangzhou-3",
+			"disks": []interface{}{map[string]interface{}{"size": 50, "type": "CLOUD_SSD"}},
+			"tags":  map[string]interface{}{"env": "prod"},
+		}
+	}
+
+	raw := base()
+	raw["name"] = "b"
+	_, err := r.Diff(context.Background(), state, config(raw), meta)
+	assert.NoError(t, err)
+
+	raw = base()
+	raw["zone"] = "ap-guangzhou-4"
+	_, err = r.Diff(context.Background(), state, config(raw), meta)
+	assert.ErrorContains(t, err, "`zone` forces a new resource")
+
+	raw = base()
+	raw["disks"] = []interface{}{map[string]interface{}{"size": 50, "type": "CLOUD_PREMIUM"}}
+	_, err = r.Diff(context.Background(), state, config(raw), meta)
+	assert.ErrorContains(t, err, "`disks.0.type` forces a new resource")
+
+	// removing the tag in the same plan does not lift the protection
+	raw = base()
+	raw["zone"] = "ap-guangzhou-4"
+	raw["tags"] = map[string]interface{}{}
+	_, err = r.Diff(context.Background(), state, config(raw), meta)
+	assert.Error(t, err)
+
+	raw = base()
+	raw["zone"] = "ap-guangzhou-4"
+	_, err = r.Diff(context.Background(), state, config(raw), protectedTagsMeta{"env": "test"})
+	assert.NoError(t, err)
+}
+
+func TestProtectedTagsGuardDelete(t *testing.T) {
+	var deleted bool
+	r := newProtectedResource(&deleted)
+
+	d := r.TestResourceData()
+	d.SetId("ins-1")
+	assert.NoError(t, d.Set("tags", map[string]interface{}{"keep": "anything"}))
+	err := r.Delete(d, protectedTagsMeta{"keep": PROTECTED_TAG_ANY_VALUE})
+	assert.ErrorContains(t, err, "protected by tag `keep=anything`")
+	assert.False(t, deleted)
+
+	err = r.Delete(d, protectedTagsMeta{"keep": "other"})
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+}
+
+func TestFlattenTagsValue(t *testing.T) {
+	assert.Equal(t, map[string]string{"a": "1"}, flattenTagsValue(map[string]interface{}{"a": "1"}))
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, flattenTagsValue([]interface{}{
+		map[string]interface{}{"key": "a", "value": "1"},
+		map[string]interface{}{"tag_key": "b", "tag_value": "2"},
+	}))
+}
@@ -9,3 +9,9 @@ type ProviderMeta interface {
 	// GetAPIV3Conn 返回访问云 API 的客户端连接对象
 	GetAPIV3Conn() *connectivity.TencentCloudClient
 }
+
+// ProtectedTagsMeta 提供 provider 级别的受保护标签
+type ProtectedTagsMeta interface {
+	// GetProtectedTags 返回 protected_tags 配置，标签匹配的资源拒绝删除和替换
+	GetProtectedTags() map[string]string
+}
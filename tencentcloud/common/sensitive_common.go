@@ -0,0 +1,102 @@
+package common
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// credentialSuffixes are the name endings of attributes carrying a credential
+var credentialSuffixes = []string{
+	"password",
+	"passwd",
+	"pwd",
+	"password_wo",
+	"secret",
+	"secret_key",
+	"secret_access_key",
+	"secret_string",
+	"secret_string_wo",
+	"secret_binary",
+	"token",
+	"private_key",
+	"kube_config",
+	"kubeconfig",
+}
+
+// credentialPrefixes are the name beginnings of attributes carrying a credential, such as `kube_config_intranet`
+var credentialPrefixes = []string{
+	"kube_config",
+}
+
+// nonCredentialNames end like a credential but are identifiers, cursors or idempotency keys
+var nonCredentialNames = map[string]bool{
+	"client_token":           true,
+	"scroll_token":           true,
+	"lifecycle_action_token": true,
+	"next_token":             true,
+	// a YES/NO switch and a file name prefix
+	"auto_generate_password":  true,
+	"kube_config_file_prefix": true,
+}
+
+// nonCredentialPaths are credential-like attributes which hold a reference to a credential rather than the credential
+var nonCredentialPaths = map[string]bool{
+	"tencentcloud_tem_workload.env_conf.secret": true,
+}
+
+// IsCredentialLikeName reports whether an attribute name suggests that its value is a credential,
+// such as a password, a secret key, a token, a private key or a kubeconfig.
+func IsCredentialLikeName(name string) bool {
+	if nonCredentialNames[name] {
+		return false
+	}
+	for _, suffix := range credentialSuffixes {
+		if name == suffix || strings.HasSuffix(name, "_"+suffix) {
+			return true
+		}
+	}
+	for _, prefix := range credentialPrefixes {
+		if name == prefix || strings.HasPrefix(name, prefix+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+// AuditSensitive walks the schemas of resources, such as `Provider().ResourcesMap`, and returns the paths,
+// such as `tencentcloud_kubernetes_cluster.kube_config`, of credential-like string attributes which are not sensitive.
+func AuditSensitive(resources map[string]*schema.Resource) []string {
+	var paths []string
+	for name, r := range resources {
+		paths = append(paths, auditSensitiveSchema(name, r.Schema)...)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func auditSensitiveSchema(prefix string, schemaMap map[string]*schema.Schema) (paths []string) {
+	for name, s := range schemaMap {
+		path := prefix + "." + name
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			paths = append(paths, auditSensitiveSchema(path, elem.Schema)...)
+			continue
+		}
+		if s.Sensitive || !IsCredentialLikeName(name) || nonCredentialPaths[path] {
+			continue
+		}
+		if s.Type == schema.TypeString || isStringCollection(s) {
+			paths = append(paths, path)
+		}
+	}
+	return
+}
+
+func isStringCollection(s *schema.Schema) bool {
+	if s.Type != schema.TypeList && s.Type != schema.TypeSet && s.Type != schema.TypeMap {
+		return false
+	}
+	elem, ok := s.Elem.(*schema.Schema)
+	return ok && elem.Type == schema.TypeString
+}
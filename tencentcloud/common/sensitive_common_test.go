@@ -0,0 +1,46 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsCredentialLikeName(t *testing.T) {
+	for _, name := range []string{"password", "root_password", "password_wo", "secret_key", "s3_secret_key", "tmp_token", "private_key", "kube_config", "kube_config_intranet"} {
+		assert.True(t, IsCredentialLikeName(name), name)
+	}
+	for _, name := range []string{"client_token", "next_token", "password_wo_version", "secret_id", "secret_name", "key_pair", "kube_config_file_prefix", "auto_generate_password"} {
+		assert.False(t, IsCredentialLikeName(name), name)
+	}
+}
+
+func TestAuditSensitive(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"tencentcloud_example": {
+			Schema: map[string]*schema.Schema{
+				"name":       {Type: schema.TypeString, Optional: true},
+				"password":   {Type: schema.TypeString, Optional: true},
+				"secret_key": {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"tokens":     {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"auth": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"private_key":  {Type: schema.TypeString, Optional: true},
+							"access_token": {Type: schema.TypeMap, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []string{
+		"tencentcloud_example.auth.access_token",
+		"tencentcloud_example.auth.private_key",
+		"tencentcloud_example.password",
+	}, AuditSensitive(resources))
+}
@@ -0,0 +1,43 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// WRITE_ONLY_SUFFIX names the write-only companion of an argument, such as `password_wo` of `password`
+	WRITE_ONLY_SUFFIX = "_wo"
+	// WRITE_ONLY_VERSION_SUFFIX names the version argument of a write-only companion, such as `password_wo_version`
+	WRITE_ONLY_VERSION_SUFFIX = "_wo_version"
+)
+
+// GetWriteOnlyString reads the write-only argument key from the raw config, as write-only values never reach the plan
+// or state and d.Get always returns the zero value for them.
+func GetWriteOnlyString(d *schema.ResourceData, key string) (string, error) {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+	if diags.HasError() {
+		return "", fmt.Errorf("read write-only argument `%s` failed: %s", key, diags[0].Summary)
+	}
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return "", nil
+	}
+	return value.AsString(), nil
+}
+
+// GetSecretWithWriteOnly returns the value of the write-only companion `<key>_wo` when it is configured,
+// otherwise the value of key itself.
+func GetSecretWithWriteOnly(d *schema.ResourceData, key string) (string, error) {
+	value, err := GetWriteOnlyString(d, key+WRITE_ONLY_SUFFIX)
+	if err != nil || value != "" {
+		return value, err
+	}
+	return d.Get(key).(string), nil
+}
+
+// HasSecretChange reports whether key changes, or its write-only companion is rotated by bumping `<key>_wo_version`.
+func HasSecretChange(d *schema.ResourceData, key string) bool {
+	return d.HasChange(key) || d.HasChange(key+WRITE_ONLY_VERSION_SUFFIX)
+}
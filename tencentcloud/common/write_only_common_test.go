@@ -0,0 +1,41 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSecretWithWriteOnly(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"password":            {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"password_wo":         {Type: schema.TypeString, Optional: true, Sensitive: true, WriteOnly: true},
+			"password_wo_version": {Type: schema.TypeInt, Optional: true},
+		},
+	}
+	data := func(password, passwordWo cty.Value) *schema.ResourceData {
+		d, err := schema.InternalMap(r.Schema).Data(&terraform.InstanceState{
+			ID:         "id",
+			Attributes: map[string]string{"password": password.AsString()},
+			RawConfig: cty.ObjectVal(map[string]cty.Value{
+				"password":            password,
+				"password_wo":         passwordWo,
+				"password_wo_version": cty.NumberIntVal(1),
+			}),
+		}, nil)
+		assert.NoError(t, err)
+		return d
+	}
+
+	value, err := GetSecretWithWriteOnly(data(cty.StringVal(""), cty.StringVal("wo-secret")), "password")
+	assert.NoError(t, err)
+	assert.Equal(t, "wo-secret", value)
+
+	value, err = GetSecretWithWriteOnly(data(cty.StringVal("secret"), cty.NullVal(cty.String)), "password")
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", value)
+}
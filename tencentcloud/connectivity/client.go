@@ -521,6 +521,20 @@ func (me *TencentCloudClient) UseCbsClient(iacExtInfo ...IacExtInfo) *cbs.Client
 	return me.cbsConn
 }
 
+// UseCbsClientRegion returns cbs client for service in the given region, which is not cached unless it is the region of the provider
+func (me *TencentCloudClient) UseCbsClientRegion(region string) *cbs.Client {
+	if region == "" || region == me.Region {
+		return me.UseCbsClient()
+	}
+
+	var reqTimeout = getEnvDefault(PROVIDER_CBS_REQUEST_TIMEOUT, 300)
+	cpf := me.NewClientProfile(reqTimeout)
+	conn, _ := cbs.NewClient(me.Credential, region, cpf)
+	conn.WithHttpTransport(&LogRoundTripper{})
+
+	return conn
+}
+
 // UseDcClient returns dc client for service
 func (me *TencentCloudClient) UseDcClient() *dc.Client {
 	if me.dcConn != nil {
@@ -592,6 +606,20 @@ func (me *TencentCloudClient) UseCvmClient(iacExtInfo ...IacExtInfo) *cvmv201703
 	return me.cvmv20170312Conn
 }
 
+// UseCvmClientRegion returns cvm client for service in the given region, which is not cached unless it is the region of the provider
+func (me *TencentCloudClient) UseCvmClientRegion(region string) *cvmv20170312.Client {
+	if region == "" || region == me.Region {
+		return me.UseCvmClient()
+	}
+
+	var reqTimeout = getEnvDefault(PROVIDER_CVM_REQUEST_TIMEOUT, 300)
+	cpf := me.NewClientProfile(reqTimeout)
+	conn, _ := cvmv20170312.NewClient(me.Credential, region, cpf)
+	conn.WithHttpTransport(&LogRoundTripper{})
+
+	return conn
+}
+
 // UseCvmIntlClient returns cvm intl client for service
 func (me *TencentCloudClient) UseCvmIntlClient(iacExtInfo ...IacExtInfo) *cvmintl.Client {
 	if me.cvmIntlConn != nil {
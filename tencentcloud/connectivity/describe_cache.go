@@ -0,0 +1,201 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const PROVIDER_DESCRIBE_CACHE_TTL = "TENCENTCLOUD_DESCRIBE_CACHE_TTL"
+
+// readActionPrefixes are the prefixes of the actions that do not change cloud state, any other action invalidates
+// the whole cache.
+var readActionPrefixes = []string{"Describe", "Inquiry", "Get", "List", "Query", "Check"}
+
+// CacheableRequest is implemented by every request of the tencentcloud-sdk-go.
+type CacheableRequest interface {
+	GetService() string
+	GetAction() string
+	ToJsonString() string
+}
+
+type describeCacheKey struct {
+	owner   *TencentCloudClient
+	service string
+	action  string
+	request string
+}
+
+type describeCacheEntry struct {
+	done     chan struct{}
+	response interface{}
+	err      error
+	expireAt time.Time
+}
+
+var describeCache = struct {
+	lock    sync.Mutex
+	entries map[describeCacheKey]*describeCacheEntry
+}{entries: make(map[describeCacheKey]*describeCacheEntry)}
+
+// CachedDescribe returns the response of a describe request, calling fetch only when no identical request was
+// answered by this client within the cache TTL. Concurrent identical requests share a single call, errors are never cached.
+// Every caller gets its own copy of the response, so it is free to modify it.
+// The cache is off unless TENCENTCLOUD_DESCRIBE_CACHE_TTL is set to a positive number of seconds.
+func (me *TencentCloudClient) CachedDescribe(request CacheableRequest, fetch func() (interface{}, error)) (interface{}, error) {
+	ttl := describeCacheTTL()
+	if ttl <= 0 {
+		return fetch()
+	}
+
+	key := describeCacheKey{
+		owner:   me,
+		service: request.GetService(),
+		action:  request.GetAction(),
+		request: canonicalRequest(request.ToJsonString()),
+	}
+
+	describeCache.lock.Lock()
+	entry, ok := describeCache.entries[key]
+	if ok {
+		select {
+		case <-entry.done:
+			if entry.err != nil || time.Now().After(entry.expireAt) {
+				ok = false
+			}
+		default:
+		}
+	}
+	if ok {
+		describeCache.lock.Unlock()
+		<-entry.done
+		if entry.err == nil {
+			log.Printf("[DEBUG] describe cache hit, api[%s.%s], request body [%s]", key.service, key.action, key.request)
+			return copyDescribeResponse(entry.response), nil
+		}
+		return fetch()
+	}
+
+	entry = &describeCacheEntry{done: make(chan struct{})}
+	describeCache.entries[key] = entry
+	describeCache.lock.Unlock()
+
+	entry.response, entry.err = fetch()
+	entry.expireAt = time.Now().Add(ttl)
+	close(entry.done)
+
+	if entry.err != nil {
+		describeCache.lock.Lock()
+		if describeCache.entries[key] == entry {
+			delete(describeCache.entries, key)
+		}
+		describeCache.lock.Unlock()
+		return entry.response, entry.err
+	}
+	return copyDescribeResponse(entry.response), nil
+}
+
+// InvalidateDescribeCache drops every cached describe, for all clients.
+// A write to one service may change what another describes, such as a subnet created for an instance type, so nothing is kept.
+func InvalidateDescribeCache() {
+	describeCache.lock.Lock()
+	defer describeCache.lock.Unlock()
+
+	describeCache.entries = make(map[describeCacheKey]*describeCacheEntry)
+}
+
+// isWriteAction reports whether action may change cloud state, a request without an action is not a cloud API call
+// and changes nothing the cache holds.
+func isWriteAction(action string) bool {
+	if action == "" {
+		return false
+	}
+
+	for _, prefix := range readActionPrefixes {
+		if strings.HasPrefix(action, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// copyDescribeResponse deep copies a response through its JSON form, other values are returned as is.
+func copyDescribeResponse(response interface{}) interface{} {
+	value := reflect.ValueOf(response)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return response
+	}
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		log.Printf("[WARN] copy describe response failed, reason:%s", err.Error())
+		return response
+	}
+	copied := reflect.New(value.Type().Elem())
+	if err := json.Unmarshal(body, copied.Interface()); err != nil {
+		log.Printf("[WARN] copy describe response failed, reason:%s", err.Error())
+		return response
+	}
+	return copied.Interface()
+}
+
+func describeCacheTTL() time.Duration {
+	val, ok := os.LookupEnv(PROVIDER_DESCRIBE_CACHE_TTL)
+	if !ok {
+		return 0
+	}
+	seconds, err := strconv.Atoi(val)
+	if err != nil {
+		log.Printf("[WARN] %s must be int, got %s, describe cache disabled", PROVIDER_DESCRIBE_CACHE_TTL, val)
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// canonicalRequest normalizes a request body so that requests differing only in key or filter order share an entry.
+func canonicalRequest(body string) string {
+	var v interface{}
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return body
+	}
+	b, err := json.Marshal(canonicalValue(v))
+	if err != nil {
+		return body
+	}
+	return string(b)
+}
+
+func canonicalValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, item := range value {
+			value[k] = canonicalValue(item)
+		}
+		return value
+	case []interface{}:
+		sortable := true
+		for i, item := range value {
+			value[i] = canonicalValue(item)
+			if _, ok := value[i].(map[string]interface{}); !ok {
+				sortable = false
+			}
+		}
+		// lists of objects are filters, their order carries no meaning
+		if sortable {
+			sort.SliceStable(value, func(i, j int) bool {
+				a, _ := json.Marshal(value[i])
+				b, _ := json.Marshal(value[j])
+				return string(a) < string(b)
+			})
+		}
+		return value
+	default:
+		return value
+	}
+}
@@ -0,0 +1,100 @@
+package connectivity
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+)
+
+func newDescribeImagesRequest(filters ...string) *cvm.DescribeImagesRequest {
+	request := cvm.NewDescribeImagesRequest()
+	for _, v := range filters {
+		name, value := v, v+"-value"
+		request.Filters = append(request.Filters, &cvm.Filter{Name: &name, Values: []*string{&value}})
+	}
+	return request
+}
+
+func TestCachedDescribe(t *testing.T) {
+	os.Setenv(PROVIDER_DESCRIBE_CACHE_TTL, "300")
+	defer os.Unsetenv(PROVIDER_DESCRIBE_CACHE_TTL)
+	client := &TencentCloudClient{}
+	calls := 0
+	fetch := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	first, err := client.CachedDescribe(newDescribeImagesRequest("image-type", "platform"), fetch)
+	assert.NoError(t, err)
+	second, err := client.CachedDescribe(newDescribeImagesRequest("platform", "image-type"), fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, calls)
+
+	_, _ = client.CachedDescribe(newDescribeImagesRequest("platform"), fetch)
+	assert.Equal(t, 2, calls)
+
+	_, _ = (&TencentCloudClient{}).CachedDescribe(newDescribeImagesRequest("platform"), fetch)
+	assert.Equal(t, 3, calls)
+
+	InvalidateDescribeCache()
+	third, _ := client.CachedDescribe(newDescribeImagesRequest("image-type", "platform"), fetch)
+	assert.Equal(t, 4, third)
+}
+
+func TestCachedDescribeCopy(t *testing.T) {
+	os.Setenv(PROVIDER_DESCRIBE_CACHE_TTL, "300")
+	defer os.Unsetenv(PROVIDER_DESCRIBE_CACHE_TTL)
+	client := &TencentCloudClient{}
+	fetch := func() (interface{}, error) {
+		response := cvm.NewDescribeImagesResponse()
+		response.Response = &cvm.DescribeImagesResponseParams{TotalCount: helperInt64(1)}
+		return response, nil
+	}
+
+	first, _ := client.CachedDescribe(newDescribeImagesRequest("copy"), fetch)
+	*first.(*cvm.DescribeImagesResponse).Response.TotalCount = 2
+
+	second, _ := client.CachedDescribe(newDescribeImagesRequest("copy"), fetch)
+	assert.Equal(t, int64(1), *second.(*cvm.DescribeImagesResponse).Response.TotalCount)
+}
+
+func TestCachedDescribeDisabled(t *testing.T) {
+	for _, ttl := range []string{"", "0"} {
+		if ttl == "" {
+			os.Unsetenv(PROVIDER_DESCRIBE_CACHE_TTL)
+		} else {
+			os.Setenv(PROVIDER_DESCRIBE_CACHE_TTL, ttl)
+		}
+		client := &TencentCloudClient{}
+		calls := 0
+		fetch := func() (interface{}, error) {
+			calls++
+			return calls, nil
+		}
+
+		_, _ = client.CachedDescribe(newDescribeImagesRequest("platform"), fetch)
+		_, _ = client.CachedDescribe(newDescribeImagesRequest("platform"), fetch)
+		assert.Equal(t, 2, calls)
+	}
+	os.Unsetenv(PROVIDER_DESCRIBE_CACHE_TTL)
+}
+
+func helperInt64(v int64) *int64 {
+	return &v
+}
+
+func TestIsWriteAction(t *testing.T) {
+	assert.False(t, isWriteAction("DescribeImages"))
+	assert.False(t, isWriteAction("InquiryPriceRunInstances"))
+	assert.False(t, isWriteAction("GetCallerIdentity"))
+	assert.False(t, isWriteAction("ListTagsForResource"))
+	assert.False(t, isWriteAction("QueryCustomerCreditPrice"))
+	assert.False(t, isWriteAction("CheckIsPrometheusNewUser"))
+	assert.False(t, isWriteAction(""))
+	assert.True(t, isWriteAction("RunInstances"))
+	assert.True(t, isWriteAction("ModifyImageAttribute"))
+}
@@ -8,6 +8,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"regexp"
 	"time"
 )
 
@@ -86,6 +87,10 @@ func (me *LogRoundTripper) RoundTrip(request *http.Request) (response *http.Resp
 
 	inBytes = append(inBytes, appendMessage...)
 	response, errRet = http.DefaultTransport.RoundTrip(request)
+	// a failed write may still have partly applied, so the cache is dropped whatever the outcome
+	if action := request.Header.Get("X-TC-Action"); isWriteAction(action) {
+		InvalidateDescribeCache()
+	}
 	if errRet != nil {
 		return
 	}
@@ -115,6 +120,7 @@ func (me *LogRoundTripper) log(in []byte, out []byte, err error, start time.Time
 
 	if len(out) > 0 {
 		buf.WriteString("; response:")
+		out = redactCredentials(out)
 		err := json.Compact(&buf, out)
 		if err != nil {
 			out := bytes.Replace(out,
@@ -139,3 +145,11 @@ func (me *LogRoundTripper) log(in []byte, out []byte, err error, start time.Time
 
 	log.Println(buf.String())
 }
+
+// credentialFieldRegexp matches the temporary credentials returned by STS, such as AssumeRole and GetFederationToken
+var credentialFieldRegexp = regexp.MustCompile(`"(TmpSecretKey|Token)"\s*:\s*"[^"]*"`)
+
+// redactCredentials keeps the temporary secret key and token out of the debug log
+func redactCredentials(out []byte) []byte {
+	return credentialFieldRegexp.ReplaceAll(out, []byte(`"$1":"******"`))
+}
@@ -0,0 +1,12 @@
+package connectivity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactCredentials(t *testing.T) {
+	out := redactCredentials([]byte(`{"Response":{"Credentials":{"Token":"token","TmpSecretId":"AKIDxxx","TmpSecretKey":"key"},"ExpiredTime":1700000000}}`))
+	assert.Equal(t, `{"Response":{"Credentials":{"Token":"******","TmpSecretId":"AKIDxxx","TmpSecretKey":"******"},"ExpiredTime":1700000000}}`, string(out))
+}
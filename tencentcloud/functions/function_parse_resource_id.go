@@ -0,0 +1,51 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+)
+
+var _ function.Function = &parseResourceIdFunction{}
+
+// NewParseResourceIdFunction splits a composite resource id, such as `ins-xxx#ap-guangzhou`, into its parts
+func NewParseResourceIdFunction() function.Function {
+	return &parseResourceIdFunction{}
+}
+
+type parseResourceIdFunction struct{}
+
+func (f *parseResourceIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_resource_id"
+}
+
+func (f *parseResourceIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Split a composite resource id into its parts.",
+		Description: "Splits a composite resource id joined by `" + tccommon.FILED_SP + "`, such as the id of `tencentcloud_mysql_account`, into the list of its parts.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Composite resource id.",
+			},
+		},
+		Return: function.ListReturn{ElementType: function.StringReturn{}.GetType()},
+	}
+}
+
+func (f *parseResourceIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+	if id == "" {
+		resp.Error = function.NewArgumentFuncError(0, "id can not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, strings.Split(id, tccommon.FILED_SP)))
+}
@@ -0,0 +1,62 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+)
+
+var _ function.Function = &qcsResourceNameFunction{}
+
+// NewQcsResourceNameFunction builds the six-segment resource name used by CAM policies and tags
+func NewQcsResourceNameFunction() function.Function {
+	return &qcsResourceNameFunction{}
+}
+
+type qcsResourceNameFunction struct{}
+
+func (f *qcsResourceNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "qcs_resource_name"
+}
+
+func (f *qcsResourceNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build a qcs resource name.",
+		Description: "Builds the resource name in the format of `qcs:project_id:service_type:region:account:resource`, which is used by CAM policies and the tag service. For more information, go to https://cloud.tencent.com/document/product/598/10606.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "service_type",
+				Description: "Service type, such as `cvm`.",
+			},
+			function.StringParameter{
+				Name:        "resource_type",
+				Description: "Resource type, such as `instance`.",
+			},
+			function.StringParameter{
+				Name:        "region",
+				Description: "Region of the resource, such as `ap-guangzhou`.",
+			},
+			function.StringParameter{
+				Name:        "id",
+				Description: "Resource id.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *qcsResourceNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var serviceType, resourceType, region, id string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &serviceType, &resourceType, &region, &id))
+	if resp.Error != nil {
+		return
+	}
+	if serviceType == "" {
+		resp.Error = function.NewArgumentFuncError(0, "service_type can not be empty")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, tccommon.BuildTagResourceName(serviceType, resourceType, region, id)))
+}
@@ -0,0 +1,53 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+)
+
+var _ function.Function = &shortRegionFunction{}
+
+// NewShortRegionFunction expands a short region name, such as `gz`, into the region, such as `ap-guangzhou`
+func NewShortRegionFunction() function.Function {
+	return &shortRegionFunction{}
+}
+
+type shortRegionFunction struct{}
+
+func (f *shortRegionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "short_region"
+}
+
+func (f *shortRegionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Expand a short region name.",
+		Description: "Expands a short region name, such as `gz` used in image and instance ids, into the region, such as `ap-guangzhou`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "short_region",
+				Description: "Short region name.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *shortRegionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var shortRegion string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &shortRegion))
+	if resp.Error != nil {
+		return
+	}
+
+	region := tccommon.ShortRegionNameParse(shortRegion)
+	if region == "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("unknown short region `%s`", shortRegion))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, region))
+}
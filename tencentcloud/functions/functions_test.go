@@ -0,0 +1,55 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func runFunction(f function.Function, result attr.Value, args ...string) (attr.Value, *function.FuncError) {
+	values := make([]attr.Value, 0, len(args))
+	for _, arg := range args {
+		values = append(values, types.StringValue(arg))
+	}
+	req := function.RunRequest{Arguments: function.NewArgumentsData(values)}
+	resp := &function.RunResponse{Result: function.NewResultData(result)}
+	f.Run(context.Background(), req, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func TestParseResourceIdFunction(t *testing.T) {
+	f := NewParseResourceIdFunction()
+	value, err := runFunction(f, types.ListUnknown(types.StringType), "cdb-xxx#test#%")
+	assert.Nil(t, err)
+	assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("cdb-xxx"), types.StringValue("test"), types.StringValue("%"),
+	}), value)
+
+	_, err = runFunction(f, types.ListUnknown(types.StringType), "")
+	assert.NotNil(t, err)
+}
+
+func TestQcsResourceNameFunction(t *testing.T) {
+	f := NewQcsResourceNameFunction()
+	value, err := runFunction(f, types.StringUnknown(), "cvm", "instance", "ap-guangzhou", "ins-xxx")
+	assert.Nil(t, err)
+	assert.Equal(t, types.StringValue("qcs::cvm:ap-guangzhou:uin/:instance/ins-xxx"), value)
+
+	value, err = runFunction(f, types.StringUnknown(), "cos", "bucket", "ap-guangzhou", "examplebucket-1250000000")
+	assert.Nil(t, err)
+	assert.Equal(t, types.StringValue("qcs::cos:ap-guangzhou:uid/:bucket/examplebucket-1250000000"), value)
+}
+
+func TestShortRegionFunction(t *testing.T) {
+	f := NewShortRegionFunction()
+	value, err := runFunction(f, types.StringUnknown(), "gz")
+	assert.Nil(t, err)
+	assert.Equal(t, types.StringValue("ap-guangzhou"), value)
+
+	_, err = runFunction(f, types.StringUnknown(), "unknown")
+	assert.NotNil(t, err)
+}
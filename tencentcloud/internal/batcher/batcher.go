@@ -0,0 +1,162 @@
+package batcher
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+)
+
+const (
+	// DefaultWindow is how long a batch stays open for more keys before it is flushed.
+	DefaultWindow = 50 * time.Millisecond
+	// DefaultMaxBatch is the largest id list most Describe* APIs accept in one request.
+	DefaultMaxBatch = 100
+)
+
+// FetchFunc describes every key in one call. Keys absent from the returned map are treated as not found.
+type FetchFunc func(ctx context.Context, keys []string) (map[string]interface{}, error)
+
+// Batcher coalesces concurrent single-key lookups issued within a short window
+// into one multi-key call and fans the results back out to every caller.
+type Batcher struct {
+	name     string
+	window   time.Duration
+	maxBatch int
+	fetch    FetchFunc
+
+	lock    sync.Mutex
+	pending *batch
+}
+
+type batch struct {
+	ctx     context.Context
+	keys    []string
+	seen    map[string]bool
+	once    sync.Once
+	done    chan struct{}
+	results map[string]interface{}
+	errs    map[string]error
+	err     error
+}
+
+// NewBatcher returns a batcher flushing at most maxBatch keys after window elapses.
+func NewBatcher(name string, window time.Duration, maxBatch int, fetch FetchFunc) *Batcher {
+	if maxBatch <= 0 {
+		maxBatch = DefaultMaxBatch
+	}
+	return &Batcher{
+		name:     name,
+		window:   window,
+		maxBatch: maxBatch,
+		fetch:    fetch,
+	}
+}
+
+var registry sync.Map
+
+type registryKey struct {
+	owner interface{}
+	name  string
+}
+
+// Shared returns the batcher registered under owner and name, creating it on first use.
+// Services are built per call, so the batcher is keyed by the long-lived API client instead.
+func Shared(owner interface{}, name string, fetch FetchFunc) *Batcher {
+	key := registryKey{owner: owner, name: name}
+	if b, ok := registry.Load(key); ok {
+		return b.(*Batcher)
+	}
+	b, _ := registry.LoadOrStore(key, NewBatcher(name, DefaultWindow, DefaultMaxBatch, fetch))
+	return b.(*Batcher)
+}
+
+// Get describes key, sharing the underlying call with other keys requested in the same window.
+// A nil result with a nil error means the key was not found.
+func (b *Batcher) Get(ctx context.Context, key string) (interface{}, error) {
+	if b.window <= 0 {
+		results, err := b.fetch(ctx, []string{key})
+		if err != nil {
+			return nil, err
+		}
+		return results[key], nil
+	}
+
+	b.lock.Lock()
+	bt := b.pending
+	if bt == nil {
+		bt = &batch{
+			ctx:  ctx,
+			seen: make(map[string]bool),
+			done: make(chan struct{}),
+		}
+		b.pending = bt
+		time.AfterFunc(b.window, func() { b.flush(bt) })
+	}
+	if !bt.seen[key] {
+		bt.seen[key] = true
+		bt.keys = append(bt.keys, key)
+	}
+	if len(bt.keys) >= b.maxBatch {
+		b.pending = nil
+		go b.flush(bt)
+	}
+	b.lock.Unlock()
+
+	select {
+	case <-bt.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if err := bt.errs[key]; err != nil {
+		return nil, err
+	}
+	if bt.err != nil {
+		return nil, bt.err
+	}
+	return bt.results[key], nil
+}
+
+func (b *Batcher) flush(bt *batch) {
+	bt.once.Do(func() {
+		b.lock.Lock()
+		if b.pending == bt {
+			b.pending = nil
+		}
+		keys := bt.keys
+		b.lock.Unlock()
+
+		log.Printf("[DEBUG] batcher[%s] flush %d keys: %s", b.name, len(keys), strings.Join(keys, ","))
+		bt.results, bt.err = b.fetch(bt.ctx, keys)
+		if bt.err != nil && len(keys) > 1 && splittable(bt.err) {
+			// one bad key must not fail its neighbours, so retry key by key
+			log.Printf("[WARN] batcher[%s] batch describe failed, fallback to single describe, reason: %v", b.name, bt.err)
+			bt.err = nil
+			bt.results = make(map[string]interface{}, len(keys))
+			bt.errs = make(map[string]error)
+			for _, key := range keys {
+				result, err := b.fetch(bt.ctx, []string{key})
+				if err != nil {
+					bt.errs[key] = err
+					continue
+				}
+				bt.results[key] = result[key]
+			}
+		}
+		close(bt.done)
+	})
+}
+
+// splittable reports whether a failed batch is worth retrying one key at a time.
+// Throttling and transport errors would only get worse with more requests.
+func splittable(err error) bool {
+	e, ok := err.(*sdkErrors.TencentCloudSDKError)
+	if !ok {
+		return false
+	}
+	return e.Code != "RequestLimitExceeded" && !strings.HasPrefix(e.Code, "ClientError")
+}
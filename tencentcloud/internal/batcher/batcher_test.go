@@ -0,0 +1,124 @@
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+)
+
+func TestBatcherCoalesce(t *testing.T) {
+	var calls int32
+	b := NewBatcher("test", 20*time.Millisecond, 100, func(ctx context.Context, keys []string) (map[string]interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		results := make(map[string]interface{})
+		for _, key := range keys {
+			if key != "missing" {
+				results[key] = "value-" + key
+			}
+		}
+		return results, nil
+	})
+
+	var wg sync.WaitGroup
+	keys := []string{"a", "b", "c", "a", "missing"}
+	got := make([]interface{}, len(keys))
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i int, key string) {
+			defer wg.Done()
+			v, err := b.Get(context.Background(), key)
+			assert.NoError(t, err)
+			got[i] = v
+		}(i, key)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, []interface{}{"value-a", "value-b", "value-c", "value-a", nil}, got)
+}
+
+func TestBatcherMaxBatch(t *testing.T) {
+	var calls int32
+	b := NewBatcher("test", time.Hour, 2, func(ctx context.Context, keys []string) (map[string]interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		results := make(map[string]interface{})
+		for _, key := range keys {
+			results[key] = key
+		}
+		return results, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v, err := b.Get(context.Background(), fmt.Sprintf("k%d", i))
+			assert.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("k%d", i), v)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestBatcherSplitOnError(t *testing.T) {
+	b := NewBatcher("test", 20*time.Millisecond, 100, func(ctx context.Context, keys []string) (map[string]interface{}, error) {
+		for _, key := range keys {
+			if key == "bad" {
+				return nil, sdkErrors.NewTencentCloudSDKError("InvalidParameterValue", "bad id", "")
+			}
+		}
+		results := make(map[string]interface{})
+		for _, key := range keys {
+			results[key] = key
+		}
+		return results, nil
+	})
+
+	var wg sync.WaitGroup
+	var goodErr, badErr error
+	var good interface{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		good, goodErr = b.Get(context.Background(), "good")
+	}()
+	go func() {
+		defer wg.Done()
+		_, badErr = b.Get(context.Background(), "bad")
+	}()
+	wg.Wait()
+
+	assert.NoError(t, goodErr)
+	assert.Equal(t, "good", good)
+	assert.Error(t, badErr)
+}
+
+func TestBatcherThrottledNotSplit(t *testing.T) {
+	var calls int32
+	b := NewBatcher("test", 20*time.Millisecond, 100, func(ctx context.Context, keys []string) (map[string]interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, sdkErrors.NewTencentCloudSDKError("RequestLimitExceeded", "throttled", "")
+	})
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"a", "b"} {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			_, err := b.Get(context.Background(), key)
+			assert.Error(t, err)
+		}(key)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -1,6 +1,12 @@
 package helper
 
-import "strings"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 const connect = "#"
 
@@ -11,3 +17,111 @@ func IdFormat(s ...string) string {
 func IdParse(s string) []string {
 	return strings.Split(s, connect)
 }
+
+// IdSpec declares the parts of a composite ID joined by `#`, each named after the argument it holds, e.g.
+// `IdSpec{"instance_id", "account_name"}`. A part written as `[name]` is optional: it may be empty, and may be
+// left out when it is trailing.
+type IdSpec []string
+
+// String returns the expected format of the ID, such as `instance_id#account_name`.
+func (spec IdSpec) String() string {
+	return IdFormat(spec...)
+}
+
+// Parse splits id by IdParse and checks it against spec. The returned parts always have the length of spec,
+// with omitted optional parts as empty strings.
+func (spec IdSpec) Parse(id string) ([]string, error) {
+	parts := IdParse(id)
+	if len(parts) > len(spec) || len(parts) < spec.required() {
+		return nil, fmt.Errorf("invalid ID `%s`, expected format `%s`", id, spec)
+	}
+	for i, name := range spec {
+		if i == len(parts) {
+			parts = append(parts, "")
+		}
+		if parts[i] == "" && !isOptionalIdPart(name) {
+			return nil, fmt.Errorf("invalid ID `%s`, `%s` is empty, expected format `%s`", id, name, spec)
+		}
+	}
+	return parts, nil
+}
+
+// Importer checks the ID being imported against spec, and keeps it as the resource ID.
+func (spec IdSpec) Importer() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			if _, err := spec.Parse(d.Id()); err != nil {
+				return nil, err
+			}
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// ImporterWithArguments is Importer for resources whose Read takes some identifiers from arguments instead of the ID.
+// The parts named in arguments are set into those arguments, and the other parts become the resource ID. The
+// imported ID is kept when every part is an argument.
+func (spec IdSpec) ImporterWithArguments(arguments ...string) *schema.ResourceImporter {
+	return spec.ImporterWithIdFunc(nil, arguments...)
+}
+
+// ImporterWithIdFunc is ImporterWithArguments for resources whose Create sets the ID in a format other than spec, such
+// as a different separator or a hash. The parts named in arguments are set into those arguments, and the resource ID
+// is set to idFunc of all the parts, so an imported resource has the same ID as a created one.
+func (spec IdSpec) ImporterWithIdFunc(idFunc func(parts []string) string, arguments ...string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			parts, err := spec.Parse(d.Id())
+			if err != nil {
+				return nil, err
+			}
+			var idParts []string
+			for i, name := range spec {
+				name = strings.Trim(name, "[]")
+				if !StringsContain(arguments, name) {
+					idParts = append(idParts, parts[i])
+					continue
+				}
+				if parts[i] == "" {
+					continue
+				}
+				if err := setIdArgument(d, name, parts[i]); err != nil {
+					return nil, fmt.Errorf("invalid ID `%s`, %s", d.Id(), err.Error())
+				}
+			}
+			if idFunc != nil {
+				d.SetId(idFunc(parts))
+			} else if len(idParts) > 0 {
+				d.SetId(IdFormat(idParts...))
+			}
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+func (spec IdSpec) required() (n int) {
+	for i, name := range spec {
+		if !isOptionalIdPart(name) {
+			n = i + 1
+		}
+	}
+	return
+}
+
+func isOptionalIdPart(name string) bool {
+	return strings.HasPrefix(name, "[") && strings.HasSuffix(name, "]")
+}
+
+// setIdArgument sets value into the argument name, converting it for the int and bool arguments d.Set refuses a string for.
+func setIdArgument(d *schema.ResourceData, name, value string) error {
+	if d.Set(name, value) == nil {
+		return nil
+	}
+	if n, err := strconv.Atoi(value); err == nil {
+		return d.Set(name, n)
+	}
+	if b, err := strconv.ParseBool(value); err == nil {
+		return d.Set(name, b)
+	}
+	return fmt.Errorf("`%s` cannot be set to `%s`", name, value)
+}
@@ -0,0 +1,78 @@
+package helper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdSpecParse(t *testing.T) {
+	spec := IdSpec{"instance_id", "account_name", "[account_host]"}
+	assert.Equal(t, "instance_id#account_name#[account_host]", spec.String())
+
+	parts, err := spec.Parse("cdb-xxx#root#%")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cdb-xxx", "root", "%"}, parts)
+
+	parts, err = spec.Parse("cdb-xxx#root")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cdb-xxx", "root", ""}, parts)
+
+	_, err = spec.Parse("cdb-xxx")
+	assert.EqualError(t, err, "invalid ID `cdb-xxx`, expected format `instance_id#account_name#[account_host]`")
+
+	_, err = spec.Parse("cdb-xxx#root#%#extra")
+	assert.Error(t, err)
+
+	_, err = spec.Parse("#root")
+	assert.EqualError(t, err, "invalid ID `#root`, `instance_id` is empty, expected format `instance_id#account_name#[account_host]`")
+}
+
+func TestIdSpecImporterWithArguments(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {Type: schema.TypeString, Optional: true},
+			"group_id":   {Type: schema.TypeInt, Optional: true},
+		},
+	}
+
+	d := r.TestResourceData()
+	d.SetId("cls-xxx#12#role")
+	result, err := IdSpec{"cluster_id", "group_id", "role_name"}.ImporterWithArguments("cluster_id", "group_id").State(d, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "role", result[0].Id())
+	assert.Equal(t, "cls-xxx", result[0].Get("cluster_id"))
+	assert.Equal(t, 12, result[0].Get("group_id"))
+
+	d = r.TestResourceData()
+	d.SetId("cls-xxx")
+	_, err = IdSpec{"cluster_id", "role_name"}.ImporterWithArguments("cluster_id").State(d, nil)
+	assert.EqualError(t, err, "invalid ID `cls-xxx`, expected format `cluster_id#role_name`")
+}
+
+func TestIdSpecImporterWithIdFunc(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"bucket": {Type: schema.TypeString, Optional: true},
+		},
+	}
+
+	d := r.TestResourceData()
+	d.SetId("ins-xxx#cls-xxx")
+	result, err := IdSpec{"instance_id", "cluster_id"}.ImporterWithIdFunc(func(parts []string) string {
+		return strings.Join(parts, "_")
+	}).State(d, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "ins-xxx_cls-xxx", result[0].Id())
+
+	d = r.TestResourceData()
+	d.SetId("bucket-xxx#/dir/key")
+	result, err = IdSpec{"bucket", "key"}.ImporterWithIdFunc(func(parts []string) string {
+		return parts[0] + parts[1]
+	}, "bucket").State(d, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "bucket-xxx/dir/key", result[0].Id())
+	assert.Equal(t, "bucket-xxx", result[0].Get("bucket"))
+}
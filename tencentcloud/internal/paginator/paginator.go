@@ -0,0 +1,173 @@
+package paginator
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	// DefaultLimit is the page size most Describe* APIs accept.
+	DefaultLimit = 100
+	// DefaultConcurrency is how many pages OffsetConcurrently fetches at once.
+	DefaultConcurrency = 5
+)
+
+// Page is one page of a Describe* API.
+type Page[T any] struct {
+	Items []T
+	// Total is the total count reported by the API, nil when it is not reported.
+	Total *int64
+	// Next is the cursor of the next page, such as `NextToken` or a serialized `SearchAfter`, empty on the last page.
+	Next string
+}
+
+// OffsetFunc fetches at most limit items starting from offset.
+type OffsetFunc[T any] func(ctx context.Context, offset, limit int64) (*Page[T], error)
+
+// PageNumberFunc fetches the page numbered pageNumber, starting from 1, of pageSize items.
+type PageNumberFunc[T any] func(ctx context.Context, pageNumber, pageSize int64) (*Page[T], error)
+
+// CursorFunc fetches the page following cursor, which is empty for the first page.
+type CursorFunc[T any] func(ctx context.Context, cursor string) (*Page[T], error)
+
+// Offset fetches every item of an offset/limit API, page by page. It stops on the first page which is short of
+// limit, and does not rely on the total count, which some APIs report inconsistently.
+func Offset[T any](ctx context.Context, limit int64, fetch OffsetFunc[T]) ([]T, error) {
+	return offsetFrom(ctx, 0, limit, fetch, nil)
+}
+
+// OffsetConcurrently is Offset for large result sets. After the first page it fetches the pages up to the total
+// count at most concurrency at once, and goes on page by page past the total when the last of them is full.
+func OffsetConcurrently[T any](ctx context.Context, limit int64, concurrency int, fetch OffsetFunc[T]) ([]T, error) {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	first, err := fetch(ctx, 0, limit)
+	if err != nil {
+		return nil, err
+	}
+	if first == nil || int64(len(first.Items)) < limit {
+		return itemsOf(first), nil
+	}
+	if first.Total == nil {
+		return offsetFrom(ctx, limit, limit, fetch, first.Items)
+	}
+
+	pageCount := (*first.Total + limit - 1) / limit
+	pages := make([][]T, pageCount)
+	pages[0] = first.Items
+
+	var (
+		wg       sync.WaitGroup
+		lock     sync.Mutex
+		firstErr error
+		sem      = make(chan struct{}, concurrency)
+	)
+	for i := int64(1); i < pageCount; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int64) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			page, err := fetch(ctx, i*limit, limit)
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			pages[i] = itemsOf(page)
+		}(i)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	var items []T
+	for _, page := range pages {
+		items = append(items, page...)
+	}
+	if int64(len(pages[pageCount-1])) < limit {
+		return items, nil
+	}
+	// the total count fell behind, such as when items are added meanwhile
+	return offsetFrom(ctx, pageCount*limit, limit, fetch, items)
+}
+
+// PageNumber fetches every item of a page-number API, such as one taking `Page` and `Rp`, page by page.
+func PageNumber[T any](ctx context.Context, pageSize int64, fetch PageNumberFunc[T]) ([]T, error) {
+	if pageSize <= 0 {
+		pageSize = DefaultLimit
+	}
+	return Offset(ctx, pageSize, pageNumberFetch(fetch))
+}
+
+// PageNumberConcurrently is OffsetConcurrently for a page-number API.
+func PageNumberConcurrently[T any](ctx context.Context, pageSize int64, concurrency int, fetch PageNumberFunc[T]) ([]T, error) {
+	if pageSize <= 0 {
+		pageSize = DefaultLimit
+	}
+	return OffsetConcurrently(ctx, pageSize, concurrency, pageNumberFetch(fetch))
+}
+
+// Cursor fetches every item of a cursor API, such as one returning `NextToken`, until the cursor is empty. It also
+// stops when the API returns the same cursor again, which would loop forever.
+func Cursor[T any](ctx context.Context, fetch CursorFunc[T]) ([]T, error) {
+	var (
+		items  []T
+		cursor string
+	)
+	for {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return nil, err
+		}
+		if page == nil {
+			return items, nil
+		}
+		items = append(items, page.Items...)
+		if page.Next == "" || page.Next == cursor || len(page.Items) == 0 {
+			return items, nil
+		}
+		cursor = page.Next
+	}
+}
+
+func offsetFrom[T any](ctx context.Context, offset, limit int64, fetch OffsetFunc[T], items []T) ([]T, error) {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	for {
+		page, err := fetch(ctx, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, itemsOf(page)...)
+		if int64(len(itemsOf(page))) < limit {
+			return items, nil
+		}
+		offset += limit
+	}
+}
+
+func pageNumberFetch[T any](fetch PageNumberFunc[T]) OffsetFunc[T] {
+	return func(ctx context.Context, offset, limit int64) (*Page[T], error) {
+		return fetch(ctx, offset/limit+1, limit)
+	}
+}
+
+func itemsOf[T any](page *Page[T]) []T {
+	if page == nil {
+		return nil
+	}
+	return page.Items
+}
@@ -0,0 +1,102 @@
+package paginator
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeOffsetAPI serves count items, reporting total as the total count
+func fakeOffsetAPI(count int64, total *int64, calls *int32) OffsetFunc[int64] {
+	return func(ctx context.Context, offset, limit int64) (*Page[int64], error) {
+		atomic.AddInt32(calls, 1)
+		page := &Page[int64]{Total: total}
+		for i := offset; i < offset+limit && i < count; i++ {
+			page.Items = append(page.Items, i)
+		}
+		return page, nil
+	}
+}
+
+func sequence(n int64) (items []int64) {
+	for i := int64(0); i < n; i++ {
+		items = append(items, i)
+	}
+	return
+}
+
+func TestOffset(t *testing.T) {
+	var calls int32
+	items, err := Offset(context.Background(), 10, fakeOffsetAPI(25, nil, &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, sequence(25), items)
+	assert.Equal(t, int32(3), calls)
+
+	// a full last page takes one more call to find the end
+	calls = 0
+	items, err = Offset(context.Background(), 10, fakeOffsetAPI(20, nil, &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, sequence(20), items)
+	assert.Equal(t, int32(3), calls)
+}
+
+func TestOffsetConcurrently(t *testing.T) {
+	total := int64(95)
+	var calls int32
+	items, err := OffsetConcurrently(context.Background(), 10, 3, fakeOffsetAPI(95, &total, &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, sequence(95), items)
+	assert.Equal(t, int32(10), calls)
+
+	// the total count falls behind the items
+	behind := int64(20)
+	calls = 0
+	items, err = OffsetConcurrently(context.Background(), 10, 3, fakeOffsetAPI(35, &behind, &calls))
+	assert.NoError(t, err)
+	assert.Equal(t, sequence(35), items)
+
+	_, err = OffsetConcurrently(context.Background(), 10, 3, func(ctx context.Context, offset, limit int64) (*Page[int64], error) {
+		if offset == 30 {
+			return nil, fmt.Errorf("RequestLimitExceeded")
+		}
+		return fakeOffsetAPI(95, &total, &calls)(ctx, offset, limit)
+	})
+	assert.EqualError(t, err, "RequestLimitExceeded")
+}
+
+func TestPageNumber(t *testing.T) {
+	var calls int32
+	api := fakeOffsetAPI(25, nil, &calls)
+	var pageNumbers []int64
+	items, err := PageNumber(context.Background(), 10, func(ctx context.Context, pageNumber, pageSize int64) (*Page[int64], error) {
+		pageNumbers = append(pageNumbers, pageNumber)
+		return api(ctx, (pageNumber-1)*pageSize, pageSize)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, sequence(25), items)
+	assert.Equal(t, []int64{1, 2, 3}, pageNumbers)
+}
+
+func TestCursor(t *testing.T) {
+	pages := map[string]*Page[int64]{
+		"":   {Items: []int64{0, 1}, Next: "t1"},
+		"t1": {Items: []int64{2, 3}, Next: "t2"},
+		"t2": {Items: []int64{4}},
+	}
+	items, err := Cursor(context.Background(), func(ctx context.Context, cursor string) (*Page[int64], error) {
+		return pages[cursor], nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, sequence(5), items)
+
+	// a repeated cursor stops instead of looping
+	pages["t2"].Next = "t2"
+	items, err = Cursor(context.Background(), func(ctx context.Context, cursor string) (*Page[int64], error) {
+		return pages[cursor], nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, sequence(5), items)
+}
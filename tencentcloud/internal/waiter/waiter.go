@@ -0,0 +1,179 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+)
+
+// Task is the state of an async task, as reported by its describe API.
+type Task struct {
+	// Status is matched against the pending, target and failed states of the waiter.
+	Status string
+	// Progress is the percentage done, nil when the API does not report it.
+	Progress *int64
+	// Reason is why the task failed, such as the error message of a failed flow.
+	Reason string
+	// Result is the raw describe response.
+	Result interface{}
+}
+
+// RefreshFunc describes the task once. A nil task means that the task is not found yet.
+type RefreshFunc func(ctx context.Context) (*Task, error)
+
+// ReasonFunc fetches why the task failed, for APIs which report it in a separate task-result call.
+type ReasonFunc func(ctx context.Context, task *Task) (string, error)
+
+// Waiter polls an async task until it reaches a target state, fails, or times out. It is built on top of
+// tccommon.BuildStateChangeConf, adding failed states, retries of transient describe errors and progress logging.
+type Waiter struct {
+	// Name describes the task in logs and errors, such as `mysql async request xxx`.
+	Name    string
+	Pending []string
+	Target  []string
+	Failed  []string
+	Timeout time.Duration
+	// Delay is how long to wait before the first poll.
+	Delay time.Duration
+	// MinInterval is the shortest interval between two polls, 3 seconds when zero. Polling backs off from it,
+	// doubling up to 10 seconds.
+	MinInterval time.Duration
+	// NotFoundChecks is how many times a nil task is tolerated, 20 when zero.
+	NotFoundChecks int
+	Refresh        RefreshFunc
+	// Reason is called for a failed task whose Reason is empty.
+	Reason ReasonFunc
+}
+
+// NewWaiter returns a waiter for the task named name, polling refresh while it is in pending until it is in target.
+// A task in failed stops the wait with an error carrying its reason.
+func NewWaiter(name string, pending, target, failed []string, timeout time.Duration, refresh RefreshFunc) *Waiter {
+	return &Waiter{
+		Name:    name,
+		Pending: pending,
+		Target:  target,
+		Failed:  failed,
+		Timeout: timeout,
+		Refresh: refresh,
+	}
+}
+
+// WaitForState polls the task and returns its last state. The error of a failed task carries its status and reason.
+func (w *Waiter) WaitForState(ctx context.Context) (*Task, error) {
+	logId := tccommon.GetLogId(ctx)
+	p := &poller{waiter: w, ctx: ctx, logId: logId}
+
+	conf := tccommon.BuildStateChangeConf(w.Pending, w.Target, w.Timeout, w.Delay, p.refresh)
+	if w.MinInterval > 0 {
+		conf.MinTimeout = w.MinInterval
+	}
+	if w.NotFoundChecks > 0 {
+		conf.NotFoundChecks = w.NotFoundChecks
+	}
+
+	result, err := conf.WaitForStateContext(ctx)
+	if p.failure != nil {
+		return p.last, p.failure
+	}
+	if err != nil {
+		var timeoutErr *resource.TimeoutError
+		if errors.As(err, &timeoutErr) && p.last != nil {
+			err = fmt.Errorf("timeout while waiting for %s, last status is %s%s", w.Name, p.last.Status, progressSuffix(p.last))
+		}
+		log.Printf("[CRITAL]%s wait for %s fail, reason:%s\n", logId, w.Name, err.Error())
+		return p.last, err
+	}
+
+	task, _ := result.(*Task)
+	return task, nil
+}
+
+type poller struct {
+	waiter  *Waiter
+	ctx     context.Context
+	logId   string
+	last    *Task
+	failure error
+}
+
+func (p *poller) refresh() (interface{}, string, error) {
+	w := p.waiter
+	task, err := w.Refresh(p.ctx)
+	if err != nil {
+		if !tccommon.RetryError(err, tccommon.InternalError).Retryable {
+			return nil, "", err
+		}
+		// keep waiting on a transient describe error
+		log.Printf("[DEBUG]%s describe %s fail, retry later, reason:%s\n", p.logId, w.Name, err.Error())
+		return p.last, p.lastStatus(), nil
+	}
+	if task == nil {
+		return nil, "", nil
+	}
+
+	if p.last == nil || p.last.Status != task.Status || !sameProgress(p.last.Progress, task.Progress) {
+		log.Printf("[DEBUG]%s %s status is %s%s\n", p.logId, w.Name, task.Status, progressSuffix(task))
+	}
+	p.last = task
+
+	if tccommon.IsContains(w.Failed, task.Status) {
+		reason := task.Reason
+		if reason == "" && w.Reason != nil {
+			if r, e := w.Reason(p.ctx, task); e != nil {
+				log.Printf("[CRITAL]%s describe failure reason of %s fail, reason:%s\n", p.logId, w.Name, e.Error())
+			} else {
+				reason = r
+			}
+		}
+		p.failure = failureError(w.Name, task.Status, reason)
+		return task, task.Status, p.failure
+	}
+	return task, task.Status, nil
+}
+
+// lastStatus is the status to report on a transient error, which keeps the wait going.
+func (p *poller) lastStatus() string {
+	if p.last != nil {
+		return p.last.Status
+	}
+	if len(p.waiter.Pending) > 0 {
+		return p.waiter.Pending[0]
+	}
+	return ""
+}
+
+func failureError(name, status, reason string) error {
+	if reason == "" {
+		return fmt.Errorf("%s failed, status is %s", name, status)
+	}
+	return fmt.Errorf("%s failed, status is %s, reason: %s", name, status, reason)
+}
+
+func progressSuffix(task *Task) string {
+	if task.Progress == nil {
+		return ""
+	}
+	return fmt.Sprintf(", progress %d%%", *task.Progress)
+}
+
+func sameProgress(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// Percent returns done of total as a percentage, for APIs which report the progress as counts.
+func Percent(done, total int64) *int64 {
+	if total <= 0 {
+		return nil
+	}
+	percent := done * 100 / total
+	return &percent
+}
@@ -0,0 +1,91 @@
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+)
+
+// sequence returns the tasks in turn, repeating the last one
+func sequence(tasks ...*Task) RefreshFunc {
+	i := 0
+	return func(ctx context.Context) (*Task, error) {
+		task := tasks[i]
+		if i < len(tasks)-1 {
+			i++
+		}
+		return task, nil
+	}
+}
+
+func TestWaiterTarget(t *testing.T) {
+	w := NewWaiter("test task", []string{"RUNNING"}, []string{"SUCCESS"}, []string{"FAILED"}, time.Minute, sequence(
+		&Task{Status: "RUNNING", Progress: Percent(1, 4)},
+		&Task{Status: "RUNNING", Progress: Percent(3, 4)},
+		&Task{Status: "SUCCESS", Result: "done"},
+	))
+	w.MinInterval = 10 * time.Millisecond
+
+	task, err := w.WaitForState(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "done", task.Result)
+}
+
+func TestWaiterFailed(t *testing.T) {
+	w := NewWaiter("test task", []string{"RUNNING"}, []string{"SUCCESS"}, []string{"FAILED"}, time.Minute, sequence(
+		&Task{Status: "RUNNING"},
+		&Task{Status: "FAILED"},
+	))
+	w.MinInterval = 10 * time.Millisecond
+	w.Reason = func(ctx context.Context, task *Task) (string, error) {
+		return "disk is full", nil
+	}
+
+	task, err := w.WaitForState(context.Background())
+	assert.EqualError(t, err, "test task failed, status is FAILED, reason: disk is full")
+	assert.Equal(t, "FAILED", task.Status)
+}
+
+func TestWaiterRetryTransientError(t *testing.T) {
+	calls := 0
+	w := NewWaiter("test task", []string{"RUNNING"}, []string{"SUCCESS"}, nil, time.Minute, func(ctx context.Context) (*Task, error) {
+		calls++
+		switch calls {
+		case 1:
+			return &Task{Status: "RUNNING"}, nil
+		case 2:
+			return nil, sdkErrors.NewTencentCloudSDKError("InternalError", "try again", "")
+		}
+		return &Task{Status: "SUCCESS"}, nil
+	})
+	w.MinInterval = 10 * time.Millisecond
+
+	_, err := w.WaitForState(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	w.Refresh = func(ctx context.Context) (*Task, error) {
+		return nil, fmt.Errorf("ResourceNotFound")
+	}
+	_, err = w.WaitForState(context.Background())
+	assert.EqualError(t, err, "ResourceNotFound")
+}
+
+func TestWaiterTimeout(t *testing.T) {
+	w := NewWaiter("test task", []string{"RUNNING"}, []string{"SUCCESS"}, nil, 300*time.Millisecond, sequence(
+		&Task{Status: "RUNNING", Progress: Percent(1, 2)},
+	))
+	w.MinInterval = 10 * time.Millisecond
+
+	_, err := w.WaitForState(context.Background())
+	assert.EqualError(t, err, "timeout while waiting for test task, last status is RUNNING, progress 50%")
+}
+
+func TestPercent(t *testing.T) {
+	assert.Nil(t, Percent(1, 0))
+	assert.Equal(t, int64(33), *Percent(1, 3))
+}
@@ -14,7 +14,6 @@ import (
 	"github.com/mitchellh/go-homedir"
 	sdkcommon "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	commonJson "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/json"
-	sdkprofile "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
 	sdksts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
@@ -160,10 +159,12 @@ const (
 )
 
 type TencentCloudClient struct {
-	apiV3Conn *connectivity.TencentCloudClient
+	apiV3Conn     *connectivity.TencentCloudClient
+	protectedTags map[string]string
 }
 
 var _ tccommon.ProviderMeta = &TencentCloudClient{}
+var _ tccommon.ProtectedTagsMeta = &TencentCloudClient{}
 
 func init() {
 	commonJson.OmitBehaviour = commonJson.OmitEmpty
@@ -174,8 +175,13 @@ func (meta *TencentCloudClient) GetAPIV3Conn() *connectivity.TencentCloudClient
 	return meta.apiV3Conn
 }
 
+// GetProtectedTags 返回 provider 配置的受保护标签
+func (meta *TencentCloudClient) GetProtectedTags() map[string]string {
+	return meta.protectedTags
+}
+
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"secret_id": {
 				Type:        schema.TypeString,
@@ -225,10 +231,9 @@ func Provider() *schema.Provider {
 			//internal version: replace enableBpass begin, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
 			//internal version: replace enableBpass end, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
 			"assume_role": {
-				Type:        schema.TypeSet,
+				Type:        schema.TypeList,
 				Optional:    true,
-				MaxItems:    1,
-				Description: "The `assume_role` block. If provided, terraform will attempt to assume this role using the supplied credentials.",
+				Description: "The `assume_role` blocks. If provided, terraform will attempt to assume these roles in order, the first one using the supplied credentials and each of the others using the temporary credentials of the previous one.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"role_arn": {
@@ -263,26 +268,28 @@ func Provider() *schema.Provider {
 						"external_id": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							DefaultFunc: schema.EnvDefaultFunc(PROVIDER_ASSUME_ROLE_EXTERNAL_ID, nil),
-							Description: "External role ID, which can be obtained by clicking the role name in the CAM console. It can contain 2-128 letters, digits, and symbols (=,.@:/-). Regex: [\\w+=,.@:/-]*. It can be sourced from the `TENCENTCLOUD_ASSUME_ROLE_EXTERNAL_ID`.",
+							Description: "External role ID, which can be obtained by clicking the role name in the CAM console. It can contain 2-128 letters, digits, and symbols (=,.@:/-). Regex: [\\w+=,.@:/-]*. For the first `assume_role` block, it can be sourced from the `TENCENTCLOUD_ASSUME_ROLE_EXTERNAL_ID`.",
 						},
 						"source_identity": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							DefaultFunc: schema.EnvDefaultFunc(PROVIDER_ASSUME_ROLE_SOURCE_IDENTITY, nil),
-							Description: "Caller identity uin. It can be sourced from the `TENCENTCLOUD_ASSUME_ROLE_SOURCE_IDENTITY`.",
+							Description: "Caller identity uin. For the first `assume_role` block, it can be sourced from the `TENCENTCLOUD_ASSUME_ROLE_SOURCE_IDENTITY`.",
 						},
 						"serial_number": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							DefaultFunc: schema.EnvDefaultFunc(PROVIDER_ASSUME_ROLE_SERIAL_NUMBER, nil),
-							Description: "MFA serial number, the identification number of the MFA device associated with the calling CAM user. Format qcs: cam:uin/${ownerUin}::mfa/${mfaType}. It can be sourced from the `TENCENTCLOUD_ASSUME_ROLE_SERIAL_NUMBER`.",
+							Description: "MFA serial number, the identification number of the MFA device associated with the calling CAM user. Format qcs: cam:uin/${ownerUin}::mfa/${mfaType}. For the first `assume_role` block, it can be sourced from the `TENCENTCLOUD_ASSUME_ROLE_SERIAL_NUMBER`.",
 						},
 						"token_code": {
 							Type:        schema.TypeString,
 							Optional:    true,
-							DefaultFunc: schema.EnvDefaultFunc(PROVIDER_ASSUME_ROLE_TOKEN_CODE, nil),
-							Description: "MFA authentication code. It can be sourced from the `TENCENTCLOUD_ASSUME_ROLE_TOKEN_CODE`.",
+							Description: "MFA authentication code. For the first `assume_role` block, it can be sourced from the `TENCENTCLOUD_ASSUME_ROLE_TOKEN_CODE`.",
+						},
+						"session_tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Session tags to pass when making the AssumeRole call.",
 						},
 					},
 				},
@@ -442,15 +449,21 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				Optional:      true,
-				ConflictsWith: []string{"forbidden_account_ids", "assume_role_with_saml", "assume_role_with_web_identity"},
-				Description:   "List of allowed TencentCloud account IDs to prevent you from mistakenly using the wrong one (and potentially end up destroying a live environment). Conflicts with `forbidden_account_ids`, If use `assume_role_with_saml` or `assume_role_with_web_identity`, it is not supported.",
+				ConflictsWith: []string{"forbidden_account_ids"},
+				Description:   "List of allowed TencentCloud account IDs to prevent you from mistakenly using the wrong one (and potentially end up destroying a live environment). Conflicts with `forbidden_account_ids`. It is checked against the final identity, including the one assumed by `assume_role_with_saml` or `assume_role_with_web_identity`.",
 			},
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				Optional:      true,
-				ConflictsWith: []string{"allowed_account_ids", "assume_role_with_saml", "assume_role_with_web_identity"},
-				Description:   "List of forbidden TencentCloud account IDs to prevent you from mistakenly using the wrong one (and potentially end up destroying a live environment). Conflicts with `allowed_account_ids`, If use `assume_role_with_saml` or `assume_role_with_web_identity`, it is not supported.",
+				ConflictsWith: []string{"allowed_account_ids"},
+				Description:   "List of forbidden TencentCloud account IDs to prevent you from mistakenly using the wrong one (and potentially end up destroying a live environment). Conflicts with `allowed_account_ids`. It is checked against the final identity, including the one assumed by `assume_role_with_saml` or `assume_role_with_web_identity`.",
+			},
+			"protected_tags": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Tags that protect resources from being destroyed or replaced. A resource whose `tags` contain any of these pairs fails at plan time if a change forces its replacement, and refuses to be deleted. Use `*` as the value to match any value of the key. Remove the tag from the resource first if the destruction is intended.",
 			},
 		},
 
@@ -475,6 +488,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_images":                                       cvm.DataSourceTencentCloudImages(),
 			"tencentcloud_image_from_family":                            cvm.DataSourceTencentCloudImageFromFamily(),
 			"tencentcloud_instance_types":                               cvm.DataSourceTencentCloudInstanceTypes(),
+			"tencentcloud_instance_price":                               cvm.DataSourceTencentCloudInstancePrice(),
 			"tencentcloud_reserved_instance_configs":                    cvm.DataSourceTencentCloudReservedInstanceConfigs(),
 			"tencentcloud_vpc_instances":                                vpc.DataSourceTencentCloudVpcInstances(),
 			"tencentcloud_vpc_subnets":                                  vpc.DataSourceTencentCloudVpcSubnets(),
@@ -568,6 +582,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_mysql_parameter_list":                         cdb.DataSourceTencentCloudMysqlParameterList(),
 			"tencentcloud_mysql_default_params":                         cdb.DataSourceTencentCloudMysqlDefaultParams(),
 			"tencentcloud_mysql_instance":                               cdb.DataSourceTencentCloudMysqlInstance(),
+			"tencentcloud_mysql_price":                                  cdb.DataSourceTencentCloudMysqlPrice(),
 			"tencentcloud_mysql_backup_overview":                        cdb.DataSourceTencentCloudMysqlBackupOverview(),
 			"tencentcloud_mysql_backup_summaries":                       cdb.DataSourceTencentCloudMysqlBackupSummaries(),
 			"tencentcloud_mysql_bin_log":                                cdb.DataSourceTencentCloudMysqlBinLog(),
@@ -604,6 +619,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_cfs_available_zone":                           cfs.DataSourceTencentCloudCfsAvailableZone(),
 			"tencentcloud_redis_zone_config":                            crs.DataSourceTencentCloudRedisZoneConfig(),
 			"tencentcloud_redis_instances":                              crs.DataSourceTencentCloudRedisInstances(),
+			"tencentcloud_redis_price":                                  crs.DataSourceTencentCloudRedisPrice(),
 			"tencentcloud_redis_backup":                                 crs.DataSourceTencentCloudRedisBackup(),
 			"tencentcloud_redis_backup_download_info":                   crs.DataSourceTencentCloudRedisBackupDownloadInfo(),
 			"tencentcloud_redis_param_records":                          crs.DataSourceTencentCloudRedisRecordsParam(),
@@ -616,6 +632,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_as_scaling_groups":                            as.DataSourceTencentCloudAsScalingGroups(),
 			"tencentcloud_as_scaling_policies":                          as.DataSourceTencentCloudAsScalingPolicies(),
 			"tencentcloud_cbs_storages":                                 cbs.DataSourceTencentCloudCbsStorages(),
+			"tencentcloud_cbs_price":                                    cbs.DataSourceTencentCloudCbsPrice(),
 			"tencentcloud_cbs_storages_set":                             cbs.DataSourceTencentCloudCbsStoragesSet(),
 			"tencentcloud_cbs_snapshots":                                cbs.DataSourceTencentCloudCbsSnapshots(),
 			"tencentcloud_cbs_snapshot_policies":                        cbs.DataSourceTencentCloudCbsSnapshotPolicies(),
@@ -1956,6 +1973,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_tat_invoker_config":                                                       tat.ResourceTencentCloudTatInvokerConfig(),
 			"tencentcloud_tat_invocation_invoke_attachment":                                         tat.ResourceTencentCloudTatInvocationInvokeAttachment(),
 			"tencentcloud_tat_invocation_command_attachment":                                        tat.ResourceTencentCloudTatInvocationCommandAttachment(),
+			"tencentcloud_tat_command_execution":                                                    tat.ResourceTencentCloudTatCommandExecution(),
 			"tencentcloud_organization_org_node":                                                    tco.ResourceTencentCloudOrganizationOrgNode(),
 			"tencentcloud_organization_org_member":                                                  tco.ResourceTencentCloudOrganizationOrgMember(),
 			"tencentcloud_organization_org_identity":                                                tco.ResourceTencentCloudOrganizationOrgIdentity(),
@@ -2125,6 +2143,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_mps_process_media_operation":                                              mps.ResourceTencentCloudMpsProcessMediaOperation(),
 			"tencentcloud_cbs_disk_backup":                                                          cbs.ResourceTencentCloudCbsDiskBackup(),
 			"tencentcloud_cbs_snapshot_share_permission":                                            cbs.ResourceTencentCloudCbsSnapshotSharePermission(),
+			"tencentcloud_cbs_snapshot_copy":                                                        cbs.ResourceTencentCloudCbsSnapshotCopy(),
 			"tencentcloud_cbs_disk_backup_rollback_operation":                                       cbs.ResourceTencentCloudCbsDiskBackupRollbackOperation(),
 			"tencentcloud_chdfs_access_group":                                                       chdfs.ResourceTencentCloudChdfsAccessGroup(),
 			"tencentcloud_chdfs_access_rule":                                                        chdfs.ResourceTencentCloudChdfsAccessRule(),
@@ -2145,6 +2164,7 @@ func Provider() *schema.Provider {
 			"tencentcloud_cvm_renew_instance":                                                       cvm.ResourceTencentCloudCvmRenewInstance(),
 			"tencentcloud_cvm_export_images":                                                        cvm.ResourceTencentCloudCvmExportImages(),
 			"tencentcloud_cvm_image_share_permission":                                               cvm.ResourceTencentCloudCvmImageSharePermission(),
+			"tencentcloud_cvm_image_pipeline":                                                       cvm.ResourceTencentCloudCvmImagePipeline(),
 			"tencentcloud_cvm_import_image":                                                         cvm.ResourceTencentCloudCvmImportImage(),
 			"tencentcloud_cvm_renew_host":                                                           cvm.ResourceTencentCloudCvmRenewHost(),
 			"tencentcloud_cvm_program_fpga_image":                                                   cvm.ResourceTencentCloudCvmProgramFpgaImage(),
@@ -2350,6 +2370,16 @@ func Provider() *schema.Provider {
 
 		ConfigureFunc: providerConfigure,
 	}
+
+	for _, dataSource := range provider.DataSourcesMap {
+		tccommon.AddResultOutputOptions(dataSource)
+	}
+
+	for _, resource := range provider.ResourcesMap {
+		tccommon.AddProtectedTagsGuard(resource)
+	}
+
+	return provider
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
@@ -2454,6 +2484,13 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		needAccountFilter = true
 	}
 
+	if v, ok := d.GetOk("protected_tags"); ok {
+		tcClient.protectedTags = make(map[string]string)
+		for k, v := range v.(map[string]interface{}) {
+			tcClient.protectedTags[k] = v.(string)
+		}
+	}
+
 	// get auth from CAM role name
 	if camRoleName != "" {
 		needSecret = false
@@ -2485,7 +2522,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	if assumeRoleArn != "" && assumeRoleSessionName != "" {
 		assumeRoleSessionDuration = 7200
-		err = genClientWithSTS(&tcClient, assumeRoleArn, assumeRoleSessionName, assumeRoleSessionDuration, assumeRolePolicy, assumeRoleExternalId, assumeRoleSourceIdentity, assumeRoleSerialNumber, assumeRoleTokenCode)
+		err = genClientWithSTS(&tcClient, assumeRoleArn, assumeRoleSessionName, assumeRoleSessionDuration, assumeRolePolicy, assumeRoleExternalId, assumeRoleSourceIdentity, assumeRoleSerialNumber, assumeRoleTokenCode, nil)
 		if err != nil {
 			return nil, fmt.Errorf("Get auth from assume role by credential failed. Reason: %s", err.Error())
 		}
@@ -2520,7 +2557,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 		if envSamlAssertion == "" && envPrincipalArn == "" && envWebIdentityToken == "" {
 			// use assume role
-			err = genClientWithSTS(&tcClient, envRoleArn, envSessionName, assumeRoleSessionDuration, "", assumeRoleExternalId, assumeRoleSourceIdentity, assumeRoleSerialNumber, assumeRoleTokenCode)
+			err = genClientWithSTS(&tcClient, envRoleArn, envSessionName, assumeRoleSessionDuration, "", assumeRoleExternalId, assumeRoleSourceIdentity, assumeRoleSerialNumber, assumeRoleTokenCode, nil)
 			if err != nil {
 				return nil, fmt.Errorf("Get auth from assume role by env failed. Reason: %s", err.Error())
 			}
@@ -2547,11 +2584,15 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
-	// get assume role from tf
+	// get assume role from tf, each hop uses the credentials of the previous one
 	if v, ok := d.GetOk("assume_role"); ok {
-		assumeRoleList := v.(*schema.Set).List()
-		if len(assumeRoleList) == 1 {
-			assumeRole := assumeRoleList[0].(map[string]interface{})
+		assumeRoleList := v.([]interface{})
+		for i, item := range assumeRoleList {
+			assumeRole, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("Get auth from assume role failed. Reason: the `assume_role` block %d is empty", i+1)
+			}
+
 			assumeRoleArn = assumeRole["role_arn"].(string)
 			assumeRoleSessionName = assumeRole["session_name"].(string)
 			assumeRoleSessionDuration = assumeRole["session_duration"].(int)
@@ -2560,9 +2601,34 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			assumeRoleSourceIdentity = assumeRole["source_identity"].(string)
 			assumeRoleSerialNumber = assumeRole["serial_number"].(string)
 			assumeRoleTokenCode = assumeRole["token_code"].(string)
+			// the env only sources the first hop, an external ID or a MFA code is not valid for the other roles of the chain
+			if i == 0 {
+				if assumeRoleExternalId == "" {
+					assumeRoleExternalId = os.Getenv(PROVIDER_ASSUME_ROLE_EXTERNAL_ID)
+				}
+				if assumeRoleSourceIdentity == "" {
+					assumeRoleSourceIdentity = os.Getenv(PROVIDER_ASSUME_ROLE_SOURCE_IDENTITY)
+				}
+				if assumeRoleSerialNumber == "" {
+					assumeRoleSerialNumber = os.Getenv(PROVIDER_ASSUME_ROLE_SERIAL_NUMBER)
+				}
+				if assumeRoleTokenCode == "" {
+					assumeRoleTokenCode = os.Getenv(PROVIDER_ASSUME_ROLE_TOKEN_CODE)
+				}
+			}
+			assumeRoleSessionTags := make(map[string]string)
+			if tags, ok := assumeRole["session_tags"].(map[string]interface{}); ok {
+				for k, v := range tags {
+					assumeRoleSessionTags[k] = v.(string)
+				}
+			}
 
-			err = genClientWithSTS(&tcClient, assumeRoleArn, assumeRoleSessionName, assumeRoleSessionDuration, assumeRolePolicy, assumeRoleExternalId, assumeRoleSourceIdentity, assumeRoleSerialNumber, assumeRoleTokenCode)
+			err = genClientWithSTS(&tcClient, assumeRoleArn, assumeRoleSessionName, assumeRoleSessionDuration, assumeRolePolicy, assumeRoleExternalId, assumeRoleSourceIdentity, assumeRoleSerialNumber, assumeRoleTokenCode, assumeRoleSessionTags)
 			if err != nil {
+				if len(assumeRoleList) > 1 {
+					return nil, fmt.Errorf("Get auth from assume role `%s` (hop %d of %d) failed. Reason: %s", assumeRoleArn, i+1, len(assumeRoleList), err.Error())
+				}
+
 				return nil, fmt.Errorf("Get auth from assume role failed. Reason: %s", err.Error())
 			}
 
@@ -2679,10 +2745,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	if needAccountFilter {
-		// get indentity
+		// get indentity with the final credentials, which are the temporary ones when any role is assumed
 		indentity, err := getCallerIdentity(&tcClient)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("Get caller identity for the account check failed. Reason: %s", err.Error())
 		}
 
 		// account filter
@@ -2725,7 +2791,7 @@ func genClientWithCAM(tcClient *TencentCloudClient, roleName string) error {
 	return nil
 }
 
-func genClientWithSTS(tcClient *TencentCloudClient, assumeRoleArn, assumeRoleSessionName string, assumeRoleSessionDuration int, assumeRolePolicy string, assumeRoleExternalId string, assumeRoleSourceIdentity string, assumeRoleSerialNumber string, assumeRoleTokenCode string) error {
+func genClientWithSTS(tcClient *TencentCloudClient, assumeRoleArn, assumeRoleSessionName string, assumeRoleSessionDuration int, assumeRolePolicy string, assumeRoleExternalId string, assumeRoleSourceIdentity string, assumeRoleSerialNumber string, assumeRoleTokenCode string, assumeRoleSessionTags map[string]string) error {
 	// applying STS credentials
 	request := sdksts.NewAssumeRoleRequest()
 	response := sdksts.NewAssumeRoleResponse()
@@ -2752,6 +2818,13 @@ func genClientWithSTS(tcClient *TencentCloudClient, assumeRoleArn, assumeRoleSes
 		request.TokenCode = helper.String(assumeRoleTokenCode)
 	}
 
+	for k, v := range assumeRoleSessionTags {
+		request.Tags = append(request.Tags, &sdksts.Tag{
+			Key:   helper.String(k),
+			Value: helper.String(v),
+		})
+	}
+
 	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 		ratelimit.Check(request.GetAction())
 		result, e := tcClient.apiV3Conn.UseStsClient().AssumeRole(request)
@@ -3027,19 +3100,14 @@ func genClientWithPodOidc(tcClient *TencentCloudClient) error {
 	return nil
 }
 
+// getCallerIdentity resolves the identity of the current credentials of tcClient, such as the temporary ones returned
+// by the STS exchange of SAML or OIDC.
 func getCallerIdentity(tcClient *TencentCloudClient) (indentity *sdksts.GetCallerIdentityResponseParams, err error) {
-	ak := tcClient.apiV3Conn.Credential.SecretId
-	sk := tcClient.apiV3Conn.Credential.SecretKey
-	token := tcClient.apiV3Conn.Credential.Token
-	region := tcClient.apiV3Conn.Region
-	credential := sdkcommon.NewTokenCredential(ak, sk, token)
-	cpf := sdkprofile.NewClientProfile()
-	cpf.HttpProfile.Endpoint = "sts.tencentcloudapi.com"
-	client, _ := sdksts.NewClient(credential, region, cpf)
 	request := sdksts.NewGetCallerIdentityRequest()
 	response := sdksts.NewGetCallerIdentityResponse()
 	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		result, e := client.GetCallerIdentity(request)
+		ratelimit.Check(request.GetAction())
+		result, e := tcClient.apiV3Conn.UseStsClient().GetCallerIdentity(request)
 		if e != nil {
 			return tccommon.RetryError(e)
 		}
@@ -0,0 +1,252 @@
+package tencentcloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/functions"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/sts"
+)
+
+// ProtoV5ProviderServerFactory muxes the SDKv2 provider with the Plugin Framework provider, which hosts the
+// provider-defined functions and everything the SDK can not express. Both servers share one configured client.
+func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider := Provider()
+	fwProvider, err := NewFrameworkProvider(ctx, sdkProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	// the SDK server goes first, so the client is configured before the framework provider picks it up
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, sdkProvider.GRPCProvider, providerserver.NewProtocol5(fwProvider))
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+var (
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithFunctions          = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+)
+
+type frameworkProvider struct {
+	sdkProvider *schema.Provider
+	schema      fwschema.Schema
+}
+
+// NewFrameworkProvider returns the Plugin Framework provider, whose schema is derived from sdkProvider,
+// as the mux server requires every provider server to declare an identical provider schema.
+func NewFrameworkProvider(ctx context.Context, sdkProvider *schema.Provider) (provider.Provider, error) {
+	resp, err := schema.NewGRPCProviderServer(sdkProvider).GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return nil, fmt.Errorf("get provider schema failed: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	attributes, blocks, err := frameworkSchemaFromBlock(resp.Provider.Block)
+	if err != nil {
+		return nil, err
+	}
+	p := &frameworkProvider{
+		sdkProvider: sdkProvider,
+		schema: fwschema.Schema{
+			Attributes: attributes,
+			Blocks:     blocks,
+		},
+	}
+	setFrameworkDescription(resp.Provider.Block.Description, resp.Provider.Block.DescriptionKind, &p.schema.Description, &p.schema.MarkdownDescription)
+	if resp.Provider.Block.Deprecated {
+		p.schema.DeprecationMessage = "deprecated"
+	}
+	return p, nil
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "tencentcloud"
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = p.schema
+}
+
+// Configure hands the client configured by the SDK provider over to the framework resources,
+// the provider arguments have been validated and parsed there already.
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	meta := p.sdkProvider.Meta()
+	resp.DataSourceData = meta
+	resp.ResourceData = meta
+	resp.EphemeralResourceData = meta
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		sts.NewStsTemporaryCredentialEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewParseResourceIdFunction,
+		functions.NewQcsResourceNameFunction,
+		functions.NewShortRegionFunction,
+	}
+}
+
+func frameworkSchemaFromBlock(block *tfprotov5.SchemaBlock) (map[string]fwschema.Attribute, map[string]fwschema.Block, error) {
+	attributes := make(map[string]fwschema.Attribute, len(block.Attributes))
+	for _, a := range block.Attributes {
+		attribute, err := frameworkAttribute(a)
+		if err != nil {
+			return nil, nil, fmt.Errorf("attribute `%s`: %s", a.Name, err)
+		}
+		attributes[a.Name] = attribute
+	}
+
+	blocks := make(map[string]fwschema.Block, len(block.BlockTypes))
+	for _, b := range block.BlockTypes {
+		nestedAttributes, nestedBlocks, err := frameworkSchemaFromBlock(b.Block)
+		if err != nil {
+			return nil, nil, fmt.Errorf("block `%s`: %s", b.TypeName, err)
+		}
+		object := fwschema.NestedBlockObject{Attributes: nestedAttributes, Blocks: nestedBlocks}
+
+		var description, markdownDescription, deprecationMessage string
+		setFrameworkDescription(b.Block.Description, b.Block.DescriptionKind, &description, &markdownDescription)
+		if b.Block.Deprecated {
+			deprecationMessage = "deprecated"
+		}
+
+		switch b.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList:
+			blocks[b.TypeName] = fwschema.ListNestedBlock{
+				NestedObject:        object,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  deprecationMessage,
+			}
+		case tfprotov5.SchemaNestedBlockNestingModeSet:
+			blocks[b.TypeName] = fwschema.SetNestedBlock{
+				NestedObject:        object,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  deprecationMessage,
+			}
+		case tfprotov5.SchemaNestedBlockNestingModeSingle:
+			blocks[b.TypeName] = fwschema.SingleNestedBlock{
+				Attributes:          nestedAttributes,
+				Blocks:              nestedBlocks,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  deprecationMessage,
+			}
+		default:
+			return nil, nil, fmt.Errorf("block `%s`: unsupported nesting mode %s", b.TypeName, b.Nesting)
+		}
+	}
+	return attributes, blocks, nil
+}
+
+func frameworkAttribute(a *tfprotov5.SchemaAttribute) (fwschema.Attribute, error) {
+	var description, markdownDescription, deprecationMessage string
+	setFrameworkDescription(a.Description, a.DescriptionKind, &description, &markdownDescription)
+	if a.Deprecated {
+		deprecationMessage = "deprecated"
+	}
+
+	attrType, err := frameworkAttrType(a.Type)
+	if err != nil {
+		return nil, err
+	}
+	switch t := attrType.(type) {
+	case basetypes.StringType:
+		return fwschema.StringAttribute{Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive,
+			Description: description, MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}, nil
+	case basetypes.NumberType:
+		return fwschema.NumberAttribute{Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive,
+			Description: description, MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}, nil
+	case basetypes.BoolType:
+		return fwschema.BoolAttribute{Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive,
+			Description: description, MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}, nil
+	case types.ListType:
+		return fwschema.ListAttribute{ElementType: t.ElemType, Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive,
+			Description: description, MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}, nil
+	case types.SetType:
+		return fwschema.SetAttribute{ElementType: t.ElemType, Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive,
+			Description: description, MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}, nil
+	case types.MapType:
+		return fwschema.MapAttribute{ElementType: t.ElemType, Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive,
+			Description: description, MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}, nil
+	case types.ObjectType:
+		return fwschema.ObjectAttribute{AttributeTypes: t.AttrTypes, Required: a.Required, Optional: a.Optional, Sensitive: a.Sensitive,
+			Description: description, MarkdownDescription: markdownDescription, DeprecationMessage: deprecationMessage}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", a.Type)
+}
+
+func frameworkAttrType(t tftypes.Type) (attr.Type, error) {
+	switch {
+	case t.Is(tftypes.String):
+		return types.StringType, nil
+	case t.Is(tftypes.Number):
+		return types.NumberType, nil
+	case t.Is(tftypes.Bool):
+		return types.BoolType, nil
+	case t.Is(tftypes.List{}):
+		elem, err := frameworkAttrType(t.(tftypes.List).ElementType)
+		return types.ListType{ElemType: elem}, err
+	case t.Is(tftypes.Set{}):
+		elem, err := frameworkAttrType(t.(tftypes.Set).ElementType)
+		return types.SetType{ElemType: elem}, err
+	case t.Is(tftypes.Map{}):
+		elem, err := frameworkAttrType(t.(tftypes.Map).ElementType)
+		return types.MapType{ElemType: elem}, err
+	case t.Is(tftypes.Object{}):
+		attrTypes := make(map[string]attr.Type)
+		for name, attributeType := range t.(tftypes.Object).AttributeTypes {
+			elem, err := frameworkAttrType(attributeType)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[name] = elem
+		}
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+func setFrameworkDescription(value string, kind tfprotov5.StringKind, description, markdownDescription *string) {
+	if kind == tfprotov5.StringKindMarkdown {
+		*markdownDescription = value
+		return
+	}
+	*description = value
+}
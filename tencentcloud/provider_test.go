@@ -1,10 +1,15 @@
 package tencentcloud
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	sdksts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 )
 
@@ -22,6 +27,73 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// TestProviderSensitiveAudit fails when a credential-like attribute, such as a password, secret key, token or
+// kubeconfig, is added to a resource or data source without `Sensitive: true`.
+func TestProviderSensitiveAudit(t *testing.T) {
+	p := Provider()
+	for _, path := range tccommon.AuditSensitive(p.ResourcesMap) {
+		t.Errorf("resource attribute %s looks like a credential but is not sensitive", path)
+	}
+	for _, path := range tccommon.AuditSensitive(p.DataSourcesMap) {
+		t.Errorf("data source attribute %s looks like a credential but is not sensitive", path)
+	}
+}
+
 func TestProviderImpl(t *testing.T) {
 	var _ = Provider()
 }
+
+func TestProtoV5ProviderServerFactory(t *testing.T) {
+	ctx := context.Background()
+	serverFactory, err := ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// the mux server reports mismatched provider schemas of the SDK and framework providers as diagnostics
+	resp, err := serverFactory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("err: %s: %s", d.Summary, d.Detail)
+		}
+	}
+	for _, name := range []string{"parse_resource_id", "qcs_resource_name", "short_region"} {
+		if _, ok := resp.Functions[name]; !ok {
+			t.Errorf("function %s is not served", name)
+		}
+	}
+	if _, ok := resp.EphemeralResourceSchemas["tencentcloud_sts_temporary_credential"]; !ok {
+		t.Errorf("ephemeral resource tencentcloud_sts_temporary_credential is not served")
+	}
+	if _, ok := resp.ResourceSchemas["tencentcloud_instance"]; !ok {
+		t.Errorf("resource tencentcloud_instance is not served")
+	}
+}
+
+// TestProviderAccountGuardWithFederation makes sure the account guard can be combined with SAML and OIDC, whose
+// temporary credentials are checked by verifyAccountIDAllowed after the STS exchange.
+func TestProviderAccountGuardWithFederation(t *testing.T) {
+	p := Provider()
+	for _, key := range []string{"allowed_account_ids", "forbidden_account_ids"} {
+		for _, conflict := range p.Schema[key].ConflictsWith {
+			if conflict == "assume_role_with_saml" || conflict == "assume_role_with_web_identity" {
+				t.Errorf("%s conflicts with %s", key, conflict)
+			}
+		}
+	}
+
+	accountId := "100000000001"
+	indentity := &sdksts.GetCallerIdentityResponseParams{AccountId: &accountId}
+	if err := verifyAccountIDAllowed(indentity, []string{accountId}, nil); err != nil {
+		t.Errorf("allowed account rejected: %s", err)
+	}
+	if err := verifyAccountIDAllowed(indentity, []string{"100000000002"}, nil); err == nil {
+		t.Errorf("account not in allowed_account_ids accepted")
+	}
+	if err := verifyAccountIDAllowed(indentity, nil, []string{accountId}); err == nil {
+		t.Errorf("account in forbidden_account_ids accepted")
+	}
+}
@@ -162,7 +162,7 @@ func dataSourceTencentCloudAntiddosBgpBizTrendRead(d *schema.ResourceData, meta
 	d.SetId(instanceId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -488,7 +488,7 @@ func dataSourceTencentCloudAntiddosListListenerRead(d *schema.ResourceData, meta
 	d.SetId(helper.BuildToken())
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
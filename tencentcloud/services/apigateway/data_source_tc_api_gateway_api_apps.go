@@ -58,6 +58,7 @@ func DataSourceTencentCloudAPIGatewayAPIApps() *schema.Resource {
 						},
 						"api_app_secret": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "ApiApp secret.",
 						},
@@ -152,7 +153,7 @@ func dataSourceTencentCloudAPIGatewayAPIAppsRead(d *schema.ResourceData, meta in
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), apiAppList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), apiAppList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -51,6 +51,7 @@ func DataSourceTencentCloudAPIGatewayAPIKeys() *schema.Resource {
 						},
 						"access_key_secret": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "Created API key.",
 						},
@@ -119,7 +120,7 @@ func dataSourceTencentCloudAPIGatewayAPIKeysRead(d *schema.ResourceData, meta in
 	d.SetId(strings.Join([]string{secretName, accessKeyId}, tccommon.FILED_SP))
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return tccommon.WriteToFile(output.(string), list)
+		return tccommon.WriteToFile(output.(string), list, tccommon.GetResultOutputOptions(d))
 	}
 	return nil
 }
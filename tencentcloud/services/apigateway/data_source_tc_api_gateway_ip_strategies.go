@@ -276,7 +276,7 @@ func dataSourceTencentCloudAPIGatewayIpStrategyRead(d *schema.ResourceData, meta
 	d.SetId(strings.Join([]string{serviceId, strategyName}, tccommon.FILED_SP))
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return tccommon.WriteToFile(output.(string), list)
+		return tccommon.WriteToFile(output.(string), list, tccommon.GetResultOutputOptions(d))
 	}
 	return nil
 }
@@ -189,7 +189,7 @@ func dataSourceTencentCloudAPIGatewayThrottlingApisRead(d *schema.ResourceData,
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err := tccommon.WriteToFile(output.(string), resultLists); err != nil {
+		if err := tccommon.WriteToFile(output.(string), resultLists, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
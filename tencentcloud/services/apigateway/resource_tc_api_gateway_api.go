@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudAPIGatewayAPI() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayAPICreate,
-		Read:   resourceTencentCloudAPIGatewayAPIRead,
-		Update: resourceTencentCloudAPIGatewayAPIUpdate,
-		Delete: resourceTencentCloudAPIGatewayAPIDelete,
+		Create:   resourceTencentCloudAPIGatewayAPICreate,
+		Read:     resourceTencentCloudAPIGatewayAPIRead,
+		Update:   resourceTencentCloudAPIGatewayAPIUpdate,
+		Delete:   resourceTencentCloudAPIGatewayAPIDelete,
+		Importer: helper.IdSpec{"service_id", "api_id"}.ImporterWithArguments("service_id"),
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
@@ -53,6 +53,7 @@ func ResourceTencentCloudAPIGatewayAPIApp() *schema.Resource {
 			},
 			"api_app_secret": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "Api app secret.",
 			},
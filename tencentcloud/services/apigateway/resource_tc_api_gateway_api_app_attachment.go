@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudAPIGatewayApiAppAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayApiAppAttachmentCreate,
-		Read:   resourceTencentCloudAPIGatewayApiAppAttachmentRead,
-		Delete: resourceTencentCloudAPIGatewayApiAppAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAPIGatewayApiAppAttachmentCreate,
+		Read:     resourceTencentCloudAPIGatewayApiAppAttachmentRead,
+		Delete:   resourceTencentCloudAPIGatewayApiAppAttachmentDelete,
+		Importer: helper.IdSpec{"api_app_id", "environment", "service_id", "api_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"api_app_id": {
 				Required:    true,
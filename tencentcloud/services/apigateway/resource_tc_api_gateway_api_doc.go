@@ -72,6 +72,7 @@ func ResourceTencentCloudAPIGatewayAPIDoc() *schema.Resource {
 			},
 			"share_password": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "API Document Sharing Password.",
 			},
@@ -53,6 +53,7 @@ func ResourceTencentCloudAPIGatewayAPIKey() *schema.Resource {
 			},
 			"access_key_secret": {
 				Type:         schema.TypeString,
+				Sensitive:    true,
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: tccommon.ValidateStringLengthInRange(10, 50),
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,12 +15,10 @@ import (
 
 func ResourceTencentCloudAPIGatewayAPIKeyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayAPIKeyAttachmentCreate,
-		Read:   resourceTencentCloudAPIGatewayAPIKeyAttachmentRead,
-		Delete: resourceTencentCloudAPIGatewayAPIKeyAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAPIGatewayAPIKeyAttachmentCreate,
+		Read:     resourceTencentCloudAPIGatewayAPIKeyAttachmentRead,
+		Delete:   resourceTencentCloudAPIGatewayAPIKeyAttachmentDelete,
+		Importer: helper.IdSpec{"api_key_id", "usage_plan_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"api_key_id": {
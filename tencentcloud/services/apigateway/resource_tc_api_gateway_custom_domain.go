@@ -14,10 +14,11 @@ import (
 
 func ResourceTencentCloudAPIGatewayCustomDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayCustomDomainCreate,
-		Read:   resourceTencentCloudAPIGatewayCustomDomainRead,
-		Update: resourceTencentCloudAPIGatewayCustomDomainUpdate,
-		Delete: resourceTencentCloudAPIGatewayCustomDomainDelete,
+		Create:   resourceTencentCloudAPIGatewayCustomDomainCreate,
+		Read:     resourceTencentCloudAPIGatewayCustomDomainRead,
+		Update:   resourceTencentCloudAPIGatewayCustomDomainUpdate,
+		Delete:   resourceTencentCloudAPIGatewayCustomDomainDelete,
+		Importer: helper.IdSpec{"service_id", "sub_domain"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
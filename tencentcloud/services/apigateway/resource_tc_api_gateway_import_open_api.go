@@ -17,9 +17,10 @@ import (
 
 func ResourceTencentCloudApiGatewayImportOpenApi() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudApiGatewayImportOpenApiCreate,
-		Read:   resourceTencentCloudApiGatewayImportOpenApiRead,
-		Delete: resourceTencentCloudApiGatewayImportOpenApiDelete,
+		Create:   resourceTencentCloudApiGatewayImportOpenApiCreate,
+		Read:     resourceTencentCloudApiGatewayImportOpenApiRead,
+		Delete:   resourceTencentCloudApiGatewayImportOpenApiDelete,
+		Importer: helper.IdSpec{"service_id", "api_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
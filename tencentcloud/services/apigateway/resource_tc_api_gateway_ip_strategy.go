@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,13 +15,11 @@ import (
 
 func ResourceTencentCloudAPIGatewayIPStrategy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayIPStrategyCreate,
-		Read:   resourceTencentCloudAPIGatewayIPStrategyRead,
-		Update: resourceTencentCloudAPIGatewayIPStrategyUpdate,
-		Delete: resourceTencentCloudAPIGatewayIPStrategyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAPIGatewayIPStrategyCreate,
+		Read:     resourceTencentCloudAPIGatewayIPStrategyRead,
+		Update:   resourceTencentCloudAPIGatewayIPStrategyUpdate,
+		Delete:   resourceTencentCloudAPIGatewayIPStrategyDelete,
+		Importer: helper.IdSpec{"service_id", "strategy_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
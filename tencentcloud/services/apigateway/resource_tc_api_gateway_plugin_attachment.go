@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudAPIGatewayPluginAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayPluginAttachmentCreate,
-		Read:   resourceTencentCloudAPIGatewayPluginAttachmentRead,
-		Delete: resourceTencentCloudAPIGatewayPluginAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAPIGatewayPluginAttachmentCreate,
+		Read:     resourceTencentCloudAPIGatewayPluginAttachmentRead,
+		Delete:   resourceTencentCloudAPIGatewayPluginAttachmentDelete,
+		Importer: helper.IdSpec{"plugin_id", "service_id", "environment_name", "api_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"plugin_id": {
 				Required:    true,
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,12 +15,10 @@ import (
 
 func ResourceTencentCloudAPIGatewayServiceRelease() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayServiceReleaseCreate,
-		Read:   resourceTencentCloudAPIGatewayServiceReleaseRead,
-		Delete: resourceTencentCloudAPIGatewayServiceReleaseDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAPIGatewayServiceReleaseCreate,
+		Read:     resourceTencentCloudAPIGatewayServiceReleaseRead,
+		Delete:   resourceTencentCloudAPIGatewayServiceReleaseDelete,
+		Importer: helper.IdSpec{"service_id", "environment_name", "release_version"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
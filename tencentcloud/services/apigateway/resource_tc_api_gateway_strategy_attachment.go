@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,12 +14,10 @@ import (
 
 func ResourceTencentCloudAPIGatewayStrategyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayStrategyAttachmentCreate,
-		Read:   resourceTencentCloudAPIGatewayStrategyAttachmentRead,
-		Delete: resourceTencentCloudAPIGatewayStrategyAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAPIGatewayStrategyAttachmentCreate,
+		Read:     resourceTencentCloudAPIGatewayStrategyAttachmentRead,
+		Delete:   resourceTencentCloudAPIGatewayStrategyAttachmentDelete,
+		Importer: helper.IdSpec{"service_id", "strategy_id", "bind_api_id", "environment_name"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"service_id": {
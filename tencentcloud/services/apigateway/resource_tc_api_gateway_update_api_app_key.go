@@ -37,6 +37,8 @@ func ResourceTencentCloudApiGatewayUpdateApiAppKey() *schema.Resource {
 			//	Type:        schema.TypeString,
 			//	Description: "Application Secret.",
 			//},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -37,6 +37,8 @@ func ResourceTencentCloudAPIGatewayUpdateService() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The version number of the switch.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,12 +15,10 @@ import (
 
 func ResourceTencentCloudAPIGatewayUsagePlanAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAPIGatewayUsagePlanAttachmentCreate,
-		Read:   resourceTencentCloudAPIGatewayUsagePlanAttachmentRead,
-		Delete: resourceTencentCloudAPIGatewayUsagePlanAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAPIGatewayUsagePlanAttachmentCreate,
+		Read:     resourceTencentCloudAPIGatewayUsagePlanAttachmentRead,
+		Delete:   resourceTencentCloudAPIGatewayUsagePlanAttachmentDelete,
+		Importer: helper.IdSpec{"usage_plan_id", "service_id", "environment", "bind_type", "[api_id]", "[access_key_ids]"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"usage_plan_id": {
@@ -287,7 +287,7 @@ func dataSourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta inter
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err := tccommon.WriteToFile(output.(string), scalingGroupList); err != nil {
+		if err := tccommon.WriteToFile(output.(string), scalingGroupList, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
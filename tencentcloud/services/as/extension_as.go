@@ -189,3 +189,23 @@ const (
 const (
 	REFRESH_ACTIVITIES_SUCCESSFUL = "SUCCESSFUL"
 )
+
+const (
+	INSTANCE_ALLOCATION_POLICY_LAUNCH_CONFIGURATION = "LAUNCH_CONFIGURATION"
+	INSTANCE_ALLOCATION_POLICY_SPOT_MIXED           = "SPOT_MIXED"
+)
+
+var INSTANCE_ALLOCATION_POLICY = []string{
+	INSTANCE_ALLOCATION_POLICY_LAUNCH_CONFIGURATION,
+	INSTANCE_ALLOCATION_POLICY_SPOT_MIXED,
+}
+
+const (
+	SPOT_ALLOCATION_STRATEGY_COST_OPTIMIZED     = "COST_OPTIMIZED"
+	SPOT_ALLOCATION_STRATEGY_CAPACITY_OPTIMIZED = "CAPACITY_OPTIMIZED"
+)
+
+var SPOT_ALLOCATION_STRATEGY = []string{
+	SPOT_ALLOCATION_STRATEGY_COST_OPTIMIZED,
+	SPOT_ALLOCATION_STRATEGY_CAPACITY_OPTIMIZED,
+}
@@ -13,10 +13,11 @@ import (
 
 func ResourceTencentCloudAsAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsAttachmentCreate,
-		Read:   resourceTencentCloudAsAttachmentRead,
-		Update: resourceTencentCloudAsAttachmentUpdate,
-		Delete: resourceTencentCloudAsAttachmentDelete,
+		Create:   resourceTencentCloudAsAttachmentCreate,
+		Read:     resourceTencentCloudAsAttachmentRead,
+		Update:   resourceTencentCloudAsAttachmentUpdate,
+		Delete:   resourceTencentCloudAsAttachmentDelete,
+		Importer: helper.IdSpec{"scaling_group_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudAsNotification() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsNotificationCreate,
-		Read:   resourceTencentCloudAsNotificationRead,
-		Update: resourceTencentCloudAsNotificationUpdate,
-		Delete: resourceTencentCloudAsNotificationDelete,
+		Create:   resourceTencentCloudAsNotificationCreate,
+		Read:     resourceTencentCloudAsNotificationRead,
+		Update:   resourceTencentCloudAsNotificationUpdate,
+		Delete:   resourceTencentCloudAsNotificationDelete,
+		Importer: helper.IdSpec{"notification_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -41,6 +41,8 @@ func ResourceTencentCloudAsProtectInstances() *schema.Resource {
 				Type:        schema.TypeBool,
 				Description: "If instances need protect.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -34,6 +34,8 @@ func ResourceTencentCloudAsRemoveInstances() *schema.Resource {
 				},
 				Description: "List of cvm instances to remove.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
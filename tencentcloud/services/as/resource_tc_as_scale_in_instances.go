@@ -31,6 +31,8 @@ func ResourceTencentCloudAsScaleInInstances() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "Number of instances to be reduced.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
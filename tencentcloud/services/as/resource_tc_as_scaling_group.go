@@ -4,15 +4,20 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	svccvm "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cvm"
 	svctag "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tag"
+	svcvpc "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	as "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/as/v20180419"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
@@ -26,6 +31,7 @@ func ResourceTencentCloudAsScalingGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceTencentCloudAsScalingGroupCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_name": {
@@ -197,6 +203,50 @@ func ResourceTencentCloudAsScalingGroup() *schema.Resource {
 				Computed:    true,
 				Description: "Grace period of the CLB health check during which the `IN_SERVICE` instances added will not be marked as `CLB_UNHEALTHY`.<br>Valid range: 0-7200, in seconds. Default value: `0`.",
 			},
+			"instance_allocation_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tccommon.ValidateAllowedStringValue(INSTANCE_ALLOCATION_POLICY),
+				Description:  "Instance allocation policy. Valid values: `LAUNCH_CONFIGURATION`: instances are created as the launch configuration describes; `SPOT_MIXED`: instances are created as a mix of pay-as-you-go and spot instances, which requires a pay-as-you-go launch configuration. Default value: `LAUNCH_CONFIGURATION`.",
+			},
+			"spot_mixed_allocation_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Allocation of pay-as-you-go and spot instances across the instance types of the launch configuration. Only available when `instance_allocation_policy` is `SPOT_MIXED`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base_capacity": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "Number of pay-as-you-go instances that make up the base capacity, which can not exceed `max_size`. Default value: `0`.",
+						},
+						"on_demand_percentage_above_base_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      70,
+							ValidateFunc: tccommon.ValidateIntegerInRange(0, 100),
+							Description:  "Percentage of pay-as-you-go instances above the base capacity, rounded up, the rest are spot instances. `0` means only spot instances are created above the base capacity, `100` means only pay-as-you-go instances. Default value: `70`.",
+						},
+						"spot_allocation_strategy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      SPOT_ALLOCATION_STRATEGY_COST_OPTIMIZED,
+							ValidateFunc: tccommon.ValidateAllowedStringValue(SPOT_ALLOCATION_STRATEGY),
+							Description:  "Strategy to allocate spot instances across the instance types. Valid values: `COST_OPTIMIZED`: try the instance types from the lowest price per core; `CAPACITY_OPTIMIZED`: try the instance types from the largest stock, to lower the chance of reclaiming. Default value: `COST_OPTIMIZED`.",
+						},
+						"compensate_with_base_instance": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether to create pay-as-you-go instances instead when all the spot instance types fail to create, such as when they are sold out. Default value: `true`.",
+						},
+					},
+				},
+			},
 			"tags": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -325,6 +375,14 @@ func resourceTencentCloudAsScalingGroupCreate(d *schema.ResourceData, meta inter
 		request.LoadBalancerHealthCheckGracePeriod = helper.IntUint64(v.(int))
 	}
 
+	if v, ok := d.GetOk("instance_allocation_policy"); ok {
+		request.InstanceAllocationPolicy = helper.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("spot_mixed_allocation_policy"); ok {
+		request.SpotMixedAllocationPolicy = expandAsSpotMixedAllocationPolicy(v.([]interface{}))
+	}
+
 	var (
 		replaceMonitorUnhealthy           = d.Get("replace_monitor_unhealthy").(bool)
 		scalingMode                       = d.Get("scaling_mode").(string)
@@ -463,6 +521,8 @@ func resourceTencentCloudAsScalingGroupRead(d *schema.ResourceData, meta interfa
 	if v, ok := d.GetOk("multi_zone_subnet_policy"); ok && v.(string) != "" {
 		_ = d.Set("multi_zone_subnet_policy", scalingGroup.MultiZoneSubnetPolicy)
 	}
+	_ = d.Set("instance_allocation_policy", scalingGroup.InstanceAllocationPolicy)
+	_ = d.Set("spot_mixed_allocation_policy", flattenAsSpotMixedAllocationPolicy(scalingGroup.SpotMixedAllocationPolicy))
 
 	if v, ok := d.GetOk("replace_monitor_unhealthy"); ok {
 		_ = d.Set("replace_monitor_unhealthy", v.(bool))
@@ -638,6 +698,13 @@ func resourceTencentCloudAsScalingGroupUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
+	if d.HasChange("instance_allocation_policy") || d.HasChange("spot_mixed_allocation_policy") {
+		request.InstanceAllocationPolicy = helper.String(d.Get("instance_allocation_policy").(string))
+		if v, ok := d.GetOk("spot_mixed_allocation_policy"); ok {
+			request.SpotMixedAllocationPolicy = expandAsSpotMixedAllocationPolicy(v.([]interface{}))
+		}
+	}
+
 	if err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		ratelimit.Check(request.GetAction())
 
@@ -785,3 +852,145 @@ func resourceTencentCloudAsScalingGroupDelete(d *schema.ResourceData, meta inter
 
 	return nil
 }
+
+func resourceTencentCloudAsScalingGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := d.GetOk("spot_mixed_allocation_policy"); ok && d.HasChange("spot_mixed_allocation_policy") {
+		if policy := d.Get("instance_allocation_policy").(string); d.NewValueKnown("instance_allocation_policy") && policy != INSTANCE_ALLOCATION_POLICY_SPOT_MIXED {
+			return fmt.Errorf("`spot_mixed_allocation_policy` is only available when `instance_allocation_policy` is `%s`", INSTANCE_ALLOCATION_POLICY_SPOT_MIXED)
+		}
+
+		if v, ok := d.GetOk("spot_mixed_allocation_policy.0.base_capacity"); ok && d.NewValueKnown("max_size") && v.(int) > d.Get("max_size").(int) {
+			return fmt.Errorf("`spot_mixed_allocation_policy.0.base_capacity` %d can not exceed `max_size` %d", v.(int), d.Get("max_size").(int))
+		}
+	}
+
+	// the instance types are checked only when both of the launch configuration and the subnets are known
+	if !d.HasChange("configuration_id") && !d.HasChange("subnet_ids") && !d.HasChange("zones") {
+		return nil
+	}
+	if !d.NewValueKnown("configuration_id") || !d.NewValueKnown("subnet_ids") || !d.NewValueKnown("zones") {
+		return nil
+	}
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx = context.WithValue(ctx, tccommon.LogIdKey, logId)
+	client := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
+	asService := AsService{client: client}
+
+	configurationId := d.Get("configuration_id").(string)
+	config, has, err := asService.DescribeLaunchConfigurationById(ctx, configurationId)
+	if err != nil {
+		return err
+	}
+	if has == 0 {
+		return fmt.Errorf("launch configuration %s of the scaling group is not found", configurationId)
+	}
+
+	instanceTypes := make([]string, 0, len(config.InstanceTypes))
+	for _, instanceType := range config.InstanceTypes {
+		instanceTypes = append(instanceTypes, *instanceType)
+	}
+	if len(instanceTypes) == 0 && config.InstanceType != nil {
+		instanceTypes = append(instanceTypes, *config.InstanceType)
+	}
+
+	zones := helper.InterfacesStrings(d.Get("zones").([]interface{}))
+	if subnetIds := helper.InterfacesStrings(d.Get("subnet_ids").([]interface{})); len(subnetIds) > 0 {
+		vpcService := svcvpc.NewVpcService(client)
+		zones = make([]string, 0, len(subnetIds))
+		for _, subnetId := range subnetIds {
+			subnet, err := vpcService.DescribeSubnetById(ctx, subnetId)
+			if err != nil {
+				return err
+			}
+			if subnet == nil {
+				return fmt.Errorf("subnet %s of the scaling group is not found", subnetId)
+			}
+			zones = append(zones, *subnet.Zone)
+		}
+	}
+	if len(instanceTypes) == 0 || len(zones) == 0 {
+		return nil
+	}
+
+	return checkAsInstanceTypesInZones(ctx, client, configurationId, instanceTypes, zones)
+}
+
+// checkAsInstanceTypesInZones errors on the instance types that are not offered in any of the zones,
+// since the scaling group would never create instances of them.
+func checkAsInstanceTypesInZones(ctx context.Context, client *connectivity.TencentCloudClient, configurationId string, instanceTypes, zones []string) error {
+	cvmService := svccvm.NewCvmService(client)
+	items, err := cvmService.DescribeInstancesSellTypeByFilter(ctx, map[string][]string{
+		"zone":          zones,
+		"instance-type": instanceTypes,
+	})
+	if err != nil {
+		return err
+	}
+
+	offered := make(map[string]bool, len(items))
+	for _, item := range items {
+		if item.InstanceType != nil {
+			offered[*item.InstanceType] = true
+		}
+	}
+
+	missing := make([]string, 0)
+	for _, instanceType := range instanceTypes {
+		if !offered[instanceType] {
+			missing = append(missing, instanceType)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	sort.Strings(missing)
+	return fmt.Errorf("instance types [%s] of launch configuration %s are not offered in the zones [%s] of the scaling group",
+		strings.Join(missing, ","), configurationId, strings.Join(zones, ","))
+}
+
+func expandAsSpotMixedAllocationPolicy(list []interface{}) *as.SpotMixedAllocationPolicy {
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+
+	m := list[0].(map[string]interface{})
+	policy := &as.SpotMixedAllocationPolicy{}
+	if v, ok := m["base_capacity"]; ok {
+		policy.BaseCapacity = helper.IntUint64(v.(int))
+	}
+	if v, ok := m["on_demand_percentage_above_base_capacity"]; ok {
+		policy.OnDemandPercentageAboveBaseCapacity = helper.IntUint64(v.(int))
+	}
+	if v, ok := m["spot_allocation_strategy"]; ok && v.(string) != "" {
+		policy.SpotAllocationStrategy = helper.String(v.(string))
+	}
+	if v, ok := m["compensate_with_base_instance"]; ok {
+		policy.CompensateWithBaseInstance = helper.Bool(v.(bool))
+	}
+
+	return policy
+}
+
+func flattenAsSpotMixedAllocationPolicy(policy *as.SpotMixedAllocationPolicy) []interface{} {
+	if policy == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{}
+	if policy.BaseCapacity != nil {
+		m["base_capacity"] = int(*policy.BaseCapacity)
+	}
+	if policy.OnDemandPercentageAboveBaseCapacity != nil {
+		m["on_demand_percentage_above_base_capacity"] = int(*policy.OnDemandPercentageAboveBaseCapacity)
+	}
+	if policy.SpotAllocationStrategy != nil {
+		m["spot_allocation_strategy"] = *policy.SpotAllocationStrategy
+	}
+	if policy.CompensateWithBaseInstance != nil {
+		m["compensate_with_base_instance"] = *policy.CompensateWithBaseInstance
+	}
+
+	return []interface{}{m}
+}
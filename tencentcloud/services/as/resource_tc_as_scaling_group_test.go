@@ -77,7 +77,7 @@ func TestAccTencentCloudAsScalingGroup_basic(t *testing.T) {
 		CheckDestroy: testAccCheckAsScalingGroupDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAsScalingGroup_basic(),
+				Config: testAccAsScalingGroup_basic(t),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckAsScalingGroupExists("tencentcloud_as_scaling_group.scaling_group"),
 					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "scaling_group_name", "tf-as-group-basic"),
@@ -107,7 +107,7 @@ func TestAccTencentCloudAsScalingGroup_full(t *testing.T) {
 		CheckDestroy: testAccCheckAsScalingGroupDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccAsScalingGroup_full(),
+				Config: testAccAsScalingGroup_full(t),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckAsScalingGroupExists("tencentcloud_as_scaling_group.scaling_group"),
 					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "scaling_group_name", "tf-as-group-full"),
@@ -129,7 +129,7 @@ func TestAccTencentCloudAsScalingGroup_full(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccAsScalingGroup_update(),
+				Config: testAccAsScalingGroup_update(t),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckAsScalingGroupExists("tencentcloud_as_scaling_group.scaling_group"),
 					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "scaling_group_name", "tf-as-group-update"),
@@ -150,6 +150,42 @@ func TestAccTencentCloudAsScalingGroup_full(t *testing.T) {
 	})
 }
 
+func TestAccTencentCloudAsScalingGroup_spotMixed(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { tcacctest.AccPreCheck(t) },
+		Providers:    tcacctest.AccProviders,
+		CheckDestroy: testAccCheckAsScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAsScalingGroup_spotMixed(t, 1, 50),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingGroupExists("tencentcloud_as_scaling_group.scaling_group"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "instance_allocation_policy", "SPOT_MIXED"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "spot_mixed_allocation_policy.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "spot_mixed_allocation_policy.0.base_capacity", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "spot_mixed_allocation_policy.0.on_demand_percentage_above_base_capacity", "50"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "spot_mixed_allocation_policy.0.spot_allocation_strategy", "CAPACITY_OPTIMIZED"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "spot_mixed_allocation_policy.0.compensate_with_base_instance", "true"),
+				),
+			},
+			{
+				Config: testAccAsScalingGroup_spotMixed(t, 0, 20),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAsScalingGroupExists("tencentcloud_as_scaling_group.scaling_group"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "spot_mixed_allocation_policy.0.base_capacity", "0"),
+					resource.TestCheckResourceAttr("tencentcloud_as_scaling_group.scaling_group", "spot_mixed_allocation_policy.0.on_demand_percentage_above_base_capacity", "20"),
+				),
+			},
+			{
+				ResourceName:      "tencentcloud_as_scaling_group.scaling_group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAsScalingGroupExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		logId := tccommon.GetLogId(tccommon.ContextNil)
@@ -195,7 +231,7 @@ func testAccCheckAsScalingGroupDestroy(s *terraform.State) error {
 	return nil
 }
 
-func testAccAsScalingGroup_basic() string {
+func testAccAsScalingGroup_basic(t *testing.T) string {
 	return fmt.Sprintf(`
 resource "tencentcloud_as_scaling_config" "launch_configuration" {
   configuration_name = "tf-as-configuration-basic"
@@ -215,10 +251,10 @@ resource "tencentcloud_as_scaling_group" "scaling_group" {
   vpc_id             = "%s"
   subnet_ids         = ["%s"]
 }
-`, tcacctest.DefaultVpcId, tcacctest.DefaultSubnetId)
+`, tcacctest.FixtureVpcId(t), tcacctest.FixtureSubnetId(t))
 }
 
-func testAccAsScalingGroup_full() string {
+func testAccAsScalingGroup_full(t *testing.T) string {
 	return fmt.Sprintf(`
 
 resource "tencentcloud_as_scaling_config" "launch_configuration" {
@@ -247,10 +283,10 @@ resource "tencentcloud_as_scaling_group" "scaling_group" {
     "test" = "test"
   }
 }
-`, tcacctest.DefaultVpcId, tcacctest.DefaultSubnetId)
+`, tcacctest.FixtureVpcId(t), tcacctest.FixtureSubnetId(t))
 }
 
-func testAccAsScalingGroup_update() string {
+func testAccAsScalingGroup_update(t *testing.T) string {
 	return fmt.Sprintf(`
 
 resource "tencentcloud_as_scaling_config" "launch_configuration" {
@@ -282,5 +318,35 @@ resource "tencentcloud_as_scaling_group" "scaling_group" {
     "abc" = "abc"
   }
 }
-`, tcacctest.DefaultVpcId, tcacctest.DefaultSubnetId)
+`, tcacctest.FixtureVpcId(t), tcacctest.FixtureSubnetId(t))
+}
+
+func testAccAsScalingGroup_spotMixed(t *testing.T, baseCapacity, onDemandPercentage int) string {
+	return fmt.Sprintf(`
+resource "tencentcloud_as_scaling_config" "launch_configuration" {
+  configuration_name = "tf-as-configuration-spot-mixed"
+  image_id           = "img-2lr9q49h"
+  instance_types     = ["SA2.SMALL1","SA2.SMALL2","SA2.SMALL4"]
+  instance_name_settings {
+    instance_name = "test-ins-name-spot-mixed"
+  }
+}
+
+resource "tencentcloud_as_scaling_group" "scaling_group" {
+  scaling_group_name         = "tf-as-group-spot-mixed"
+  configuration_id           = tencentcloud_as_scaling_config.launch_configuration.id
+  max_size                   = 2
+  min_size                   = 0
+  vpc_id                     = "%s"
+  subnet_ids                 = ["%s"]
+  instance_allocation_policy = "SPOT_MIXED"
+
+  spot_mixed_allocation_policy {
+    base_capacity                            = %d
+    on_demand_percentage_above_base_capacity = %d
+    spot_allocation_strategy                 = "CAPACITY_OPTIMIZED"
+    compensate_with_base_instance            = true
+  }
+}
+`, tcacctest.FixtureVpcId(t), tcacctest.FixtureSubnetId(t), baseCapacity, onDemandPercentage)
 }
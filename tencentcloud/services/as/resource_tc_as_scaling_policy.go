@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudAsScalingPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsScalingPolicyCreate,
-		Read:   resourceTencentCloudAsScalingPolicyRead,
-		Update: resourceTencentCloudAsScalingPolicyUpdate,
-		Delete: resourceTencentCloudAsScalingPolicyDelete,
+		Create:   resourceTencentCloudAsScalingPolicyCreate,
+		Read:     resourceTencentCloudAsScalingPolicyRead,
+		Update:   resourceTencentCloudAsScalingPolicyUpdate,
+		Delete:   resourceTencentCloudAsScalingPolicyDelete,
+		Importer: helper.IdSpec{"scaling_policy_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
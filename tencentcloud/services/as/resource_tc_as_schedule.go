@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudAsSchedule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAsScheduleCreate,
-		Read:   resourceTencentCloudAsScheduleRead,
-		Update: resourceTencentCloudAsScheduleUpdate,
-		Delete: resourceTencentCloudAsScheduleDelete,
+		Create:   resourceTencentCloudAsScheduleCreate,
+		Read:     resourceTencentCloudAsScheduleRead,
+		Update:   resourceTencentCloudAsScheduleUpdate,
+		Delete:   resourceTencentCloudAsScheduleDelete,
+		Importer: helper.IdSpec{"scheduled_action_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
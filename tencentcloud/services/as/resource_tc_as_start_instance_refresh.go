@@ -19,6 +19,7 @@ func ResourceTencentCloudAsStartInstanceRefresh() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudAsStartInstanceRefreshCreate,
 		Read:   resourceTencentCloudAsStartInstanceRefreshRead,
+		Update: resourceTencentCloudAsStartInstanceRefreshUpdate,
 		Delete: resourceTencentCloudAsStartInstanceRefreshDelete,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -83,6 +84,10 @@ func ResourceTencentCloudAsStartInstanceRefresh() *schema.Resource {
 				ForceNew:    true,
 				Description: "Refresh mode, currently, only rolling updates are supported, with the default value being ROLLING_UPDATE_RESET.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -163,31 +168,33 @@ func resourceTencentCloudAsStartInstanceRefreshCreate(d *schema.ResourceData, me
 	refreshActivityId = *response.Response.RefreshActivityId
 	d.SetId(refreshActivityId)
 
-	// wait
-	waitRequest.RefreshActivityIds = helper.Strings([]string{refreshActivityId})
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseAsClient().DescribeRefreshActivitiesWithContext(ctx, waitRequest)
-		if e != nil {
-			return tccommon.RetryError(e)
-		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitRequest.GetAction(), waitRequest.ToJsonString(), result.ToJsonString())
-		}
+	if tccommon.OperationWaitForCompletion(d) {
+		// wait
+		waitRequest.RefreshActivityIds = helper.Strings([]string{refreshActivityId})
+		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseAsClient().DescribeRefreshActivitiesWithContext(ctx, waitRequest)
+			if e != nil {
+				return tccommon.RetryError(e)
+			} else {
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitRequest.GetAction(), waitRequest.ToJsonString(), result.ToJsonString())
+			}
 
-		if result == nil || result.Response == nil || len(result.Response.RefreshActivitySet) != 1 {
-			e = fmt.Errorf("create as start instance refresh failed.")
-			return resource.NonRetryableError(e)
-		}
+			if result == nil || result.Response == nil || len(result.Response.RefreshActivitySet) != 1 {
+				e = fmt.Errorf("create as start instance refresh failed.")
+				return resource.NonRetryableError(e)
+			}
 
-		if *result.Response.RefreshActivitySet[0].Status == REFRESH_ACTIVITIES_SUCCESSFUL {
-			return nil
-		}
+			if *result.Response.RefreshActivitySet[0].Status == REFRESH_ACTIVITIES_SUCCESSFUL {
+				return nil
+			}
 
-		return resource.RetryableError(fmt.Errorf("start instance refresh is still in running, state %s", *result.Response.RefreshActivitySet[0].Status))
-	})
+			return resource.RetryableError(fmt.Errorf("start instance refresh is still in running, state %s", *result.Response.RefreshActivitySet[0].Status))
+		})
 
-	if err != nil {
-		log.Printf("[CRITAL]%s create as start instance refresh failed, reason:%+v", logId, err)
-		return err
+		if err != nil {
+			log.Printf("[CRITAL]%s create as start instance refresh failed, reason:%+v", logId, err)
+			return err
+		}
 	}
 
 	return resourceTencentCloudAsStartInstanceRefreshRead(d, meta)
@@ -200,6 +207,14 @@ func resourceTencentCloudAsStartInstanceRefreshRead(d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceTencentCloudAsStartInstanceRefreshUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_as_start_instance_refresh.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudAsStartInstanceRefreshRead(d, meta)
+}
+
 func resourceTencentCloudAsStartInstanceRefreshDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_as_start_instance_refresh.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
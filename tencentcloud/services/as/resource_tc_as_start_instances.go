@@ -18,6 +18,7 @@ func ResourceTencentCloudAsStartInstances() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudAsStartInstancesCreate,
 		Read:   resourceTencentCloudAsStartInstancesRead,
+		Update: resourceTencentCloudAsStartInstancesUpdate,
 		Delete: resourceTencentCloudAsStartInstancesDelete,
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_group_id": {
@@ -36,6 +37,10 @@ func ResourceTencentCloudAsStartInstances() *schema.Resource {
 				},
 				Description: "List of cvm instances to start.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -80,26 +85,28 @@ func resourceTencentCloudAsStartInstancesCreate(d *schema.ResourceData, meta int
 
 	activityId = *response.Response.ActivityId
 
-	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
-	service := AsService{
-		client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
-	}
+	if tccommon.OperationWaitForCompletion(d) {
+		ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service := AsService{
+			client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
+		}
 
-	err = resource.Retry(4*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		status, err := service.DescribeActivityById(ctx, activityId)
+		err = resource.Retry(4*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			status, err := service.DescribeActivityById(ctx, activityId)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if status == SCALING_GROUP_ACTIVITY_STATUS_INIT || status == SCALING_GROUP_ACTIVITY_STATUS_RUNNING {
+				return resource.RetryableError(fmt.Errorf("remove status is running(%s)", status))
+			}
+			if status == SCALING_GROUP_ACTIVITY_STATUS_SUCCESSFUL {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("remove status is failed(%s)", status))
+		})
 		if err != nil {
-			return resource.NonRetryableError(err)
+			return err
 		}
-		if status == SCALING_GROUP_ACTIVITY_STATUS_INIT || status == SCALING_GROUP_ACTIVITY_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("remove status is running(%s)", status))
-		}
-		if status == SCALING_GROUP_ACTIVITY_STATUS_SUCCESSFUL {
-			return nil
-		}
-		return resource.NonRetryableError(fmt.Errorf("remove status is failed(%s)", status))
-	})
-	if err != nil {
-		return err
 	}
 
 	d.SetId(activityId)
@@ -114,6 +121,14 @@ func resourceTencentCloudAsStartInstancesRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceTencentCloudAsStartInstancesUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_as_start_instances.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudAsStartInstancesRead(d, meta)
+}
+
 func resourceTencentCloudAsStartInstancesDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_as_start_instances.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
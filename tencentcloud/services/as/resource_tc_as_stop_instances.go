@@ -18,6 +18,7 @@ func ResourceTencentCloudAsStopInstances() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudAsStopInstancesCreate,
 		Read:   resourceTencentCloudAsStopInstancesRead,
+		Update: resourceTencentCloudAsStopInstancesUpdate,
 		Delete: resourceTencentCloudAsStopInstancesDelete,
 		Schema: map[string]*schema.Schema{
 			"auto_scaling_group_id": {
@@ -43,6 +44,10 @@ func ResourceTencentCloudAsStopInstances() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Billing method of a pay-as-you-go instance after shutdown. Available values: `KEEP_CHARGING`,`STOP_CHARGING`. Default `KEEP_CHARGING`.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -91,26 +96,28 @@ func resourceTencentCloudAsStopInstancesCreate(d *schema.ResourceData, meta inte
 
 	activityId = *response.Response.ActivityId
 
-	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
-	service := AsService{
-		client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
-	}
+	if tccommon.OperationWaitForCompletion(d) {
+		ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service := AsService{
+			client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
+		}
 
-	err = resource.Retry(4*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		status, err := service.DescribeActivityById(ctx, activityId)
+		err = resource.Retry(4*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			status, err := service.DescribeActivityById(ctx, activityId)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if status == SCALING_GROUP_ACTIVITY_STATUS_INIT || status == SCALING_GROUP_ACTIVITY_STATUS_RUNNING {
+				return resource.RetryableError(fmt.Errorf("remove status is running(%s)", status))
+			}
+			if status == SCALING_GROUP_ACTIVITY_STATUS_SUCCESSFUL {
+				return nil
+			}
+			return resource.NonRetryableError(fmt.Errorf("remove status is failed(%s)", status))
+		})
 		if err != nil {
-			return resource.NonRetryableError(err)
+			return err
 		}
-		if status == SCALING_GROUP_ACTIVITY_STATUS_INIT || status == SCALING_GROUP_ACTIVITY_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("remove status is running(%s)", status))
-		}
-		if status == SCALING_GROUP_ACTIVITY_STATUS_SUCCESSFUL {
-			return nil
-		}
-		return resource.NonRetryableError(fmt.Errorf("remove status is failed(%s)", status))
-	})
-	if err != nil {
-		return err
 	}
 
 	d.SetId(activityId)
@@ -125,6 +132,14 @@ func resourceTencentCloudAsStopInstancesRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
+func resourceTencentCloudAsStopInstancesUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_as_stop_instances.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudAsStopInstancesRead(d, meta)
+}
+
 func resourceTencentCloudAsStopInstancesDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_as_stop_instances.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -329,7 +329,7 @@ func dataSourceTencentCloudAuditEventsRead(d *schema.ResourceData, meta interfac
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), eventsList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), eventsList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -117,7 +117,7 @@ func dataSourceTencentCloudAuditsRead(d *schema.ResourceData, meta interface{})
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), auditList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), auditList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -18,6 +18,7 @@ func ResourceTencentCloudDasbAssetSyncJobOperationOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDasbAssetSyncJobOperationCreate,
 		Read:   resourceTencentCloudDasbAssetSyncJobOperationRead,
+		Update: resourceTencentCloudDasbAssetSyncJobOperationUpdate,
 		Delete: resourceTencentCloudDasbAssetSyncJobOperationDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -27,6 +28,10 @@ func ResourceTencentCloudDasbAssetSyncJobOperationOperation() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "Synchronize asset categories, 1- Host assets, 2- Database assets.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -66,33 +71,35 @@ func resourceTencentCloudDasbAssetSyncJobOperationCreate(d *schema.ResourceData,
 
 	d.SetId(category)
 
-	// wait
-	err = resource.Retry(4*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseDasbClient().DescribeAssetSyncStatus(waitReq)
-		if e != nil {
-			return tccommon.RetryError(e)
-		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), waitReq.ToJsonString(), result.ToJsonString())
-		}
-
-		if result == nil || result.Response == nil || result.Response.Status == nil {
-			return resource.NonRetryableError(fmt.Errorf("Describe dasb AssetSyncJob failed, Response is nil."))
-		}
-
-		if result.Response.Status.InProcess == nil {
-			return resource.NonRetryableError(fmt.Errorf("InProcess is nil."))
-		}
-
-		if !*result.Response.Status.InProcess {
-			return nil
+	if tccommon.OperationWaitForCompletion(d) {
+		// wait
+		err = resource.Retry(4*tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseDasbClient().DescribeAssetSyncStatus(waitReq)
+			if e != nil {
+				return tccommon.RetryError(e)
+			} else {
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, waitReq.GetAction(), waitReq.ToJsonString(), result.ToJsonString())
+			}
+
+			if result == nil || result.Response == nil || result.Response.Status == nil {
+				return resource.NonRetryableError(fmt.Errorf("Describe dasb AssetSyncJob failed, Response is nil."))
+			}
+
+			if result.Response.Status.InProcess == nil {
+				return resource.NonRetryableError(fmt.Errorf("InProcess is nil."))
+			}
+
+			if !*result.Response.Status.InProcess {
+				return nil
+			}
+
+			return resource.RetryableError(fmt.Errorf("Dasb asset sync job is still running..."))
+		})
+
+		if err != nil {
+			log.Printf("[CRITAL]%s describe dasb AssetSyncJob failed, reason:%+v", logId, err)
+			return err
 		}
-
-		return resource.RetryableError(fmt.Errorf("Dasb asset sync job is still running..."))
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s describe dasb AssetSyncJob failed, reason:%+v", logId, err)
-		return err
 	}
 
 	return resourceTencentCloudDasbAssetSyncJobOperationRead(d, meta)
@@ -105,6 +112,14 @@ func resourceTencentCloudDasbAssetSyncJobOperationRead(d *schema.ResourceData, m
 	return nil
 }
 
+func resourceTencentCloudDasbAssetSyncJobOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dasb_asset_sync_job_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDasbAssetSyncJobOperationRead(d, meta)
+}
+
 func resourceTencentCloudDasbAssetSyncJobOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dasb_asset_sync_job_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -31,6 +31,7 @@ func ResourceTencentCloudDasbBindDeviceAccountPassword() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Host account password.",
 			},
 		},
@@ -31,12 +31,14 @@ func ResourceTencentCloudDasbBindDeviceAccountPrivateKey() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Host account private key, the latest length is 128 bytes, the maximum length is 8192 bytes.",
 			},
 			"private_key_password": {
 				Optional:    true,
 				ForceNew:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Host account private key password, maximum length 256 bytes.",
 			},
 		},
@@ -15,10 +15,11 @@ import (
 
 func ResourceTencentCloudDasbBindDeviceResource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDasbBindDeviceResourceCreate,
-		Read:   resourceTencentCloudDasbBindDeviceResourceRead,
-		Update: resourceTencentCloudDasbBindDeviceResourceUpdate,
-		Delete: resourceTencentCloudDasbBindDeviceResourceDelete,
+		Create:   resourceTencentCloudDasbBindDeviceResourceCreate,
+		Read:     resourceTencentCloudDasbBindDeviceResourceRead,
+		Update:   resourceTencentCloudDasbBindDeviceResourceUpdate,
+		Delete:   resourceTencentCloudDasbBindDeviceResourceDelete,
+		Importer: helper.IdSpec{"resource_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"device_id_set": {
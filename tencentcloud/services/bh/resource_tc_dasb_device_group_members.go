@@ -18,12 +18,10 @@ import (
 
 func ResourceTencentCloudDasbDeviceGroupMembers() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDasbDeviceGroupMembersCreate,
-		Read:   resourceTencentCloudDasbDeviceGroupMembersRead,
-		Delete: resourceTencentCloudDasbDeviceGroupMembersDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudDasbDeviceGroupMembersCreate,
+		Read:     resourceTencentCloudDasbDeviceGroupMembersRead,
+		Delete:   resourceTencentCloudDasbDeviceGroupMembersDelete,
+		Importer: helper.IdSpec{"device_group_id", "member_id_set"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"device_group_id": {
 				Required:    true,
@@ -26,6 +26,8 @@ func ResourceTencentCloudDasbResetUser() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "User Id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -19,12 +19,10 @@ import (
 
 func ResourceTencentCloudDasbUserGroupMembers() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDasbUserGroupMembersCreate,
-		Read:   resourceTencentCloudDasbUserGroupMembersRead,
-		Delete: resourceTencentCloudDasbUserGroupMembersDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudDasbUserGroupMembersCreate,
+		Read:     resourceTencentCloudDasbUserGroupMembersRead,
+		Delete:   resourceTencentCloudDasbUserGroupMembersDelete,
+		Importer: helper.IdSpec{"user_group_id", "member_id_set"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"user_group_id": {
 				Required:    true,
@@ -228,7 +228,7 @@ func dataSourceTencentCloudBiUserProjectRead(d *schema.ResourceData, meta interf
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), dataList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), dataList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
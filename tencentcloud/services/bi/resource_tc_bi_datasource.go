@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudBiDatasource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudBiDatasourceCreate,
-		Read:   resourceTencentCloudBiDatasourceRead,
-		Update: resourceTencentCloudBiDatasourceUpdate,
-		Delete: resourceTencentCloudBiDatasourceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudBiDatasourceCreate,
+		Read:     resourceTencentCloudBiDatasourceRead,
+		Update:   resourceTencentCloudBiDatasourceUpdate,
+		Delete:   resourceTencentCloudBiDatasourceDelete,
+		Importer: helper.IdSpec{"project_id", "datasource_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"db_host": {
 				Required:    true,
@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudBiDatasourceCloud() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudBiDatasourceCloudCreate,
-		Read:   resourceTencentCloudBiDatasourceCloudRead,
-		Update: resourceTencentCloudBiDatasourceCloudUpdate,
-		Delete: resourceTencentCloudBiDatasourceCloudDelete,
+		Create:   resourceTencentCloudBiDatasourceCloudCreate,
+		Read:     resourceTencentCloudBiDatasourceCloudRead,
+		Update:   resourceTencentCloudBiDatasourceCloudUpdate,
+		Delete:   resourceTencentCloudBiDatasourceCloudDelete,
+		Importer: helper.IdSpec{"project_id", "datasource_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"service_type": {
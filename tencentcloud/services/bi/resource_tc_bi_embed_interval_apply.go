@@ -38,6 +38,7 @@ func ResourceTencentCloudBiEmbedIntervalApply() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Token that needs to be applied for extension.",
 			},
 
@@ -47,6 +48,8 @@ func ResourceTencentCloudBiEmbedIntervalApply() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Choose panel or page.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -71,6 +71,7 @@ func ResourceTencentCloudBiEmbedTokenApply() *schema.Resource {
 			"bi_token": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Create the generated token.",
 			},
 
@@ -85,6 +86,8 @@ func ResourceTencentCloudBiEmbedTokenApply() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Upadte time.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudBiProjectUserRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudBiProjectUserRoleCreate,
-		Read:   resourceTencentCloudBiProjectUserRoleRead,
-		Update: resourceTencentCloudBiProjectUserRoleUpdate,
-		Delete: resourceTencentCloudBiProjectUserRoleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudBiProjectUserRoleCreate,
+		Read:     resourceTencentCloudBiProjectUserRoleRead,
+		Update:   resourceTencentCloudBiProjectUserRoleUpdate,
+		Delete:   resourceTencentCloudBiProjectUserRoleDelete,
+		Importer: helper.IdSpec{"project_id", "user_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Optional:    true,
@@ -116,7 +116,7 @@ func dataSourceTencentCloudCamAccountSummaryRead(d *schema.ResourceData, meta in
 	d.SetId(helper.BuildToken())
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), template); e != nil {
+		if e := tccommon.WriteToFile(output.(string), template, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
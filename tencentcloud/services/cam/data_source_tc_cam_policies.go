@@ -175,7 +175,7 @@ func dataSourceTencentCloudCamPoliciesRead(d *schema.ResourceData, meta interfac
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), policyList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), policyList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -151,7 +151,7 @@ func dataSourceTencentCloudCamRolePolicyAttachmentsRead(d *schema.ResourceData,
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), policyOfRoleList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), policyOfRoleList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -139,7 +139,7 @@ func datasourceTencentCloudUserInfoRead(d *schema.ResourceData, meta interface{}
 			"uin":      uin,
 			"ownerUin": ownerUin,
 			"name":     name,
-		}); e != nil {
+		}, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
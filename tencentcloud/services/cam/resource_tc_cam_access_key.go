@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCamAccessKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamAccessKeyCreate,
-		Read:   resourceTencentCloudCamAccessKeyRead,
-		Update: resourceTencentCloudCamAccessKeyUpdate,
-		Delete: resourceTencentCloudCamAccessKeyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCamAccessKeyCreate,
+		Read:     resourceTencentCloudCamAccessKeyRead,
+		Update:   resourceTencentCloudCamAccessKeyUpdate,
+		Delete:   resourceTencentCloudCamAccessKeyDelete,
+		Importer: helper.IdSpec{"uin", "access_key"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"target_uin": {
 				Optional:    true,
@@ -51,6 +49,7 @@ func ResourceTencentCloudCamAccessKey() *schema.Resource {
 			},
 			"encrypted_secret_access_key": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "Encrypted secret, base64 encoded, if pgp_key was specified. This attribute is not available for imported resources. The encrypted secret may be decrypted using the command line, for example: terraform output -raw encrypted_secret | base64 --decode | keybase pgp decrypt.",
 			},
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudCamPolicyVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamPolicyVersionCreate,
-		Read:   resourceTencentCloudCamPolicyVersionRead,
-		Update: resourceTencentCloudCamPolicyVersionUpdate,
-		Delete: resourceTencentCloudCamPolicyVersionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCamPolicyVersionCreate,
+		Read:     resourceTencentCloudCamPolicyVersionRead,
+		Update:   resourceTencentCloudCamPolicyVersionUpdate,
+		Delete:   resourceTencentCloudCamPolicyVersionDelete,
+		Importer: helper.IdSpec{"policy_id", "version_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
 				Required:    true,
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudCamRolePermissionBoundaryAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamRolePermissionBoundaryAttachmentCreate,
-		Read:   resourceTencentCloudCamRolePermissionBoundaryAttachmentRead,
-		Delete: resourceTencentCloudCamRolePermissionBoundaryAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCamRolePermissionBoundaryAttachmentCreate,
+		Read:     resourceTencentCloudCamRolePermissionBoundaryAttachmentRead,
+		Delete:   resourceTencentCloudCamRolePermissionBoundaryAttachmentDelete,
+		Importer: helper.IdSpec{"policy_id", "[role_id]", "[role_name]"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
 				Required:    true,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudCamSetPolicyVersionConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamSetPolicyVersionConfigCreate,
-		Read:   resourceTencentCloudCamSetPolicyVersionConfigRead,
-		Update: resourceTencentCloudCamSetPolicyVersionConfigUpdate,
-		Delete: resourceTencentCloudCamSetPolicyVersionConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCamSetPolicyVersionConfigCreate,
+		Read:     resourceTencentCloudCamSetPolicyVersionConfigRead,
+		Update:   resourceTencentCloudCamSetPolicyVersionConfigUpdate,
+		Delete:   resourceTencentCloudCamSetPolicyVersionConfigDelete,
+		Importer: helper.IdSpec{"policy_id", "version_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
 				Required:    true,
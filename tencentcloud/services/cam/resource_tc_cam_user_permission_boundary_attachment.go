@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudCamUserPermissionBoundaryAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCamUserPermissionBoundaryAttachmentCreate,
-		Read:   resourceTencentCloudCamUserPermissionBoundaryAttachmentRead,
-		Delete: resourceTencentCloudCamUserPermissionBoundaryAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCamUserPermissionBoundaryAttachmentCreate,
+		Read:     resourceTencentCloudCamUserPermissionBoundaryAttachmentRead,
+		Delete:   resourceTencentCloudCamUserPermissionBoundaryAttachmentDelete,
+		Importer: helper.IdSpec{"target_uin", "policy_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"target_uin": {
 				Required:    true,
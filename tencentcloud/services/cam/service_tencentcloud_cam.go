@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/paginator"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -73,49 +74,47 @@ func (me *CamService) DescribeRolesByFilter(ctx context.Context, params map[stri
 	logId := tccommon.GetLogId(ctx)
 	//need travel
 	request := cam.NewDescribeRoleListRequest()
-	pageStart := uint64(1)
-	rp := uint64(PAGE_ITEM)
 	roles = make([]*cam.RoleInfo, 0)
-	for {
-		request.Page = &pageStart
-		request.Rp = &rp
+	items, err := paginator.PageNumber(ctx, PAGE_ITEM, func(ctx context.Context, pageNumber, pageSize int64) (*paginator.Page[*cam.RoleInfo], error) {
+		request.Page = helper.Int64Uint64(pageNumber)
+		request.Rp = helper.Int64Uint64(pageSize)
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().DescribeRoleList(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.List) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
+		return &paginator.Page[*cam.RoleInfo]{Items: response.Response.List}, nil
+	})
+	if err != nil {
+		errRet = err
+		return
+	}
 
-		for _, role := range response.Response.List {
-			if params["role_id"] != nil {
-				if *role.RoleId != params["role_id"].(string) {
-					continue
-				}
-			}
-			if params["name"] != nil {
-				if *role.RoleName != params["name"].(string) {
-					continue
-				}
+	for _, role := range items {
+		if params["role_id"] != nil {
+			if *role.RoleId != params["role_id"].(string) {
+				continue
 			}
-			if params["description"] != nil {
-				if *role.Description != params["description"].(string) {
-					continue
-				}
+		}
+		if params["name"] != nil {
+			if *role.RoleName != params["name"].(string) {
+				continue
 			}
-			roles = append(roles, role)
 		}
-		if len(response.Response.List) < PAGE_ITEM {
-			break
+		if params["description"] != nil {
+			if *role.Description != params["description"].(string) {
+				continue
+			}
 		}
-		pageStart += 1
+		roles = append(roles, role)
 	}
 	return
 }
@@ -278,12 +277,10 @@ func (me *CamService) DescribeRolePolicyAttachmentsByFilter(ctx context.Context,
 	logId := tccommon.GetLogId(ctx)
 	roleId := params["role_id"].(string)
 	request := cam.NewListAttachedRolePoliciesRequest()
-	pageStart := uint64(1)
-	rp := uint64(PAGE_ITEM)
 	policyOfRoles = make([]*cam.AttachedPolicyOfRole, 0)
-	for {
-		request.Page = &pageStart
-		request.Rp = &rp
+	items, err := paginator.PageNumber(ctx, PAGE_ITEM, func(ctx context.Context, pageNumber, pageSize int64) (*paginator.Page[*cam.AttachedPolicyOfRole], error) {
+		request.Page = helper.Int64Uint64(pageNumber)
+		request.Rp = helper.Int64Uint64(pageSize)
 		request.RoleId = &roleId
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedRolePolicies(request)
@@ -294,40 +291,41 @@ func (me *CamService) DescribeRolePolicyAttachmentsByFilter(ctx context.Context,
 				errCode := ee.GetCode()
 				//check if read empty
 				if strings.Contains(errCode, "ResourceNotFound") || errCode == "InvalidParameter.RoleNotExist" {
-					return
+					return nil, nil
 				}
 			}
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.List) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		for _, policy := range response.Response.List {
-			if params["policy_id"] != nil {
-				if *policy.PolicyId != params["policy_id"].(uint64) {
-					continue
-				}
-			}
-			if params["policy_type"] != nil {
-				if *policy.PolicyType != params["policy_type"].(string) {
-					continue
-				}
+		return &paginator.Page[*cam.AttachedPolicyOfRole]{Items: response.Response.List}, nil
+	})
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	for _, policy := range items {
+		if params["policy_id"] != nil {
+			if *policy.PolicyId != params["policy_id"].(uint64) {
+				continue
 			}
-			if params["create_mode"] != nil {
-				if int(*policy.CreateMode) != params["create_mode"].(int) {
-					continue
-				}
+		}
+		if params["policy_type"] != nil {
+			if *policy.PolicyType != params["policy_type"].(string) {
+				continue
 			}
-			policyOfRoles = append(policyOfRoles, policy)
 		}
-		if len(response.Response.List) < PAGE_ITEM {
-			break
+		if params["create_mode"] != nil {
+			if int(*policy.CreateMode) != params["create_mode"].(int) {
+				continue
+			}
 		}
-		pageStart += 1
+		policyOfRoles = append(policyOfRoles, policy)
 	}
 	return
 }
@@ -445,12 +443,10 @@ func (me *CamService) DescribeUserPolicyAttachmentsByFilter(ctx context.Context,
 	}
 	uin := user.Response.Uin
 	request := cam.NewListAttachedUserPoliciesRequest()
-	pageStart := uint64(1)
-	rp := uint64(PAGE_ITEM)
 	policyResults = make([]*cam.AttachPolicyInfo, 0)
-	for {
-		request.Page = &pageStart
-		request.Rp = &rp
+	items, err := paginator.PageNumber(ctx, PAGE_ITEM, func(ctx context.Context, pageNumber, pageSize int64) (*paginator.Page[*cam.AttachPolicyInfo], error) {
+		request.Page = helper.Int64Uint64(pageNumber)
+		request.Rp = helper.Int64Uint64(pageSize)
 		request.TargetUin = uin
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedUserPolicies(request)
@@ -461,40 +457,41 @@ func (me *CamService) DescribeUserPolicyAttachmentsByFilter(ctx context.Context,
 				errCode := ee.GetCode()
 				//check if read empty
 				if strings.Contains(errCode, "ResourceNotFound") {
-					return
+					return nil, nil
 				}
 			}
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.List) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		for _, policy := range response.Response.List {
-			if params["policy_id"] != nil {
-				if *policy.PolicyId != params["policy_id"].(uint64) {
-					continue
-				}
-			}
-			if params["policy_type"] != nil {
-				if *policy.PolicyType != params["policy_type"].(string) {
-					continue
-				}
+		return &paginator.Page[*cam.AttachPolicyInfo]{Items: response.Response.List}, nil
+	})
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	for _, policy := range items {
+		if params["policy_id"] != nil {
+			if *policy.PolicyId != params["policy_id"].(uint64) {
+				continue
 			}
-			if params["create_mode"] != nil {
-				if int(*policy.CreateMode) != params["create_mode"].(int) {
-					continue
-				}
+		}
+		if params["policy_type"] != nil {
+			if *policy.PolicyType != params["policy_type"].(string) {
+				continue
 			}
-			policyResults = append(policyResults, policy)
 		}
-		if len(response.Response.List) < PAGE_ITEM {
-			break
+		if params["create_mode"] != nil {
+			if int(*policy.CreateMode) != params["create_mode"].(int) {
+				continue
+			}
 		}
-		pageStart += 1
+		policyResults = append(policyResults, policy)
 	}
 	return
 }
@@ -629,12 +626,10 @@ func (me *CamService) DescribeGroupPolicyAttachmentsByFilter(ctx context.Context
 	}
 	groupIdInt64 := uint64(groupIdInt)
 	request := cam.NewListAttachedGroupPoliciesRequest()
-	pageStart := uint64(1)
-	rp := uint64(PAGE_ITEM)
 	policyResults = make([]*cam.AttachPolicyInfo, 0)
-	for {
-		request.Page = &pageStart
-		request.Rp = &rp
+	items, err := paginator.PageNumber(ctx, PAGE_ITEM, func(ctx context.Context, pageNumber, pageSize int64) (*paginator.Page[*cam.AttachPolicyInfo], error) {
+		request.Page = helper.Int64Uint64(pageNumber)
+		request.Rp = helper.Int64Uint64(pageSize)
 		request.TargetGroupId = &groupIdInt64
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedGroupPolicies(request)
@@ -645,41 +640,41 @@ func (me *CamService) DescribeGroupPolicyAttachmentsByFilter(ctx context.Context
 				errCode := ee.GetCode()
 				//check if read empty
 				if strings.Contains(errCode, "ResourceNotFound") {
-					return
+					return nil, nil
 				}
 			}
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.List) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
+		return &paginator.Page[*cam.AttachPolicyInfo]{Items: response.Response.List}, nil
+	})
+	if err != nil {
+		errRet = err
+		return
+	}
 
-		for _, policy := range response.Response.List {
-			if params["policy_id"] != nil {
-				if *policy.PolicyId != params["policy_id"].(uint64) {
-					continue
-				}
-			}
-			if params["policy_type"] != nil {
-				if *policy.PolicyType != params["policy_type"].(string) {
-					continue
-				}
+	for _, policy := range items {
+		if params["policy_id"] != nil {
+			if *policy.PolicyId != params["policy_id"].(uint64) {
+				continue
 			}
-			if params["create_mode"] != nil {
-				if int(*policy.CreateMode) != params["create_mode"].(int) {
-					continue
-				}
+		}
+		if params["policy_type"] != nil {
+			if *policy.PolicyType != params["policy_type"].(string) {
+				continue
 			}
-			policyResults = append(policyResults, policy)
 		}
-		if len(response.Response.List) < PAGE_ITEM {
-			break
+		if params["create_mode"] != nil {
+			if int(*policy.CreateMode) != params["create_mode"].(int) {
+				continue
+			}
 		}
-		pageStart += 1
+		policyResults = append(policyResults, policy)
 	}
 	return
 }
@@ -781,8 +776,6 @@ func (me *CamService) DescribePoliciesByFilter(ctx context.Context, params map[s
 	createMode := -1
 
 	request := cam.NewListPoliciesRequest()
-	pageStart := uint64(1)
-	rp := uint64(PAGE_ITEM)
 
 	for k, v := range params {
 		if k == "policy_id" {
@@ -801,10 +794,9 @@ func (me *CamService) DescribePoliciesByFilter(ctx context.Context, params map[s
 			createMode = v.(int)
 		}
 	}
-	policies = make([]*cam.StrategyInfo, 0)
-	for {
-		request.Page = &pageStart
-		request.Rp = &rp
+	items, err := paginator.PageNumber(ctx, PAGE_ITEM, func(ctx context.Context, pageNumber, pageSize int64) (*paginator.Page[*cam.StrategyInfo], error) {
+		request.Page = helper.Int64Uint64(pageNumber)
+		request.Rp = helper.Int64Uint64(pageSize)
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListPolicies(request)
 		if err != nil {
@@ -813,44 +805,49 @@ func (me *CamService) DescribePoliciesByFilter(ctx context.Context, params map[s
 				errCode := ee.GetCode()
 				//check if read empty
 				if strings.Contains(errCode, "ResourceNotFound") {
-					return
+					return nil, nil
 				}
 			}
-			errRet = err
-			return
+			return nil, err
 		}
-		for _, policy := range response.Response.List {
-			if policyId != -1 {
-				if int(*policy.PolicyId) != policyId {
-					continue
-				}
-			}
-			if policyName != "" {
-				if *policy.PolicyName != policyName {
-					continue
-				}
+		if response == nil || response.Response == nil {
+			return nil, nil
+		}
+		return &paginator.Page[*cam.StrategyInfo]{Items: response.Response.List}, nil
+	})
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	policies = make([]*cam.StrategyInfo, 0)
+	for _, policy := range items {
+		if policyId != -1 {
+			if int(*policy.PolicyId) != policyId {
+				continue
 			}
-			if policyType != -1 {
-				if int(*policy.Type) != policyType {
-					continue
-				}
+		}
+		if policyName != "" {
+			if *policy.PolicyName != policyName {
+				continue
 			}
-			if description != "" {
-				if *policy.Description != description {
-					continue
-				}
+		}
+		if policyType != -1 {
+			if int(*policy.Type) != policyType {
+				continue
 			}
-			if createMode != -1 {
-				if int(*policy.CreateMode) != createMode {
-					continue
-				}
+		}
+		if description != "" {
+			if *policy.Description != description {
+				continue
 			}
-			policies = append(policies, policy)
 		}
-		if len(response.Response.List) < PAGE_ITEM {
-			break
+		if createMode != -1 {
+			if int(*policy.CreateMode) != createMode {
+				continue
+			}
 		}
-		pageStart += 1
+		policies = append(policies, policy)
 	}
 	return
 }
@@ -1012,12 +1009,10 @@ func (me *CamService) DescribeGroupById(ctx context.Context, groupId string) (ca
 func (me *CamService) DescribeGroupsByFilter(ctx context.Context, params map[string]interface{}) (groups []*cam.GroupInfo, errRet error) {
 	logId := tccommon.GetLogId(ctx)
 	request := cam.NewListGroupsRequest()
-	pageStart := uint64(1)
-	rp := uint64(PAGE_ITEM)
 	groups = make([]*cam.GroupInfo, 0)
-	for {
-		request.Page = &pageStart
-		request.Rp = &rp
+	items, err := paginator.PageNumber(ctx, PAGE_ITEM, func(ctx context.Context, pageNumber, pageSize int64) (*paginator.Page[*cam.GroupInfo], error) {
+		request.Page = helper.Int64Uint64(pageNumber)
+		request.Rp = helper.Int64Uint64(pageSize)
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListGroups(request)
 		if err != nil {
@@ -1027,41 +1022,42 @@ func (me *CamService) DescribeGroupsByFilter(ctx context.Context, params map[str
 				errCode := ee.GetCode()
 				//check if read empty
 				if strings.Contains(errCode, "ResourceNotFound") {
-					return
+					return nil, nil
 				}
 			}
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.GroupInfo) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		for _, group := range response.Response.GroupInfo {
-			if params["group_id"] != nil {
-				if int(*group.GroupId) != params["group_id"].(int) {
-					continue
-				}
-			}
-			if params["name"] != nil {
-				if *group.GroupName != params["name"].(string) {
-					continue
-				}
+		return &paginator.Page[*cam.GroupInfo]{Items: response.Response.GroupInfo}, nil
+	})
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	for _, group := range items {
+		if params["group_id"] != nil {
+			if int(*group.GroupId) != params["group_id"].(int) {
+				continue
 			}
-			if params["remark"] != nil {
-				if group.Remark == nil || (group.Remark != nil && *group.Remark != params["remark"].(string)) {
-					continue
-				}
-				log.Printf("in")
+		}
+		if params["name"] != nil {
+			if *group.GroupName != params["name"].(string) {
+				continue
 			}
-			groups = append(groups, group)
 		}
-		if len(response.Response.GroupInfo) < PAGE_ITEM {
-			break
+		if params["remark"] != nil {
+			if group.Remark == nil || (group.Remark != nil && *group.Remark != params["remark"].(string)) {
+				continue
+			}
+			log.Printf("in")
 		}
-		pageStart += 1
+		groups = append(groups, group)
 	}
 	return
 }
@@ -1575,33 +1571,24 @@ func (me *CamService) DescribeCamListEntitiesForPolicyByFilter(ctx context.Conte
 
 	ratelimit.Check(request.GetAction())
 
-	pageStart := uint64(1)
-	rp := uint64(PAGE_ITEM) //to save in extension
-	result := make([]*cam.AttachEntityOfPolicy, 0)
-	for {
-		request.Page = &pageStart
-		request.Rp = &rp
+	ListEntitiesForPolicy, errRet = paginator.PageNumber(ctx, PAGE_ITEM, func(ctx context.Context, pageNumber, pageSize int64) (*paginator.Page[*cam.AttachEntityOfPolicy], error) {
+		request.Page = helper.Int64Uint64(pageNumber)
+		request.Rp = helper.Int64Uint64(pageSize)
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListEntitiesForPolicy(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.List) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		result = append(result, response.Response.List...)
-		if len(response.Response.List) < PAGE_ITEM {
-			break
-		}
-		pageStart += 1
-	}
-	ListEntitiesForPolicy = result
+		return &paginator.Page[*cam.AttachEntityOfPolicy]{Items: response.Response.List}, nil
+	})
 	return
 }
 
@@ -1632,31 +1619,21 @@ func (me *CamService) DescribeCamListAttachedUserPolicyByFilter(ctx context.Cont
 		}
 	}
 
-	pageStart := uint64(1)
-	rp := uint64(PAGE_ITEM) //to save in extension
-	result := make([]*cam.AttachedUserPolicy, 0)
-	for {
-		request.Page = &pageStart
-		request.Rp = &rp
+	ListAttachedUserPolicy, errRet = paginator.PageNumber(ctx, PAGE_ITEM, func(ctx context.Context, pageNumber, pageSize int64) (*paginator.Page[*cam.AttachedUserPolicy], error) {
+		request.Page = helper.Int64Uint64(pageNumber)
+		request.Rp = helper.Int64Uint64(pageSize)
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCamClient().ListAttachedUserAllPolicies(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.PolicyList) < 1 {
-			break
-		}
-		result = append(result, response.Response.PolicyList...)
-		if len(response.Response.PolicyList) < PAGE_ITEM {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		pageStart += 1
-	}
-	ListAttachedUserPolicy = result
+		return &paginator.Page[*cam.AttachedUserPolicy]{Items: response.Response.PolicyList}, nil
+	})
 	return
 }
 
@@ -1940,30 +1917,20 @@ func (me *CamService) DescribeCamGroupUserAccountByFilter(ctx context.Context, p
 
 	ratelimit.Check(request.GetAction())
 
-	pageStart := uint64(1)
-	rp := uint64(PAGE_ITEM) //to save in extension
-	result := make([]*cam.GroupInfo, 0)
-	for {
-		request.Page = &pageStart
-		request.Rp = &rp
+	GroupUserAccount, errRet = paginator.PageNumber(ctx, PAGE_ITEM, func(ctx context.Context, pageNumber, pageSize int64) (*paginator.Page[*cam.GroupInfo], error) {
+		request.Page = helper.Int64Uint64(pageNumber)
+		request.Rp = helper.Int64Uint64(pageSize)
 		response, err := me.client.UseCamClient().ListGroupsForUser(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.GroupInfo) < 1 {
-			break
-		}
-		result = append(result, response.Response.GroupInfo...)
-		if len(response.Response.GroupInfo) < PAGE_ITEM {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		pageStart += 1
-	}
-	GroupUserAccount = result
+		return &paginator.Page[*cam.GroupInfo]{Items: response.Response.GroupInfo}, nil
+	})
 	return
 }
 
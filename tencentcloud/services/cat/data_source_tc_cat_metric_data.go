@@ -134,7 +134,7 @@ func dataSourceTencentCloudCatMetricDataRead(d *schema.ResourceData, meta interf
 	d.SetId(helper.DataResourceIdsHash([]string{metricSet}))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), metricSet); e != nil {
+		if e := tccommon.WriteToFile(output.(string), metricSet, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -237,7 +237,7 @@ func dataSourceTencentCloudCatNodeRead(d *schema.ResourceData, meta interface{})
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), nodeSetList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), nodeSetList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -355,7 +355,7 @@ func dataSourceTencentCloudCatProbedataRead(d *schema.ResourceData, meta interfa
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), dataSetList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), dataSetList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
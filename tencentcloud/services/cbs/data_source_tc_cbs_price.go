@@ -0,0 +1,168 @@
+package cbs
+
+import (
+	"context"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func DataSourceTencentCloudCbsPrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudCbsPriceRead,
+		Schema: map[string]*schema.Schema{
+			"storage_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Type of CBS medium. Valid values: CLOUD_BASIC: HDD cloud disk, CLOUD_PREMIUM: Premium Cloud Storage, CLOUD_BSSD: General Purpose SSD, CLOUD_SSD: SSD, CLOUD_HSSD: Enhanced SSD, CLOUD_TSSD: Tremendous SSD.",
+			},
+			"storage_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Volume of CBS, and unit is GB.",
+			},
+			"charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      CBS_CHARGE_TYPE_POSTPAID,
+				ValidateFunc: tccommon.ValidateAllowedStringValue([]string{CBS_CHARGE_TYPE_PREPAID, CBS_CHARGE_TYPE_POSTPAID}),
+				Description:  "The charge type of CBS instance. Valid values are `PREPAID` and `POSTPAID_BY_HOUR`. The default is `POSTPAID_BY_HOUR`.",
+			},
+			"prepaid_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: tccommon.ValidateAllowedIntValue(CBS_PREPAID_PERIOD),
+				Description:  "The tenancy (time unit is month) of the prepaid instance, NOTE: it only works when charge_type is set to `PREPAID`. Default is 1.",
+			},
+			"disk_count": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "The number of disks to be purchased. Default is 1.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "ID of the project to which the instance belongs.",
+			},
+			"throughput_performance": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Add extra performance to the data disk. Only works when disk type is `CLOUD_TSSD` or `CLOUD_HSSD`.",
+			},
+			"original_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Original price of `PREPAID` disks for the whole period.",
+			},
+			"discount_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Discounted price of `PREPAID` disks for the whole period.",
+			},
+			"unit_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Original price of `POSTPAID_BY_HOUR` disks per `charge_unit`.",
+			},
+			"unit_price_discount": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Discounted price of `POSTPAID_BY_HOUR` disks per `charge_unit`.",
+			},
+			"charge_unit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Charge unit of `POSTPAID_BY_HOUR` disks, such as `HOUR`. Prices are in CNY for the Chinese site and USD for the international site.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudCbsPriceRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("data_source.tencentcloud_cbs_price.read")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = CbsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		request = cbs.NewInquiryPriceCreateDisksRequest()
+	)
+
+	chargeType := d.Get("charge_type").(string)
+	request.DiskType = helper.String(d.Get("storage_type").(string))
+	request.DiskSize = helper.IntUint64(d.Get("storage_size").(int))
+	request.DiskChargeType = helper.String(chargeType)
+	request.DiskCount = helper.IntUint64(d.Get("disk_count").(int))
+	if chargeType == CBS_CHARGE_TYPE_PREPAID {
+		request.DiskChargePrepaid = &cbs.DiskChargePrepaid{
+			Period: helper.IntUint64(d.Get("prepaid_period").(int)),
+		}
+	}
+
+	if v, ok := d.GetOk("project_id"); ok {
+		request.ProjectId = helper.IntUint64(v.(int))
+	}
+
+	if v, ok := d.GetOk("throughput_performance"); ok {
+		request.ThroughputPerformance = helper.IntUint64(v.(int))
+	}
+
+	var price *cbs.Price
+	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		result, e := service.InquiryPriceCreateDisks(ctx, request)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+
+		price = result
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if price != nil {
+		if price.OriginalPrice != nil {
+			_ = d.Set("original_price", price.OriginalPrice)
+		}
+
+		if price.DiscountPrice != nil {
+			_ = d.Set("discount_price", price.DiscountPrice)
+		}
+
+		if price.UnitPrice != nil {
+			_ = d.Set("unit_price", price.UnitPrice)
+		}
+
+		if price.UnitPriceDiscount != nil {
+			_ = d.Set("unit_price_discount", price.UnitPriceDiscount)
+		}
+
+		if price.ChargeUnit != nil {
+			_ = d.Set("charge_unit", price.ChargeUnit)
+		}
+	}
+
+	d.SetId(helper.DataResourceIdsHash([]string{request.ToJsonString()}))
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := tccommon.WriteToFile(output.(string), price, tccommon.GetResultOutputOptions(d)); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
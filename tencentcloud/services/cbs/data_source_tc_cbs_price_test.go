@@ -0,0 +1,47 @@
+package cbs_test
+
+import (
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudCbsPriceDataSource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCbsPriceDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					tcacctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_cbs_price.postpaid"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_price.postpaid", "unit_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_price.postpaid", "unit_price_discount"),
+					resource.TestCheckResourceAttr("data.tencentcloud_cbs_price.postpaid", "charge_unit", "HOUR"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_price.prepaid", "original_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_cbs_price.prepaid", "discount_price"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCbsPriceDataSource = tcacctest.DefaultAzVariable + `
+
+data "tencentcloud_cbs_price" "postpaid" {
+  storage_type = "CLOUD_PREMIUM"
+  storage_size = 100
+}
+
+data "tencentcloud_cbs_price" "prepaid" {
+  storage_type   = "CLOUD_PREMIUM"
+  storage_size   = 100
+  charge_type    = "PREPAID"
+  prepaid_period = 1
+}
+`
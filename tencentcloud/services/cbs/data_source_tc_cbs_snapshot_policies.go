@@ -146,7 +146,7 @@ func dataSourceTencentCloudCbsSnapshotPoliciesRead(d *schema.ResourceData, meta
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err = tccommon.WriteToFile(output.(string), policyList); err != nil {
+		if err = tccommon.WriteToFile(output.(string), policyList, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
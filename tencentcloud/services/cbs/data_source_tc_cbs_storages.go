@@ -323,7 +323,7 @@ func dataSourceTencentCloudCbsStoragesRead(d *schema.ResourceData, meta interfac
 
 		output, ok := d.GetOk("result_output_file")
 		if ok && output.(string) != "" {
-			if e := tccommon.WriteToFile(output.(string), storageList); e != nil {
+			if e := tccommon.WriteToFile(output.(string), storageList, tccommon.GetResultOutputOptions(d)); e != nil {
 				return resource.NonRetryableError(e)
 			}
 		}
@@ -321,7 +321,7 @@ func dataSourceTencentCloudCbsStoragesSetRead(d *schema.ResourceData, meta inter
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), storageList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), storageList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
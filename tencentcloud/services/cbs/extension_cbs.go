@@ -15,10 +15,22 @@ const (
 	CBS_STORAGE_STATUS_ROLLBACKING = "ROLLBACKING"
 	CBS_STORAGE_STATUS_TORECYCLE   = "TORECYCLE"
 
-	CBS_SNAPSHOT_STATUS_NORMAL   = "NORMAL"
-	CBS_SNAPSHOT_STATUS_CREATING = "CREATING"
+	CBS_SNAPSHOT_STATUS_NORMAL              = "NORMAL"
+	CBS_SNAPSHOT_STATUS_CREATING            = "CREATING"
+	CBS_SNAPSHOT_STATUS_COPYING_FROM_REMOTE = "COPYING_FROM_REMOTE"
+	CBS_SNAPSHOT_STATUS_CHECKING_COPIED     = "CHECKING_COPIED"
+	CBS_SNAPSHOT_STATUS_ROLLBACKING         = "ROLLBACKING"
+	CBS_SNAPSHOT_STATUS_TORECYCLE           = "TORECYCLE"
 )
 
+// CBS_SNAPSHOT_STATUS_PENDING are the states a snapshot leaves by itself, such as a copy still copying
+var CBS_SNAPSHOT_STATUS_PENDING = []string{
+	CBS_SNAPSHOT_STATUS_CREATING,
+	CBS_SNAPSHOT_STATUS_ROLLBACKING,
+	CBS_SNAPSHOT_STATUS_COPYING_FROM_REMOTE,
+	CBS_SNAPSHOT_STATUS_CHECKING_COPIED,
+}
+
 var CBS_STORAGE_TYPE = []string{
 	CBS_STORAGE_TYPE_CLOUD_BASIC,
 	CBS_STORAGE_TYPE_CLOUD_PREMIUM,
@@ -17,6 +17,7 @@ func ResourceTencentCloudCbsDiskBackupRollbackOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudCbsDiskBackupRollbackOperationCreate,
 		Read:   resourceTencentCloudCbsDiskBackupRollbackOperationRead,
+		Update: resourceTencentCloudCbsDiskBackupRollbackOperationUpdate,
 		Delete: resourceTencentCloudCbsDiskBackupRollbackOperationDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -37,6 +38,10 @@ func ResourceTencentCloudCbsDiskBackupRollbackOperation() *schema.Resource {
 				Computed:    true,
 				Description: "Whether the rollback is completed. `true` meaing rollback completed, `false` meaning still rollbacking.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -57,20 +62,22 @@ func resourceTencentCloudCbsDiskBackupRollbackOperationCreate(d *schema.Resource
 	if err := cbsService.ApplyDiskBackup(ctx, diskBackupId, diskId); err != nil {
 		return err
 	}
-	// deal with state sync delay
-	time.Sleep(time.Second * 1)
-	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		disk, e := cbsService.DescribeDiskById(ctx, diskId)
-		if e != nil {
-			return tccommon.RetryError(e)
-		}
-		if *disk.Rollbacking {
-			return resource.RetryableError(errors.New("Disk still rollbacking"))
+	if tccommon.OperationWaitForCompletion(d) {
+		// deal with state sync delay
+		time.Sleep(time.Second * 1)
+		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			disk, e := cbsService.DescribeDiskById(ctx, diskId)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			if *disk.Rollbacking {
+				return resource.RetryableError(errors.New("Disk still rollbacking"))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
 		}
-		return nil
-	})
-	if err != nil {
-		return err
 	}
 
 	d.SetId(diskBackupId + tccommon.FILED_SP + diskId)
@@ -102,6 +109,14 @@ func resourceTencentCloudCbsDiskBackupRollbackOperationRead(d *schema.ResourceDa
 	return nil
 }
 
+func resourceTencentCloudCbsDiskBackupRollbackOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cbs_disk_backup_rollback_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudCbsDiskBackupRollbackOperationRead(d, meta)
+}
+
 func resourceTencentCloudCbsDiskBackupRollbackOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cbs_disk_backup_rollback_operation.delete")()
 
@@ -0,0 +1,333 @@
+package cbs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func ResourceTencentCloudCbsSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudCbsSnapshotCopyCreate,
+		Read:   resourceTencentCloudCbsSnapshotCopyRead,
+		Update: resourceTencentCloudCbsSnapshotCopyUpdate,
+		Delete: resourceTencentCloudCbsSnapshotCopyDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		CustomizeDiff: resourceTencentCloudCbsSnapshotCopyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"snapshot_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the snapshot to copy, in the region of the provider.",
+			},
+			"destination_regions": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Regions to copy the snapshot to.",
+			},
+			"snapshot_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: tccommon.ValidateStringLengthInRange(2, 60),
+				Description:  "Name of the copied snapshots. Defaults to `Copied <snapshot_id> from <region>`.",
+			},
+			"retention_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: tccommon.ValidateIntegerMin(1),
+				Description:  "Days to keep the copied snapshots for, counted from when it is set. The copies are deleted automatically when they expire. The copies are kept permanently when it is not set.",
+			},
+			"snapshot_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the copied snapshots, keyed by destination region. When the copy of a destination region is gone, such as when it expires, the resource is replaced to copy the snapshot again.",
+			},
+			"copies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Details of the copied snapshots.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Destination region of the copy.",
+						},
+						"snapshot_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the copied snapshot.",
+						},
+						"snapshot_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the copied snapshot.",
+						},
+						"is_permanent": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the copied snapshot is kept permanently.",
+						},
+						"deadline_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time when the copied snapshot expires and is deleted.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTencentCloudCbsSnapshotCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cbs_snapshot_copy.create")()
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	cbsService := CbsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+
+	snapshotId := d.Get("snapshot_id").(string)
+	regions := helper.InterfacesStrings(d.Get("destination_regions").(*schema.Set).List())
+	sort.Strings(regions)
+
+	var snapshotIds map[string]string
+	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		result, e := cbsService.CopySnapshotCrossRegions(ctx, snapshotId, d.Get("snapshot_name").(string), regions)
+		snapshotIds = result
+		if e != nil && len(result) > 0 {
+			// copying again would copy the snapshot to the succeeded regions twice
+			return resource.NonRetryableError(e)
+		}
+
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+
+		return nil
+	})
+
+	// the copies are recorded as soon as they exist, so that a failed copy still deletes them
+	if len(snapshotIds) > 0 {
+		d.SetId(strings.Join(append([]string{snapshotId}, regions...), tccommon.FILED_SP))
+		_ = d.Set("snapshot_ids", snapshotIds)
+	}
+
+	if err != nil {
+		log.Printf("[CRITAL]%s copy cbs snapshot failed, reason:%s\n ", logId, err.Error())
+		return err
+	}
+
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+	for _, region := range regions {
+		if err := cbsService.WaitForSnapshotNormalByRegion(ctx, region, snapshotIds[region], time.Until(deadline)); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("retention_days"); ok {
+		if err := modifyCbsSnapshotCopyRetention(ctx, &cbsService, snapshotIds, v.(int)); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudCbsSnapshotCopyRead(d, meta)
+}
+
+func resourceTencentCloudCbsSnapshotCopyRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cbs_snapshot_copy.read")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	cbsService := CbsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+
+	snapshotIds := d.Get("snapshot_ids").(map[string]interface{})
+	regions := make([]string, 0, len(snapshotIds))
+	for region := range snapshotIds {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	existing := make(map[string]interface{}, len(snapshotIds))
+	copies := make([]interface{}, 0, len(snapshotIds))
+	for _, region := range regions {
+		copyId := snapshotIds[region].(string)
+
+		var snapshot *cbs.Snapshot
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			result, e := cbsService.DescribeSnapshotByRegion(ctx, region, copyId)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			snapshot = result
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s read cbs snapshot copy failed, reason:%s\n ", logId, err.Error())
+			return err
+		}
+
+		if snapshot == nil {
+			log.Printf("[WARN]%s copied snapshot [%s] in region [%s] not found, drop it.\n", logId, copyId, region)
+			continue
+		}
+
+		existing[region] = copyId
+		copies = append(copies, map[string]interface{}{
+			"region":          region,
+			"snapshot_id":     copyId,
+			"snapshot_status": helper.PString(snapshot.SnapshotState),
+			"is_permanent":    snapshot.IsPermanent != nil && *snapshot.IsPermanent,
+			"deadline_time":   helper.PString(snapshot.DeadlineTime),
+		})
+	}
+
+	// a copy gone from only some regions is copied again by replacing the resource, see the CustomizeDiff
+	if len(existing) == 0 {
+		log.Printf("[WARN]%s copies of cbs snapshot [%s] not found, please check if them have been deleted or expired.\n", logId, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("snapshot_ids", existing)
+	_ = d.Set("copies", copies)
+
+	return nil
+}
+
+func resourceTencentCloudCbsSnapshotCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cbs_snapshot_copy.update")()
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	cbsService := CbsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+
+	if d.HasChange("retention_days") {
+		snapshotIds := make(map[string]string)
+		for region, copyId := range d.Get("snapshot_ids").(map[string]interface{}) {
+			snapshotIds[region] = copyId.(string)
+		}
+
+		if err := modifyCbsSnapshotCopyRetention(ctx, &cbsService, snapshotIds, d.Get("retention_days").(int)); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudCbsSnapshotCopyRead(d, meta)
+}
+
+func resourceTencentCloudCbsSnapshotCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cbs_snapshot_copy.delete")()
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	cbsService := CbsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+
+	for region, copyId := range d.Get("snapshot_ids").(map[string]interface{}) {
+		region, copyId := region, copyId.(string)
+		err := resource.Retry(3*tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			snapshot, e := cbsService.DescribeSnapshotByRegion(ctx, region, copyId)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			if snapshot == nil {
+				return nil
+			}
+
+			switch state := helper.PString(snapshot.SnapshotState); {
+			case state == CBS_SNAPSHOT_STATUS_NORMAL:
+			case state == CBS_SNAPSHOT_STATUS_TORECYCLE:
+				// a failed or expired copy is recycled by itself
+				log.Printf("[WARN]%s copied snapshot [%s] in region [%s] is %s, treat it as deleted.\n", logId, copyId, region, state)
+				return nil
+			case tccommon.IsContains(CBS_SNAPSHOT_STATUS_PENDING, state):
+				// a copy can not be deleted until it finishes copying
+				return resource.RetryableError(fmt.Errorf("copied snapshot %s in region %s is %s, retry...", copyId, region, state))
+			default:
+				return resource.NonRetryableError(fmt.Errorf("copied snapshot %s in region %s is %s, which can not be deleted", copyId, region, state))
+			}
+
+			if e := cbsService.DeleteSnapshotByRegion(ctx, region, copyId); e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s delete cbs snapshot copy failed, reason:%s\n ", logId, err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceTencentCloudCbsSnapshotCopyCustomizeDiff replaces the resource when the copy of a destination region is gone,
+// such as when it expired or was deleted, so that every region gets a copy again.
+func resourceTencentCloudCbsSnapshotCopyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.HasChange("destination_regions") || !d.NewValueKnown("destination_regions") {
+		return nil
+	}
+
+	snapshotIds := d.Get("snapshot_ids").(map[string]interface{})
+	for _, region := range helper.InterfacesStrings(d.Get("destination_regions").(*schema.Set).List()) {
+		if _, ok := snapshotIds[region]; ok {
+			continue
+		}
+
+		log.Printf("[WARN]copied snapshot of [%s] in region [%s] not found, copy it again.\n", d.Id(), region)
+		if err := d.SetNewComputed("snapshot_ids"); err != nil {
+			return err
+		}
+
+		return d.ForceNew("snapshot_ids")
+	}
+
+	return nil
+}
+
+// modifyCbsSnapshotCopyRetention sets the copies to expire after days, or keeps them permanently when days is 0.
+func modifyCbsSnapshotCopyRetention(ctx context.Context, cbsService *CbsService, snapshotIds map[string]string, days int) error {
+	deadline := ""
+	if days > 0 {
+		deadline = time.Now().UTC().AddDate(0, 0, days).Format(time.RFC3339)
+	}
+
+	for region, copyId := range snapshotIds {
+		region, copyId := region, copyId
+		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			if e := cbsService.ModifySnapshotRetentionByRegion(ctx, region, copyId, deadline); e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
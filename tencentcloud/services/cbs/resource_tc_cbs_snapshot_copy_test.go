@@ -0,0 +1,70 @@
+package cbs_test
+
+import (
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudCbsSnapshotCopyResource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCbsSnapshotCopy,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tencentcloud_cbs_snapshot_copy.copy", "id"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cbs_snapshot_copy.copy", "snapshot_ids.ap-shanghai"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_snapshot_copy.copy", "copies.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_snapshot_copy.copy", "copies.0.snapshot_status", "NORMAL"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_snapshot_copy.copy", "copies.0.is_permanent", "true"),
+				),
+			},
+			{
+				Config: testAccCbsSnapshotCopyRetention,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tencentcloud_cbs_snapshot_copy.copy", "retention_days", "7"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_snapshot_copy.copy", "copies.0.is_permanent", "false"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cbs_snapshot_copy.copy", "copies.0.deadline_time"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCbsSnapshotCopyBasic = tcacctest.DefaultAzVariable + `
+resource "tencentcloud_cbs_storage" "storage" {
+  storage_type      = "CLOUD_PREMIUM"
+  storage_name      = "tf-test-snapshot-copy"
+  storage_size      = 10
+  availability_zone = var.default_az
+}
+
+resource "tencentcloud_cbs_snapshot" "snapshot" {
+  storage_id    = tencentcloud_cbs_storage.storage.id
+  snapshot_name = "tf-test-snapshot-copy"
+}
+`
+
+const testAccCbsSnapshotCopy = testAccCbsSnapshotCopyBasic + `
+resource "tencentcloud_cbs_snapshot_copy" "copy" {
+  snapshot_id         = tencentcloud_cbs_snapshot.snapshot.id
+  destination_regions = ["ap-shanghai"]
+  snapshot_name       = "tf-test-snapshot-copy-dr"
+}
+`
+
+const testAccCbsSnapshotCopyRetention = testAccCbsSnapshotCopyBasic + `
+resource "tencentcloud_cbs_snapshot_copy" "copy" {
+  snapshot_id         = tencentcloud_cbs_snapshot.snapshot.id
+  destination_regions = ["ap-shanghai"]
+  snapshot_name       = "tf-test-snapshot-copy-dr"
+  retention_days      = 7
+}
+`
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,9 +16,10 @@ import (
 
 func ResourceTencentCloudCbsSnapshotPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCbsSnapshotPolicyAttachmentCreate,
-		Read:   resourceTencentCloudCbsSnapshotPolicyAttachmentRead,
-		Delete: resourceTencentCloudCbsSnapshotPolicyAttachmentDelete,
+		Create:   resourceTencentCloudCbsSnapshotPolicyAttachmentCreate,
+		Read:     resourceTencentCloudCbsSnapshotPolicyAttachmentRead,
+		Delete:   resourceTencentCloudCbsSnapshotPolicyAttachmentDelete,
+		Importer: helper.IdSpec{"storage_id", "snapshot_policy_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"storage_id": {
@@ -2,15 +2,21 @@ package cbs
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
+	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	svctag "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tag"
+	svctat "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tat"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
+	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
@@ -24,6 +30,14 @@ func ResourceTencentCloudCbsStorage() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			// the filesystem size is only known after it is expanded
+			if d.Id() != "" && d.HasChange("storage_size") && d.Get("expand_filesystem").(bool) {
+				return d.SetNewComputed("filesystem_size")
+			}
+
+			return nil
+		},
 
 		Schema: map[string]*schema.Schema{
 			"storage_type": {
@@ -131,6 +145,22 @@ func ResourceTencentCloudCbsStorage() *schema.Resource {
 				Computed:    true,
 				Description: "The quota of backup points of cloud disk.",
 			},
+			"expand_filesystem": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicate whether to grow the partition and the filesystem inside the instance when `storage_size` is increased, default is false. It runs a TAT command on the attached Linux instance, which supports the `ext2`, `ext3`, `ext4` and `xfs` filesystems mounted on the disk or its last partition. Only the disk is resized when it is not attached.",
+			},
+			"filesystem_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Usable size of the filesystem after it is expanded by `expand_filesystem`, and unit is MB.",
+			},
+			"expanded_storage_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Volume of CBS the filesystem was last expanded for by `expand_filesystem`, and unit is GB. `storage_size` stays at it until the filesystem expansion succeeds, so that a failed expansion is retried by the next apply.",
+			},
 			// computed
 			"storage_status": {
 				Type:        schema.TypeString,
@@ -314,6 +344,15 @@ func resourceTencentCloudCbsStorageRead(d *schema.ResourceData, meta interface{}
 
 	_ = d.Set("storage_type", storage.DiskType)
 	_ = d.Set("storage_size", storage.DiskSize)
+
+	// a disk resized without its filesystem expanded keeps the size the filesystem was expanded for, so that the
+	// expansion shows up as a diff again
+	expandedSize := d.Get("expanded_storage_size").(int)
+	if d.Get("expand_filesystem").(bool) && expandedSize > 0 && uint64(expandedSize) < *storage.DiskSize {
+		_ = d.Set("storage_size", expandedSize)
+	} else {
+		_ = d.Set("expanded_storage_size", storage.DiskSize)
+	}
 	_ = d.Set("availability_zone", storage.Placement.Zone)
 	_ = d.Set("dedicated_cluster_id", storage.Placement.DedicatedClusterId)
 	_ = d.Set("storage_name", storage.DiskName)
@@ -404,20 +443,29 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 			return fmt.Errorf("storage size must be greater than current storage size")
 		}
 
-		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			e := cbsService.ResizeDisk(ctx, storageId, newValue)
-			if e != nil {
-				return tccommon.RetryError(e)
-			}
-
-			return nil
-		})
-
+		var diskSize uint64
+		storage, err := cbsService.DescribeDiskById(ctx, storageId)
 		if err != nil {
-			log.Printf("[CRITAL]%s update cbs failed, reason:%s\n ", logId, err.Error())
 			return err
 		}
 
+		// the disk is already resized when only its filesystem expansion failed last time
+		if storage == nil || *storage.DiskSize < uint64(newValue) {
+			err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+				e := cbsService.ResizeDisk(ctx, storageId, newValue)
+				if e != nil {
+					return tccommon.RetryError(e)
+				}
+
+				return nil
+			})
+
+			if err != nil {
+				log.Printf("[CRITAL]%s update cbs failed, reason:%s\n ", logId, err.Error())
+				return err
+			}
+		}
+
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 			storage, e := cbsService.DescribeDiskById(ctx, storageId)
 			if e != nil {
@@ -428,10 +476,11 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 				return resource.RetryableError(fmt.Errorf("cbs storage status is %s", *storage.DiskState))
 			}
 
-			if *storage.DiskSize != uint64(newValue) {
+			if *storage.DiskSize < uint64(newValue) {
 				return resource.RetryableError(fmt.Errorf("waiting for cbs size changed to %d, now %d", newValue, *storage.DiskSize))
 			}
 
+			diskSize = *storage.DiskSize
 			return nil
 		})
 
@@ -440,6 +489,27 @@ func resourceTencentCloudCbsStorageUpdate(d *schema.ResourceData, meta interface
 			return err
 		}
 
+		if d.Get("expand_filesystem").(bool) {
+			storage, err := cbsService.DescribeDiskById(ctx, storageId)
+			if err != nil {
+				return err
+			}
+
+			if storage != nil && storage.Attached != nil && *storage.Attached && storage.InstanceId != nil {
+				tatService := svctat.NewTatService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
+				size, err := expandCbsStorageFilesystem(ctx, &tatService, storageId, *storage.InstanceId)
+				if err != nil {
+					return err
+				}
+
+				_ = d.Set("filesystem_size", size)
+			} else {
+				log.Printf("[WARN]%s cbs storage [%s] is not attached, skip expanding its filesystem.\n", logId, storageId)
+			}
+		}
+
+		// the filesystem grows to the whole disk
+		_ = d.Set("expanded_storage_size", diskSize)
 	}
 
 	if d.HasChange("snapshot_id") {
@@ -677,3 +747,87 @@ func resourceTencentCloudCbsStorageDelete(d *schema.ResourceData, meta interface
 
 	return nil
 }
+
+// cbsExpandFilesystemCommand grows the last partition of the disk, when there is one, and the filesystem on it, then
+// prints the usable size of the filesystem in MB.
+const cbsExpandFilesystemCommand = `set -e
+disk_id="%s"
+dev=$(readlink -f "/dev/disk/by-id/virtio-$disk_id" 2>/dev/null || true)
+if [ ! -b "$dev" ]; then
+  for block in /sys/block/*; do
+    if [ "$(cat "$block/serial" 2>/dev/null)" = "$disk_id" ]; then
+      dev="/dev/$(basename "$block")"
+    fi
+  done
+fi
+if [ ! -b "$dev" ]; then
+  echo "device of disk $disk_id is not found" >&2
+  exit 1
+fi
+echo 1 > "/sys/class/block/$(basename "$dev")/device/rescan" 2>/dev/null || true
+target="$dev"
+part=$(lsblk -lnpo NAME,TYPE "$dev" | awk '$2 == "part" {print $1}' | tail -n 1)
+if [ -n "$part" ]; then
+  growpart "$dev" "$(cat "/sys/class/block/$(basename "$part")/partition")" || [ $? -eq 1 ]
+  target="$part"
+fi
+mount_point=$(findmnt -nro TARGET -S "$target" | head -n 1)
+if [ -z "$mount_point" ]; then
+  echo "$target is not mounted" >&2
+  exit 1
+fi
+fstype=$(findmnt -nro FSTYPE -S "$target" | head -n 1)
+case "$fstype" in
+  ext2|ext3|ext4) resize2fs "$target" ;;
+  xfs) xfs_growfs "$mount_point" ;;
+  *) echo "filesystem $fstype on $target is not supported" >&2; exit 1 ;;
+esac
+df -BM --output=size "$mount_point" | tail -n 1 | tr -dc 0-9
+`
+
+// expandCbsStorageFilesystem grows the filesystem on the disk inside the instance, returning its usable size in MB.
+func expandCbsStorageFilesystem(ctx context.Context, tatService *svctat.TatService, storageId, instanceId string) (int, error) {
+	timeout := 5 * time.Minute
+	if err := tatService.WaitForTatAgentOnline(ctx, instanceId, timeout); err != nil {
+		return 0, fmt.Errorf("expand filesystem of cbs storage %s failed: %s", storageId, err.Error())
+	}
+
+	request := tat.NewRunCommandRequest()
+	request.Content = helper.String(base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf(cbsExpandFilesystemCommand, storageId))))
+	request.CommandType = helper.String(svctat.TAT_COMMAND_TYPE_SHELL)
+	request.InstanceIds = []*string{helper.String(instanceId)}
+	request.Timeout = helper.Int64Uint64(int64(timeout.Seconds()))
+	request.SaveCommand = helper.Bool(false)
+
+	var invocationId string
+	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		result, e := tatService.RunTatCommand(ctx, request)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+
+		invocationId = result
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	task, err := tatService.WaitForTatInvocationTask(ctx, invocationId, instanceId, timeout+time.Minute)
+	if err != nil {
+		return 0, fmt.Errorf("expand filesystem of cbs storage %s failed: %s", storageId, err.Error())
+	}
+
+	output := svctat.TatInvocationTaskOutput(task)
+	if *task.TaskStatus != svctat.TAT_TASK_STATUS_SUCCESS {
+		return 0, fmt.Errorf("expand filesystem of cbs storage %s on instance %s finished as %s, output:\n%s", storageId, instanceId, *task.TaskStatus, output)
+	}
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	size, err := strconv.Atoi(strings.TrimSpace(lines[len(lines)-1]))
+	if err != nil {
+		return 0, fmt.Errorf("expand filesystem of cbs storage %s succeeded, but its size is not reported, output:\n%s", storageId, output)
+	}
+
+	return size, nil
+}
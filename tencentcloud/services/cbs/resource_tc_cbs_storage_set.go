@@ -20,10 +20,11 @@ import (
 
 func ResourceTencentCloudCbsStorageSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCbsStorageSetCreate,
-		Read:   resourceTencentCloudCbsStorageSetRead,
-		Update: resourceTencentCloudCbsStorageSetUpdate,
-		Delete: resourceTencentCloudCbsStorageSetDelete,
+		Create:   resourceTencentCloudCbsStorageSetCreate,
+		Read:     resourceTencentCloudCbsStorageSetRead,
+		Update:   resourceTencentCloudCbsStorageSetUpdate,
+		Delete:   resourceTencentCloudCbsStorageSetDelete,
+		Importer: helper.IdSpec{"storage_ids"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"storage_type": {
@@ -202,6 +202,34 @@ func TestAccTencentCloudCbsStorageResource_upgrade(t *testing.T) {
 	})
 }
 
+func TestAccTencentCloudCbsStorageResource_expandFilesystem(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { tcacctest.AccPreCheck(t) },
+		Providers:    tcacctest.AccProviders,
+		CheckDestroy: testAccCheckCbsStorageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCbsStorage_expandFilesystem(t), 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageExists("tencentcloud_cbs_storage.storage_expand"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage.storage_expand", "storage_size", "20"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage.storage_expand", "expand_filesystem", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testAccCbsStorage_expandFilesystem(t), 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageExists("tencentcloud_cbs_storage.storage_expand"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage.storage_expand", "storage_size", "30"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cbs_storage.storage_expand", "filesystem_size"),
+					resource.TestCheckResourceAttr("tencentcloud_cbs_storage.storage_expand", "expanded_storage_size", "30"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCbsStorageDestroy(s *terraform.State) error {
 	logId := tccommon.GetLogId(tccommon.ContextNil)
 	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
@@ -341,3 +369,59 @@ resource "tencentcloud_cbs_storage" "storage_upgrade" {
 	force_delete = true
 }
 `
+
+func testAccCbsStorage_expandFilesystem(t *testing.T) string {
+	return tcacctest.FixtureVpcVariable(t) + `
+data "tencentcloud_images" "default" {
+  image_type       = ["PUBLIC_IMAGE"]
+  image_name_regex = "OpenCloudOS Server"
+}
+
+data "tencentcloud_instance_types" "default" {
+  availability_zone = var.availability_zone
+  cpu_core_count    = 2
+  memory_size       = 2
+  exclude_sold_out  = true
+}
+
+resource "tencentcloud_instance" "instance_expand" {
+  instance_name     = "tf-storage-expand"
+  availability_zone = var.availability_zone
+  image_id          = data.tencentcloud_images.default.images.0.image_id
+  instance_type     = data.tencentcloud_instance_types.default.instance_types.0.instance_type
+  vpc_id            = var.vpc_id
+  subnet_id         = var.subnet_id
+  system_disk_type  = "CLOUD_PREMIUM"
+}
+
+resource "tencentcloud_cbs_storage" "storage_expand" {
+  storage_type      = "CLOUD_PREMIUM"
+  storage_name      = "tf-storage-expand"
+  storage_size      = %d
+  availability_zone = var.availability_zone
+  expand_filesystem = true
+  force_delete      = true
+}
+
+resource "tencentcloud_cbs_storage_attachment" "attachment_expand" {
+  storage_id  = tencentcloud_cbs_storage.storage_expand.id
+  instance_id = tencentcloud_instance.instance_expand.id
+}
+
+resource "tencentcloud_tat_command" "mount_expand" {
+  command_name = "tf-storage-expand-mount"
+  command_type = "SHELL"
+  content      = <<-EOT
+    dev=$(readlink -f /dev/disk/by-id/virtio-${tencentcloud_cbs_storage.storage_expand.id})
+    mkfs.ext4 -F "$dev"
+    mkdir -p /data
+    mount "$dev" /data
+  EOT
+}
+
+resource "tencentcloud_tat_command_execution" "mount_expand" {
+  command_id   = tencentcloud_tat_command.mount_expand.id
+  instance_ids = [tencentcloud_cbs_storage_attachment.attachment_expand.instance_id]
+}
+`
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"strings"
 	"sync"
+	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -15,7 +16,9 @@ import (
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/batcher"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -48,14 +51,29 @@ func (me *CbsService) DescribeDiskSetByIds(ctx context.Context, diskSetIds strin
 }
 
 func (me *CbsService) DescribeDiskById(ctx context.Context, diskId string) (disk *cbs.Disk, errRet error) {
+	result, err := batcher.Shared(me.client, "cbs.DescribeDisks", me.describeDisksByIds).Get(ctx, diskId)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if result != nil {
+		disk = result.(*cbs.Disk)
+	}
+	return
+}
+
+// describeDisksByIds backs DescribeDiskById, describing every coalesced disk id in one call.
+func (me *CbsService) describeDisksByIds(ctx context.Context, diskIds []string) (disks map[string]interface{}, errRet error) {
 	logId := tccommon.GetLogId(ctx)
 	request := cbs.NewDescribeDisksRequest()
-	request.DiskIds = common.StringPtrs([]string{diskId})
+	request.DiskIds = common.StringPtrs(diskIds)
 	request.Limit = helper.IntUint64(100)
 	ratelimit.Check(request.GetAction())
 
 	var iacExtInfo connectivity.IacExtInfo
-	iacExtInfo.InstanceId = diskId
+	if len(diskIds) == 1 {
+		iacExtInfo.InstanceId = diskIds[0]
+	}
 	response, err := me.client.UseCbsClient(iacExtInfo).DescribeDisks(request)
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
@@ -67,13 +85,11 @@ func (me *CbsService) DescribeDiskById(ctx context.Context, diskId string) (disk
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-	if err != nil {
-		errRet = err
-		return
-	}
-
-	if len(response.Response.DiskSet) > 0 {
-		disk = response.Response.DiskSet[0]
+	disks = make(map[string]interface{}, len(response.Response.DiskSet))
+	for _, disk := range response.Response.DiskSet {
+		if disk.DiskId != nil {
+			disks[*disk.DiskId] = disk
+		}
 	}
 
 	return
@@ -960,3 +976,185 @@ func (me *CbsService) DescribeDiskConfigQuota(ctx context.Context, cvmInfo map[s
 	}
 	return
 }
+
+func (me *CbsService) InquiryPriceCreateDisks(ctx context.Context, request *cbs.InquiryPriceCreateDisksRequest) (price *cbs.Price, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCbsClient().InquiryPriceCreateDisks(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil {
+		return
+	}
+
+	price = response.Response.DiskPrice
+	return
+}
+
+// CopySnapshotCrossRegions copies a snapshot to the destination regions, returning the IDs of the copies by region.
+func (me *CbsService) CopySnapshotCrossRegions(ctx context.Context, snapshotId, snapshotName string, regions []string) (snapshotIds map[string]string, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	request := cbs.NewCopySnapshotCrossRegionsRequest()
+	request.SnapshotId = helper.String(snapshotId)
+	request.DestinationRegions = helper.StringsStringsPoint(regions)
+	if snapshotName != "" {
+		request.SnapshotName = helper.String(snapshotName)
+	}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCbsClient().CopySnapshotCrossRegions(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil {
+		errRet = fmt.Errorf("Response is null")
+		return
+	}
+
+	snapshotIds = make(map[string]string, len(response.Response.SnapshotCopyResultSet))
+	failed := make([]string, 0)
+	for _, result := range response.Response.SnapshotCopyResultSet {
+		region := helper.PString(result.DestinationRegion)
+		if helper.PString(result.Code) != "Success" || result.SnapshotId == nil {
+			failed = append(failed, fmt.Sprintf("%s: %s", region, helper.PString(result.Message)))
+			continue
+		}
+
+		snapshotIds[region] = *result.SnapshotId
+	}
+
+	// the copies of the other regions are returned along with the error, they exist whether it fails or not
+	if len(failed) > 0 {
+		errRet = fmt.Errorf("copy snapshot %s failed in regions %s", snapshotId, strings.Join(failed, ", "))
+	}
+
+	return
+}
+
+// DescribeSnapshotByRegion describes a snapshot in the given region, returning nil when it is not found.
+func (me *CbsService) DescribeSnapshotByRegion(ctx context.Context, region, snapshotId string) (snapshot *cbs.Snapshot, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	request := cbs.NewDescribeSnapshotsRequest()
+	request.SnapshotIds = []*string{helper.String(snapshotId)}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, region [%s], request body [%s], reason[%s]\n", logId, request.GetAction(), region, request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCbsClientRegion(region).DescribeSnapshots(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, region [%s], request body [%s], response body [%s]\n", logId, request.GetAction(), region, request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil || len(response.Response.SnapshotSet) < 1 {
+		return
+	}
+
+	snapshot = response.Response.SnapshotSet[0]
+	return
+}
+
+// WaitForSnapshotNormalByRegion waits for a snapshot in the given region to be created or copied.
+func (me *CbsService) WaitForSnapshotNormalByRegion(ctx context.Context, region, snapshotId string, timeout time.Duration) error {
+	_, err := waiter.NewWaiter(fmt.Sprintf("snapshot %s in region %s", snapshotId, region),
+		CBS_SNAPSHOT_STATUS_PENDING, []string{CBS_SNAPSHOT_STATUS_NORMAL}, []string{CBS_SNAPSHOT_STATUS_TORECYCLE}, timeout, func(ctx context.Context) (*waiter.Task, error) {
+			snapshot, err := me.DescribeSnapshotByRegion(ctx, region, snapshotId)
+			if err != nil {
+				return nil, err
+			}
+
+			if snapshot == nil || snapshot.SnapshotState == nil {
+				return nil, nil
+			}
+
+			task := &waiter.Task{Status: *snapshot.SnapshotState, Result: snapshot}
+			if snapshot.Percent != nil {
+				task.Progress = helper.Int64(int64(*snapshot.Percent))
+			}
+
+			return task, nil
+		}).WaitForState(ctx)
+
+	return err
+}
+
+// ModifySnapshotRetentionByRegion sets when a snapshot in the given region expires, keeping it permanently when deadline is empty.
+func (me *CbsService) ModifySnapshotRetentionByRegion(ctx context.Context, region, snapshotId, deadline string) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	request := cbs.NewModifySnapshotAttributeRequest()
+	request.SnapshotId = helper.String(snapshotId)
+	if deadline != "" {
+		request.Deadline = helper.String(deadline)
+	} else {
+		request.IsPermanent = helper.Bool(true)
+	}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, region [%s], request body [%s], reason[%s]\n", logId, request.GetAction(), region, request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCbsClientRegion(region).ModifySnapshotAttribute(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, region [%s], request body [%s], response body [%s]\n", logId, request.GetAction(), region, request.ToJsonString(), response.ToJsonString())
+
+	return
+}
+
+// DeleteSnapshotByRegion deletes a snapshot in the given region.
+func (me *CbsService) DeleteSnapshotByRegion(ctx context.Context, region, snapshotId string) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	request := cbs.NewDeleteSnapshotsRequest()
+	request.SnapshotIds = []*string{helper.String(snapshotId)}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, region [%s], request body [%s], reason[%s]\n", logId, request.GetAction(), region, request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCbsClientRegion(region).DeleteSnapshots(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, region [%s], request body [%s], response body [%s]\n", logId, request.GetAction(), region, request.ToJsonString(), response.ToJsonString())
+
+	return
+}
@@ -195,7 +195,7 @@ func dataSourceTencentCloudVpcCrossBorderFlowMonitorRead(d *schema.ResourceData,
 	d.SetId(ccnId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -235,7 +235,7 @@ func dataSourceTencentCloudCcnRouteTableInputPoliciesRead(d *schema.ResourceData
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), policySetList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), policySetList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
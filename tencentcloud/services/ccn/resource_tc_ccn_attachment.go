@@ -11,6 +11,7 @@ import (
 
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -23,6 +24,10 @@ func ResourceTencentCloudCcnAttachment() *schema.Resource {
 		Read:   resourceTencentCloudCcnAttachmentRead,
 		Update: resourceTencentCloudCcnAttachmentUpdate,
 		Delete: resourceTencentCloudCcnAttachmentDelete,
+		// the ID of an attachment is the md5 of its ccn ID, instance type, instance region and instance ID
+		Importer: helper.IdSpec{"ccn_id", "instance_type", "instance_region", "instance_id"}.ImporterWithIdFunc(func(parts []string) string {
+			return fmt.Sprintf("%x", md5.Sum([]byte(strings.Join(parts, ""))))
+		}, "ccn_id", "instance_type", "instance_region", "instance_id"),
 
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,6 +17,10 @@ func ResourceTencentCloudCcnBandwidthLimit() *schema.Resource {
 		Read:   resourceTencentCloudCcnBandwidthLimitRead,
 		Update: resourceTencentCloudCcnBandwidthLimitUpdate,
 		Delete: resourceTencentCloudCcnBandwidthLimitDelete,
+		// the ID of a limit is the ccn ID and the region, without the destination region
+		Importer: helper.IdSpec{"ccn_id", "region", "[dst_region]"}.ImporterWithIdFunc(func(parts []string) string {
+			return fmt.Sprintf("%s#%s", parts[0], parts[1])
+		}, "ccn_id", "region", "dst_region"),
 
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
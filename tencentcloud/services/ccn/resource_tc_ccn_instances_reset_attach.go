@@ -68,6 +68,8 @@ func ResourceTencentCloudCcnInstancesResetAttach() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
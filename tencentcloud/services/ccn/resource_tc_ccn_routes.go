@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,13 +16,11 @@ import (
 
 func ResourceTencentCloudCcnRoutes() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCcnRoutesCreate,
-		Read:   resourceTencentCloudCcnRoutesRead,
-		Update: resourceTencentCloudCcnRoutesUpdate,
-		Delete: resourceTencentCloudCcnRoutesDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCcnRoutesCreate,
+		Read:     resourceTencentCloudCcnRoutesRead,
+		Update:   resourceTencentCloudCcnRoutesUpdate,
+		Delete:   resourceTencentCloudCcnRoutesDelete,
+		Importer: helper.IdSpec{"ccn_id", "route_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"ccn_id": {
 				Required:    true,
@@ -129,7 +129,7 @@ func dataSourceTencentMysqlBackupListRead(d *schema.ResourceData, meta interface
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
 
-		if err := tccommon.WriteToFile(output.(string), itemShemas); err != nil {
+		if err := tccommon.WriteToFile(output.(string), itemShemas, tccommon.GetResultOutputOptions(d)); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail,  reason[%s]\n",
 				logId, output.(string), err.Error())
 		}
@@ -132,7 +132,7 @@ func dataSourceTencentCloudMysqlBackupOverviewRead(d *schema.ResourceData, meta
 	d.SetId(helper.DataResourceIdsHash([]string{product}))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), backupCount); e != nil {
+		if e := tccommon.WriteToFile(output.(string), backupCount, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -143,7 +143,7 @@ func dataSourceTencentCloudMysqlBinlogBackupOverviewRead(d *schema.ResourceData,
 	d.SetId(helper.DataResourceIdsHash([]string{product}))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), binlogBackupOverview); e != nil {
+		if e := tccommon.WriteToFile(output.(string), binlogBackupOverview, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
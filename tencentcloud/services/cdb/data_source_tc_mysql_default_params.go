@@ -144,7 +144,7 @@ func datasourceTencentCloudMysqlDefaultParamsRead(d *schema.ResourceData, meta i
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), params); e != nil {
+		if e := tccommon.WriteToFile(output.(string), params, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
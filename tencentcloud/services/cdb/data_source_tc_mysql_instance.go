@@ -446,7 +446,7 @@ func dataSourceTencentCloudMysqlInstanceRead(d *schema.ResourceData, meta interf
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		err = tccommon.WriteToFile(output.(string), instanceList)
+		err = tccommon.WriteToFile(output.(string), instanceList, tccommon.GetResultOutputOptions(d))
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,188 @@
+package cdb
+
+import (
+	"context"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	cdb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdb/v20170320"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func DataSourceTencentCloudMysqlPrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudMysqlPriceRead,
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Indicates which availability zone will be used.",
+			},
+			"mem_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Memory size (in MB).",
+			},
+			"volume_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Disk size (in GB).",
+			},
+			"cpu": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "CPU cores. Defaults to the cores matching `mem_size`.",
+			},
+			"device_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specify device type, available values: `UNIVERSAL` (default), `EXCLUSIVE`, `BASIC_V2`.",
+			},
+			"slave_sync_mode": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: tccommon.ValidateAllowedIntValue([]int{0, 1, 2}),
+				Description:  "Data replication mode. 0 - Async replication; 1 - Semisync replication; 2 - Strongsync replication.",
+			},
+			"instance_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "master",
+				ValidateFunc: tccommon.ValidateAllowedStringValue([]string{"master", "ro", "dr"}),
+				Description:  "Instance role. Valid values: `master`, `ro` (read-only instance) and `dr` (disaster recovery instance). Default is `master`.",
+			},
+			"charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      MYSQL_CHARGE_TYPE_POSTPAID,
+				ValidateFunc: tccommon.ValidateAllowedStringValue([]string{MYSQL_CHARGE_TYPE_PREPAID, MYSQL_CHARGE_TYPE_POSTPAID}),
+				Description:  "Pay type of instance. Valid values: `PREPAID`, `POSTPAID`. Default is `POSTPAID`.",
+			},
+			"prepaid_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: tccommon.ValidateAllowedIntValue(MYSQL_AVAILABLE_PERIOD),
+				Description:  "Period of instance. NOTES: Only supported prepaid instance. Default is 1.",
+			},
+			"original_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Original price of a `PREPAID` instance for the whole period.",
+			},
+			"discount_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Discounted price of a `PREPAID` instance for the whole period.",
+			},
+			"unit_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Original price of a `POSTPAID` instance per `charge_unit`.",
+			},
+			"unit_price_discount": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Discounted price of a `POSTPAID` instance per `charge_unit`.",
+			},
+			"charge_unit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Charge unit of a `POSTPAID` instance, `HOUR`.",
+			},
+			"currency": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Currency of the prices, `CNY` or `USD`.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudMysqlPriceRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("data_source.tencentcloud_mysql_price.read")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		request = cdb.NewDescribeDBPriceRequest()
+	)
+
+	chargeType := d.Get("charge_type").(string)
+	request.Zone = helper.String(d.Get("availability_zone").(string))
+	request.Memory = helper.IntInt64(d.Get("mem_size").(int))
+	request.Volume = helper.IntInt64(d.Get("volume_size").(int))
+	request.ProtectMode = helper.IntInt64(d.Get("slave_sync_mode").(int))
+	request.InstanceRole = helper.String(d.Get("instance_role").(string))
+	request.GoodsNum = helper.IntInt64(1)
+	if chargeType == MYSQL_CHARGE_TYPE_PREPAID {
+		request.PayType = helper.String("PRE_PAID")
+		request.Period = helper.IntInt64(d.Get("prepaid_period").(int))
+	} else {
+		request.PayType = helper.String("HOUR_PAID")
+	}
+
+	if v, ok := d.GetOk("cpu"); ok {
+		request.Cpu = helper.IntInt64(v.(int))
+	}
+
+	if v, ok := d.GetOk("device_type"); ok {
+		request.DeviceType = helper.String(v.(string))
+	}
+
+	var price *cdb.DescribeDBPriceResponseParams
+	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		result, e := service.DescribeDBPrice(ctx, request)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+
+		price = result
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the API reports prices in cents
+	if price != nil {
+		originalKey, discountKey := "original_price", "discount_price"
+		if chargeType == MYSQL_CHARGE_TYPE_POSTPAID {
+			originalKey, discountKey = "unit_price", "unit_price_discount"
+			_ = d.Set("charge_unit", "HOUR")
+		}
+
+		if price.OriginalPrice != nil {
+			_ = d.Set(originalKey, float64(*price.OriginalPrice)/100)
+		}
+
+		if price.Price != nil {
+			_ = d.Set(discountKey, float64(*price.Price)/100)
+		}
+
+		if price.Currency != nil {
+			_ = d.Set("currency", price.Currency)
+		}
+	}
+
+	d.SetId(helper.DataResourceIdsHash([]string{request.ToJsonString()}))
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := tccommon.WriteToFile(output.(string), price, tccommon.GetResultOutputOptions(d)); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package cdb_test
+
+import (
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudMysqlPriceDataSource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMysqlPriceDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					tcacctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_mysql_price.postpaid"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_price.postpaid", "unit_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_price.postpaid", "unit_price_discount"),
+					resource.TestCheckResourceAttr("data.tencentcloud_mysql_price.postpaid", "charge_unit", "HOUR"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_price.prepaid", "original_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_price.prepaid", "discount_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_mysql_price.prepaid", "currency"),
+				),
+			},
+		},
+	})
+}
+
+const testAccMysqlPriceDataSource = tcacctest.DefaultAzVariable + `
+
+data "tencentcloud_mysql_price" "postpaid" {
+  availability_zone = var.default_az
+  mem_size          = 1000
+  volume_size       = 50
+}
+
+data "tencentcloud_mysql_price" "prepaid" {
+  availability_zone = var.default_az
+  mem_size          = 1000
+  volume_size       = 50
+  charge_type       = "PREPAID"
+  prepaid_period    = 1
+}
+`
@@ -95,7 +95,7 @@ func dataSourceTencentCloudMysqlRoMinScaleRead(d *schema.ResourceData, meta inte
 		if e := tccommon.WriteToFile(output.(string), map[string]interface{}{
 			"memory": minScale.Memory,
 			"volume": minScale.Volume,
-		}); e != nil {
+		}, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
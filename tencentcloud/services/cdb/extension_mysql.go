@@ -26,8 +26,9 @@ const (
 	MYSQL_TASK_STATUS_RUNNING = "RUNNING"
 	MYSQL_TASK_STATUS_SUCCESS = "SUCCESS"
 	MYSQL_TASK_STATUS_FAILED  = "FAILED"
+	MYSQL_TASK_STATUS_KILLED  = "KILLED"
 	MYSQL_TASK_STATUS_REMOVED = "REMOVED"
-	MYSQL_TASK_STATUS_PAUSED  = "PAUSED "
+	MYSQL_TASK_STATUS_PAUSED  = "PAUSED"
 )
 
 // default to all host
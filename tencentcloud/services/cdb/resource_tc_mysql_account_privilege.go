@@ -3,7 +3,6 @@ package cdb
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -32,6 +31,9 @@ func ResourceTencentCloudMysqlAccountPrivilege() *schema.Resource {
 		Read:               resourceTencentCloudMysqlAccountPrivilegeRead,
 		Update:             resourceTencentCloudMysqlAccountPrivilegeUpdate,
 		Delete:             resourceTencentCloudMysqlAccountPrivilegeDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudMysqlAccountPrivilegeImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
@@ -76,6 +78,34 @@ func ResourceTencentCloudMysqlAccountPrivilege() *schema.Resource {
 	}
 }
 
+// mysqlAccountPrivilegeIdSpec is the format of an imported ID, `database_names` joined by `,`, since the resource ID
+// is a JSON document and Read only describes the databases of `database_names`.
+var mysqlAccountPrivilegeIdSpec = helper.IdSpec{"mysql_id", "account_name", "[account_host]", "database_names"}
+
+func resourceTencentCloudMysqlAccountPrivilegeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := mysqlAccountPrivilegeIdSpec.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	accountHost := parts[2]
+	if accountHost == "" {
+		accountHost = MYSQL_DEFAULT_ACCOUNT_HOST
+	}
+
+	privilegeId := ResourceTencentCloudMysqlAccountPrivilegeId{MysqlId: parts[0], AccountName: parts[1]}
+	if accountHost != MYSQL_DEFAULT_ACCOUNT_HOST {
+		privilegeId.AccountHost = accountHost
+	}
+
+	privilegeIdStr, _ := json.Marshal(privilegeId)
+	d.SetId(string(privilegeIdStr))
+	_ = d.Set("account_host", accountHost)
+	_ = d.Set("database_names", strings.Split(parts[3], ","))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudMysqlAccountPrivilegeCreate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_account_privilege.create")()
 
@@ -262,23 +292,7 @@ func resourceTencentCloudMysqlAccountPrivilegeUpdate(d *schema.ResourceData, met
 			return err
 		}
 
-		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("modify account privilege   task  status is %s", taskStatus))
-			}
-			if taskStatus == MYSQL_TASK_STATUS_FAILED || taskStatus == MYSQL_TASK_STATUS_REMOVED {
-				return resource.NonRetryableError(errors.New("sdk ModifyAccountPrivileges task running fail," + message))
-			}
-			err = fmt.Errorf("modify account privilege task status is %s,we won't wait for it finish ,it show message:%s\n", taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s modify account privilege fail, reason:%s\n ", logId, err.Error())
@@ -329,20 +343,7 @@ func resourceTencentCloudMysqlAccountPrivilegeDelete(d *schema.ResourceData, met
 	if err != nil {
 		return err
 	}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("delete account privilege   task  status is %s", taskStatus))
-		}
-		err = fmt.Errorf("delete account privilege  task status is %s,we won't wait for it finish ,it show message:%s", taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s delete account privilege fail, reason:%s\n ", logId, err.Error())
@@ -18,9 +18,10 @@ import (
 
 func ResourceTencentCloudMysqlAuditLogFile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlAuditLogFileCreate,
-		Read:   resourceTencentCloudMysqlAuditLogFileRead,
-		Delete: resourceTencentCloudMysqlAuditLogFileDelete,
+		Create:   resourceTencentCloudMysqlAuditLogFileCreate,
+		Read:     resourceTencentCloudMysqlAuditLogFileRead,
+		Delete:   resourceTencentCloudMysqlAuditLogFileDelete,
+		Importer: helper.IdSpec{"instance_id", "file_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,10 +14,11 @@ import (
 
 func ResourceTencentCloudMysqlBackupPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlBackupPolicyCreate,
-		Read:   resourceTencentCloudMysqlBackupPolicyRead,
-		Update: resourceTencentCloudMysqlBackupPolicyUpdate,
-		Delete: resourceTencentCloudMysqlBackupPolicyDelete,
+		Create:   resourceTencentCloudMysqlBackupPolicyCreate,
+		Read:     resourceTencentCloudMysqlBackupPolicyRead,
+		Update:   resourceTencentCloudMysqlBackupPolicyUpdate,
+		Delete:   resourceTencentCloudMysqlBackupPolicyDelete,
+		Importer: helper.IdSpec{"mysql_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
@@ -17,9 +17,10 @@ import (
 
 func ResourceTencentCloudMysqlClsLogAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlClsLogAttachmentCreate,
-		Read:   resourceTencentCloudMysqlClsLogAttachmentRead,
-		Delete: resourceTencentCloudMysqlClsLogAttachmentDelete,
+		Create:   resourceTencentCloudMysqlClsLogAttachmentCreate,
+		Read:     resourceTencentCloudMysqlClsLogAttachmentRead,
+		Delete:   resourceTencentCloudMysqlClsLogAttachmentDelete,
+		Importer: helper.IdSpec{"instance_id", "log_type"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudMysqlDatabase() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlDatabaseCreate,
-		Read:   resourceTencentCloudMysqlDatabaseRead,
-		Update: resourceTencentCloudMysqlDatabaseUpdate,
-		Delete: resourceTencentCloudMysqlDatabaseDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMysqlDatabaseCreate,
+		Read:     resourceTencentCloudMysqlDatabaseRead,
+		Update:   resourceTencentCloudMysqlDatabaseUpdate,
+		Delete:   resourceTencentCloudMysqlDatabaseDelete,
+		Importer: helper.IdSpec{"instance_id", "db_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
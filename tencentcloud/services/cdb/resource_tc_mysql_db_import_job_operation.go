@@ -18,6 +18,7 @@ func ResourceTencentCloudMysqlDbImportJobOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMysqlDbImportJobOperationCreate,
 		Read:   resourceTencentCloudMysqlDbImportJobOperationRead,
+		Update: resourceTencentCloudMysqlDbImportJobOperationUpdate,
 		Delete: resourceTencentCloudMysqlDbImportJobOperationDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -46,6 +47,7 @@ func ResourceTencentCloudMysqlDbImportJobOperation() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "The password of the user account of the cloud database instance.",
 			},
 
@@ -68,6 +70,10 @@ func ResourceTencentCloudMysqlDbImportJobOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The request ID of the asynchronous task.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -126,24 +132,13 @@ func resourceTencentCloudMysqlDbImportJobOperationCreate(d *schema.ResourceData,
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
+	if tccommon.OperationWaitForCompletion(d) {
+		err = service.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
+
 		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
+			log.Printf("[CRITAL]%s create dbImportJob fail, reason:%s\n ", logId, err.Error())
+			return err
 		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s create dbImportJob  status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s create dbImportJob status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s create dbImportJob fail, reason:%s\n ", logId, err.Error())
-		return err
 	}
 
 	d.SetId(instanceId + tccommon.FILED_SP + asyncRequestId)
@@ -197,6 +192,14 @@ func resourceTencentCloudMysqlDbImportJobOperationRead(d *schema.ResourceData, m
 	return nil
 }
 
+func resourceTencentCloudMysqlDbImportJobOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mysql_db_import_job_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMysqlDbImportJobOperationRead(d, meta)
+}
+
 func resourceTencentCloudMysqlDbImportJobOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_db_import_job_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
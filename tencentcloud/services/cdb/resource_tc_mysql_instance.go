@@ -260,7 +260,22 @@ func ResourceTencentCloudMysqlInstance() *schema.Resource {
 			Optional:     true,
 			Sensitive:    true,
 			ValidateFunc: tccommon.ValidateMysqlPassword,
-			Description:  "Password of root account. This parameter can be specified when you purchase master instances, but it should be ignored when you purchase read-only instances or disaster recovery instances.",
+			Description:  "Password of root account. This parameter can be specified when you purchase master instances, but it should be ignored when you purchase read-only instances or disaster recovery instances. Use `root_password_wo` instead to keep it out of the state.",
+		},
+		"root_password_wo": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			WriteOnly:     true,
+			ConflictsWith: []string{"root_password"},
+			ValidateFunc:  tccommon.ValidateMysqlPassword,
+			Description:   "Write-only password of root account, which is never stored in the plan or state. Requires Terraform 1.11 or later. Bump `root_password_wo_version` to rotate it.",
+		},
+		"root_password_wo_version": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			RequiredWith: []string{"root_password_wo"},
+			Description:  "Version of `root_password_wo`. Changing it updates the root password to the current value of `root_password_wo`.",
 		},
 		"slave_deploy_mode": {
 			Type:         schema.TypeInt,
@@ -519,8 +534,12 @@ func mysqlMasterInstanceRoleSet(ctx context.Context, requestInter interface{}, d
 		}
 	}
 
-	if v, ok := d.GetOk("root_password"); ok && v.(string) != "" && !isBasic {
-		str := v.(string)
+	rootPassword, err := tccommon.GetSecretWithWriteOnly(d, "root_password")
+	if err != nil {
+		return err
+	}
+	if rootPassword != "" && !isBasic {
+		str := rootPassword
 		if okByMonth {
 			requestByMonth.Password = &str
 		} else {
@@ -742,25 +761,7 @@ func resourceTencentCloudMysqlInstanceCreate(d *schema.ResourceData, meta interf
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("create account task  status is %s", taskStatus))
-			}
-			err = fmt.Errorf("open internet service task status is %s,we won't wait for it finish ,it show message:%s", ",",
-				message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s open internet service   fail, reason:%s\n ", logId, err.Error())
@@ -1061,27 +1062,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 			}
 
 			if waitSwitch != InWindow {
-				err = resource.Retry(6*time.Hour, func() *resource.RetryError {
-					taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-
-					if err != nil {
-						if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-							return resource.RetryableError(err)
-						} else {
-							return resource.NonRetryableError(err)
-						}
-					}
-
-					if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-						return nil
-					}
-					if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-						return resource.RetryableError(fmt.Errorf("update mysql mem_size/volume_size status is %s", taskStatus))
-					}
-					err = fmt.Errorf("update mysql mem_size/volume_size task status is %s, we won't wait for it finish, it show message:%s",
-						taskStatus, message)
-					return resource.NonRetryableError(err)
-				})
+				err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, 6*time.Hour)
 
 				if err != nil {
 					log.Printf("[CRITAL]%s update mysql mem_size/volume_size fail, reason:%s\n", logId, err.Error())
@@ -1170,27 +1151,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 			}
 
 			if waitSwitch != InWindow {
-				err = resource.Retry(6*time.Hour, func() *resource.RetryError {
-					taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-
-					if err != nil {
-						if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-							return resource.RetryableError(err)
-						} else {
-							return resource.NonRetryableError(err)
-						}
-					}
-
-					if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-						return nil
-					}
-					if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-						return resource.RetryableError(fmt.Errorf("update mysql mem_size/volume_size status is %s", taskStatus))
-					}
-					err = fmt.Errorf("update mysql mem_size/volume_size task status is %s, we won't wait for it finish, it show message:%s",
-						taskStatus, message)
-					return resource.NonRetryableError(err)
-				})
+				err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, 6*time.Hour)
 
 				if err != nil {
 					log.Printf("[CRITAL]%s update mysql mem_size/volume_size fail, reason:%s\n ", logId, err.Error())
@@ -1277,27 +1238,7 @@ func mysqlAllInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 
 		if waitSwitch != InWindow {
-			err = resource.Retry(6*time.Hour, func() *resource.RetryError {
-				taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-
-				if err != nil {
-					if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-						return resource.RetryableError(err)
-					} else {
-						return resource.NonRetryableError(err)
-					}
-				}
-
-				if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-					return nil
-				}
-				if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-					return resource.RetryableError(fmt.Errorf("update mysql engineVersion status is %s", taskStatus))
-				}
-				err = fmt.Errorf("update mysql engineVersion task status is %s, we won't wait for it finish, it show message:%s",
-					taskStatus, message)
-				return resource.NonRetryableError(err)
-			})
+			err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, 6*time.Hour)
 
 			if err != nil {
 				log.Printf("[CRITAL]%s update mysql engineVersion fail, reason:%s\n", logId, err.Error())
@@ -1435,25 +1376,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 				log.Printf("[CRITAL]%s update mysql %s fail, reason:%s\n ", logId, tag, err.Error())
 				return err
 			}
-			err = resource.Retry(10*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-				taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-				if err != nil {
-					if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-						return resource.RetryableError(err)
-					} else {
-						return resource.NonRetryableError(err)
-					}
-				}
-				if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-					return nil
-				}
-				if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-					return resource.RetryableError(fmt.Errorf("update mysql  %s status is %s", tag, taskStatus))
-				}
-				err = fmt.Errorf("update mysql   task status is %s,we won't wait for it finish ,it show message:%s",
-					tag, message)
-				return resource.NonRetryableError(err)
-			})
+			err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, 10*tccommon.ReadRetryTimeout)
 			if err != nil {
 				log.Printf("[CRITAL]%s update mysql  %s  fail, reason:%s\n ", logId, tag, err.Error())
 				return err
@@ -1480,25 +1403,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 			log.Printf("[CRITAL]%s update mysql %s fail, reason:%s\n ", logId, tag, err.Error())
 			return err
 		}
-		err = resource.Retry(10*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("update mysql  %s status is %s", tag, taskStatus))
-			}
-			err = fmt.Errorf("update mysql task status is %s,we won't wait for it finish ,it show message:%s",
-				tag, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, 10*tccommon.ReadRetryTimeout)
 		if err != nil {
 			log.Printf("[CRITAL]%s update mysql  %s  fail, reason:%s\n ", logId, tag, err.Error())
 			return err
@@ -1506,12 +1411,12 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 
 	}
 
-	if d.HasChange("root_password") {
-
-		var (
-			newPassword = d.Get("root_password").(string)
-			userName    = "root"
-		)
+	if tccommon.HasSecretChange(d, "root_password") {
+		newPassword, err := tccommon.GetSecretWithWriteOnly(d, "root_password")
+		if err != nil {
+			return err
+		}
+		userName := "root"
 
 		asyncRequestId, err := mysqlService.ModifyAccountPassword(ctx, d.Id(), userName, MYSQL_DEFAULT_ACCOUNT_HOST, newPassword)
 
@@ -1519,25 +1424,7 @@ func mysqlMasterInstanceRoleUpdate(ctx context.Context, d *schema.ResourceData,
 			return err
 		}
 
-		err = resource.Retry(10*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				if _, ok := err.(*errors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("change root password status is %s", taskStatus))
-			}
-			err = fmt.Errorf("change root password task status is %s,we won't wait for it finish ,it show message:%s",
-				taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, 10*tccommon.ReadRetryTimeout)
 		if err != nil {
 			log.Printf("[CRITAL]%s change root password   fail, reason:%s\n ", logId, err.Error())
 			return err
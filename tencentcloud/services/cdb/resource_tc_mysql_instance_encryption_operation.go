@@ -18,6 +18,7 @@ func ResourceTencentCloudMysqlInstanceEncryptionOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMysqlInstanceEncryptionOperationCreate,
 		Read:   resourceTencentCloudMysqlInstanceEncryptionOperationRead,
+		Update: resourceTencentCloudMysqlInstanceEncryptionOperationUpdate,
 		Delete: resourceTencentCloudMysqlInstanceEncryptionOperationDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -41,6 +42,10 @@ func ResourceTencentCloudMysqlInstanceEncryptionOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Custom storage region, such as ap-guangzhou. When `KeyId` is not empty, this parameter is required.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -85,25 +90,27 @@ func resourceTencentCloudMysqlInstanceEncryptionOperationCreate(d *schema.Resour
 
 	d.SetId(instanceId)
 
-	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		instanceInfo, err := service.DescribeMysqlInstanceInfoById(ctx, instanceId)
-		if err != nil {
+	if tccommon.OperationWaitForCompletion(d) {
+		service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			instanceInfo, err := service.DescribeMysqlInstanceInfoById(ctx, instanceId)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if *instanceInfo.Encryption == "YES" {
+				return nil
+			}
+			if *instanceInfo.Encryption == "NO" {
+				return resource.RetryableError(fmt.Errorf("%s instanceEncryption status is %s", instanceId, *instanceInfo.Encryption))
+			}
+			err = fmt.Errorf("%s operate mysql instanceEncryption status is %s,we won't wait for it finish", instanceId, *instanceInfo.Encryption)
 			return resource.NonRetryableError(err)
-		}
-		if *instanceInfo.Encryption == "YES" {
-			return nil
-		}
-		if *instanceInfo.Encryption == "NO" {
-			return resource.RetryableError(fmt.Errorf("%s instanceEncryption status is %s", instanceId, *instanceInfo.Encryption))
-		}
-		err = fmt.Errorf("%s operate mysql instanceEncryption status is %s,we won't wait for it finish", instanceId, *instanceInfo.Encryption)
-		return resource.NonRetryableError(err)
-	})
+		})
 
-	if err != nil {
-		log.Printf("[CRITAL]%s instanceEncryption fail, reason:%s\n ", logId, err.Error())
-		return err
+		if err != nil {
+			log.Printf("[CRITAL]%s instanceEncryption fail, reason:%s\n ", logId, err.Error())
+			return err
+		}
 	}
 
 	return resourceTencentCloudMysqlInstanceEncryptionOperationRead(d, meta)
@@ -145,6 +152,14 @@ func resourceTencentCloudMysqlInstanceEncryptionOperationRead(d *schema.Resource
 	return nil
 }
 
+func resourceTencentCloudMysqlInstanceEncryptionOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mysql_instance_encryption_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMysqlInstanceEncryptionOperationRead(d, meta)
+}
+
 func resourceTencentCloudMysqlInstanceEncryptionOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_instance_encryption_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudMysqlIsolateInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlIsolateInstanceCreate,
-		Read:   resourceTencentCloudMysqlIsolateInstanceRead,
-		Update: resourceTencentCloudMysqlIsolateInstanceUpdate,
-		Delete: resourceTencentCloudMysqlIsolateInstanceDelete,
+		Create:   resourceTencentCloudMysqlIsolateInstanceCreate,
+		Read:     resourceTencentCloudMysqlIsolateInstanceRead,
+		Update:   resourceTencentCloudMysqlIsolateInstanceUpdate,
+		Delete:   resourceTencentCloudMysqlIsolateInstanceDelete,
+		Importer: helper.IdSpec{"instance_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -2,7 +2,6 @@ package cdb
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
@@ -16,10 +15,11 @@ import (
 
 func ResourceTencentCloudMysqlPasswordComplexity() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlPasswordComplexityCreate,
-		Read:   resourceTencentCloudMysqlPasswordComplexityRead,
-		Update: resourceTencentCloudMysqlPasswordComplexityUpdate,
-		Delete: resourceTencentCloudMysqlPasswordComplexityDelete,
+		Create:   resourceTencentCloudMysqlPasswordComplexityCreate,
+		Read:     resourceTencentCloudMysqlPasswordComplexityRead,
+		Update:   resourceTencentCloudMysqlPasswordComplexityUpdate,
+		Delete:   resourceTencentCloudMysqlPasswordComplexityDelete,
+		Importer: helper.IdSpec{"instance_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -137,20 +137,7 @@ func resourceTencentCloudMysqlPasswordComplexityUpdate(d *schema.ResourceData, m
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s update mysql passwordComplexity status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s update mysql passwordComplexity status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s update mysql passwordComplexity fail, reason:%s\n ", logId, err.Error())
@@ -25,12 +25,17 @@ type ResourceTencentCloudMysqlPrivilegeId struct {
 	AccountHost string `json:"AccountHost,omitempty"`
 }
 
+var mysqlPrivilegeIdSpec = helper.IdSpec{"mysql_id", "account_name", "[account_host]"}
+
 func ResourceTencentCloudMysqlPrivilege() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMysqlPrivilegeCreate,
 		Read:   resourceTencentCloudMysqlPrivilegeRead,
 		Update: resourceTencentCloudMysqlPrivilegeUpdate,
 		Delete: resourceTencentCloudMysqlPrivilegeDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudMysqlPrivilegeImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"mysql_id": {
 				Type:        schema.TypeString,
@@ -252,27 +257,29 @@ func (me *ResourceTencentCloudMysqlPrivilegeId) update(ctx context.Context, d *s
 	asyncRequestId := *response.Response.AsyncRequestId
 	mysqlService := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := mysqlService.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return tccommon.RetryError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("modify account privilege  task  status is %s", taskStatus))
-		}
-
-		if taskStatus == MYSQL_TASK_STATUS_FAILED || taskStatus == MYSQL_TASK_STATUS_REMOVED {
-			return resource.NonRetryableError(errors.New("sdk ModifyAccountPrivileges task running fail," + message))
-		}
-		err = fmt.Errorf("modify account privilege task status is %s,we won't wait for it finish ,it show message:%s\n", taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = mysqlService.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
 	return err
 }
 
+// resourceTencentCloudMysqlPrivilegeImport turns the imported mysqlId#accountName[#accountHost] into the JSON ID kept in the state
+func resourceTencentCloudMysqlPrivilegeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := mysqlPrivilegeIdSpec.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	privilegeId := ResourceTencentCloudMysqlPrivilegeId{
+		MysqlId:     parts[0],
+		AccountName: parts[1],
+		AccountHost: parts[2],
+	}
+	privilegeIdStr, err := json.Marshal(privilegeId)
+	if err != nil {
+		return nil, errors.New("json encode to id fail," + err.Error())
+	}
+	d.SetId(string(privilegeIdStr))
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudMysqlPrivilegeCreate(d *schema.ResourceData, meta interface{}) error {
 
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_privilege.update")()
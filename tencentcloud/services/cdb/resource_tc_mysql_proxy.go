@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudMysqlProxy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlProxyCreate,
-		Read:   resourceTencentCloudMysqlProxyRead,
-		Update: resourceTencentCloudMysqlProxyUpdate,
-		Delete: resourceTencentCloudMysqlProxyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMysqlProxyCreate,
+		Read:     resourceTencentCloudMysqlProxyRead,
+		Update:   resourceTencentCloudMysqlProxyUpdate,
+		Delete:   resourceTencentCloudMysqlProxyDelete,
+		Importer: helper.IdSpec{"instance_id", "proxy_group_id", "proxy_address_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -226,20 +224,7 @@ func resourceTencentCloudMysqlProxyCreate(d *schema.ResourceData, meta interface
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s create mysql proxy status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s create mysql proxy status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql proxy fail, reason:%s\n ", logId, err.Error())
@@ -453,20 +438,7 @@ func resourceTencentCloudMysqlProxyUpdate(d *schema.ResourceData, meta interface
 		}
 
 		asyncRequestId := *response.Response.AsyncRequestId
-		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-			if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-				return nil
-			}
-			if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-				return resource.RetryableError(fmt.Errorf("%s update mysql proxy status is %s", instanceId, taskStatus))
-			}
-			err = fmt.Errorf("%s update mysql proxy status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-			return resource.NonRetryableError(err)
-		})
+		err = service.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
 
 		if err != nil {
 			log.Printf("[CRITAL]%s update mysql proxy fail, reason:%s\n ", logId, err.Error())
@@ -32,6 +32,8 @@ func ResourceTencentCloudMysqlReloadBalanceProxyNode() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Proxy address id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
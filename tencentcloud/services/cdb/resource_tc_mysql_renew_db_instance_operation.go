@@ -53,6 +53,8 @@ func ResourceTencentCloudMysqlRenewDbInstanceOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Instance expiration time.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
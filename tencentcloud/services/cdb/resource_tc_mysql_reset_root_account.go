@@ -25,6 +25,8 @@ func ResourceTencentCloudMysqlResetRootAccount() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The instance ID, in the format: cdb-c1nl9rpv, is the same as the instance ID displayed on the cloud database console page.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
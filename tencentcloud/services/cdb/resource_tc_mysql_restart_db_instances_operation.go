@@ -2,7 +2,6 @@ package cdb
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
@@ -16,6 +15,7 @@ func ResourceTencentCloudMysqlRestartDbInstancesOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMysqlRestartDbInstancesOperationCreate,
 		Read:   resourceTencentCloudMysqlRestartDbInstancesOperationRead,
+		Update: resourceTencentCloudMysqlRestartDbInstancesOperationUpdate,
 		Delete: resourceTencentCloudMysqlRestartDbInstancesOperationDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -31,6 +31,10 @@ func ResourceTencentCloudMysqlRestartDbInstancesOperation() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "Instance status.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -71,24 +75,13 @@ func resourceTencentCloudMysqlRestartDbInstancesOperationCreate(d *schema.Resour
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
+	if tccommon.OperationWaitForCompletion(d) {
+		err = service.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
+
 		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
+			log.Printf("[CRITAL]%s operate mysql restartDbInstancesOperation fail, reason:%s\n ", logId, err.Error())
+			return err
 		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s operate mysql restartDbInstancesOperation status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s operate mysql restartDbInstancesOperation is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s operate mysql restartDbInstancesOperation fail, reason:%s\n ", logId, err.Error())
-		return err
 	}
 
 	return resourceTencentCloudMysqlRestartDbInstancesOperationRead(d, meta)
@@ -128,6 +121,14 @@ func resourceTencentCloudMysqlRestartDbInstancesOperationRead(d *schema.Resource
 	return nil
 }
 
+func resourceTencentCloudMysqlRestartDbInstancesOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mysql_restart_db_instances_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMysqlRestartDbInstancesOperationRead(d, meta)
+}
+
 func resourceTencentCloudMysqlRestartDbInstancesOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_restart_db_instances_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
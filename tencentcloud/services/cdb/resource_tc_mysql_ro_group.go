@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudMysqlRoGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlRoGroupCreate,
-		Read:   resourceTencentCloudMysqlRoGroupRead,
-		Update: resourceTencentCloudMysqlRoGroupUpdate,
-		Delete: resourceTencentCloudMysqlRoGroupDelete,
+		Create:   resourceTencentCloudMysqlRoGroupCreate,
+		Read:     resourceTencentCloudMysqlRoGroupRead,
+		Update:   resourceTencentCloudMysqlRoGroupUpdate,
+		Delete:   resourceTencentCloudMysqlRoGroupDelete,
+		Importer: helper.IdSpec{"instance_id", "ro_group_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -220,7 +221,6 @@ func resourceTencentCloudMysqlRoGroupUpdate(d *schema.ResourceData, meta interfa
 	if len(idSplit) != 2 {
 		return fmt.Errorf("id is broken,%s", d.Id())
 	}
-	instanceId := idSplit[0]
 	roGroupId := idSplit[1]
 
 	request.RoGroupId = &roGroupId
@@ -289,20 +289,7 @@ func resourceTencentCloudMysqlRoGroupUpdate(d *schema.ResourceData, meta interfa
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
-		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s create mysql rollback status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s create mysql rollback status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
+	err = service.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
 
 	if err != nil {
 		log.Printf("[CRITAL]%s create mysql rollback fail, reason:%s\n ", logId, err.Error())
@@ -25,6 +25,8 @@ func ResourceTencentCloudMysqlRoGroupLoadOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The ID of the RO group, in the format: cdbrg-c1nl9rpv.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -18,6 +18,9 @@ func ResourceTencentCloudMysqlRoInstanceIp() *schema.Resource {
 		Create: resourceTencentCloudMysqlRoInstanceIpCreate,
 		Read:   resourceTencentCloudMysqlRoInstanceIpRead,
 		Delete: resourceTencentCloudMysqlRoInstanceIpDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -52,6 +55,8 @@ func ResourceTencentCloudMysqlRoInstanceIp() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "Intranet port number of the read-only instance.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -2,7 +2,6 @@ package cdb
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
@@ -18,6 +17,7 @@ func ResourceTencentCloudMysqlRoStartReplication() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMysqlRoStartReplicationCreate,
 		Read:   resourceTencentCloudMysqlRoStartReplicationRead,
+		Update: resourceTencentCloudMysqlRoStartReplicationUpdate,
 		Delete: resourceTencentCloudMysqlRoStartReplicationDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -27,6 +27,10 @@ func ResourceTencentCloudMysqlRoStartReplication() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Read-Only instance ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -67,24 +71,13 @@ func resourceTencentCloudMysqlRoStartReplicationCreate(d *schema.ResourceData, m
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
+	if tccommon.OperationWaitForCompletion(d) {
+		err = service.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
+
 		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
+			log.Printf("[CRITAL]%s start mysql roStopReplication fail, reason:%s\n ", logId, err.Error())
+			return err
 		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s start mysql roStopReplication status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s start mysql roStopReplication status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s start mysql roStopReplication fail, reason:%s\n ", logId, err.Error())
-		return err
 	}
 
 	return resourceTencentCloudMysqlRoStartReplicationRead(d, meta)
@@ -97,6 +90,14 @@ func resourceTencentCloudMysqlRoStartReplicationRead(d *schema.ResourceData, met
 	return nil
 }
 
+func resourceTencentCloudMysqlRoStartReplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mysql_ro_start_replication.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMysqlRoStartReplicationRead(d, meta)
+}
+
 func resourceTencentCloudMysqlRoStartReplicationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_ro_start_replication.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -2,7 +2,6 @@ package cdb
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
@@ -18,6 +17,7 @@ func ResourceTencentCloudMysqlRoStopReplication() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMysqlRoStopReplicationCreate,
 		Read:   resourceTencentCloudMysqlRoStopReplicationRead,
+		Update: resourceTencentCloudMysqlRoStopReplicationUpdate,
 		Delete: resourceTencentCloudMysqlRoStopReplicationDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -27,6 +27,10 @@ func ResourceTencentCloudMysqlRoStopReplication() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Read-Only instance ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -67,24 +71,13 @@ func resourceTencentCloudMysqlRoStopReplicationCreate(d *schema.ResourceData, me
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
+	if tccommon.OperationWaitForCompletion(d) {
+		err = service.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
+
 		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
+			log.Printf("[CRITAL]%s stop mysql roStopReplication fail, reason:%s\n ", logId, err.Error())
+			return err
 		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s stop mysql roStopReplication status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s stop mysql roStopReplication status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s stop mysql roStopReplication fail, reason:%s\n ", logId, err.Error())
-		return err
 	}
 
 	return resourceTencentCloudMysqlRoStopReplicationRead(d, meta)
@@ -97,6 +90,14 @@ func resourceTencentCloudMysqlRoStopReplicationRead(d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceTencentCloudMysqlRoStopReplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mysql_ro_stop_replication.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMysqlRoStopReplicationRead(d, meta)
+}
+
 func resourceTencentCloudMysqlRoStopReplicationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_ro_stop_replication.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
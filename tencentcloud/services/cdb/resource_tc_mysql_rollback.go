@@ -19,6 +19,7 @@ func ResourceTencentCloudMysqlRollback() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMysqlRollbackCreate,
 		Read:   resourceTencentCloudMysqlRollbackRead,
+		Update: resourceTencentCloudMysqlRollbackUpdate,
 		Delete: resourceTencentCloudMysqlRollbackDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -98,6 +99,10 @@ func ResourceTencentCloudMysqlRollback() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -184,24 +189,13 @@ func resourceTencentCloudMysqlRollbackCreate(d *schema.ResourceData, meta interf
 	d.SetId(instanceId + tccommon.FILED_SP + asyncRequestId)
 
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(5*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
+	if tccommon.OperationWaitForCompletion(d) {
+		err = service.WaitForAsyncRequest(ctx, asyncRequestId, 5*tccommon.ReadRetryTimeout)
+
 		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
+			log.Printf("[CRITAL]%s create mysql rollback fail, reason:%s\n ", logId, err.Error())
+			return err
 		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s create mysql rollback status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s create mysql rollback status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s create mysql rollback fail, reason:%s\n ", logId, err.Error())
-		return err
 	}
 
 	return resourceTencentCloudMysqlRollbackRead(d, meta)
@@ -305,6 +299,14 @@ func resourceTencentCloudMysqlRollbackRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+func resourceTencentCloudMysqlRollbackUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mysql_rollback.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMysqlRollbackRead(d, meta)
+}
+
 func resourceTencentCloudMysqlRollbackDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_rollback.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
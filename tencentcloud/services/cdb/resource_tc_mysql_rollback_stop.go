@@ -2,12 +2,10 @@ package cdb
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,6 +13,7 @@ func ResourceTencentCloudMysqlRollbackStop() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMysqlRollbackStopCreate,
 		Read:   resourceTencentCloudMysqlRollbackStopRead,
+		Update: resourceTencentCloudMysqlRollbackStopUpdate,
 		Delete: resourceTencentCloudMysqlRollbackStopDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -24,6 +23,10 @@ func ResourceTencentCloudMysqlRollbackStop() *schema.Resource {
 				ForceNew:    true,
 				Description: "Cloud database instance ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -46,24 +49,13 @@ func resourceTencentCloudMysqlRollbackStopCreate(d *schema.ResourceData, meta in
 		return err
 	}
 
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
+	if tccommon.OperationWaitForCompletion(d) {
+		err = service.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
+
 		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
+			log.Printf("[CRITAL]%s delete mysql rollback fail, reason:%s\n ", logId, err.Error())
+			return err
 		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s delete mysql rollback status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s delete mysql rollback status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s delete mysql rollback fail, reason:%s\n ", logId, err.Error())
-		return err
 	}
 
 	d.SetId(instanceId)
@@ -78,6 +70,14 @@ func resourceTencentCloudMysqlRollbackStopRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceTencentCloudMysqlRollbackStopUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mysql_rollback_stop.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMysqlRollbackStopRead(d, meta)
+}
+
 func resourceTencentCloudMysqlRollbackStopDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_rollback_Stop.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudMysqlSecurityGroupsAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMysqlSecurityGroupsAttachmentCreate,
-		Read:   resourceTencentCloudMysqlSecurityGroupsAttachmentRead,
-		Delete: resourceTencentCloudMysqlSecurityGroupsAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMysqlSecurityGroupsAttachmentCreate,
+		Read:     resourceTencentCloudMysqlSecurityGroupsAttachmentRead,
+		Delete:   resourceTencentCloudMysqlSecurityGroupsAttachmentDelete,
+		Importer: helper.IdSpec{"security_group_id", "instance_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
 				Required:    true,
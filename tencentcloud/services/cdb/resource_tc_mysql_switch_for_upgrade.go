@@ -18,6 +18,7 @@ func ResourceTencentCloudMysqlSwitchForUpgrade() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMysqlSwitchForUpgradeCreate,
 		Read:   resourceTencentCloudMysqlSwitchForUpgradeRead,
+		Update: resourceTencentCloudMysqlSwitchForUpgradeUpdate,
 		Delete: resourceTencentCloudMysqlSwitchForUpgradeDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -27,6 +28,10 @@ func ResourceTencentCloudMysqlSwitchForUpgrade() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Instance ID in the format of cdb-c1nl9rpv. It is the same as the instance ID displayed on the TencentDB Console page.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -63,29 +68,31 @@ func resourceTencentCloudMysqlSwitchForUpgradeCreate(d *schema.ResourceData, met
 
 	d.SetId(instanceId)
 
-	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(7*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		mysqlInfo, err := service.DescribeDBInstanceById(ctx, instanceId)
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if mysqlInfo == nil {
-			err = fmt.Errorf("mysqlid %s instance not exists", instanceId)
+	if tccommon.OperationWaitForCompletion(d) {
+		service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		err = resource.Retry(7*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			mysqlInfo, err := service.DescribeDBInstanceById(ctx, instanceId)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if mysqlInfo == nil {
+				err = fmt.Errorf("mysqlid %s instance not exists", instanceId)
+				return resource.NonRetryableError(err)
+			}
+			if *mysqlInfo.Status == MYSQL_STATUS_DELIVING {
+				return resource.RetryableError(fmt.Errorf("mysql switchForUpgrade status is MYSQL_STATUS_DELIVING(%d)", MYSQL_STATUS_DELIVING))
+			}
+			if *mysqlInfo.Status == MYSQL_STATUS_RUNNING {
+				return nil
+			}
+			err = fmt.Errorf("mysql switchForUpgrade status is %v,we won't wait for it finish", *mysqlInfo.Status)
 			return resource.NonRetryableError(err)
-		}
-		if *mysqlInfo.Status == MYSQL_STATUS_DELIVING {
-			return resource.RetryableError(fmt.Errorf("mysql switchForUpgrade status is MYSQL_STATUS_DELIVING(%d)", MYSQL_STATUS_DELIVING))
-		}
-		if *mysqlInfo.Status == MYSQL_STATUS_RUNNING {
-			return nil
-		}
-		err = fmt.Errorf("mysql switchForUpgrade status is %v,we won't wait for it finish", *mysqlInfo.Status)
-		return resource.NonRetryableError(err)
-	})
+		})
 
-	if err != nil {
-		log.Printf("[CRITAL]%s mysql switchForUpgrade fail, reason:%s\n ", logId, err.Error())
-		return err
+		if err != nil {
+			log.Printf("[CRITAL]%s mysql switchForUpgrade fail, reason:%s\n ", logId, err.Error())
+			return err
+		}
 	}
 
 	return resourceTencentCloudMysqlSwitchForUpgradeRead(d, meta)
@@ -121,6 +128,14 @@ func resourceTencentCloudMysqlSwitchForUpgradeRead(d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceTencentCloudMysqlSwitchForUpgradeUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mysql_switch_for_upgrade.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMysqlSwitchForUpgradeRead(d, meta)
+}
+
 func resourceTencentCloudMysqlSwitchForUpgradeDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_switch_for_upgrade.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
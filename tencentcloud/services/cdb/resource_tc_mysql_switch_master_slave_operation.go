@@ -2,7 +2,6 @@ package cdb
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
@@ -18,6 +17,7 @@ func ResourceTencentCloudMysqlSwitchMasterSlaveOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMysqlSwitchMasterSlaveOperationCreate,
 		Read:   resourceTencentCloudMysqlSwitchMasterSlaveOperationRead,
+		Update: resourceTencentCloudMysqlSwitchMasterSlaveOperationUpdate,
 		Delete: resourceTencentCloudMysqlSwitchMasterSlaveOperationDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -48,6 +48,10 @@ func ResourceTencentCloudMysqlSwitchMasterSlaveOperation() *schema.Resource {
 				Type:        schema.TypeBool,
 				Description: "Whether to switch within the time window. The default is False, i.e. do not switch within the time window. Note that if the ForceSwitch parameter is set to True, this parameter will not take effect.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -100,24 +104,13 @@ func resourceTencentCloudMysqlSwitchMasterSlaveOperationCreate(d *schema.Resourc
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
+	if tccommon.OperationWaitForCompletion(d) {
+		err = service.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
+
 		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
+			log.Printf("[CRITAL]%s operate mysql switchMasterSlaveOperation fail, reason:%s\n ", logId, err.Error())
+			return err
 		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s operate mysql switchMasterSlaveOperation status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s operate mysql switchMasterSlaveOperation status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s operate mysql switchMasterSlaveOperation fail, reason:%s\n ", logId, err.Error())
-		return err
 	}
 
 	return resourceTencentCloudMysqlSwitchMasterSlaveOperationRead(d, meta)
@@ -130,6 +123,14 @@ func resourceTencentCloudMysqlSwitchMasterSlaveOperationRead(d *schema.ResourceD
 	return nil
 }
 
+func resourceTencentCloudMysqlSwitchMasterSlaveOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mysql_switch_master_slave_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMysqlSwitchMasterSlaveOperationRead(d, meta)
+}
+
 func resourceTencentCloudMysqlSwitchMasterSlaveOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_switch_master_slave_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
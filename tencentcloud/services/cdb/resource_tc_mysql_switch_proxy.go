@@ -17,6 +17,7 @@ func ResourceTencentCloudMysqlSwitchProxy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMysqlSwitchProxyCreate,
 		Read:   resourceTencentCloudMysqlSwitchProxyRead,
+		Update: resourceTencentCloudMysqlSwitchProxyUpdate,
 		Delete: resourceTencentCloudMysqlSwitchProxyDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -33,6 +34,10 @@ func ResourceTencentCloudMysqlSwitchProxy() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Proxy group id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -75,22 +80,24 @@ func resourceTencentCloudMysqlSwitchProxyCreate(d *schema.ResourceData, meta int
 
 	d.SetId(instanceId + tccommon.FILED_SP + proxyGroupId)
 
-	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		proxy, err := service.DescribeMysqlProxyById(ctx, instanceId, proxyGroupId)
-		if err != nil {
+	if tccommon.OperationWaitForCompletion(d) {
+		service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			proxy, err := service.DescribeMysqlProxyById(ctx, instanceId, proxyGroupId)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if *proxy.Status != "online" {
+				return resource.RetryableError(fmt.Errorf("%s Switch mysql proxy status is %s", instanceId, *proxy.Status))
+			}
+			err = fmt.Errorf("%s Switch mysql proxy status is %s,we won't wait for it finish", instanceId, *proxy.Status)
 			return resource.NonRetryableError(err)
-		}
-		if *proxy.Status != "online" {
-			return resource.RetryableError(fmt.Errorf("%s Switch mysql proxy status is %s", instanceId, *proxy.Status))
-		}
-		err = fmt.Errorf("%s Switch mysql proxy status is %s,we won't wait for it finish", instanceId, *proxy.Status)
-		return resource.NonRetryableError(err)
-	})
+		})
 
-	if err != nil {
-		log.Printf("[CRITAL]%s Switch mysql proxy fail, reason:%s\n ", logId, err.Error())
-		return err
+		if err != nil {
+			log.Printf("[CRITAL]%s Switch mysql proxy fail, reason:%s\n ", logId, err.Error())
+			return err
+		}
 	}
 
 	return resourceTencentCloudMysqlSwitchProxyRead(d, meta)
@@ -103,6 +110,14 @@ func resourceTencentCloudMysqlSwitchProxyRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceTencentCloudMysqlSwitchProxyUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mysql_switch_proxy.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMysqlSwitchProxyRead(d, meta)
+}
+
 func resourceTencentCloudMysqlSwitchProxyDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_switch_proxy.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -2,7 +2,6 @@ package cdb
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
@@ -18,6 +17,7 @@ func ResourceTencentCloudMysqlVerifyRootAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMysqlVerifyRootAccountCreate,
 		Read:   resourceTencentCloudMysqlVerifyRootAccountRead,
+		Update: resourceTencentCloudMysqlVerifyRootAccountUpdate,
 		Delete: resourceTencentCloudMysqlVerifyRootAccountDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -32,8 +32,13 @@ func ResourceTencentCloudMysqlVerifyRootAccount() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "The password of the ROOT account of the instance.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -78,24 +83,13 @@ func resourceTencentCloudMysqlVerifyRootAccountCreate(d *schema.ResourceData, me
 
 	asyncRequestId := *response.Response.AsyncRequestId
 	service := MysqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		taskStatus, message, err := service.DescribeAsyncRequestInfo(ctx, asyncRequestId)
+	if tccommon.OperationWaitForCompletion(d) {
+		err = service.WaitForAsyncRequest(ctx, asyncRequestId, tccommon.ReadRetryTimeout)
+
 		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		if taskStatus == MYSQL_TASK_STATUS_SUCCESS {
-			return nil
+			log.Printf("[CRITAL]%s verify rootAccount fail, reason:%s\n ", logId, err.Error())
+			return err
 		}
-		if taskStatus == MYSQL_TASK_STATUS_INITIAL || taskStatus == MYSQL_TASK_STATUS_RUNNING {
-			return resource.RetryableError(fmt.Errorf("%s verify rootAccount status is %s", instanceId, taskStatus))
-		}
-		err = fmt.Errorf("%s verify rootAccount status is %s,we won't wait for it finish ,it show message:%s", instanceId, taskStatus, message)
-		return resource.NonRetryableError(err)
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s verify rootAccount fail, reason:%s\n ", logId, err.Error())
-		return err
 	}
 
 	return resourceTencentCloudMysqlVerifyRootAccountRead(d, meta)
@@ -108,6 +102,14 @@ func resourceTencentCloudMysqlVerifyRootAccountRead(d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceTencentCloudMysqlVerifyRootAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mysql_verify_root_account.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMysqlVerifyRootAccountRead(d, meta)
+}
+
 func resourceTencentCloudMysqlVerifyRootAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mysql_verify_root_account.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
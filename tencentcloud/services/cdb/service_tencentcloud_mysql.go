@@ -15,6 +15,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -681,6 +682,27 @@ func (me *MysqlService) DescribeAsyncRequestInfo(ctx context.Context, asyncReque
 	return
 }
 
+// AsyncRequestRefreshFunc reports the async request, such as the one started by ModifyAccountPrivileges, as a task.
+func (me *MysqlService) AsyncRequestRefreshFunc(asyncRequestId string) waiter.RefreshFunc {
+	return func(ctx context.Context) (*waiter.Task, error) {
+		status, message, err := me.DescribeAsyncRequestInfo(ctx, asyncRequestId)
+		if err != nil {
+			return nil, err
+		}
+		return &waiter.Task{Status: status, Reason: message}, nil
+	}
+}
+
+// WaitForAsyncRequest waits for the async request to succeed, and fails with its message when it fails.
+func (me *MysqlService) WaitForAsyncRequest(ctx context.Context, asyncRequestId string, timeout time.Duration) error {
+	_, err := waiter.NewWaiter(fmt.Sprintf("mysql async request %s", asyncRequestId),
+		[]string{MYSQL_TASK_STATUS_INITIAL, MYSQL_TASK_STATUS_RUNNING},
+		[]string{MYSQL_TASK_STATUS_SUCCESS},
+		[]string{MYSQL_TASK_STATUS_FAILED, MYSQL_TASK_STATUS_KILLED, MYSQL_TASK_STATUS_REMOVED, MYSQL_TASK_STATUS_PAUSED},
+		timeout, me.AsyncRequestRefreshFunc(asyncRequestId)).WaitForState(ctx)
+	return err
+}
+
 func (me *MysqlService) ModifyAccountPrivileges(ctx context.Context, mysqlId string,
 	accountName, accountHost string, databaseNames []string, privileges []string) (asyncRequestId string, errRet error) {
 
@@ -3509,3 +3531,29 @@ func (me *MysqlService) DeleteMysqlDatabaseById(ctx context.Context, instanceId
 
 	return
 }
+
+func (me *MysqlService) DescribeDBPrice(ctx context.Context, request *cdb.DescribeDBPriceRequest) (price *cdb.DescribeDBPriceResponseParams, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseMysqlClient().DescribeDBPrice(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil {
+		return
+	}
+
+	price = response.Response
+	return
+}
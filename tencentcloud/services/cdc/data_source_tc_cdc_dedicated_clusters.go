@@ -225,7 +225,7 @@ func dataSourceTencentCloudCdcDedicatedClustersRead(d *schema.ResourceData, meta
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), dedicatedClusterSetList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), dedicatedClusterSetList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
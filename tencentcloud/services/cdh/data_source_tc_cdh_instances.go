@@ -249,7 +249,7 @@ func dataSourceTencentCloudCdhInstancesRead(d *schema.ResourceData, meta interfa
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err := tccommon.WriteToFile(output.(string), instanceList); err != nil {
+		if err := tccommon.WriteToFile(output.(string), instanceList, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
@@ -465,7 +465,7 @@ func dataSourceTencentCloudCdnDomainsRead(d *schema.ResourceData, meta interface
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err := tccommon.WriteToFile(output.(string), cdnDomainList); err != nil {
+		if err := tccommon.WriteToFile(output.(string), cdnDomainList, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
@@ -212,6 +212,7 @@ func ResourceTencentCloudCdnDomain() *schema.Resource {
 									},
 									"private_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Server key information. This is required when uploading an external certificate.",
 									},
@@ -357,6 +358,7 @@ func ResourceTencentCloudCdnDomain() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									"secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Required:    true,
 										Description: "The key for signature calculation. Only digits, upper and lower-case letters are allowed. Length limit: 6-32 characters.",
 									},
@@ -383,6 +385,7 @@ func ResourceTencentCloudCdnDomain() *schema.Resource {
 									},
 									"backup_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Used for calculate a signature. 6-32 characters. Only digits and letters are allowed.",
 									},
@@ -398,6 +401,7 @@ func ResourceTencentCloudCdnDomain() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									"secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Required:    true,
 										Description: "The key for signature calculation. Only digits, upper and lower-case letters are allowed. Length limit: 6-32 characters.",
 									},
@@ -419,6 +423,7 @@ func ResourceTencentCloudCdnDomain() *schema.Resource {
 									},
 									"backup_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Used for calculate a signature. 6-32 characters. Only digits and letters are allowed.",
 									},
@@ -434,6 +439,7 @@ func ResourceTencentCloudCdnDomain() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									"secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Required:    true,
 										Description: "The key for signature calculation. Only digits, upper and lower-case letters are allowed. Length limit: 6-32 characters.",
 									},
@@ -460,6 +466,7 @@ func ResourceTencentCloudCdnDomain() *schema.Resource {
 									},
 									"backup_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Used for calculate a signature. 6-32 characters. Only digits and letters are allowed.",
 									},
@@ -475,6 +482,7 @@ func ResourceTencentCloudCdnDomain() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									"secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Required:    true,
 										Description: "The key for signature calculation. Only digits, upper and lower-case letters are allowed. Length limit: 6-32 characters.",
 									},
@@ -506,6 +514,7 @@ func ResourceTencentCloudCdnDomain() *schema.Resource {
 									},
 									"backup_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Used for calculate a signature. 6-32 characters. Only digits and letters are allowed.",
 									},
@@ -89,6 +89,9 @@ func ResourceTencentCloudUrlPurge() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS:            tccommon.OperationTriggersSchema(),
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -124,21 +127,6 @@ func resourceTencentCloudUrlPurgeRead(d *schema.ResourceData, meta interface{})
 		if len(logs) == 0 {
 			return resource.RetryableError(fmt.Errorf("task %s returns nil logs, retrying", taskId))
 		}
-		for i := range logs {
-			item := logs[i]
-			status := item.Status
-			if status == nil {
-				continue
-			}
-			switch *status {
-			case "process":
-				return resource.RetryableError(fmt.Errorf("processing %s", *item.Url))
-			case "fail":
-				return resource.NonRetryableError(fmt.Errorf("purge url %s failed", *item.Url))
-			default:
-				continue
-			}
-		}
 		return nil
 	})
 
@@ -182,6 +170,12 @@ func resourceTencentCloudUrlPurgeCreate(d *schema.ResourceData, meta interface{}
 
 	_ = d.Set("task_id", taskId)
 
+	if tccommon.OperationWaitForCompletion(d) {
+		if err := waitForCdnUrlPurgeTask(meta, taskId); err != nil {
+			return err
+		}
+	}
+
 	urls := d.Get("urls").([]interface{})
 	d.SetId("purges-" + GetUrlsHash(helper.InterfacesStrings(urls)))
 
@@ -205,6 +199,12 @@ func resourceTencentCloudUrlPurgeUpdate(d *schema.ResourceData, meta interface{}
 
 	_ = d.Set("task_id", taskId)
 
+	if tccommon.OperationWaitForCompletion(d) {
+		if err := waitForCdnUrlPurgeTask(meta, taskId); err != nil {
+			return err
+		}
+	}
+
 	return resourceTencentCloudUrlPurgeRead(d, meta)
 }
 
@@ -235,3 +235,41 @@ func tencentcloudCdnUrlPurge(d *schema.ResourceData, meta interface{}) (string,
 
 	return service.PurgeUrlsCache(ctx, request)
 }
+
+// waitForCdnUrlPurgeTask polls the purge task until no url of it is processing, and fails if any url failed
+func waitForCdnUrlPurgeTask(meta interface{}, taskId string) error {
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+
+	client := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
+	service := CdnService{client}
+
+	request := cdn.NewDescribePurgeTasksRequest()
+	request.TaskId = &taskId
+
+	return resource.Retry(tccommon.ReadRetryTimeout*2, func() *resource.RetryError {
+		logs, err := service.DescribePurgeTasks(ctx, request)
+		if err != nil {
+			return tccommon.RetryError(err)
+		}
+		if len(logs) == 0 {
+			return resource.RetryableError(fmt.Errorf("task %s returns nil logs, retrying", taskId))
+		}
+		for i := range logs {
+			item := logs[i]
+			status := item.Status
+			if status == nil {
+				continue
+			}
+			switch *status {
+			case "process":
+				return resource.RetryableError(fmt.Errorf("processing %s", *item.Url))
+			case "fail":
+				return resource.NonRetryableError(fmt.Errorf("purge url %s failed", *item.Url))
+			default:
+				continue
+			}
+		}
+		return nil
+	})
+}
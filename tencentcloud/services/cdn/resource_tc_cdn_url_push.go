@@ -110,6 +110,9 @@ func ResourceTencentCloudUrlPush() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS:            tccommon.OperationTriggersSchema(),
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -145,21 +148,6 @@ func resourceTencentCloudUrlPushRead(d *schema.ResourceData, meta interface{}) e
 		if len(logs) == 0 {
 			return resource.RetryableError(fmt.Errorf("task %s returns nil logs, retrying", taskId))
 		}
-		for i := range logs {
-			item := logs[i]
-			status := item.Status
-			if status == nil {
-				continue
-			}
-			switch *status {
-			case "process":
-				return resource.RetryableError(fmt.Errorf("processing %s", *item.Url))
-			case "fail":
-				return resource.NonRetryableError(fmt.Errorf("push url %s failed", *item.Url))
-			default:
-				continue
-			}
-		}
 		return nil
 	})
 
@@ -205,6 +193,12 @@ func resourceTencentCloudUrlPushCreate(d *schema.ResourceData, meta interface{})
 
 	_ = d.Set("task_id", taskId)
 
+	if tccommon.OperationWaitForCompletion(d) {
+		if err := waitForCdnUrlPushTask(meta, taskId); err != nil {
+			return err
+		}
+	}
+
 	urls := d.Get("urls").([]interface{})
 	d.SetId("pushes-" + GetUrlsHash(helper.InterfacesStrings(urls)))
 
@@ -228,6 +222,12 @@ func resourceTencentCloudUrlPushUpdate(d *schema.ResourceData, meta interface{})
 
 	_ = d.Set("task_id", taskId)
 
+	if tccommon.OperationWaitForCompletion(d) {
+		if err := waitForCdnUrlPushTask(meta, taskId); err != nil {
+			return err
+		}
+	}
+
 	return resourceTencentCloudUrlPushRead(d, meta)
 }
 
@@ -267,3 +267,41 @@ func tencentcloudCdnUrlPush(d *schema.ResourceData, meta interface{}) (string, e
 
 	return service.PushUrlsCache(ctx, request)
 }
+
+// waitForCdnUrlPushTask polls the push task until no url of it is processing, and fails if any url failed
+func waitForCdnUrlPushTask(meta interface{}, taskId string) error {
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+
+	client := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
+	service := CdnService{client}
+
+	request := cdn.NewDescribePushTasksRequest()
+	request.TaskId = &taskId
+
+	return resource.Retry(tccommon.ReadRetryTimeout*2, func() *resource.RetryError {
+		logs, err := service.DescribePushTasks(ctx, request)
+		if err != nil {
+			return tccommon.RetryError(err)
+		}
+		if len(logs) == 0 {
+			return resource.RetryableError(fmt.Errorf("task %s returns nil logs, retrying", taskId))
+		}
+		for i := range logs {
+			item := logs[i]
+			status := item.Status
+			if status == nil {
+				continue
+			}
+			switch *status {
+			case "process":
+				return resource.RetryableError(fmt.Errorf("processing %s", *item.Url))
+			case "fail":
+				return resource.NonRetryableError(fmt.Errorf("push url %s failed", *item.Url))
+			default:
+				continue
+			}
+		}
+		return nil
+	})
+}
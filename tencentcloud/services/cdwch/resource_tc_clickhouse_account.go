@@ -7,19 +7,18 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceTencentCloudClickhouseAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClickhouseAccountCreate,
-		Read:   resourceTencentCloudClickhouseAccountRead,
-		Update: resourceTencentCloudClickhouseAccountUpdate,
-		Delete: resourceTencentCloudClickhouseAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClickhouseAccountCreate,
+		Read:     resourceTencentCloudClickhouseAccountRead,
+		Update:   resourceTencentCloudClickhouseAccountUpdate,
+		Delete:   resourceTencentCloudClickhouseAccountDelete,
+		Importer: helper.IdSpec{"instance_id", "user_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
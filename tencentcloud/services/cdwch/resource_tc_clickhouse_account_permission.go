@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudClickhouseAccountPermission() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClickhouseAccountPermissionCreate,
-		Read:   resourceTencentCloudClickhouseAccountPermissionRead,
-		Update: resourceTencentCloudClickhouseAccountPermissionUpdate,
-		Delete: resourceTencentCloudClickhouseAccountPermissionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClickhouseAccountPermissionCreate,
+		Read:     resourceTencentCloudClickhouseAccountPermissionRead,
+		Update:   resourceTencentCloudClickhouseAccountPermissionUpdate,
+		Delete:   resourceTencentCloudClickhouseAccountPermissionDelete,
+		Importer: helper.IdSpec{"instance_id", "cluster", "user_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
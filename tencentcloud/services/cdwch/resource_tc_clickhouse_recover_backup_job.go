@@ -31,6 +31,8 @@ func ResourceTencentCloudClickhouseRecoverBackupJob() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "Back up job id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
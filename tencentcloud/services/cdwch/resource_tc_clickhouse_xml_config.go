@@ -8,6 +8,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,13 +17,11 @@ import (
 
 func ResourceTencentCloudClickhouseXmlConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClickhouseXmlConfigCreate,
-		Read:   resourceTencentCloudClickhouseXmlConfigRead,
-		Update: resourceTencentCloudClickhouseXmlConfigUpdate,
-		Delete: resourceTencentCloudClickhouseXmlConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClickhouseXmlConfigCreate,
+		Read:     resourceTencentCloudClickhouseXmlConfigRead,
+		Update:   resourceTencentCloudClickhouseXmlConfigUpdate,
+		Delete:   resourceTencentCloudClickhouseXmlConfigDelete,
+		Importer: helper.IdSpec{"instance_id", "file_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
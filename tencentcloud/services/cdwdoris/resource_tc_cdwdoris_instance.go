@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudCdwdorisInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCdwdorisInstanceCreate,
-		Read:   resourceTencentCloudCdwdorisInstanceRead,
-		Update: resourceTencentCloudCdwdorisInstanceUpdate,
-		Delete: resourceTencentCloudCdwdorisInstanceDelete,
+		Create:   resourceTencentCloudCdwdorisInstanceCreate,
+		Read:     resourceTencentCloudCdwdorisInstanceRead,
+		Update:   resourceTencentCloudCdwdorisInstanceUpdate,
+		Delete:   resourceTencentCloudCdwdorisInstanceDelete,
+		Importer: helper.IdSpec{"instance_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Type:        schema.TypeString,
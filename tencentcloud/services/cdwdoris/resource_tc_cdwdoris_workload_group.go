@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudCdwdorisWorkloadGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCdwdorisWorkloadGroupCreate,
-		Read:   resourceTencentCloudCdwdorisWorkloadGroupRead,
-		Update: resourceTencentCloudCdwdorisWorkloadGroupUpdate,
-		Delete: resourceTencentCloudCdwdorisWorkloadGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCdwdorisWorkloadGroupCreate,
+		Read:     resourceTencentCloudCdwdorisWorkloadGroupRead,
+		Update:   resourceTencentCloudCdwdorisWorkloadGroupUpdate,
+		Delete:   resourceTencentCloudCdwdorisWorkloadGroupDelete,
+		Importer: helper.IdSpec{"instance_id", "workload_group_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
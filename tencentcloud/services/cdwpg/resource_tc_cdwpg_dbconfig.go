@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudCdwpgDbconfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCdwpgDbconfigCreate,
-		Read:   resourceTencentCloudCdwpgDbconfigRead,
-		Update: resourceTencentCloudCdwpgDbconfigUpdate,
-		Delete: resourceTencentCloudCdwpgDbconfigDelete,
+		Create:   resourceTencentCloudCdwpgDbconfigCreate,
+		Read:     resourceTencentCloudCdwpgDbconfigRead,
+		Update:   resourceTencentCloudCdwpgDbconfigUpdate,
+		Delete:   resourceTencentCloudCdwpgDbconfigDelete,
+		Importer: helper.IdSpec{"instance_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudCdwpgResetAccountPassword() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCdwpgResetAccountPasswordCreate,
-		Read:   resourceTencentCloudCdwpgResetAccountPasswordRead,
-		Update: resourceTencentCloudCdwpgResetAccountPasswordUpdate,
-		Delete: resourceTencentCloudCdwpgResetAccountPasswordDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCdwpgResetAccountPasswordCreate,
+		Read:     resourceTencentCloudCdwpgResetAccountPasswordRead,
+		Update:   resourceTencentCloudCdwpgResetAccountPasswordUpdate,
+		Delete:   resourceTencentCloudCdwpgResetAccountPasswordDelete,
+		Importer: helper.IdSpec{"instance_id", "user_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -17,6 +17,7 @@ func ResourceTencentCloudCdwpgRestartInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudCdwpgRestartInstanceCreate,
 		Read:   resourceTencentCloudCdwpgRestartInstanceRead,
+		Update: resourceTencentCloudCdwpgRestartInstanceUpdate,
 		Delete: resourceTencentCloudCdwpgRestartInstanceDelete,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -45,6 +46,10 @@ func ResourceTencentCloudCdwpgRestartInstance() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -103,11 +108,13 @@ func resourceTencentCloudCdwpgRestartInstanceCreate(d *schema.ResourceData, meta
 
 	_ = response
 
-	service := CdwpgService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Serving"}, 10*tccommon.ReadRetryTimeout, time.Second, service.InstanceStateRefreshFunc(instanceId, []string{}))
+	if tccommon.OperationWaitForCompletion(d) {
+		service := CdwpgService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"Serving"}, 10*tccommon.ReadRetryTimeout, time.Second, service.InstanceStateRefreshFunc(instanceId, []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 	d.SetId(instanceId)
 
@@ -121,6 +128,14 @@ func resourceTencentCloudCdwpgRestartInstanceRead(d *schema.ResourceData, meta i
 	return nil
 }
 
+func resourceTencentCloudCdwpgRestartInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cdwpg_restart_instance.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudCdwpgRestartInstanceRead(d, meta)
+}
+
 func resourceTencentCloudCdwpgRestartInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cdwpg_restart_instance.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
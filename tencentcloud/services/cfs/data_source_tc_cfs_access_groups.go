@@ -118,7 +118,7 @@ func dataSourceTencentCloudCfsAccessGroupsRead(d *schema.ResourceData, meta inte
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err := tccommon.WriteToFile(output.(string), accessGroupList); err != nil {
+		if err := tccommon.WriteToFile(output.(string), accessGroupList, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
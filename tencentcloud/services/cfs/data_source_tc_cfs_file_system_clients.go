@@ -134,7 +134,7 @@ func dataSourceTencentCloudCfsFileSystemClientsRead(d *schema.ResourceData, meta
 	d.SetId(fsId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -19,10 +19,11 @@ import (
 
 func ResourceTencentCloudCfsAccessRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfsAccessRuleCreate,
-		Read:   resourceTencentCloudCfsAccessRuleRead,
-		Update: resourceTencentCloudCfsAccessRuleUpdate,
-		Delete: resourceTencentCloudCfsAccessRuleDelete,
+		Create:   resourceTencentCloudCfsAccessRuleCreate,
+		Read:     resourceTencentCloudCfsAccessRuleRead,
+		Update:   resourceTencentCloudCfsAccessRuleUpdate,
+		Delete:   resourceTencentCloudCfsAccessRuleDelete,
+		Importer: helper.IdSpec{"access_group_id", "access_rule_id"}.ImporterWithArguments("access_group_id"),
 
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudCfsAutoSnapshotPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfsAutoSnapshotPolicyAttachmentCreate,
-		Read:   resourceTencentCloudCfsAutoSnapshotPolicyAttachmentRead,
-		Delete: resourceTencentCloudCfsAutoSnapshotPolicyAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCfsAutoSnapshotPolicyAttachmentCreate,
+		Read:     resourceTencentCloudCfsAutoSnapshotPolicyAttachmentRead,
+		Delete:   resourceTencentCloudCfsAutoSnapshotPolicyAttachmentDelete,
+		Importer: helper.IdSpec{"auto_snapshot_policy_id", "file_system_ids"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"auto_snapshot_policy_id": {
 				Required:    true,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudCfsUserQuota() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfsUserQuotaCreate,
-		Read:   resourceTencentCloudCfsUserQuotaRead,
-		Update: resourceTencentCloudCfsUserQuotaUpdate,
-		Delete: resourceTencentCloudCfsUserQuotaDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCfsUserQuotaCreate,
+		Read:     resourceTencentCloudCfsUserQuotaRead,
+		Update:   resourceTencentCloudCfsUserQuotaUpdate,
+		Delete:   resourceTencentCloudCfsUserQuotaDelete,
+		Importer: helper.IdSpec{"file_system_id", "user_type", "user_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"file_system_id": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCfwBlockIgnore() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwBlockIgnoreCreate,
-		Read:   resourceTencentCloudCfwBlockIgnoreRead,
-		Update: resourceTencentCloudCfwBlockIgnoreUpdate,
-		Delete: resourceTencentCloudCfwBlockIgnoreDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCfwBlockIgnoreCreate,
+		Read:     resourceTencentCloudCfwBlockIgnoreRead,
+		Update:   resourceTencentCloudCfwBlockIgnoreUpdate,
+		Delete:   resourceTencentCloudCfwBlockIgnoreDelete,
+		Importer: helper.IdSpec{"ip", "domain", "direction", "rule_type"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"ip": {
 				Type:         schema.TypeString,
@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudCfwEdgeFirewallSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwEdgeFirewallSwitchCreate,
-		Read:   resourceTencentCloudCfwEdgeFirewallSwitchRead,
-		Update: resourceTencentCloudCfwEdgeFirewallSwitchUpdate,
-		Delete: resourceTencentCloudCfwEdgeFirewallSwitchDelete,
+		Create:   resourceTencentCloudCfwEdgeFirewallSwitchCreate,
+		Read:     resourceTencentCloudCfwEdgeFirewallSwitchRead,
+		Update:   resourceTencentCloudCfwEdgeFirewallSwitchUpdate,
+		Delete:   resourceTencentCloudCfwEdgeFirewallSwitchDelete,
+		Importer: helper.IdSpec{"public_ip"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"public_ip": {
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCfwNatFirewallSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwNatFirewallSwitchCreate,
-		Read:   resourceTencentCloudCfwNatFirewallSwitchRead,
-		Update: resourceTencentCloudCfwNatFirewallSwitchUpdate,
-		Delete: resourceTencentCloudCfwNatFirewallSwitchDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCfwNatFirewallSwitchCreate,
+		Read:     resourceTencentCloudCfwNatFirewallSwitchRead,
+		Update:   resourceTencentCloudCfwNatFirewallSwitchUpdate,
+		Delete:   resourceTencentCloudCfwNatFirewallSwitchDelete,
+		Importer: helper.IdSpec{"nat_ins_id", "subnet_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"nat_ins_id": {
 				Required:    true,
@@ -17,8 +17,13 @@ func ResourceTencentCloudCfwSyncAsset() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudCfwSyncAssetCreate,
 		Read:   resourceTencentCloudCfwSyncAssetRead,
+		Update: resourceTencentCloudCfwSyncAssetUpdate,
 		Delete: resourceTencentCloudCfwSyncAssetDelete,
-		Schema: map[string]*schema.Schema{},
+		Schema: map[string]*schema.Schema{
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
+		},
 	}
 }
 
@@ -48,23 +53,25 @@ func resourceTencentCloudCfwSyncAssetCreate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	// wait
-	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCfwClient().DescribeAssetSync(statusRequest)
-		if e != nil {
-			return tccommon.RetryError(e)
-		}
+	if tccommon.OperationWaitForCompletion(d) {
+		// wait
+		err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCfwClient().DescribeAssetSync(statusRequest)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
 
-		if *result.Response.Status == 2 {
-			return nil
-		}
+			if *result.Response.Status == 2 {
+				return nil
+			}
 
-		return resource.RetryableError(fmt.Errorf("The fw sync asset status is %d.", *result.Response.Status))
-	})
+			return resource.RetryableError(fmt.Errorf("The fw sync asset status is %d.", *result.Response.Status))
+		})
 
-	if err != nil {
-		log.Printf("[CRITAL]%s operate cfw syncAsset status failed, reason:%+v", logId, err)
-		return err
+		if err != nil {
+			log.Printf("[CRITAL]%s operate cfw syncAsset status failed, reason:%+v", logId, err)
+			return err
+		}
 	}
 
 	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
@@ -79,6 +86,14 @@ func resourceTencentCloudCfwSyncAssetRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourceTencentCloudCfwSyncAssetUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cfw_sync_asset.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudCfwSyncAssetRead(d, meta)
+}
+
 func resourceTencentCloudCfwSyncAssetDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cfw_sync_asset.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
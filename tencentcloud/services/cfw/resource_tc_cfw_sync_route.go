@@ -19,6 +19,7 @@ func ResourceTencentCloudCfwSyncRoute() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudCfwSyncRouteCreate,
 		Read:   resourceTencentCloudCfwSyncRouteRead,
+		Update: resourceTencentCloudCfwSyncRouteUpdate,
 		Delete: resourceTencentCloudCfwSyncRouteDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -35,6 +36,10 @@ func ResourceTencentCloudCfwSyncRoute() *schema.Resource {
 				ValidateFunc: tccommon.ValidateAllowedStringValue(FW_TYPE),
 				Description:  "Firewall type; nat: nat firewall; ew: inter-vpc firewall.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -73,23 +78,25 @@ func resourceTencentCloudCfwSyncRouteCreate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	// wait
-	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCfwClient().DescribeFwSyncStatus(statusRequest)
-		if e != nil {
-			return tccommon.RetryError(e)
-		}
+	if tccommon.OperationWaitForCompletion(d) {
+		// wait
+		err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCfwClient().DescribeFwSyncStatus(statusRequest)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
 
-		if *result.Response.SyncStatus == 0 {
-			return nil
-		}
+			if *result.Response.SyncStatus == 0 {
+				return nil
+			}
 
-		return resource.RetryableError(fmt.Errorf("The fw sync status is %d.", *result.Response.SyncStatus))
-	})
+			return resource.RetryableError(fmt.Errorf("The fw sync status is %d.", *result.Response.SyncStatus))
+		})
 
-	if err != nil {
-		log.Printf("[CRITAL]%s operate cfw syncAsset status failed, reason:%+v", logId, err)
-		return err
+		if err != nil {
+			log.Printf("[CRITAL]%s operate cfw syncAsset status failed, reason:%+v", logId, err)
+			return err
+		}
 	}
 
 	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
@@ -104,6 +111,14 @@ func resourceTencentCloudCfwSyncRouteRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourceTencentCloudCfwSyncRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cfw_sync_route.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudCfwSyncRouteRead(d, meta)
+}
+
 func resourceTencentCloudCfwSyncRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cfw_sync_route.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCfwVpcFirewallSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCfwVpcFirewallSwitchCreate,
-		Read:   resourceTencentCloudCfwVpcFirewallSwitchRead,
-		Update: resourceTencentCloudCfwVpcFirewallSwitchUpdate,
-		Delete: resourceTencentCloudCfwVpcFirewallSwitchDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCfwVpcFirewallSwitchCreate,
+		Read:     resourceTencentCloudCfwVpcFirewallSwitchRead,
+		Update:   resourceTencentCloudCfwVpcFirewallSwitchUpdate,
+		Delete:   resourceTencentCloudCfwVpcFirewallSwitchDelete,
+		Importer: helper.IdSpec{"vpc_ins_id", "switch_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"vpc_ins_id": {
 				Required:    true,
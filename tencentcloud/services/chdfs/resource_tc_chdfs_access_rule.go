@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudChdfsAccessRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudChdfsAccessRuleCreate,
-		Read:   resourceTencentCloudChdfsAccessRuleRead,
-		Update: resourceTencentCloudChdfsAccessRuleUpdate,
-		Delete: resourceTencentCloudChdfsAccessRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudChdfsAccessRuleCreate,
+		Read:     resourceTencentCloudChdfsAccessRuleRead,
+		Update:   resourceTencentCloudChdfsAccessRuleUpdate,
+		Delete:   resourceTencentCloudChdfsAccessRuleDelete,
+		Importer: helper.IdSpec{"access_group_id", "access_rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"access_rule": {
 				Required:    true,
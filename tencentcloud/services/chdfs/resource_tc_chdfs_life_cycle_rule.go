@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudChdfsLifeCycleRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudChdfsLifeCycleRuleCreate,
-		Read:   resourceTencentCloudChdfsLifeCycleRuleRead,
-		Update: resourceTencentCloudChdfsLifeCycleRuleUpdate,
-		Delete: resourceTencentCloudChdfsLifeCycleRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudChdfsLifeCycleRuleCreate,
+		Read:     resourceTencentCloudChdfsLifeCycleRuleRead,
+		Update:   resourceTencentCloudChdfsLifeCycleRuleUpdate,
+		Delete:   resourceTencentCloudChdfsLifeCycleRuleDelete,
+		Importer: helper.IdSpec{"file_system_id", "life_cycle_rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"file_system_id": {
 				Required:    true,
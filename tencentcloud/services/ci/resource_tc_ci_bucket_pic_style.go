@@ -8,6 +8,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,12 +18,10 @@ import (
 
 func ResourceTencentCloudCiBucketPicStyle() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiBucketPicStyleCreate,
-		Read:   resourceTencentCloudCiBucketPicStyleRead,
-		Delete: resourceTencentCloudCiBucketPicStyleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiBucketPicStyleCreate,
+		Read:     resourceTencentCloudCiBucketPicStyleRead,
+		Delete:   resourceTencentCloudCiBucketPicStyleDelete,
+		Importer: helper.IdSpec{"bucket", "style_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:     true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCiMediaAnimationTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaAnimationTemplateCreate,
-		Read:   resourceTencentCloudCiMediaAnimationTemplateRead,
-		Update: resourceTencentCloudCiMediaAnimationTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaAnimationTemplateDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Create:   resourceTencentCloudCiMediaAnimationTemplateCreate,
+		Read:     resourceTencentCloudCiMediaAnimationTemplateRead,
+		Update:   resourceTencentCloudCiMediaAnimationTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaAnimationTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
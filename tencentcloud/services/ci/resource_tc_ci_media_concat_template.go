@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCiMediaConcatTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaConcatTemplateCreate,
-		Read:   resourceTencentCloudCiMediaConcatTemplateRead,
-		Update: resourceTencentCloudCiMediaConcatTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaConcatTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiMediaConcatTemplateCreate,
+		Read:     resourceTencentCloudCiMediaConcatTemplateRead,
+		Update:   resourceTencentCloudCiMediaConcatTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaConcatTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCiMediaPicProcessTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaPicProcessTemplateCreate,
-		Read:   resourceTencentCloudCiMediaPicProcessTemplateRead,
-		Update: resourceTencentCloudCiMediaPicProcessTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaPicProcessTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiMediaPicProcessTemplateCreate,
+		Read:     resourceTencentCloudCiMediaPicProcessTemplateRead,
+		Update:   resourceTencentCloudCiMediaPicProcessTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaPicProcessTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCiMediaSmartCoverTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaSmartCoverTemplateCreate,
-		Read:   resourceTencentCloudCiMediaSmartCoverTemplateRead,
-		Update: resourceTencentCloudCiMediaSmartCoverTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaSmartCoverTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiMediaSmartCoverTemplateCreate,
+		Read:     resourceTencentCloudCiMediaSmartCoverTemplateRead,
+		Update:   resourceTencentCloudCiMediaSmartCoverTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaSmartCoverTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCiMediaSnapshotTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaSnapshotTemplateCreate,
-		Read:   resourceTencentCloudCiMediaSnapshotTemplateRead,
-		Update: resourceTencentCloudCiMediaSnapshotTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaSnapshotTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiMediaSnapshotTemplateCreate,
+		Read:     resourceTencentCloudCiMediaSnapshotTemplateRead,
+		Update:   resourceTencentCloudCiMediaSnapshotTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaSnapshotTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
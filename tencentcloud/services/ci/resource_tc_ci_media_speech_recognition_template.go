@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCiMediaSpeechRecognitionTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaSpeechRecognitionTemplateCreate,
-		Read:   resourceTencentCloudCiMediaSpeechRecognitionTemplateRead,
-		Update: resourceTencentCloudCiMediaSpeechRecognitionTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaSpeechRecognitionTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiMediaSpeechRecognitionTemplateCreate,
+		Read:     resourceTencentCloudCiMediaSpeechRecognitionTemplateRead,
+		Update:   resourceTencentCloudCiMediaSpeechRecognitionTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaSpeechRecognitionTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
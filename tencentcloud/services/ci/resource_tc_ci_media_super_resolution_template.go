@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,13 +17,11 @@ import (
 
 func ResourceTencentCloudCiMediaSuperResolutionTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaSuperResolutionTemplateCreate,
-		Read:   resourceTencentCloudCiMediaSuperResolutionTemplateRead,
-		Update: resourceTencentCloudCiMediaSuperResolutionTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaSuperResolutionTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiMediaSuperResolutionTemplateCreate,
+		Read:     resourceTencentCloudCiMediaSuperResolutionTemplateRead,
+		Update:   resourceTencentCloudCiMediaSuperResolutionTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaSuperResolutionTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCiMediaTranscodeProTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaTranscodeProTemplateCreate,
-		Read:   resourceTencentCloudCiMediaTranscodeProTemplateRead,
-		Update: resourceTencentCloudCiMediaTranscodeProTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaTranscodeProTemplateDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Create:   resourceTencentCloudCiMediaTranscodeProTemplateCreate,
+		Read:     resourceTencentCloudCiMediaTranscodeProTemplateRead,
+		Update:   resourceTencentCloudCiMediaTranscodeProTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaTranscodeProTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
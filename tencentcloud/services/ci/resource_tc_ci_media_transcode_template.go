@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCiMediaTranscodeTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaTranscodeTemplateCreate,
-		Read:   resourceTencentCloudCiMediaTranscodeTemplateRead,
-		Update: resourceTencentCloudCiMediaTranscodeTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaTranscodeTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiMediaTranscodeTemplateCreate,
+		Read:     resourceTencentCloudCiMediaTranscodeTemplateRead,
+		Update:   resourceTencentCloudCiMediaTranscodeTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaTranscodeTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
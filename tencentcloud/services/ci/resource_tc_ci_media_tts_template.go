@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,13 +17,11 @@ import (
 
 func ResourceTencentCloudCiMediaTtsTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaTtsTemplateCreate,
-		Read:   resourceTencentCloudCiMediaTtsTemplateRead,
-		Update: resourceTencentCloudCiMediaTtsTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaTtsTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiMediaTtsTemplateCreate,
+		Read:     resourceTencentCloudCiMediaTtsTemplateRead,
+		Update:   resourceTencentCloudCiMediaTtsTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaTtsTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
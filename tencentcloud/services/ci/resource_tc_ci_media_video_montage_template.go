@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCiMediaVideoMontageTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaVideoMontageTemplateCreate,
-		Read:   resourceTencentCloudCiMediaVideoMontageTemplateRead,
-		Update: resourceTencentCloudCiMediaVideoMontageTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaVideoMontageTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiMediaVideoMontageTemplateCreate,
+		Read:     resourceTencentCloudCiMediaVideoMontageTemplateRead,
+		Update:   resourceTencentCloudCiMediaVideoMontageTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaVideoMontageTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCiMediaVideoProcessTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaVideoProcessTemplateCreate,
-		Read:   resourceTencentCloudCiMediaVideoProcessTemplateRead,
-		Update: resourceTencentCloudCiMediaVideoProcessTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaVideoProcessTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiMediaVideoProcessTemplateCreate,
+		Read:     resourceTencentCloudCiMediaVideoProcessTemplateRead,
+		Update:   resourceTencentCloudCiMediaVideoProcessTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaVideoProcessTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
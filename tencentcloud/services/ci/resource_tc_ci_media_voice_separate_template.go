@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCiMediaVoiceSeparateTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaVoiceSeparateTemplateCreate,
-		Read:   resourceTencentCloudCiMediaVoiceSeparateTemplateRead,
-		Update: resourceTencentCloudCiMediaVoiceSeparateTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaVoiceSeparateTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiMediaVoiceSeparateTemplateCreate,
+		Read:     resourceTencentCloudCiMediaVoiceSeparateTemplateRead,
+		Update:   resourceTencentCloudCiMediaVoiceSeparateTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaVoiceSeparateTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
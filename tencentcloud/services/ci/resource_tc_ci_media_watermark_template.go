@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCiMediaWatermarkTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiMediaWatermarkTemplateCreate,
-		Read:   resourceTencentCloudCiMediaWatermarkTemplateRead,
-		Update: resourceTencentCloudCiMediaWatermarkTemplateUpdate,
-		Delete: resourceTencentCloudCiMediaWatermarkTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiMediaWatermarkTemplateCreate,
+		Read:     resourceTencentCloudCiMediaWatermarkTemplateRead,
+		Update:   resourceTencentCloudCiMediaWatermarkTemplateUpdate,
+		Delete:   resourceTencentCloudCiMediaWatermarkTemplateDelete,
+		Importer: helper.IdSpec{"bucket", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Required:    true,
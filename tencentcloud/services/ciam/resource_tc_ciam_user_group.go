@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudCiamUserGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCiamUserGroupCreate,
-		Read:   resourceTencentCloudCiamUserGroupRead,
-		Update: resourceTencentCloudCiamUserGroupUpdate,
-		Delete: resourceTencentCloudCiamUserGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCiamUserGroupCreate,
+		Read:     resourceTencentCloudCiamUserGroupRead,
+		Update:   resourceTencentCloudCiamUserGroupUpdate,
+		Delete:   resourceTencentCloudCiamUserGroupDelete,
+		Importer: helper.IdSpec{"user_store_id", "user_group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"user_store_id": {
 				Required:    true,
@@ -146,6 +146,7 @@ func DataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "The password of the Dts consumer group.",
 												},
@@ -185,6 +186,7 @@ func DataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "The password of the connection source.",
 												},
@@ -234,6 +236,7 @@ func DataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "The password of the connection source.",
 												},
@@ -283,6 +286,7 @@ func DataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "The password of the connection source.",
 												},
@@ -332,6 +336,7 @@ func DataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "The password of the connection source.",
 												},
@@ -386,6 +391,7 @@ func DataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "The password of the connection source.",
 												},
@@ -440,6 +446,7 @@ func DataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "The password of the connection source.",
 												},
@@ -484,6 +491,7 @@ func DataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "The password of the connection source.",
 												},
@@ -538,6 +546,7 @@ func DataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "The password of the connection source.",
 												},
@@ -567,6 +576,7 @@ func DataSourceTencentCloudCkafkaConnectResource() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "The password of the connection source.",
 												},
@@ -1167,7 +1177,7 @@ func dataSourceTencentCloudCkafkaConnectResourceRead(d *schema.ResourceData, met
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), describeConnectResourcesRespMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), describeConnectResourcesRespMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
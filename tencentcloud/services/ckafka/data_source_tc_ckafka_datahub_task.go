@@ -288,6 +288,7 @@ func DataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "MongoDB database password.",
 												},
@@ -337,6 +338,7 @@ func DataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "Es Password.",
 												},
@@ -700,6 +702,7 @@ func DataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												},
 												"group_password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "Dts consumer group passwd.",
 												},
@@ -783,6 +786,7 @@ func DataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "ClickHouse passwd.",
 												},
@@ -1670,6 +1674,7 @@ func DataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "MongoDB database password.",
 												},
@@ -1719,6 +1724,7 @@ func DataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "Es Password.",
 												},
@@ -2082,6 +2088,7 @@ func DataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												},
 												"group_password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "Dts consumer group passwd.",
 												},
@@ -2165,6 +2172,7 @@ func DataSourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "ClickHouse passwd.",
 												},
@@ -5178,7 +5186,7 @@ func dataSourceTencentCloudCkafkaDatahubTaskRead(d *schema.ResourceData, meta in
 	_ = d.Set("task_list", taskList)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), taskList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), taskList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
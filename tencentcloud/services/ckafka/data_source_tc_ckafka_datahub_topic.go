@@ -175,7 +175,7 @@ func dataSourceTencentCloudCkafkaDatahubTopicRead(d *schema.ResourceData, meta i
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), topicList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), topicList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
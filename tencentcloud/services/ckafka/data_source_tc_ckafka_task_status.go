@@ -92,7 +92,7 @@ func dataSourceTencentCloudCkafkaTaskStatusRead(d *schema.ResourceData, meta int
 	d.SetId(strconv.Itoa(flowId))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), taskStatusResponseMapList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), taskStatusResponseMapList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -253,7 +253,7 @@ func dataSourceTencentCloudCkafkaTopicSubscribeGroupRead(d *schema.ResourceData,
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), groupsInfoList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), groupsInfoList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCkafkaAclRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaAclRuleCreate,
-		Read:   resourceTencentCloudCkafkaAclRuleRead,
-		Update: resourceTencentCloudCkafkaAclRuleUpdate,
-		Delete: resourceTencentCloudCkafkaAclRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCkafkaAclRuleCreate,
+		Read:     resourceTencentCloudCkafkaAclRuleRead,
+		Update:   resourceTencentCloudCkafkaAclRuleUpdate,
+		Delete:   resourceTencentCloudCkafkaAclRuleDelete,
+		Importer: helper.IdSpec{"instance_id", "rule_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -67,6 +67,7 @@ func ResourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							Description: "The password of the Dts consumption group.",
 						},
@@ -109,6 +110,7 @@ func ResourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							Description: "Password for the source of the Mongo DB connection.",
 						},
@@ -161,6 +163,7 @@ func ResourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							Description: "Es The password of the connection source.",
 						},
@@ -213,6 +216,7 @@ func ResourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							Description: "Password for Clickhouse connection source.",
 						},
@@ -265,6 +269,7 @@ func ResourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							Description: "Mysql connection source password.",
 						},
@@ -323,6 +328,7 @@ func ResourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							Description: "PostgreSQL password.",
 						},
@@ -381,6 +387,7 @@ func ResourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							Description: "MariaDB password.",
 						},
@@ -428,6 +435,7 @@ func ResourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							Description: "SQLServer password.",
 						},
@@ -475,6 +483,7 @@ func ResourceTencentCloudCkafkaConnectResource() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							Description: "Doris  password.",
 						},
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudCkafkaConsumerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCkafkaConsumerGroupCreate,
-		Read:   resourceTencentCloudCkafkaConsumerGroupRead,
-		Delete: resourceTencentCloudCkafkaConsumerGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCkafkaConsumerGroupCreate,
+		Read:     resourceTencentCloudCkafkaConsumerGroupRead,
+		Delete:   resourceTencentCloudCkafkaConsumerGroupDelete,
+		Importer: helper.IdSpec{"instance_id", "group_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -82,6 +82,8 @@ func ResourceTencentCloudCkafkaConsumerGroupModifyOffset() *schema.Resource {
 				},
 				Description: "The list of partition that needs to be reset if no Topics parameter is specified. Resets the partition in the corresponding Partition list of all topics. When Topics is specified, the partition of the corresponding topic list of the specified Partitions list is reset.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -248,6 +248,7 @@ func ResourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "MongoDB database password.",
 									},
@@ -298,6 +299,7 @@ func ResourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Es Password.",
 									},
@@ -657,6 +659,7 @@ func ResourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									},
 									"group_password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Dts consumer group passwd.",
 									},
@@ -741,6 +744,7 @@ func ResourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "ClickHouse passwd.",
 									},
@@ -1635,6 +1639,7 @@ func ResourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "MongoDB database password.",
 									},
@@ -1685,6 +1690,7 @@ func ResourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Es Password.",
 									},
@@ -2044,6 +2050,7 @@ func ResourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									},
 									"group_password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Dts consumer group passwd.",
 									},
@@ -2128,6 +2135,7 @@ func ResourceTencentCloudCkafkaDatahubTask() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "ClickHouse passwd.",
 									},
@@ -31,6 +31,8 @@ func ResourceTencentCloudCkafkaRenewInstance() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "Renewal duration, the default is 1, and the unit is month.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
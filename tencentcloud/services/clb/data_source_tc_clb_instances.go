@@ -298,7 +298,7 @@ func dataSourceTencentCloudClbInstancesRead(d *schema.ResourceData, meta interfa
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), clbList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), clbList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -259,7 +259,7 @@ func dataSourceTencentCloudClbListenerRulesRead(d *schema.ResourceData, meta int
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), ruleList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), ruleList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
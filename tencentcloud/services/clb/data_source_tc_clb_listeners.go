@@ -290,7 +290,7 @@ func dataSourceTencentCloudClbListenersRead(d *schema.ResourceData, meta interfa
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), listenerList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), listenerList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
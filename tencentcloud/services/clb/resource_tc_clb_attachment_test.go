@@ -61,7 +61,7 @@ func TestAccTencentCloudClbAttachmentResource_http(t *testing.T) {
 		CheckDestroy: testAccCheckClbServerAttachmentDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccClbServerAttachment_http, tcacctest.DefaultSshCertificate),
+				Config: fmt.Sprintf(testAccClbServerAttachment_http, tcacctest.FixtureCertificateId(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClbServerAttachmentExists("tencentcloud_clb_attachment.clb_attachment_http"),
 					resource.TestCheckResourceAttrSet("tencentcloud_clb_attachment.clb_attachment_http", "clb_id"),
@@ -71,7 +71,7 @@ func TestAccTencentCloudClbAttachmentResource_http(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccClbServerAttachment_httpUpdate, tcacctest.DefaultSshCertificate),
+				Config: fmt.Sprintf(testAccClbServerAttachment_httpUpdate, tcacctest.FixtureCertificateId(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClbServerAttachmentExists("tencentcloud_clb_attachment.clb_attachment_http"),
 					resource.TestCheckResourceAttrSet("tencentcloud_clb_attachment.clb_attachment_http", "clb_id"),
@@ -123,14 +123,14 @@ func TestAccTencentCloudClbAttachmentResource_argetGroups(t *testing.T) {
 		CheckDestroy: testAccCheckClbServerAttachmentDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccClbServerAttachment_multiple, tcacctest.DefaultSshCertificate),
+				Config: fmt.Sprintf(testAccClbServerAttachment_multiple, tcacctest.FixtureCertificateId(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClbServerAttachmentExists("tencentcloud_clb_attachment.foo"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_attachment.foo", "targets.#", "2"),
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccClbServerAttachment_multiple_update, tcacctest.DefaultSshCertificate),
+				Config: fmt.Sprintf(testAccClbServerAttachment_multiple_update, tcacctest.FixtureCertificateId(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClbServerAttachmentExists("tencentcloud_clb_attachment.foo"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_attachment.foo", "targets.#", "1"),
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudClbCustomizedConfigV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbCustomizedConfigV2Create,
-		Read:   resourceTencentCloudClbCustomizedConfigV2Read,
-		Update: resourceTencentCloudClbCustomizedConfigV2Update,
-		Delete: resourceTencentCloudClbCustomizedConfigV2Delete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClbCustomizedConfigV2Create,
+		Read:     resourceTencentCloudClbCustomizedConfigV2Read,
+		Update:   resourceTencentCloudClbCustomizedConfigV2Update,
+		Delete:   resourceTencentCloudClbCustomizedConfigV2Delete,
+		Importer: helper.IdSpec{"config_id", "config_type"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"config_name": {
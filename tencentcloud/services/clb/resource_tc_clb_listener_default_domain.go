@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudClbListenerDefaultDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbListenerDefaultDomainCreate,
-		Read:   resourceTencentCloudClbListenerDefaultDomainRead,
-		Update: resourceTencentCloudClbListenerDefaultDomainUpdate,
-		Delete: resourceTencentCloudClbListenerDefaultDomainDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClbListenerDefaultDomainCreate,
+		Read:     resourceTencentCloudClbListenerDefaultDomainRead,
+		Update:   resourceTencentCloudClbListenerDefaultDomainUpdate,
+		Delete:   resourceTencentCloudClbListenerDefaultDomainDelete,
+		Importer: helper.IdSpec{"clb_id", "listener_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"clb_id": {
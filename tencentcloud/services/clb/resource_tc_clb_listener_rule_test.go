@@ -67,7 +67,7 @@ func TestAccTencentCloudClbListenerRuleResource_full(t *testing.T) {
 		CheckDestroy: testAccCheckClbListenerRuleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccClbListenerRule_full, tcacctest.DefaultSshCertificate, tcacctest.DefaultSshCertificateB),
+				Config: fmt.Sprintf(testAccClbListenerRule_full, tcacctest.FixtureCertificateId(t), tcacctest.FixtureCertificateIdB(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClbListenerRuleExists("tencentcloud_clb_listener_rule.rule_full"),
 					resource.TestCheckResourceAttrSet("tencentcloud_clb_listener_rule.rule_full", "clb_id"),
@@ -85,10 +85,10 @@ func TestAccTencentCloudClbListenerRuleResource_full(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener_rule.rule_full", "health_check_http_domain", "abc.com"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener_rule.rule_full", "health_check_http_path", "/"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener_rule.rule_full", "certificate_ssl_mode", "UNIDIRECTIONAL"),
-					resource.TestCheckResourceAttr("tencentcloud_clb_listener_rule.rule_full", "certificate_id", tcacctest.DefaultSshCertificateB),
+					resource.TestCheckResourceAttr("tencentcloud_clb_listener_rule.rule_full", "certificate_id", tcacctest.FixtureCertificateIdB(t)),
 				),
 			}, {
-				Config: fmt.Sprintf(testAccClbListenerRule_update, tcacctest.DefaultSshCertificate, tcacctest.DefaultSshCertificateB),
+				Config: fmt.Sprintf(testAccClbListenerRule_update, tcacctest.FixtureCertificateId(t), tcacctest.FixtureCertificateIdB(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClbListenerRuleExists("tencentcloud_clb_listener_rule.rule_full"),
 					resource.TestCheckResourceAttrSet("tencentcloud_clb_listener_rule.rule_full", "clb_id"),
@@ -106,7 +106,7 @@ func TestAccTencentCloudClbListenerRuleResource_full(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener_rule.rule_full", "health_check_http_domain", "abcd.com"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener_rule.rule_full", "health_check_http_path", "/"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener_rule.rule_full", "certificate_ssl_mode", "UNIDIRECTIONAL"),
-					resource.TestCheckResourceAttr("tencentcloud_clb_listener_rule.rule_full", "certificate_id", tcacctest.DefaultSshCertificateB),
+					resource.TestCheckResourceAttr("tencentcloud_clb_listener_rule.rule_full", "certificate_id", tcacctest.FixtureCertificateIdB(t)),
 				),
 			},
 			{
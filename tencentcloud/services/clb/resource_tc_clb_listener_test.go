@@ -310,7 +310,7 @@ func TestAccTencentCloudClbListener_https(t *testing.T) {
 		CheckDestroy: testAccCheckClbListenerDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccClbListener_https, tcacctest.DefaultSshCertificate),
+				Config: fmt.Sprintf(testAccClbListener_https, tcacctest.FixtureCertificateId(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClbListenerExists("tencentcloud_clb_listener.listener_https"),
 					resource.TestCheckResourceAttrSet("tencentcloud_clb_listener.listener_https", "clb_id"),
@@ -318,11 +318,11 @@ func TestAccTencentCloudClbListener_https(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_https", "listener_name", "listener_https"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_https", "port", "77"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_https", "certificate_ssl_mode", "UNIDIRECTIONAL"),
-					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_https", "certificate_id", tcacctest.DefaultSshCertificate),
+					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_https", "certificate_id", tcacctest.FixtureCertificateId(t)),
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccClbListener_https_update, tcacctest.DefaultSshCertificateB),
+				Config: fmt.Sprintf(testAccClbListener_https_update, tcacctest.FixtureCertificateIdB(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClbListenerExists("tencentcloud_clb_listener.listener_https"),
 					resource.TestCheckResourceAttrSet("tencentcloud_clb_listener.listener_https", "clb_id"),
@@ -330,7 +330,7 @@ func TestAccTencentCloudClbListener_https(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_https", "listener_name", "listener_https_update"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_https", "port", "33"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_https", "certificate_ssl_mode", "UNIDIRECTIONAL"),
-					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_https", "certificate_id", tcacctest.DefaultSshCertificateB),
+					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_https", "certificate_id", tcacctest.FixtureCertificateIdB(t)),
 				),
 			},
 			{
@@ -351,7 +351,7 @@ func TestAccTencentCloudClbListener_tcpssl(t *testing.T) {
 		CheckDestroy: testAccCheckClbListenerDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccClbListener_tcpssl, tcacctest.DefaultSshCertificate),
+				Config: fmt.Sprintf(testAccClbListener_tcpssl, tcacctest.FixtureCertificateId(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClbListenerExists("tencentcloud_clb_listener.listener_tcpssl"),
 					resource.TestCheckResourceAttrSet("tencentcloud_clb_listener.listener_tcpssl", "clb_id"),
@@ -359,7 +359,7 @@ func TestAccTencentCloudClbListener_tcpssl(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "listener_name", "listener_tcpssl"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "port", "44"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "certificate_ssl_mode", "UNIDIRECTIONAL"),
-					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "certificate_id", tcacctest.DefaultSshCertificate),
+					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "certificate_id", tcacctest.FixtureCertificateId(t)),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "port", "44"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "scheduler", "WRR"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "health_check_switch", "true"),
@@ -370,7 +370,7 @@ func TestAccTencentCloudClbListener_tcpssl(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccClbListener_tcpssl_update, tcacctest.DefaultSshCertificateB),
+				Config: fmt.Sprintf(testAccClbListener_tcpssl_update, tcacctest.FixtureCertificateIdB(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClbListenerExists("tencentcloud_clb_listener.listener_tcpssl"),
 					resource.TestCheckResourceAttrSet("tencentcloud_clb_listener.listener_tcpssl", "clb_id"),
@@ -378,7 +378,7 @@ func TestAccTencentCloudClbListener_tcpssl(t *testing.T) {
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "listener_name", "listener_tcpssl_update"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "port", "44"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "certificate_ssl_mode", "UNIDIRECTIONAL"),
-					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "certificate_id", tcacctest.DefaultSshCertificateB),
+					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "certificate_id", tcacctest.FixtureCertificateIdB(t)),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "port", "44"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "scheduler", "WRR"),
 					resource.TestCheckResourceAttr("tencentcloud_clb_listener.listener_tcpssl", "health_check_switch", "true"),
@@ -44,7 +44,7 @@ func TestAccTencentCloudClbRedirection_auto(t *testing.T) {
 		CheckDestroy: testAccCheckClbRedirectionDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccClbRedirection_auto, tcacctest.DefaultSshCertificate),
+				Config: fmt.Sprintf(testAccClbRedirection_auto, tcacctest.FixtureCertificateId(t)),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClbRedirectionExists("tencentcloud_clb_redirection.redirection_basic"),
 					resource.TestCheckResourceAttrSet("tencentcloud_clb_redirection.redirection_basic", "clb_id"),
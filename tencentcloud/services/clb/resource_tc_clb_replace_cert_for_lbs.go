@@ -76,6 +76,8 @@ func ResourceTencentCloudClbReplaceCertForLbs() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
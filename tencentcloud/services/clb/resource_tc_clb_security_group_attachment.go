@@ -16,12 +16,10 @@ import (
 
 func ResourceTencentCloudClbSecurityGroupAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbSecurityGroupAttachmentCreate,
-		Read:   resourceTencentCloudClbSecurityGroupAttachmentRead,
-		Delete: resourceTencentCloudClbSecurityGroupAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClbSecurityGroupAttachmentCreate,
+		Read:     resourceTencentCloudClbSecurityGroupAttachmentRead,
+		Delete:   resourceTencentCloudClbSecurityGroupAttachmentDelete,
+		Importer: helper.IdSpec{"security_group", "load_balancer_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"security_group": {
 				Required:    true,
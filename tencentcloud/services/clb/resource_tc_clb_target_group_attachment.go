@@ -18,12 +18,10 @@ import (
 
 func ResourceTencentCloudClbTargetGroupAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbTargetGroupAttachmentCreate,
-		Read:   resourceTencentCloudClbTargetGroupAttachmentRead,
-		Delete: resourceTencentCloudClbTargetGroupAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClbTargetGroupAttachmentCreate,
+		Read:     resourceTencentCloudClbTargetGroupAttachmentRead,
+		Delete:   resourceTencentCloudClbTargetGroupAttachmentDelete,
+		Importer: helper.IdSpec{"target_group_id", "[listener_id]", "clb_id", "[rule_id]"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"clb_id": {
 				Type:        schema.TypeString,
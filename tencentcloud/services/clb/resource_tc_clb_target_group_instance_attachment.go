@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudClbTGAttachmentInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClbTGAttachmentInstanceCreate,
-		Read:   resourceTencentCloudClbTGAttachmentInstanceRead,
-		Update: resourceTencentCloudClbTGAttachmentInstanceUpdate,
-		Delete: resourceTencentCloudClbTGAttachmentInstanceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClbTGAttachmentInstanceCreate,
+		Read:     resourceTencentCloudClbTGAttachmentInstanceRead,
+		Update:   resourceTencentCloudClbTGAttachmentInstanceUpdate,
+		Delete:   resourceTencentCloudClbTGAttachmentInstanceDelete,
+		Importer: helper.IdSpec{"target_group_id", "bind_ip", "port"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"target_group_id": {
 				Type:         schema.TypeString,
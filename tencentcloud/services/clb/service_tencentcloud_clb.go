@@ -20,6 +20,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/paginator"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -96,32 +97,22 @@ func (me *ClbService) DescribeLoadBalancerByFilter(ctx context.Context, params m
 		}
 	}
 
-	offset := int64(0)
-	pageSize := int64(CLB_PAGE_LIMIT)
-	clbs = make([]*clb.LoadBalancer, 0)
-	for {
-		request.Offset = &(offset)
-		request.Limit = &(pageSize)
+	clbs, errRet = paginator.Offset(ctx, CLB_PAGE_LIMIT, func(ctx context.Context, offset, limit int64) (*paginator.Page[*clb.LoadBalancer], error) {
+		request.Offset = &offset
+		request.Limit = &limit
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClbClient().DescribeLoadBalancers(request)
 		if err != nil {
-			errRet = errors.WithStack(err)
-			return
+			return nil, errors.WithStack(err)
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.LoadBalancerSet) < 1 {
-			break
-		}
-
-		clbs = append(clbs, response.Response.LoadBalancerSet...)
-
-		if int64(len(response.Response.LoadBalancerSet)) < pageSize {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		offset += pageSize
-	}
+		return &paginator.Page[*clb.LoadBalancer]{Items: response.Response.LoadBalancerSet}, nil
+	})
 	return
 }
 
@@ -1371,31 +1362,24 @@ func (me *ClbService) DescribeTargetGroups(ctx context.Context, targetGroupId st
 		request.Filters = append(request.Filters, &tmpFilter)
 	}
 
-	var offset uint64 = 0
-	var pageSize = uint64(CLB_PAGE_LIMIT)
-	for {
-		request.Offset = &offset
-		request.Limit = &pageSize
+	targetGroupInfos, errRet = paginator.Offset(ctx, CLB_PAGE_LIMIT, func(ctx context.Context, offset, limit int64) (*paginator.Page[*clb.TargetGroupInfo], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClbClient().DescribeTargetGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || response.Response == nil || len(response.Response.TargetGroupSet) < 1 {
-			break
-		}
-		targetGroupInfos = append(targetGroupInfos, response.Response.TargetGroupSet...)
-		if len(response.Response.TargetGroupSet) < int(pageSize) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		offset += pageSize
-	}
+		return &paginator.Page[*clb.TargetGroupInfo]{Items: response.Response.TargetGroupSet}, nil
+	})
 	return
 }
 
@@ -1411,31 +1395,24 @@ func (me *ClbService) DescribeTargetGroupInstances(ctx context.Context, filters
 		request.Filters = append(request.Filters, &filter)
 	}
 
-	var offset uint64 = 0
-	var pageSize = uint64(CLB_PAGE_LIMIT)
-	for {
-		request.Offset = &offset
-		request.Limit = &pageSize
+	targetGroupInstances, errRet = paginator.Offset(ctx, CLB_PAGE_LIMIT, func(ctx context.Context, offset, limit int64) (*paginator.Page[*clb.TargetGroupBackend], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClbClient().DescribeTargetGroupInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || response.Response == nil || len(response.Response.TargetGroupInstanceSet) < 1 {
-			break
-		}
-		targetGroupInstances = append(targetGroupInstances, response.Response.TargetGroupInstanceSet...)
-		if len(response.Response.TargetGroupInstanceSet) < int(pageSize) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		offset += pageSize
-	}
+		return &paginator.Page[*clb.TargetGroupBackend]{Items: response.Response.TargetGroupInstanceSet}, nil
+	})
 	return
 }
 
@@ -1955,30 +1932,20 @@ func (me *ClbService) DescribeClbClusterResourcesByFilter(ctx context.Context, p
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	clusterResources, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*clb.ClusterResource], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseClbClient().DescribeClusterResources(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.ClusterResourceSet) < 1 {
-			break
-		}
-		clusterResources = append(clusterResources, response.Response.ClusterResourceSet...)
-		if len(response.Response.ClusterResourceSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*clb.ClusterResource]{Items: response.Response.ClusterResourceSet}, nil
+	})
 
 	return
 }
@@ -2003,30 +1970,20 @@ func (me *ClbService) DescribeClbCrossTargetsByFilter(ctx context.Context, param
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	crossTargets, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*clb.CrossTargets], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseClbClient().DescribeCrossTargets(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.CrossTargetSet) < 1 {
-			break
-		}
-		crossTargets = append(crossTargets, response.Response.CrossTargetSet...)
-		if len(response.Response.CrossTargetSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*clb.CrossTargets]{Items: response.Response.CrossTargetSet}, nil
+	})
 
 	return
 }
@@ -2051,30 +2008,20 @@ func (me *ClbService) DescribeClbExclusiveClustersByFilter(ctx context.Context,
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	exclusiveClusters, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*clb.Cluster], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseClbClient().DescribeExclusiveClusters(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.ClusterSet) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		exclusiveClusters = append(exclusiveClusters, response.Response.ClusterSet...)
-		if len(response.Response.ClusterSet) < int(limit) {
-			break
-		}
-
-		offset += limit
-	}
+		return &paginator.Page[*clb.Cluster]{Items: response.Response.ClusterSet}, nil
+	})
 
 	return
 }
@@ -2099,30 +2046,20 @@ func (me *ClbService) DescribeClbIdleInstancesByFilter(ctx context.Context, para
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	idleLoadbalancers, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*clb.IdleLoadBalancer], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseClbClient().DescribeIdleLoadBalancers(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.IdleLoadBalancers) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		idleLoadbalancers = append(idleLoadbalancers, response.Response.IdleLoadBalancers...)
-		if len(response.Response.IdleLoadBalancers) < int(limit) {
-			break
-		}
-
-		offset += limit
-	}
+		return &paginator.Page[*clb.IdleLoadBalancer]{Items: response.Response.IdleLoadBalancers}, nil
+	})
 
 	return
 }
@@ -2249,30 +2186,20 @@ func (me *ClbService) DescribeClbInstanceDetailByFilter(ctx context.Context, par
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	instanceDetail, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*clb.LoadBalancerDetail], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseClbClient().DescribeLoadBalancersDetail(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.LoadBalancerDetailSet) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		instanceDetail = append(instanceDetail, response.Response.LoadBalancerDetailSet...)
-		if len(response.Response.LoadBalancerDetailSet) < int(limit) {
-			break
-		}
-
-		offset += limit
-	}
+		return &paginator.Page[*clb.LoadBalancerDetail]{Items: response.Response.LoadBalancerDetailSet}, nil
+	})
 
 	return
 }
@@ -2297,30 +2224,20 @@ func (me *ClbService) DescribeClbResourcesByFilter(ctx context.Context, param ma
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	resources, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*clb.ZoneResource], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseClbClient().DescribeResources(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.ZoneResourceSet) < 1 {
-			break
-		}
-		resources = append(resources, response.Response.ZoneResourceSet...)
-		if len(response.Response.ZoneResourceSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*clb.ZoneResource]{Items: response.Response.ZoneResourceSet}, nil
+	})
 
 	return
 }
@@ -2348,30 +2265,20 @@ func (me *ClbService) DescribeClbTargetGroupListByFilter(ctx context.Context, pa
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	targetGroupList, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*clb.TargetGroupInfo], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseClbClient().DescribeTargetGroupList(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.TargetGroupSet) < 1 {
-			break
-		}
-		targetGroupList = append(targetGroupList, response.Response.TargetGroupSet...)
-		if len(response.Response.TargetGroupSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*clb.TargetGroupInfo]{Items: response.Response.TargetGroupSet}, nil
+	})
 
 	return
 }
@@ -2533,34 +2440,22 @@ func (me *ClbService) DescribeDescribeCustomizedConfigAssociateListById(ctx cont
 	request := clb.NewDescribeCustomizedConfigAssociateListRequest()
 	request.UconfigId = helper.String(configId)
 
-	var (
-		offset int64 = 0
-		limit  int64 = 100
-	)
-
-	for {
+	bindList, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*clb.BindDetailItem], error) {
 		request.Offset = &offset
 		request.Limit = &limit
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseClbClient().DescribeCustomizedConfigAssociateList(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.BindList) < 1 {
-			break
-		}
-
-		bindList = append(bindList, response.Response.BindList...)
-		if len(response.Response.BindList) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*clb.BindDetailItem]{Items: response.Response.BindList}, nil
+	})
 
 	return
 }
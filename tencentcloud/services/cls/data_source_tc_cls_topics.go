@@ -332,7 +332,7 @@ func dataSourceTencentCloudClsTopicsRead(d *schema.ResourceData, meta interface{
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), topicsList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), topicsList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -22,9 +22,7 @@ func ResourceTencentCloudClsCloudProductLogTask() *schema.Resource {
 		Read:               resourceTencentCloudClsCloudProductLogTaskRead,
 		Update:             resourceTencentCloudClsCloudProductLogTaskUpdate,
 		Delete:             resourceTencentCloudClsCloudProductLogTaskDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer:           helper.IdSpec{"instance_id", "assumer_name", "log_type", "cloud_product_region"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
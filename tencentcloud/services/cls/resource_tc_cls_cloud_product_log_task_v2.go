@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudClsCloudProductLogTaskV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsCloudProductLogTaskV2Create,
-		Read:   resourceTencentCloudClsCloudProductLogTaskV2Read,
-		Update: resourceTencentCloudClsCloudProductLogTaskV2Update,
-		Delete: resourceTencentCloudClsCloudProductLogTaskV2Delete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClsCloudProductLogTaskV2Create,
+		Read:     resourceTencentCloudClsCloudProductLogTaskV2Read,
+		Update:   resourceTencentCloudClsCloudProductLogTaskV2Update,
+		Delete:   resourceTencentCloudClsCloudProductLogTaskV2Delete,
+		Importer: helper.IdSpec{"instance_id", "assumer_name", "log_type", "cloud_product_region"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
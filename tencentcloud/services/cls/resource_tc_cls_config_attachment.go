@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudClsConfigAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsConfigAttachmentCreate,
-		Read:   resourceTencentCloudClsConfigAttachmentRead,
-		Delete: resourceTencentCloudClsConfigAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClsConfigAttachmentCreate,
+		Read:     resourceTencentCloudClsConfigAttachmentRead,
+		Delete:   resourceTencentCloudClsConfigAttachmentDelete,
+		Importer: helper.IdSpec{"config_id", "group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"config_id": {
 				Type:        schema.TypeString,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudClsCosRecharge() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsCosRechargeCreate,
-		Read:   resourceTencentCloudClsCosRechargeRead,
-		Update: resourceTencentCloudClsCosRechargeUpdate,
-		Delete: resourceTencentCloudClsCosRechargeDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClsCosRechargeCreate,
+		Read:     resourceTencentCloudClsCosRechargeRead,
+		Update:   resourceTencentCloudClsCosRechargeUpdate,
+		Delete:   resourceTencentCloudClsCosRechargeDelete,
+		Importer: helper.IdSpec{"topic_id", "recharge_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"topic_id": {
 				Required:    true,
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudClsExport() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsExportCreate,
-		Read:   resourceTencentCloudClsExportRead,
-		Delete: resourceTencentCloudClsExportDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClsExportCreate,
+		Read:     resourceTencentCloudClsExportRead,
+		Delete:   resourceTencentCloudClsExportDelete,
+		Importer: helper.IdSpec{"topic_id", "export_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"topic_id": {
 				Required:    true,
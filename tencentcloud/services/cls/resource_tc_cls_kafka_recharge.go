@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudClsKafkaRecharge() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudClsKafkaRechargeCreate,
-		Read:   resourceTencentCloudClsKafkaRechargeRead,
-		Update: resourceTencentCloudClsKafkaRechargeUpdate,
-		Delete: resourceTencentCloudClsKafkaRechargeDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudClsKafkaRechargeCreate,
+		Read:     resourceTencentCloudClsKafkaRechargeRead,
+		Update:   resourceTencentCloudClsKafkaRechargeUpdate,
+		Delete:   resourceTencentCloudClsKafkaRechargeDelete,
+		Importer: helper.IdSpec{"kafka_recharge_id", "kafka_topic"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"topic_id": {
 				Required:    true,
@@ -99,6 +97,7 @@ func ResourceTencentCloudClsKafkaRecharge() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Description: "user password.",
 						},
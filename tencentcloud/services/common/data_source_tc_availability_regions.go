@@ -123,7 +123,7 @@ func dataSourceTencentCloudAvailabilityRegionsRead(d *schema.ResourceData, meta
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err := tccommon.WriteToFile(output.(string), regionList); err != nil {
+		if err := tccommon.WriteToFile(output.(string), regionList, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
@@ -22,15 +22,18 @@ func (me *APIService) DescribeZonesWithProduct(ctx context.Context, product stri
 	request := api.NewDescribeZonesRequest()
 	request.Product = common.StringPtr(product)
 
-	ratelimit.Check(request.GetAction())
 	// API: https://cloud.tencent.com/document/product/1278/55254
-	response, err := me.client.UseApiClient().DescribeZones(request)
+	result, err := me.client.CachedDescribe(request, func() (interface{}, error) {
+		ratelimit.Check(request.GetAction())
+		return me.client.UseApiClient().DescribeZones(request)
+	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
 		errRet = err
 		return
 	}
+	response := result.(*api.DescribeZonesResponse)
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
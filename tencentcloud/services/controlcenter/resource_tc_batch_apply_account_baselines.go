@@ -48,6 +48,8 @@ func ResourceTencentCloudBatchApplyAccountBaselines() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -444,7 +444,7 @@ func dataSourceTencentCloudCosBucketsRead(d *schema.ResourceData, meta interface
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err = tccommon.WriteToFile(output.(string), bucketList); err != nil {
+		if err = tccommon.WriteToFile(output.(string), bucketList, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
@@ -20,13 +20,11 @@ import (
 
 func ResourceTencentCloudCosBatch() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudCosBatchRead,
-		Create: resourceTencentCloudCosBatchCreate,
-		Update: resourceTencentCloudCosBatchUpdate,
-		Delete: resourceTencentCloudCosBatchDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudCosBatchRead,
+		Create:   resourceTencentCloudCosBatchCreate,
+		Update:   resourceTencentCloudCosBatchUpdate,
+		Delete:   resourceTencentCloudCosBatchDelete,
+		Importer: helper.IdSpec{"uin", "appid", "job_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"uin": {
@@ -73,6 +73,7 @@ func ResourceTencentCloudCosBucketDomainCertificateAttachment() *schema.Resource
 												},
 												"private_key": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Required:    true,
 													Description: "Private key of certificate.",
 												},
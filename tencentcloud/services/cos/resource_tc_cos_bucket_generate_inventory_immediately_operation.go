@@ -33,6 +33,8 @@ func ResourceTencentCloudCosBucketGenerateInventoryImmediatelyOperation() *schem
 				Type:        schema.TypeString,
 				Description: "Bucket.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
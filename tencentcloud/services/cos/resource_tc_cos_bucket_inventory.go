@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,13 +18,11 @@ import (
 
 func ResourceTencentCloudCosBucketInventory() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCosBucketInventoryCreate,
-		Read:   resourceTencentCloudCosBucketInventoryRead,
-		Update: resourceTencentCloudCosBucketInventoryUpdate,
-		Delete: resourceTencentCloudCosBucketInventoryDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCosBucketInventoryCreate,
+		Read:     resourceTencentCloudCosBucketInventoryRead,
+		Update:   resourceTencentCloudCosBucketInventoryUpdate,
+		Delete:   resourceTencentCloudCosBucketInventoryDelete,
+		Importer: helper.IdSpec{"bucket", "name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:        schema.TypeString,
@@ -24,6 +24,9 @@ func ResourceTencentCloudCosBucketObject() *schema.Resource {
 		Read:   resourceTencentCloudCosBucketObjectRead,
 		Update: resourceTencentCloudCosBucketObjectUpdate,
 		Delete: resourceTencentCloudCosBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudCosBucketObjectImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -104,6 +107,20 @@ func ResourceTencentCloudCosBucketObject() *schema.Resource {
 	}
 }
 
+// resourceTencentCloudCosBucketObjectImport turns the imported bucket#key into the bucket and key arguments and the
+// ID Create sets, the key is everything after the first `#` as bucket names never contain one
+func resourceTencentCloudCosBucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), tccommon.FILED_SP, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid ID `%s`, expected format `bucket#key`", d.Id())
+	}
+	bucket, key := parts[0], parts[1]
+	_ = d.Set("bucket", bucket)
+	_ = d.Set("key", key)
+	d.SetId(bucket + key)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudCosBucketObjectCreate(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cos_bucket_object.create")()
 
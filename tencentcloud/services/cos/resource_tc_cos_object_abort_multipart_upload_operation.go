@@ -34,6 +34,8 @@ func ResourceTencentCloudCosObjectAbortMultipartUploadOperation() *schema.Resour
 				Type:        schema.TypeString,
 				Description: "Multipart uploaded id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
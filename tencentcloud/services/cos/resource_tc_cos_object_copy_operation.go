@@ -34,6 +34,8 @@ func ResourceTencentCloudCosObjectCopyOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Source url. In the CDC scenario, the CDC source url is used.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
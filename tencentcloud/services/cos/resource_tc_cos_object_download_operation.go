@@ -36,6 +36,8 @@ func ResourceTencentCloudCosObjectDownloadOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Download path.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -48,6 +48,8 @@ func ResourceTencentCloudCosObjectRestoreOperation() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "Specifies the valid duration of the restored temporary copy in days.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -0,0 +1,165 @@
+package crs
+
+import (
+	"context"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	redis "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/redis/v20180412"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func DataSourceTencentCloudRedisPrice() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTencentCloudRedisPriceRead,
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The available zone ID of an instance to be created.",
+			},
+			"type_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: tccommon.ValidateIntegerMin(2),
+				Description:  "Instance type. Available values reference data source `tencentcloud_redis_zone_config` or [document](https://intl.cloud.tencent.com/document/product/239/32069).",
+			},
+			"mem_size": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The memory volume of an available instance(in MB). When redis is standard type, it represents total memory size of the instance; when Redis is cluster type, it represents memory size of per sharding.",
+			},
+			"redis_shard_num": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The number of instance shards; this parameter does not need to be configured for standard version instances.",
+			},
+			"redis_replicas_num": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "The number of instance copies. Default is 1.",
+			},
+			"replicas_read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether copy read-only is supported.",
+			},
+			"product_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specify the product version of the instance. `local`: Local disk version, `cloud`: Cloud disk version, `cdc`: Exclusive cluster version. Default is `local`.",
+			},
+			"charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      REDIS_CHARGE_TYPE_POSTPAID,
+				ValidateFunc: tccommon.ValidateAllowedStringValue([]string{REDIS_CHARGE_TYPE_POSTPAID, REDIS_CHARGE_TYPE_PREPAID}),
+				Description:  "The charge type of instance. Valid values: `PREPAID` and `POSTPAID`. Default value is `POSTPAID`.",
+			},
+			"prepaid_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: tccommon.ValidateAllowedIntValue(REDIS_PREPAID_PERIOD),
+				Description:  "The tenancy (in month) of the prepaid instance, NOTE: it only works when charge_type is set to `PREPAID`. Default is 1.",
+			},
+			"original_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Price of a `PREPAID` instance for the whole period. Redis quotes a single price, so there is no discounted price.",
+			},
+			"unit_price": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Price of a `POSTPAID` instance per `charge_unit`. Redis quotes a single price, so there is no discounted price.",
+			},
+			"charge_unit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Charge unit of a `POSTPAID` instance, `HOUR`, as Redis bills it by the hour. Prices are in CNY for the Chinese site and USD for the international site.",
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudRedisPriceRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("data_source.tencentcloud_redis_price.read")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		request = redis.NewInquiryPriceCreateInstanceRequest()
+	)
+
+	chargeType := d.Get("charge_type").(string)
+	request.ZoneName = helper.String(d.Get("availability_zone").(string))
+	request.TypeId = helper.IntUint64(d.Get("type_id").(int))
+	request.MemSize = helper.IntUint64(d.Get("mem_size").(int))
+	request.GoodsNum = helper.IntUint64(1)
+	request.BillingMode = helper.Int64(REDIS_CHARGE_TYPE_ID[chargeType])
+	request.Period = helper.IntUint64(1)
+	if chargeType == REDIS_CHARGE_TYPE_PREPAID {
+		request.Period = helper.IntUint64(d.Get("prepaid_period").(int))
+	}
+
+	if v, ok := d.GetOk("redis_shard_num"); ok {
+		request.RedisShardNum = helper.IntInt64(v.(int))
+	}
+
+	if v, ok := d.GetOk("redis_replicas_num"); ok {
+		request.RedisReplicasNum = helper.IntInt64(v.(int))
+	}
+
+	if v, ok := d.GetOkExists("replicas_read_only"); ok {
+		request.ReplicasReadonly = helper.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("product_version"); ok {
+		request.ProductVersion = helper.String(v.(string))
+	}
+
+	var price *float64
+	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		result, e := service.InquiryPriceCreateInstance(ctx, request)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+
+		price = result
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the API reports the price in cents, and the price of a postpaid instance per hour without naming the unit
+	if price != nil {
+		if chargeType == REDIS_CHARGE_TYPE_PREPAID {
+			_ = d.Set("original_price", *price/100)
+		} else {
+			_ = d.Set("unit_price", *price/100)
+			_ = d.Set("charge_unit", "HOUR")
+		}
+	}
+
+	d.SetId(helper.DataResourceIdsHash([]string{request.ToJsonString()}))
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := tccommon.WriteToFile(output.(string), price, tccommon.GetResultOutputOptions(d)); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package crs_test
+
+import (
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudRedisPriceDataSource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisPriceDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					tcacctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_redis_price.postpaid"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_redis_price.postpaid", "unit_price"),
+					resource.TestCheckResourceAttr("data.tencentcloud_redis_price.postpaid", "charge_unit", "HOUR"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_redis_price.prepaid", "original_price"),
+				),
+			},
+		},
+	})
+}
+
+const testAccRedisPriceDataSource = tcacctest.DefaultAzVariable + `
+
+data "tencentcloud_redis_price" "postpaid" {
+  availability_zone = var.default_az
+  type_id           = 15
+  mem_size          = 1024
+}
+
+data "tencentcloud_redis_price" "prepaid" {
+  availability_zone = var.default_az
+  type_id           = 15
+  mem_size          = 1024
+  charge_type       = "PREPAID"
+  prepaid_period    = 1
+}
+`
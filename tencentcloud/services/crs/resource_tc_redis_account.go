@@ -19,13 +19,11 @@ import (
 
 func ResourceTencentCloudRedisAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisAccountCreate,
-		Read:   resourceTencentCloudRedisAccountRead,
-		Update: resourceTencentCloudRedisAccountUpdate,
-		Delete: resourceTencentCloudRedisAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudRedisAccountCreate,
+		Read:     resourceTencentCloudRedisAccountRead,
+		Update:   resourceTencentCloudRedisAccountUpdate,
+		Delete:   resourceTencentCloudRedisAccountDelete,
+		Importer: helper.IdSpec{"instance_id", "account_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -40,10 +38,26 @@ func ResourceTencentCloudRedisAccount() *schema.Resource {
 			},
 
 			"account_password": {
-				Required:    true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"account_password", "account_password_wo"},
+				Description:  "1: Length 8-30 digits, it is recommended to use a password of more than 12 digits; 2: Cannot start with `/`; 3: Include at least two items: a.Lowercase letters `a-z`; b.Uppercase letters `A-Z` c.Numbers `0-9`;  d.`()`~!@#$%^&*-+=_|{}[]:;<>,.?/`. Use `account_password_wo` instead to keep it out of the state.",
+			},
+
+			"account_password_wo": {
+				Optional:    true,
 				Type:        schema.TypeString,
 				Sensitive:   true,
-				Description: "1: Length 8-30 digits, it is recommended to use a password of more than 12 digits; 2: Cannot start with `/`; 3: Include at least two items: a.Lowercase letters `a-z`; b.Uppercase letters `A-Z` c.Numbers `0-9`;  d.`()`~!@#$%^&*-+=_|{}[]:;<>,.?/`.",
+				WriteOnly:   true,
+				Description: "Write-only account password, which is never stored in the plan or state. Requires Terraform 1.11 or later. Bump `account_password_wo_version` to rotate it.",
+			},
+
+			"account_password_wo_version": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				RequiredWith: []string{"account_password_wo"},
+				Description:  "Version of `account_password_wo`. Changing it updates the password to the current value of `account_password_wo`.",
 			},
 
 			"remark": {
@@ -93,8 +107,12 @@ func resourceTencentCloudRedisAccountCreate(d *schema.ResourceData, meta interfa
 		request.AccountName = helper.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("account_password"); ok {
-		request.AccountPassword = helper.String(v.(string))
+	accountPassword, err := tccommon.GetSecretWithWriteOnly(d, "account_password")
+	if err != nil {
+		return err
+	}
+	if accountPassword != "" {
+		request.AccountPassword = helper.String(accountPassword)
 	}
 
 	if v, ok := d.GetOk("remark"); ok {
@@ -113,7 +131,7 @@ func resourceTencentCloudRedisAccountCreate(d *schema.ResourceData, meta interfa
 		request.Privilege = helper.String(v.(string))
 	}
 
-	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseRedisClient().CreateInstanceAccount(request)
 		if e != nil {
 			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok {
@@ -255,9 +273,13 @@ func resourceTencentCloudRedisAccountUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("account_password") {
-		if v, ok := d.GetOk("account_password"); ok {
-			request.AccountPassword = helper.String(v.(string))
+	if tccommon.HasSecretChange(d, "account_password") {
+		accountPassword, err := tccommon.GetSecretWithWriteOnly(d, "account_password")
+		if err != nil {
+			return err
+		}
+		if accountPassword != "" {
+			request.AccountPassword = helper.String(accountPassword)
 		}
 	}
 
@@ -19,6 +19,7 @@ func ResourceTencentCloudRedisBackupOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudRedisBackupOperationCreate,
 		Read:   resourceTencentCloudRedisBackupOperationRead,
+		Update: resourceTencentCloudRedisBackupOperationUpdate,
 		Delete: resourceTencentCloudRedisBackupOperationDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -42,6 +43,10 @@ func ResourceTencentCloudRedisBackupOperation() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "Number of days to store.0 specifies the default retention time.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -89,27 +94,29 @@ func resourceTencentCloudRedisBackupOperationCreate(d *schema.ResourceData, meta
 	taskId := *response.Response.TaskId
 	d.SetId(instanceId)
 
-	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	if taskId > 0 {
-		err := resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-			if err != nil {
-				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-					return resource.RetryableError(err)
+	if tccommon.OperationWaitForCompletion(d) {
+		service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		if taskId > 0 {
+			err := resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+				ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
+				if err != nil {
+					if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
+						return resource.RetryableError(err)
+					} else {
+						return resource.NonRetryableError(err)
+					}
+				}
+				if ok {
+					return nil
 				} else {
-					return resource.NonRetryableError(err)
+					return resource.RetryableError(fmt.Errorf("redis backupOperation is processing"))
 				}
-			}
-			if ok {
-				return nil
-			} else {
-				return resource.RetryableError(fmt.Errorf("redis backupOperation is processing"))
-			}
-		})
+			})
 
-		if err != nil {
-			log.Printf("[CRITAL]%s redis backupOperation fail, reason:%s\n", logId, err.Error())
-			return err
+			if err != nil {
+				log.Printf("[CRITAL]%s redis backupOperation fail, reason:%s\n", logId, err.Error())
+				return err
+			}
 		}
 	}
 
@@ -123,6 +130,14 @@ func resourceTencentCloudRedisBackupOperationRead(d *schema.ResourceData, meta i
 	return nil
 }
 
+func resourceTencentCloudRedisBackupOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_redis_backup_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudRedisBackupOperationRead(d, meta)
+}
+
 func resourceTencentCloudRedisBackupOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_redis_backup_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
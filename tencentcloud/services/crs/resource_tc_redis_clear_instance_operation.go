@@ -19,6 +19,7 @@ func ResourceTencentCloudRedisClearInstanceOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudRedisClearInstanceOperationCreate,
 		Read:   resourceTencentCloudRedisClearInstanceOperationRead,
+		Update: resourceTencentCloudRedisClearInstanceOperationUpdate,
 		Delete: resourceTencentCloudRedisClearInstanceOperationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -38,6 +39,10 @@ func ResourceTencentCloudRedisClearInstanceOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Redis instance password (password-free instances do not need to pass passwords, non-password-free instances must be transmitted).",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -80,27 +85,29 @@ func resourceTencentCloudRedisClearInstanceOperationCreate(d *schema.ResourceDat
 
 	d.SetId(instanceId)
 
-	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	taskId := *response.Response.TaskId
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
+	if tccommon.OperationWaitForCompletion(d) {
+		service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		taskId := *response.Response.TaskId
+		err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
+			if err != nil {
+				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
+					return resource.RetryableError(err)
+				} else {
+					return resource.NonRetryableError(err)
+				}
+			}
+			if ok {
+				return nil
 			} else {
-				return resource.NonRetryableError(err)
+				return resource.RetryableError(fmt.Errorf("clear instance is processing"))
 			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("clear instance is processing"))
-		}
-	})
+		})
 
-	if err != nil {
-		log.Printf("[CRITAL]%s redis clear instance fail, reason:%s\n", logId, err.Error())
-		return err
+		if err != nil {
+			log.Printf("[CRITAL]%s redis clear instance fail, reason:%s\n", logId, err.Error())
+			return err
+		}
 	}
 
 	return resourceTencentCloudRedisClearInstanceOperationRead(d, meta)
@@ -113,6 +120,14 @@ func resourceTencentCloudRedisClearInstanceOperationRead(d *schema.ResourceData,
 	return nil
 }
 
+func resourceTencentCloudRedisClearInstanceOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_redis_clear_instance_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudRedisClearInstanceOperationRead(d, meta)
+}
+
 func resourceTencentCloudRedisClearInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_redis_clear_instance_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -102,7 +102,22 @@ func ResourceTencentCloudRedisInstance() *schema.Resource {
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: tccommon.ValidateMysqlPassword,
-				Description:  "Password for a Redis user, which should be 8 to 16 characters. NOTE: Only `no_auth=true` specified can make password empty.",
+				Description:  "Password for a Redis user, which should be 8 to 16 characters. NOTE: Only `no_auth=true` specified can make password empty. Use `password_wo` instead to keep it out of the state.",
+			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"password"},
+				ValidateFunc:  tccommon.ValidateMysqlPassword,
+				Description:   "Write-only password for a Redis user, which is never stored in the plan or state. Requires Terraform 1.11 or later. Bump `password_wo_version` to rotate it.",
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+				Description:  "Version of `password_wo`. Changing it resets the password to the current value of `password_wo`.",
 			},
 			"no_auth": {
 				Type:        schema.TypeBool,
@@ -310,7 +325,10 @@ func resourceTencentCloudRedisInstanceCreate(d *schema.ResourceData, meta interf
 	}
 
 	redisReplicasNum := d.Get("redis_replicas_num").(int)
-	password := d.Get("password").(string)
+	password, err := tccommon.GetSecretWithWriteOnly(d, "password")
+	if err != nil {
+		return err
+	}
 	noAuth := d.Get("no_auth").(bool)
 	memSize := d.Get("mem_size").(int)
 	vpcId := d.Get("vpc_id").(string)
@@ -822,13 +840,15 @@ func resourceTencentCloudRedisInstanceUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange("password") || d.HasChange("no_auth") {
+	if tccommon.HasSecretChange(d, "password") || d.HasChange("no_auth") {
 		var (
-			taskId   int64
-			password = d.Get("password").(string)
-			noAuth   = d.Get("no_auth").(bool)
-			err      error
+			taskId int64
+			noAuth = d.Get("no_auth").(bool)
 		)
+		password, err := tccommon.GetSecretWithWriteOnly(d, "password")
+		if err != nil {
+			return err
+		}
 
 		// After redis spec modified, reset password may not successfully response immediately.
 		err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
@@ -43,6 +43,8 @@ func ResourceTencentCloudRedisRenewInstanceOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Identifies whether the billing model is modified:The current instance billing mode is pay-as-you-go, which is prepaid and renewed.The billing mode of the current instance is subscription and you can not set this parameter.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,10 +16,11 @@ import (
 
 func ResourceTencentCloudRedisReplicaReadonly() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisReplicaReadonlyCreate,
-		Read:   resourceTencentCloudRedisReplicaReadonlyRead,
-		Update: resourceTencentCloudRedisReplicaReadonlyUpdate,
-		Delete: resourceTencentCloudRedisReplicaReadonlyDelete,
+		Create:   resourceTencentCloudRedisReplicaReadonlyCreate,
+		Read:     resourceTencentCloudRedisReplicaReadonlyRead,
+		Update:   resourceTencentCloudRedisReplicaReadonlyUpdate,
+		Delete:   resourceTencentCloudRedisReplicaReadonlyDelete,
+		Importer: helper.IdSpec{"instance_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
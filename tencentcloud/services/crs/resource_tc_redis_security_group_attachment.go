@@ -19,12 +19,10 @@ const PRODUCT string = "redis"
 
 func ResourceTencentCloudRedisSecurityGroupAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisSecurityGroupAttachmentCreate,
-		Read:   resourceTencentCloudRedisSecurityGroupAttachmentRead,
-		Delete: resourceTencentCloudRedisSecurityGroupAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudRedisSecurityGroupAttachmentCreate,
+		Read:     resourceTencentCloudRedisSecurityGroupAttachmentRead,
+		Delete:   resourceTencentCloudRedisSecurityGroupAttachmentDelete,
+		Importer: helper.IdSpec{"instance_id", "security_group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
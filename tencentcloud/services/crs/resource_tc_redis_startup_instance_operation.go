@@ -18,6 +18,7 @@ func ResourceTencentCloudRedisStartupInstanceOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudRedisStartupInstanceOperationCreate,
 		Read:   resourceTencentCloudRedisStartupInstanceOperationRead,
+		Update: resourceTencentCloudRedisStartupInstanceOperationUpdate,
 		Delete: resourceTencentCloudRedisStartupInstanceOperationDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -27,6 +28,10 @@ func ResourceTencentCloudRedisStartupInstanceOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The ID of instance.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -63,25 +68,27 @@ func resourceTencentCloudRedisStartupInstanceOperationCreate(d *schema.ResourceD
 
 	d.SetId(instanceId)
 
-	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		instance, err := service.DescribeRedisInstanceById(ctx, d.Id())
+	if tccommon.OperationWaitForCompletion(d) {
+		service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			instance, err := service.DescribeRedisInstanceById(ctx, d.Id())
+			if err != nil {
+				return tccommon.RetryError(err, tccommon.InternalError)
+			}
+			if instance == nil {
+				return resource.RetryableError(fmt.Errorf("redis instance is nil, retry..."))
+			}
+			if *instance.Status == REDIS_STATUS_ONLINE {
+				return nil
+			}
+			log.Printf("[DEBUG]%s api[%s] redis instance status is %v[%s], need 2[online], retry...", logId, request.GetAction(), *instance.Status, REDIS_STATUS[*instance.Status])
+			return resource.RetryableError(fmt.Errorf("redis instance is %v, need 2, retry...", *instance.Status))
+		})
+
 		if err != nil {
-			return tccommon.RetryError(err, tccommon.InternalError)
-		}
-		if instance == nil {
-			return resource.RetryableError(fmt.Errorf("redis instance is nil, retry..."))
+			log.Printf("[CRITAL]%s redis startup instance fail, reason:%s\n", logId, err.Error())
+			return err
 		}
-		if *instance.Status == REDIS_STATUS_ONLINE {
-			return nil
-		}
-		log.Printf("[DEBUG]%s api[%s] redis instance status is %v[%s], need 2[online], retry...", logId, request.GetAction(), *instance.Status, REDIS_STATUS[*instance.Status])
-		return resource.RetryableError(fmt.Errorf("redis instance is %v, need 2, retry...", *instance.Status))
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s redis startup instance fail, reason:%s\n", logId, err.Error())
-		return err
 	}
 
 	return resourceTencentCloudRedisStartupInstanceOperationRead(d, meta)
@@ -94,6 +101,14 @@ func resourceTencentCloudRedisStartupInstanceOperationRead(d *schema.ResourceDat
 	return nil
 }
 
+func resourceTencentCloudRedisStartupInstanceOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_redis_startup_instance_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudRedisStartupInstanceOperationRead(d, meta)
+}
+
 func resourceTencentCloudRedisStartupInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_redis_startup_instance_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudRedisSwitchMaster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRedisSwitchMasterCreate,
-		Read:   resourceTencentCloudRedisSwitchMasterRead,
-		Update: resourceTencentCloudRedisSwitchMasterUpdate,
-		Delete: resourceTencentCloudRedisSwitchMasterDelete,
+		Create:   resourceTencentCloudRedisSwitchMasterCreate,
+		Read:     resourceTencentCloudRedisSwitchMasterRead,
+		Update:   resourceTencentCloudRedisSwitchMasterUpdate,
+		Delete:   resourceTencentCloudRedisSwitchMasterDelete,
+		Importer: helper.IdSpec{"instance_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -19,6 +19,7 @@ func ResourceTencentCloudRedisUpgradeCacheVersionOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudRedisUpgradeCacheVersionOperationCreate,
 		Read:   resourceTencentCloudRedisUpgradeCacheVersionOperationRead,
+		Update: resourceTencentCloudRedisUpgradeCacheVersionOperationUpdate,
 		Delete: resourceTencentCloudRedisUpgradeCacheVersionOperationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -51,6 +52,10 @@ func ResourceTencentCloudRedisUpgradeCacheVersionOperation() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "Switch mode:1 - Upgrade now0 - Maintenance window upgrade.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -101,27 +106,29 @@ func resourceTencentCloudRedisUpgradeCacheVersionOperationCreate(d *schema.Resou
 
 	d.SetId(instanceId)
 
-	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	taskId := *response.Response.FlowId
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
+	if tccommon.OperationWaitForCompletion(d) {
+		service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		taskId := *response.Response.FlowId
+		err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
+			if err != nil {
+				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
+					return resource.RetryableError(err)
+				} else {
+					return resource.NonRetryableError(err)
+				}
+			}
+			if ok {
+				return nil
 			} else {
-				return resource.NonRetryableError(err)
+				return resource.RetryableError(fmt.Errorf("upgrade cache version is processing"))
 			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("upgrade cache version is processing"))
-		}
-	})
+		})
 
-	if err != nil {
-		log.Printf("[CRITAL]%s redis upgrade cache version fail, reason:%s\n", logId, err.Error())
-		return err
+		if err != nil {
+			log.Printf("[CRITAL]%s redis upgrade cache version fail, reason:%s\n", logId, err.Error())
+			return err
+		}
 	}
 
 	return resourceTencentCloudRedisUpgradeCacheVersionOperationRead(d, meta)
@@ -134,6 +141,14 @@ func resourceTencentCloudRedisUpgradeCacheVersionOperationRead(d *schema.Resourc
 	return nil
 }
 
+func resourceTencentCloudRedisUpgradeCacheVersionOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_redis_upgrade_cache_version_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudRedisUpgradeCacheVersionOperationRead(d, meta)
+}
+
 func resourceTencentCloudRedisUpgradeCacheVersionOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_redis_upgrade_cache_version_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
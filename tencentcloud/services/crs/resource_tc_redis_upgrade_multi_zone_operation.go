@@ -19,6 +19,7 @@ func ResourceTencentCloudRedisUpgradeMultiZoneOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudRedisUpgradeMultiZoneOperationCreate,
 		Read:   resourceTencentCloudRedisUpgradeMultiZoneOperationRead,
+		Update: resourceTencentCloudRedisUpgradeMultiZoneOperationUpdate,
 		Delete: resourceTencentCloudRedisUpgradeMultiZoneOperationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -37,6 +38,10 @@ func ResourceTencentCloudRedisUpgradeMultiZoneOperation() *schema.Resource {
 				Type:        schema.TypeBool,
 				Description: "After you upgrade Multi-AZ, whether the nearby access feature is supported.true: Supports nearby access.The upgrade process, which requires upgrading both the proxy version and the Redis kernel minor version, involves data migration and can take several hours.false: No need to support nearby access.Upgrading Multi-AZ only involves managing metadata migration, with no service impact, and the upgrade process typically completes within 3 minutes.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -79,27 +84,29 @@ func resourceTencentCloudRedisUpgradeMultiZoneOperationCreate(d *schema.Resource
 
 	d.SetId(instanceId)
 
-	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	taskId := *response.Response.FlowId
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
+	if tccommon.OperationWaitForCompletion(d) {
+		service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		taskId := *response.Response.FlowId
+		err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
+			if err != nil {
+				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
+					return resource.RetryableError(err)
+				} else {
+					return resource.NonRetryableError(err)
+				}
+			}
+			if ok {
+				return nil
 			} else {
-				return resource.NonRetryableError(err)
+				return resource.RetryableError(fmt.Errorf("upgrade multi zone is processing"))
 			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("upgrade multi zone is processing"))
-		}
-	})
+		})
 
-	if err != nil {
-		log.Printf("[CRITAL]%s redis upgrade multi zone fail, reason:%s\n", logId, err.Error())
-		return err
+		if err != nil {
+			log.Printf("[CRITAL]%s redis upgrade multi zone fail, reason:%s\n", logId, err.Error())
+			return err
+		}
 	}
 
 	return resourceTencentCloudRedisUpgradeMultiZoneOperationRead(d, meta)
@@ -112,6 +119,14 @@ func resourceTencentCloudRedisUpgradeMultiZoneOperationRead(d *schema.ResourceDa
 	return nil
 }
 
+func resourceTencentCloudRedisUpgradeMultiZoneOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_redis_upgrade_multi_zone_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudRedisUpgradeMultiZoneOperationRead(d, meta)
+}
+
 func resourceTencentCloudRedisUpgradeMultiZoneOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_redis_upgrade_multi_zone_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
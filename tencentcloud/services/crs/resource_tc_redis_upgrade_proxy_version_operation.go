@@ -19,6 +19,7 @@ func ResourceTencentCloudRedisUpgradeProxyVersionOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudRedisUpgradeProxyVersionOperationCreate,
 		Read:   resourceTencentCloudRedisUpgradeProxyVersionOperationRead,
+		Update: resourceTencentCloudRedisUpgradeProxyVersionOperationUpdate,
 		Delete: resourceTencentCloudRedisUpgradeProxyVersionOperationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -51,6 +52,10 @@ func ResourceTencentCloudRedisUpgradeProxyVersionOperation() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "Switch mode:1 - Upgrade now0 - Maintenance window upgrade.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -101,27 +106,29 @@ func resourceTencentCloudRedisUpgradeProxyVersionOperationCreate(d *schema.Resou
 
 	d.SetId(instanceId)
 
-	service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	taskId := *response.Response.FlowId
-	err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
-		if err != nil {
-			if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
-				return resource.RetryableError(err)
+	if tccommon.OperationWaitForCompletion(d) {
+		service := RedisService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		taskId := *response.Response.FlowId
+		err = resource.Retry(6*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			ok, err := service.DescribeTaskInfo(ctx, instanceId, taskId)
+			if err != nil {
+				if _, ok := err.(*sdkErrors.TencentCloudSDKError); !ok {
+					return resource.RetryableError(err)
+				} else {
+					return resource.NonRetryableError(err)
+				}
+			}
+			if ok {
+				return nil
 			} else {
-				return resource.NonRetryableError(err)
+				return resource.RetryableError(fmt.Errorf("upgrade proxy version is processing"))
 			}
-		}
-		if ok {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("upgrade proxy version is processing"))
-		}
-	})
+		})
 
-	if err != nil {
-		log.Printf("[CRITAL]%s redis upgrade proxy version fail, reason:%s\n", logId, err.Error())
-		return err
+		if err != nil {
+			log.Printf("[CRITAL]%s redis upgrade proxy version fail, reason:%s\n", logId, err.Error())
+			return err
+		}
 	}
 
 	return resourceTencentCloudRedisUpgradeProxyVersionOperationRead(d, meta)
@@ -134,6 +141,14 @@ func resourceTencentCloudRedisUpgradeProxyVersionOperationRead(d *schema.Resourc
 	return nil
 }
 
+func resourceTencentCloudRedisUpgradeProxyVersionOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_redis_upgrade_proxy_version_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudRedisUpgradeProxyVersionOperationRead(d, meta)
+}
+
 func resourceTencentCloudRedisUpgradeProxyVersionOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_redis_upgrade_proxy_version_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
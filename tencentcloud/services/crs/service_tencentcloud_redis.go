@@ -2281,3 +2281,29 @@ func (me *RedisService) DescribeRedisLogDeliveryById(ctx context.Context, instan
 	ret = response.Response.SlowLog
 	return
 }
+
+func (me *RedisService) InquiryPriceCreateInstance(ctx context.Context, request *redis.InquiryPriceCreateInstanceRequest) (price *float64, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseRedisClient().InquiryPriceCreateInstance(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil {
+		return
+	}
+
+	price = response.Response.Price
+	return
+}
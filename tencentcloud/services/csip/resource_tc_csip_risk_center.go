@@ -15,10 +15,11 @@ import (
 
 func ResourceTencentCloudCsipRiskCenter() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCsipRiskCenterCreate,
-		Read:   resourceTencentCloudCsipRiskCenterRead,
-		Update: resourceTencentCloudCsipRiskCenterUpdate,
-		Delete: resourceTencentCloudCsipRiskCenterDelete,
+		Create:   resourceTencentCloudCsipRiskCenterCreate,
+		Read:     resourceTencentCloudCsipRiskCenterRead,
+		Update:   resourceTencentCloudCsipRiskCenterUpdate,
+		Delete:   resourceTencentCloudCsipRiskCenterDelete,
+		Importer: helper.IdSpec{"task_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"task_name": {
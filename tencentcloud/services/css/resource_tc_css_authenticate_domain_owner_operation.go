@@ -33,6 +33,8 @@ func ResourceTencentCloudCssAuthenticateDomainOwnerOperation() *schema.Resource
 				Default:     CSS_VERIFY_TYPE_DB_CHECK,
 				Description: "Authentication type. Possible values:`dnsCheck`: Immediately verify whether the resolution record of the configured dns is consistent with the content to be verified, and save the record if successful.`fileCheck`: Immediately verify whether the web file is consistent with the content to be verified, and save the record if successful.`dbCheck`: Check if authentication has been successful.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
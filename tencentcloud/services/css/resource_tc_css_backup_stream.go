@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudCssBackupStream() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssBackupStreamCreate,
-		Read:   resourceTencentCloudCssBackupStreamRead,
-		Update: resourceTencentCloudCssBackupStreamUpdate,
-		Delete: resourceTencentCloudCssBackupStreamDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCssBackupStreamCreate,
+		Read:     resourceTencentCloudCssBackupStreamRead,
+		Update:   resourceTencentCloudCssBackupStreamUpdate,
+		Delete:   resourceTencentCloudCssBackupStreamDelete,
+		Importer: helper.IdSpec{"push_domain_name", "app_name", "stream_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"push_domain_name": {
 				Required:    true,
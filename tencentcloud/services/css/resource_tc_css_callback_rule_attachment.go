@@ -18,12 +18,10 @@ import (
 
 func ResourceTencentCloudCssCallbackRuleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssCallbackRuleAttachmentCreate,
-		Read:   resourceTencentCloudCssCallbackRuleAttachmentRead,
-		Delete: resourceTencentCloudCssCallbackRuleAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCssCallbackRuleAttachmentCreate,
+		Read:     resourceTencentCloudCssCallbackRuleAttachmentRead,
+		Delete:   resourceTencentCloudCssCallbackRuleAttachmentDelete,
+		Importer: helper.IdSpec{"template_id", "domain_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Required:    true,
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudCssLiveTranscodeRuleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudCssLiveTranscodeRuleAttachmentRead,
-		Create: resourceTencentCloudCssLiveTranscodeRuleAttachmentCreate,
-		Delete: resourceTencentCloudCssLiveTranscodeRuleAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudCssLiveTranscodeRuleAttachmentRead,
+		Create:   resourceTencentCloudCssLiveTranscodeRuleAttachmentCreate,
+		Delete:   resourceTencentCloudCssLiveTranscodeRuleAttachmentDelete,
+		Importer: helper.IdSpec{"domain_name", "app_name", "stream_name", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Type:        schema.TypeString,
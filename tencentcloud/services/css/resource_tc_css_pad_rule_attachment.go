@@ -18,12 +18,10 @@ import (
 
 func ResourceTencentCloudCssPadRuleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssPadRuleAttachmentCreate,
-		Read:   resourceTencentCloudCssPadRuleAttachmentRead,
-		Delete: resourceTencentCloudCssPadRuleAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCssPadRuleAttachmentCreate,
+		Read:     resourceTencentCloudCssPadRuleAttachmentRead,
+		Delete:   resourceTencentCloudCssPadRuleAttachmentDelete,
+		Importer: helper.IdSpec{"template_id", "domain_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Required:    true,
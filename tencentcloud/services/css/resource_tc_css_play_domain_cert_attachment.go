@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudCssPlayDomainCertAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssPlayDomainCertAttachmentCreate,
-		Read:   resourceTencentCloudCssPlayDomainCertAttachmentRead,
-		Delete: resourceTencentCloudCssPlayDomainCertAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCssPlayDomainCertAttachmentCreate,
+		Read:     resourceTencentCloudCssPlayDomainCertAttachmentRead,
+		Delete:   resourceTencentCloudCssPlayDomainCertAttachmentDelete,
+		Importer: helper.IdSpec{"domain_name", "[cloud_cert_id]"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain_info": {
 				Required:    true,
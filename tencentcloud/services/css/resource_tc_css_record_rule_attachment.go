@@ -18,12 +18,10 @@ import (
 
 func ResourceTencentCloudCssRecordRuleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssRecordRuleAttachmentCreate,
-		Read:   resourceTencentCloudCssRecordRuleAttachmentRead,
-		Delete: resourceTencentCloudCssRecordRuleAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCssRecordRuleAttachmentCreate,
+		Read:     resourceTencentCloudCssRecordRuleAttachmentRead,
+		Delete:   resourceTencentCloudCssRecordRuleAttachmentDelete,
+		Importer: helper.IdSpec{"template_id", "domain_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Required:    true,
@@ -18,12 +18,10 @@ import (
 
 func ResourceTencentCloudCssSnapshotRuleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssSnapshotRuleAttachmentCreate,
-		Read:   resourceTencentCloudCssSnapshotRuleAttachmentRead,
-		Delete: resourceTencentCloudCssSnapshotRuleAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCssSnapshotRuleAttachmentCreate,
+		Read:     resourceTencentCloudCssSnapshotRuleAttachmentRead,
+		Delete:   resourceTencentCloudCssSnapshotRuleAttachmentDelete,
+		Importer: helper.IdSpec{"template_id", "domain_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Required:    true,
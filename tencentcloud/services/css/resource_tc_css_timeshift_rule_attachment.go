@@ -18,12 +18,10 @@ import (
 
 func ResourceTencentCloudCssTimeshiftRuleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssTimeshiftRuleAttachmentCreate,
-		Read:   resourceTencentCloudCssTimeshiftRuleAttachmentRead,
-		Delete: resourceTencentCloudCssTimeshiftRuleAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCssTimeshiftRuleAttachmentCreate,
+		Read:     resourceTencentCloudCssTimeshiftRuleAttachmentRead,
+		Delete:   resourceTencentCloudCssTimeshiftRuleAttachmentDelete,
+		Importer: helper.IdSpec{"template_id", "domain_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Required:    true,
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudCssWatermarkRuleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCssWatermarkRuleAttachmentCreate,
-		Read:   resourceTencentCloudCssWatermarkRuleAttachmentRead,
-		Delete: resourceTencentCloudCssWatermarkRuleAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCssWatermarkRuleAttachmentCreate,
+		Read:     resourceTencentCloudCssWatermarkRuleAttachmentRead,
+		Delete:   resourceTencentCloudCssWatermarkRuleAttachmentDelete,
+		Importer: helper.IdSpec{"domain_name", "app_name", "stream_name", "template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Required:    true,
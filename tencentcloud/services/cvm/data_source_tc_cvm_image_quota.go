@@ -61,7 +61,7 @@ func dataSourceTencentCloudCvmImageQuotaRead(d *schema.ResourceData, meta interf
 	if ok && output.(string) != "" {
 		if e := tccommon.WriteToFile(output.(string), map[string]interface{}{
 			"image_num_quota": imageNumQuota,
-		}); e != nil {
+		}, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
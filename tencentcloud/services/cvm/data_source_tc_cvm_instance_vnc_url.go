@@ -70,7 +70,7 @@ func dataSourceTencentCloudCvmInstanceVncUrlRead(d *schema.ResourceData, meta in
 	if ok && output.(string) != "" {
 		if e := tccommon.WriteToFile(output.(string), map[string]interface{}{
 			"instance_vnc_url": *response.Response.InstanceVncUrl,
-		}); e != nil {
+		}, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
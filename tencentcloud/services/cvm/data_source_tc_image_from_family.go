@@ -315,7 +315,7 @@ func dataSourceTencentCloudImageFromFamilyRead(d *schema.ResourceData, meta inte
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), imageMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), imageMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -0,0 +1,249 @@
+package cvm
+
+import (
+	"context"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func DataSourceTencentCloudInstancePrice() *schema.Resource {
+	priceSchema := map[string]*schema.Schema{
+		"original_price": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Original price of a `PREPAID` instance for the whole period.",
+		},
+		"discount_price": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Discounted price of a `PREPAID` instance for the whole period.",
+		},
+		"unit_price": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Original price of a postpaid instance per `charge_unit`.",
+		},
+		"unit_price_discount": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Discounted price of a postpaid instance per `charge_unit`.",
+		},
+		"charge_unit": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Charge unit of a postpaid instance, such as `HOUR`, or `GB` for traffic.",
+		},
+	}
+
+	return &schema.Resource{
+		Read: dataSourceTencentCloudInstancePriceRead,
+		Schema: map[string]*schema.Schema{
+			"availability_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The available zone for the CVM instance.",
+			},
+			"image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The image to use for the instance.",
+			},
+			"instance_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tccommon.ValidateInstanceType,
+				Description:  "The type of the instance.",
+			},
+			"instance_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: tccommon.ValidateIntegerInRange(1, 100),
+				Description:  "The number of instances to be purchased. Default is 1.",
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      CVM_CHARGE_TYPE_POSTPAID,
+				ValidateFunc: tccommon.ValidateAllowedStringValue([]string{CVM_CHARGE_TYPE_PREPAID, CVM_CHARGE_TYPE_POSTPAID, CVM_CHARGE_TYPE_SPOTPAID}),
+				Description:  "The charge type of instance. Valid values are `PREPAID`, `POSTPAID_BY_HOUR` and `SPOTPAID`. The default is `POSTPAID_BY_HOUR`.",
+			},
+			"instance_charge_type_prepaid_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: tccommon.ValidateAllowedIntValue(CVM_PREPAID_PERIOD),
+				Description:  "The tenancy (time unit is month) of the prepaid instance, NOTE: it only works when instance_charge_type is set to `PREPAID`. Default is 1.",
+			},
+			"system_disk_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      CVM_DISK_TYPE_CLOUD_PREMIUM,
+				ValidateFunc: tccommon.ValidateAllowedStringValue(CVM_DISK_TYPE),
+				Description:  "System disk type. Default is `CLOUD_PREMIUM`.",
+			},
+			"system_disk_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     50,
+				Description: "Size of the system disk. unit is GB, Default is 50GB.",
+			},
+			"data_disks": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Settings for data disks.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_disk_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Data disk type.",
+						},
+						"data_disk_size": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Size of the data disk, and unit is GB.",
+						},
+					},
+				},
+			},
+			"internet_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tccommon.ValidateAllowedStringValue(CVM_INTERNET_CHARGE_TYPE),
+				Description:  "Internet charge type of the instance, Valid values are `BANDWIDTH_PREPAID`, `TRAFFIC_POSTPAID_BY_HOUR`, `BANDWIDTH_POSTPAID_BY_HOUR` and `BANDWIDTH_PACKAGE`.",
+			},
+			"internet_max_bandwidth_out": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum outgoing bandwidth to the public network, measured in Mbps (Mega bits per second). The bandwidth is not priced when it is not set.",
+			},
+			"instance_price": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Price of the instances. Prices are in CNY for the Chinese site and USD for the international site.",
+				Elem:        &schema.Resource{Schema: priceSchema},
+			},
+			"bandwidth_price": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Price of the public network bandwidth, in the same currency as `instance_price`.",
+				Elem:        &schema.Resource{Schema: priceSchema},
+			},
+			"result_output_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Used to save results.",
+			},
+		},
+	}
+}
+
+func dataSourceTencentCloudInstancePriceRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("data_source.tencentcloud_instance_price.read")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = CvmService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		request = cvm.NewInquiryPriceRunInstancesRequest()
+	)
+
+	chargeType := d.Get("instance_charge_type").(string)
+	request.Placement = &cvm.Placement{Zone: helper.String(d.Get("availability_zone").(string))}
+	request.ImageId = helper.String(d.Get("image_id").(string))
+	request.InstanceType = helper.String(d.Get("instance_type").(string))
+	request.InstanceCount = helper.IntInt64(d.Get("instance_count").(int))
+	request.InstanceChargeType = helper.String(chargeType)
+	if chargeType == CVM_CHARGE_TYPE_PREPAID {
+		request.InstanceChargePrepaid = &cvm.InstanceChargePrepaid{
+			Period: helper.IntInt64(d.Get("instance_charge_type_prepaid_period").(int)),
+		}
+	}
+
+	request.SystemDisk = &cvm.SystemDisk{
+		DiskType: helper.String(d.Get("system_disk_type").(string)),
+		DiskSize: helper.IntInt64(d.Get("system_disk_size").(int)),
+	}
+
+	for _, item := range d.Get("data_disks").([]interface{}) {
+		dataDisk := item.(map[string]interface{})
+		request.DataDisks = append(request.DataDisks, &cvm.DataDisk{
+			DiskType: helper.String(dataDisk["data_disk_type"].(string)),
+			DiskSize: helper.IntInt64(dataDisk["data_disk_size"].(int)),
+		})
+	}
+
+	if v, ok := d.GetOk("internet_max_bandwidth_out"); ok {
+		request.InternetAccessible = &cvm.InternetAccessible{
+			InternetMaxBandwidthOut: helper.IntInt64(v.(int)),
+			PublicIpAssigned:        helper.Bool(true),
+		}
+		if v, ok := d.GetOk("internet_charge_type"); ok {
+			request.InternetAccessible.InternetChargeType = helper.String(v.(string))
+		}
+	}
+
+	var price *cvm.Price
+	err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		result, e := service.InquiryPriceRunInstances(ctx, request)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+
+		price = result
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if price != nil {
+		_ = d.Set("instance_price", flattenCvmItemPrice(price.InstancePrice))
+		_ = d.Set("bandwidth_price", flattenCvmItemPrice(price.BandwidthPrice))
+	}
+
+	d.SetId(helper.DataResourceIdsHash([]string{request.ToJsonString()}))
+	output, ok := d.GetOk("result_output_file")
+	if ok && output.(string) != "" {
+		if e := tccommon.WriteToFile(output.(string), price, tccommon.GetResultOutputOptions(d)); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
+
+func flattenCvmItemPrice(price *cvm.ItemPrice) []interface{} {
+	if price == nil {
+		return nil
+	}
+
+	item := map[string]interface{}{
+		"charge_unit": helper.PString(price.ChargeUnit),
+	}
+	if price.OriginalPrice != nil {
+		item["original_price"] = *price.OriginalPrice
+	}
+
+	if price.DiscountPrice != nil {
+		item["discount_price"] = *price.DiscountPrice
+	}
+
+	if price.UnitPrice != nil {
+		item["unit_price"] = *price.UnitPrice
+	}
+
+	if price.UnitPriceDiscount != nil {
+		item["unit_price_discount"] = *price.UnitPriceDiscount
+	}
+
+	return []interface{}{item}
+}
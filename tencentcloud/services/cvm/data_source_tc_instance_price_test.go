@@ -0,0 +1,69 @@
+package cvm_test
+
+import (
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccTencentCloudInstancePriceDataSource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancePriceDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					tcacctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_instance_price.postpaid"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_instance_price.postpaid", "instance_price.0.unit_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_instance_price.postpaid", "instance_price.0.unit_price_discount"),
+					resource.TestCheckResourceAttr("data.tencentcloud_instance_price.postpaid", "instance_price.0.charge_unit", "HOUR"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_instance_price.postpaid", "bandwidth_price.0.unit_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_instance_price.prepaid", "instance_price.0.original_price"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_instance_price.prepaid", "instance_price.0.discount_price"),
+				),
+			},
+		},
+	})
+}
+
+const testAccInstancePriceDataSource = tcacctest.DefaultAzVariable + `
+
+data "tencentcloud_images" "default" {
+  image_type       = ["PUBLIC_IMAGE"]
+  image_name_regex = "OpenCloudOS Server"
+}
+
+data "tencentcloud_instance_types" "default" {
+  availability_zone = var.default_az
+  cpu_core_count    = 2
+  memory_size       = 2
+  exclude_sold_out  = true
+}
+
+data "tencentcloud_instance_price" "postpaid" {
+  availability_zone          = var.default_az
+  image_id                   = data.tencentcloud_images.default.images.0.image_id
+  instance_type              = data.tencentcloud_instance_types.default.instance_types.0.instance_type
+  internet_charge_type       = "BANDWIDTH_POSTPAID_BY_HOUR"
+  internet_max_bandwidth_out = 10
+
+  data_disks {
+    data_disk_type = "CLOUD_PREMIUM"
+    data_disk_size = 100
+  }
+}
+
+data "tencentcloud_instance_price" "prepaid" {
+  availability_zone                   = var.default_az
+  image_id                            = data.tencentcloud_images.default.images.0.image_id
+  instance_type                       = data.tencentcloud_instance_types.default.instance_types.0.instance_type
+  instance_charge_type                = "PREPAID"
+  instance_charge_type_prepaid_period = 1
+}
+`
@@ -2,7 +2,9 @@ package cvm
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"sort"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -20,32 +22,80 @@ func DataSourceTencentCloudInstanceTypes() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"cpu_core_count": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "The number of CPU cores of the instance.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"min_cpu_core_count"},
+				Description:   "The number of CPU cores of the instance.",
 			},
 			"gpu_core_count": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "The number of GPU cores of the instance.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"min_gpu_core_count"},
+				Description:   "The number of GPU cores of the instance.",
 			},
 			"memory_size": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Instance memory capacity, unit in GB.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"min_memory_size"},
+				Description:   "Instance memory capacity, unit in GB.",
+			},
+			"min_cpu_core_count": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"cpu_core_count"},
+				Description:   "The minimum number of CPU cores of the instance. This field is conflict with `cpu_core_count`.",
+			},
+			"min_gpu_core_count": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"gpu_core_count"},
+				Description:   "The minimum number of GPU cores of the instance. This field is conflict with `gpu_core_count`.",
+			},
+			"min_memory_size": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"memory_size"},
+				Description:   "The minimum memory capacity of the instance, unit in GB. This field is conflict with `memory_size`.",
 			},
 			"availability_zone": {
 				Type:          schema.TypeString,
 				Optional:      true,
+				ConflictsWith: []string{"filter", "availability_zones"},
+				Description:   "The available zone that the CVM instance locates at. This field is conflict with `filter` and `availability_zones`.",
+			},
+			"availability_zones": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"filter", "availability_zone"},
+				Description:   "The available zones to query instance types in. This field is conflict with `filter` and `availability_zone`.",
+			},
+			"instance_families": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"filter"},
+				Description:   "The instance families allowed, such as `S5` and `SA2`. This field is conflict with `filter`.",
+			},
+			"instance_charge_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  tccommon.ValidateAllowedStringValue([]string{CVM_CHARGE_TYPE_PREPAID, CVM_CHARGE_TYPE_POSTPAID, CVM_CHARGE_TYPE_SPOTPAID}),
 				ConflictsWith: []string{"filter"},
-				Description:   "The available zone that the CVM instance locates at. This field is conflict with `filter`.",
+				Description:   "The charge type of the instance types. Valid values are `PREPAID`, `POSTPAID_BY_HOUR` and `SPOTPAID`. This field is conflict with `filter`.",
+			},
+			"sort_by_price": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Indicate to sort the instance types by price, cheapest first, default is false. Requires a single charge type, set by `instance_charge_type` or the `instance-charge-type` filter. Instance types without a price are placed last.",
 			},
 			"filter": {
 				Type:          schema.TypeSet,
 				Optional:      true,
 				MaxItems:      10,
-				ConflictsWith: []string{"availability_zone"},
-				Description:   "One or more name/value pairs to filter. This field is conflict with `availability_zone`.",
+				ConflictsWith: []string{"availability_zone", "availability_zones", "instance_families", "instance_charge_type"},
+				Description:   "One or more name/value pairs to filter. This field is conflict with `availability_zone`, `availability_zones`, `instance_families` and `instance_charge_type`.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -155,6 +205,50 @@ func DataSourceTencentCloudInstanceTypes() *schema.Resource {
 							Computed:    true,
 							Description: "Sell status of the instance.",
 						},
+						"status_category": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Stock status of the instance. Valid values: `EnoughStock`, `NormalStock`, `UnderStock` and `WithoutStock`.",
+						},
+						"sold_out_reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Reason why the instance type is sold out.",
+						},
+						"price": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Price of the instance type for its charge type.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"original_price": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Original price of a `PREPAID` instance per month.",
+									},
+									"discount_price": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Discounted price of a `PREPAID` instance per month.",
+									},
+									"unit_price": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Original price of a postpaid instance per `charge_unit`.",
+									},
+									"unit_price_discount": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "Discounted price of a postpaid instance per `charge_unit`.",
+									},
+									"charge_unit": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Charge unit of a postpaid instance, such as `HOUR`.",
+									},
+								},
+							},
+						},
 						"cbs_configs": {
 							Type:        schema.TypeList,
 							Computed:    true,
@@ -247,6 +341,9 @@ func dataSourceTencentCloudInstanceTypesRead(d *schema.ResourceData, meta interf
 	cpu, cpuOk := d.GetOk("cpu_core_count")
 	gpu, gpuOk := d.GetOk("gpu_core_count")
 	memory, memoryOk := d.GetOk("memory_size")
+	minCpu, minCpuOk := d.GetOk("min_cpu_core_count")
+	minGpu, minGpuOk := d.GetOk("min_gpu_core_count")
+	minMemory, minMemoryOk := d.GetOk("min_memory_size")
 	var instanceSellTypes []*cvm.InstanceTypeQuotaItem
 	var errRet error
 	var err error
@@ -274,6 +371,20 @@ func dataSourceTencentCloudInstanceTypesRead(d *schema.ResourceData, meta interf
 	if zone != "" {
 		filterMap["zone"] = []string{zone}
 	}
+	if v, ok := d.GetOk("availability_zones"); ok {
+		filterMap["zone"] = helper.InterfacesStrings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("instance_families"); ok {
+		filterMap["instance-family"] = helper.InterfacesStrings(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("instance_charge_type"); ok {
+		filterMap["instance-charge-type"] = []string{v.(string)}
+	}
+
+	sortByPrice := d.Get("sort_by_price").(bool)
+	if sortByPrice && len(filterMap["instance-charge-type"]) != 1 {
+		return fmt.Errorf("`sort_by_price` requires a single charge type, set by `instance_charge_type` or the `instance-charge-type` filter")
+	}
 	err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 		instanceSellTypes, errRet = cvmService.DescribeInstancesSellTypeByFilter(ctx, filterMap)
 		if errRet != nil {
@@ -284,6 +395,7 @@ func dataSourceTencentCloudInstanceTypesRead(d *schema.ResourceData, meta interf
 	if err != nil {
 		return err
 	}
+	matchedTypes := make([]*cvm.InstanceTypeQuotaItem, 0, len(instanceSellTypes))
 	for _, instanceType := range instanceSellTypes {
 		flag := true
 		if cpuOk && int64(cpu.(int)) != *instanceType.Cpu {
@@ -295,24 +407,51 @@ func dataSourceTencentCloudInstanceTypesRead(d *schema.ResourceData, meta interf
 		if memoryOk && int64(memory.(int)) != *instanceType.Memory {
 			flag = false
 		}
+		if minCpuOk && int64(minCpu.(int)) > *instanceType.Cpu {
+			flag = false
+		}
+		if minGpuOk && (instanceType.Gpu == nil || int64(minGpu.(int)) > *instanceType.Gpu) {
+			flag = false
+		}
+		if minMemoryOk && int64(minMemory.(int)) > *instanceType.Memory {
+			flag = false
+		}
 		if isExcludeSoldOut && CVM_SOLD_OUT_STATUS == *instanceType.Status {
 			flag = false
 		}
 
 		if flag {
-			mapping := map[string]interface{}{
-				"availability_zone":    instanceType.Zone,
-				"cpu_core_count":       instanceType.Cpu,
-				"gpu_core_count":       instanceType.Gpu,
-				"memory_size":          instanceType.Memory,
-				"family":               instanceType.InstanceFamily,
-				"instance_type":        instanceType.InstanceType,
-				"instance_charge_type": instanceType.InstanceChargeType,
-				"status":               instanceType.Status,
+			matchedTypes = append(matchedTypes, instanceType)
+		}
+	}
+
+	if sortByPrice {
+		sort.SliceStable(matchedTypes, func(i, j int) bool {
+			iPrice, iOk := instanceTypeQuotaPrice(matchedTypes[i])
+			jPrice, jOk := instanceTypeQuotaPrice(matchedTypes[j])
+			if iOk != jOk {
+				return iOk
 			}
-			typeList = append(typeList, mapping)
-			ids = append(ids, *instanceType.InstanceType)
+			return iPrice < jPrice
+		})
+	}
+
+	for _, instanceType := range matchedTypes {
+		mapping := map[string]interface{}{
+			"availability_zone":    instanceType.Zone,
+			"cpu_core_count":       instanceType.Cpu,
+			"gpu_core_count":       instanceType.Gpu,
+			"memory_size":          instanceType.Memory,
+			"family":               instanceType.InstanceFamily,
+			"instance_type":        instanceType.InstanceType,
+			"instance_charge_type": instanceType.InstanceChargeType,
+			"status":               instanceType.Status,
+			"status_category":      instanceType.StatusCategory,
+			"sold_out_reason":      instanceType.SoldOutReason,
+			"price":                flattenCvmItemPrice(instanceType.Price),
 		}
+		typeList = append(typeList, mapping)
+		ids = append(ids, *instanceType.InstanceType)
 	}
 
 	client := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
@@ -383,9 +522,25 @@ func dataSourceTencentCloudInstanceTypesRead(d *schema.ResourceData, meta interf
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err := tccommon.WriteToFile(output.(string), typeList); err != nil {
+		if err := tccommon.WriteToFile(output.(string), typeList, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// instanceTypeQuotaPrice returns the price used to compare instance types, preferring the discounted price.
+func instanceTypeQuotaPrice(instanceType *cvm.InstanceTypeQuotaItem) (float64, bool) {
+	price := instanceType.Price
+	if price == nil {
+		return 0, false
+	}
+
+	for _, v := range []*float64{price.UnitPriceDiscount, price.UnitPrice, price.DiscountPrice, price.OriginalPrice} {
+		if v != nil && *v > 0 {
+			return *v, true
+		}
+	}
+
+	return 0, false
+}
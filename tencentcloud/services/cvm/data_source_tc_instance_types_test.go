@@ -50,6 +50,42 @@ func TestAccTencentCloudCvmInstanceTypesDataSource_Sell(t *testing.T) {
 		},
 	})
 }
+func TestAccTencentCloudCvmInstanceTypesDataSource_SortByPrice(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.AccPreCheck(t)
+		},
+		Providers: acctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCvmInstanceTypesDataSource_SortByPrice,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_instance_types.cheapest"),
+					resource.TestCheckResourceAttr("data.tencentcloud_instance_types.cheapest", "instance_types.0.instance_charge_type", "POSTPAID_BY_HOUR"),
+					resource.TestCheckResourceAttr("data.tencentcloud_instance_types.cheapest", "instance_types.0.status", "SELL"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_instance_types.cheapest", "instance_types.0.status_category"),
+					resource.TestCheckResourceAttrSet("data.tencentcloud_instance_types.cheapest", "instance_types.0.price.0.unit_price_discount"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCvmInstanceTypesDataSource_SortByPrice = `
+
+data "tencentcloud_instance_types" "cheapest" {
+  availability_zones   = ["ap-guangzhou-3", "ap-guangzhou-6"]
+  instance_families    = ["S5", "SA2"]
+  instance_charge_type = "POSTPAID_BY_HOUR"
+  min_cpu_core_count   = 2
+  min_memory_size      = 4
+  exclude_sold_out     = true
+  sort_by_price        = true
+}
+
+`
+
 func TestAccTencentCloudCvmInstanceTypesDataSource_WithCbsFilter(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
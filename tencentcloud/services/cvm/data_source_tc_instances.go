@@ -396,7 +396,7 @@ func dataSourceTencentCloudInstancesRead(d *schema.ResourceData, meta interface{
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err := tccommon.WriteToFile(output.(string), instanceList); err != nil {
+		if err := tccommon.WriteToFile(output.(string), instanceList, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
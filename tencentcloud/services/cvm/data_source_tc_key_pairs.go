@@ -161,7 +161,7 @@ func dataSourceTencentCloudKeyPairsRead(d *schema.ResourceData, meta interface{}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err := tccommon.WriteToFile(output.(string), keyPairList); err != nil {
+		if err := tccommon.WriteToFile(output.(string), keyPairList, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
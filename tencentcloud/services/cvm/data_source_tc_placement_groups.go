@@ -138,7 +138,7 @@ func dataSourceTencentCloudPlacementGroupsRead(d *schema.ResourceData, meta inte
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err := tccommon.WriteToFile(output.(string), placementGroupList); err != nil {
+		if err := tccommon.WriteToFile(output.(string), placementGroupList, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
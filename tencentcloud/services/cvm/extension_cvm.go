@@ -78,6 +78,17 @@ var CVM_RETRYABLE_ERROR = []string{
 	"ClientError.HttpStatusCodeError",
 }
 
+// Sold-out capacity, on which the instance create moves on to the next of `instance_type_fallbacks`
+var CVM_SOLD_OUT_ERROR = []string{
+	"ResourceInsufficient.SpecifiedInstanceType",
+	"ResourceInsufficient.ZoneSoldOutForSpecifiedInstance",
+	"ResourceInsufficient.AvailabilityZoneSoldOut",
+	"ResourcesSoldOut.SpecifiedInstanceType",
+	"ResourcesSoldOut.AvailableZone",
+	// spot capacity
+	"InvalidParameterValue.InsufficientOffering",
+}
+
 var CVM_CHARGE_TYPE = []string{
 	CVM_CHARGE_TYPE_PREPAID,
 	CVM_CHARGE_TYPE_POSTPAID,
@@ -4,3 +4,11 @@ const (
 	TRUE  = "true"
 	FALSE = "false"
 )
+
+const (
+	IMAGE_STATE_CREATING     = "CREATING"
+	IMAGE_STATE_NORMAL       = "NORMAL"
+	IMAGE_STATE_CREATEFAILED = "CREATEFAILED"
+	IMAGE_STATE_USING        = "USING"
+	IMAGE_STATE_SYNCING      = "SYNCING"
+)
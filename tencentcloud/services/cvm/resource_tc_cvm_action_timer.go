@@ -17,10 +17,8 @@ func ResourceTencentCloudCvmActionTimer() *schema.Resource {
 		Create: resourceTencentCloudCvmActionTimerCreate,
 		Read:   resourceTencentCloudCvmActionTimerRead,
 		//Update: resourceTencentCloudCvmActionTimerUpdate,
-		Delete: resourceTencentCloudCvmActionTimerDelete,
-		//Importer: &schema.ResourceImporter{
-		//	State: schema.ImportStatePassthrough,
-		//},
+		Delete:   resourceTencentCloudCvmActionTimerDelete,
+		Importer: helper.IdSpec{"action_timer_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
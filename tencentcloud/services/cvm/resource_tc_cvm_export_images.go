@@ -17,6 +17,7 @@ func ResourceTencentCloudCvmExportImages() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudCvmExportImagesCreate,
 		Read:   resourceTencentCloudCvmExportImagesRead,
+		Update: resourceTencentCloudCvmExportImagesUpdate,
 		Delete: resourceTencentCloudCvmExportImagesDelete,
 		Schema: map[string]*schema.Schema{
 			"bucket_name": {
@@ -67,6 +68,10 @@ func ResourceTencentCloudCvmExportImages() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Role name (Default: CVM_QcsRole). Before exporting the images, make sure the role exists, and it has write permission to COS.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -122,12 +127,14 @@ func resourceTencentCloudCvmExportImagesCreate(d *schema.ResourceData, meta inte
 
 	d.SetId(imageId)
 
-	service := CvmService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := CvmService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"NORMAL"}, 20*tccommon.ReadRetryTimeout, time.Second, service.CvmSyncImagesStateRefreshFunc(d.Id(), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"NORMAL"}, 20*tccommon.ReadRetryTimeout, time.Second, service.CvmSyncImagesStateRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudCvmExportImagesRead(d, meta)
@@ -140,6 +147,14 @@ func resourceTencentCloudCvmExportImagesRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
+func resourceTencentCloudCvmExportImagesUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cvm_export_images.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudCvmExportImagesRead(d, meta)
+}
+
 func resourceTencentCloudCvmExportImagesDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cvm_export_images.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -0,0 +1,652 @@
+package cvm
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	svctat "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tat"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func ResourceTencentCloudCvmImagePipeline() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudCvmImagePipelineCreate,
+		Read:   resourceTencentCloudCvmImagePipelineRead,
+		Update: resourceTencentCloudCvmImagePipelineUpdate,
+		Delete: resourceTencentCloudCvmImagePipelineDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"base_image_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the image to launch the builder instance from.",
+			},
+			"image_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the built image, which is also used for its copies in `destination_regions`.",
+			},
+			"image_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Description of the built image.",
+			},
+			"builder": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Settings of the temporary `POSTPAID_BY_HOUR` instance the image is built on. The instance is always terminated, whether the build succeeds or not.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The available zone for the builder instance.",
+						},
+						"instance_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: tccommon.ValidateInstanceType,
+							Description:  "The type of the builder instance.",
+						},
+						"vpc_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							RequiredWith: []string{"builder.0.subnet_id"},
+							Description:  "The ID of a VPC network for the builder instance. The default VPC is used when it is not set.",
+						},
+						"subnet_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							RequiredWith: []string{"builder.0.vpc_id"},
+							Description:  "The ID of a VPC subnet for the builder instance.",
+						},
+						"security_group_ids": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "A list of security group IDs to associate with the builder instance.",
+						},
+						"system_disk_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      CVM_DISK_TYPE_CLOUD_PREMIUM,
+							ValidateFunc: tccommon.ValidateAllowedStringValue(CVM_DISK_TYPE),
+							Description:  "System disk type of the builder instance. Default is `CLOUD_PREMIUM`.",
+						},
+						"system_disk_size": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     50,
+							Description: "Size of the system disk of the builder instance, and the built image. unit is GB, Default is 50GB.",
+						},
+						"internet_max_bandwidth_out": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "Maximum outgoing bandwidth to the public network of the builder instance, measured in Mbps, charged by traffic. The builder instance has no public IP when it is not set.",
+						},
+					},
+				},
+			},
+			"build_steps": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "TAT commands run in order on the builder instance before the image is created. The build fails on the first step which does not succeed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"command": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Content of the command, in plain text.",
+						},
+						"command_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      svctat.TAT_COMMAND_TYPE_SHELL,
+							ValidateFunc: tccommon.ValidateAllowedStringValue(svctat.TAT_COMMAND_TYPE),
+							Description:  "Type of the command. Valid values: `SHELL`, `POWERSHELL`, `BAT`. Default is `SHELL`.",
+						},
+						"timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      600,
+							ValidateFunc: tccommon.ValidateIntegerInRange(1, 86400),
+							Description:  "Timeout of the command, in seconds. Default is 600.",
+						},
+						"working_directory": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "Working directory of the command.",
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The username used to run the command. By default, the user root is used on Linux and the user System is used on Windows.",
+						},
+					},
+				},
+			},
+			"destination_regions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Regions to copy the built image to. The region of the provider must not be included.",
+			},
+			"share_account_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the accounts to share the built image and its copies with.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values which build the image again when changed, such as the version of the application baked into it.",
+			},
+			"image_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the built image in the region of the provider.",
+			},
+			"image_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the built image and its copies, keyed by region.",
+			},
+		},
+	}
+}
+
+func resourceTencentCloudCvmImagePipelineCreate(d *schema.ResourceData, meta interface{}) (errRet error) {
+	defer tccommon.LogElapsed("resource.tencentcloud_cvm_image_pipeline.create")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	var (
+		logId      = tccommon.GetLogId(tccommon.ContextNil)
+		ctx        = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		client     = meta.(tccommon.ProviderMeta).GetAPIV3Conn()
+		service    = CvmService{client: client}
+		tatService = svctat.NewTatService(client)
+		region     = client.Region
+		deadline   = time.Now().Add(d.Timeout(schema.TimeoutCreate))
+		builderId  string
+	)
+
+	// the builder is terminated on every path, since it is never recorded in the state
+	defer func() {
+		if builderId == "" {
+			return
+		}
+
+		if e := deleteImagePipelineBuilder(ctx, &service, builderId); e != nil {
+			if errRet == nil {
+				errRet = e
+			}
+			errRet = fmt.Errorf("%s, and the builder instance %s is not terminated, please terminate it manually", errRet.Error(), builderId)
+		}
+	}()
+
+	builder := d.Get("builder").([]interface{})[0].(map[string]interface{})
+	instanceId, err := runImagePipelineBuilder(ctx, &service, d.Get("base_image_id").(string), d.Get("image_name").(string), builder)
+	builderId = instanceId
+	if err != nil {
+		return err
+	}
+
+	if err := tatService.WaitForTatAgentOnline(ctx, builderId, time.Until(deadline)); err != nil {
+		return fmt.Errorf("builder instance %s is not ready: %s", builderId, err.Error())
+	}
+
+	for i, item := range d.Get("build_steps").([]interface{}) {
+		if err := runImagePipelineBuildStep(ctx, &tatService, builderId, i, item.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	request := cvm.NewCreateImageRequest()
+	request.InstanceId = helper.String(builderId)
+	request.ImageName = helper.String(d.Get("image_name").(string))
+	request.ForcePoweroff = helper.String(TRUE)
+	if v, ok := d.GetOk("image_description"); ok {
+		request.ImageDescription = helper.String(v.(string))
+	}
+
+	var imageId string
+	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		result, e := client.UseCvmClient().CreateImage(request)
+		if e != nil {
+			return tccommon.RetryError(e, "OperationDenied.InstanceOperationInProgress")
+		} else {
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		}
+
+		if result == nil || result.Response == nil || result.Response.ImageId == nil {
+			return resource.NonRetryableError(fmt.Errorf("create image from builder instance %s failed, image id is nil", builderId))
+		}
+
+		imageId = *result.Response.ImageId
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create image from builder instance %s failed, reason:%+v", logId, builderId, err)
+		return err
+	}
+
+	// the images are recorded as soon as they exist, so that a failed build still deletes them
+	imageIds := map[string]interface{}{region: imageId}
+	d.SetId(imageId)
+	_ = d.Set("image_id", imageId)
+	_ = d.Set("image_ids", imageIds)
+
+	if err := service.WaitForImageNormal(ctx, region, imageId, time.Until(deadline)); err != nil {
+		return err
+	}
+
+	if err := deleteImagePipelineBuilder(ctx, &service, builderId); err != nil {
+		return err
+	}
+	builderId = ""
+
+	if v, ok := d.GetOk("destination_regions"); ok {
+		var copies map[string]string
+		err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			result, e := service.SyncImageToRegions(ctx, imageId, helper.InterfacesStrings(v.(*schema.Set).List()))
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			copies = result
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for copyRegion, copyId := range copies {
+			imageIds[copyRegion] = copyId
+		}
+		_ = d.Set("image_ids", imageIds)
+
+		for copyRegion, copyId := range copies {
+			if err := service.WaitForImageNormal(ctx, copyRegion, copyId, time.Until(deadline)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("share_account_ids"); ok {
+		accountIds := helper.InterfacesStrings(v.(*schema.Set).List())
+		if err := modifyImagePipelineSharePermission(ctx, &service, imageIds, IMAGE_SHARE_PERMISSION_SHARE, accountIds); err != nil {
+			return err
+		}
+	}
+
+	return resourceTencentCloudCvmImagePipelineRead(d, meta)
+}
+
+func resourceTencentCloudCvmImagePipelineRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cvm_image_pipeline.read")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		client  = meta.(tccommon.ProviderMeta).GetAPIV3Conn()
+		service = CvmService{client: client}
+	)
+
+	imageIds := make(map[string]interface{})
+	for imageRegion, imageId := range d.Get("image_ids").(map[string]interface{}) {
+		var image *cvm.Image
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			result, e := service.DescribeImageByRegion(ctx, imageRegion, imageId.(string))
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			image = result
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if image == nil {
+			log.Printf("[WARN]%s image [%s] of pipeline [%s] in region [%s] not found, drop it.\n", logId, imageId, d.Id(), imageRegion)
+			continue
+		}
+
+		imageIds[imageRegion] = imageId
+	}
+
+	if len(imageIds) == 0 {
+		log.Printf("[WARN]%s images of pipeline [%s] not found, please check if them have been deleted.\n", logId, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("image_id", imageIds[client.Region])
+	_ = d.Set("image_ids", imageIds)
+
+	if imageId, ok := imageIds[client.Region]; ok {
+		var accountIds []string
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			result, e := service.DescribeImageSharedAccountsByRegion(ctx, client.Region, imageId.(string))
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			accountIds = result
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		_ = d.Set("share_account_ids", accountIds)
+	}
+
+	return nil
+}
+
+func resourceTencentCloudCvmImagePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cvm_image_pipeline.update")()
+
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = CvmService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	)
+
+	if d.HasChange("share_account_ids") {
+		o, n := d.GetChange("share_account_ids")
+		oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+		imageIds := d.Get("image_ids").(map[string]interface{})
+
+		if removed := helper.InterfacesStrings(oldSet.Difference(newSet).List()); len(removed) > 0 {
+			if err := modifyImagePipelineSharePermission(ctx, &service, imageIds, IMAGE_SHARE_PERMISSION_CANCEL, removed); err != nil {
+				return err
+			}
+		}
+
+		if added := helper.InterfacesStrings(newSet.Difference(oldSet).List()); len(added) > 0 {
+			if err := modifyImagePipelineSharePermission(ctx, &service, imageIds, IMAGE_SHARE_PERMISSION_SHARE, added); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceTencentCloudCvmImagePipelineRead(d, meta)
+}
+
+func resourceTencentCloudCvmImagePipelineDelete(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cvm_image_pipeline.delete")()
+
+	var (
+		logId   = tccommon.GetLogId(tccommon.ContextNil)
+		ctx     = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service = CvmService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	)
+
+	imageIds := d.Get("image_ids").(map[string]interface{})
+
+	// shared images can not be deleted
+	if accountIds := helper.InterfacesStrings(d.Get("share_account_ids").(*schema.Set).List()); len(accountIds) > 0 {
+		if err := modifyImagePipelineSharePermission(ctx, &service, imageIds, IMAGE_SHARE_PERMISSION_CANCEL, accountIds); err != nil {
+			return err
+		}
+	}
+
+	for imageRegion, imageId := range imageIds {
+		imageRegion, imageId := imageRegion, imageId.(string)
+		err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+			image, e := service.DescribeImageByRegion(ctx, imageRegion, imageId)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			if image == nil {
+				return nil
+			}
+
+			// the image can not be deleted until it finishes creating or synchronizing
+			if state := helper.PString(image.ImageState); state == IMAGE_STATE_CREATING || state == IMAGE_STATE_SYNCING {
+				return resource.RetryableError(fmt.Errorf("image %s in region %s is %s, retry...", imageId, imageRegion, state))
+			}
+
+			if e := service.DeleteImageByRegion(ctx, imageRegion, imageId); e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			return resource.RetryableError(fmt.Errorf("image %s in region %s is still deleting, retry...", imageId, imageRegion))
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runImagePipelineBuilder launches the builder instance and waits for it to be running. The ID of the instance is
+// returned along with the error once it is launched, so that it is terminated.
+func runImagePipelineBuilder(ctx context.Context, service *CvmService, imageId, imageName string, builder map[string]interface{}) (instanceId string, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	request := cvm.NewRunInstancesRequest()
+	request.ImageId = helper.String(imageId)
+	request.InstanceName = helper.String(imageName + "-builder")
+	request.InstanceChargeType = helper.String(CVM_CHARGE_TYPE_POSTPAID)
+	request.InstanceType = helper.String(builder["instance_type"].(string))
+	request.Placement = &cvm.Placement{Zone: helper.String(builder["availability_zone"].(string))}
+	request.SystemDisk = &cvm.SystemDisk{
+		DiskType: helper.String(builder["system_disk_type"].(string)),
+		DiskSize: helper.IntInt64(builder["system_disk_size"].(int)),
+	}
+
+	if v, ok := builder["vpc_id"].(string); ok && v != "" {
+		request.VirtualPrivateCloud = &cvm.VirtualPrivateCloud{
+			VpcId:    helper.String(v),
+			SubnetId: helper.String(builder["subnet_id"].(string)),
+		}
+	}
+
+	if v, ok := builder["security_group_ids"].([]interface{}); ok && len(v) > 0 {
+		request.SecurityGroupIds = helper.InterfacesStringsPoint(v)
+	}
+
+	if v, ok := builder["internet_max_bandwidth_out"].(int); ok && v > 0 {
+		request.InternetAccessible = &cvm.InternetAccessible{
+			InternetChargeType:      helper.String(CVM_INTERNET_CHARGE_TYPE_TRAFFIC_POSTPAID),
+			InternetMaxBandwidthOut: helper.IntInt64(v),
+			PublicIpAssigned:        helper.Bool(true),
+		}
+	}
+
+	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		result, e := service.client.UseCvmClient().RunInstances(request)
+		if e != nil {
+			return tccommon.RetryError(e)
+		} else {
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		}
+
+		if result == nil || result.Response == nil || len(result.Response.InstanceIdSet) < 1 {
+			return resource.NonRetryableError(fmt.Errorf("run builder instance failed, instance id is nil"))
+		}
+
+		instanceId = *result.Response.InstanceIdSet[0]
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s run builder instance failed, reason:%+v", logId, err)
+		errRet = err
+		return
+	}
+
+	errRet = resource.Retry(10*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		instance, e := service.DescribeInstanceById(ctx, instanceId)
+		if e != nil {
+			return tccommon.RetryError(e, tccommon.InternalError)
+		}
+
+		if instance == nil {
+			return resource.RetryableError(fmt.Errorf("builder instance %s is not found, retry...", instanceId))
+		}
+
+		if *instance.InstanceState == CVM_STATUS_LAUNCH_FAILED {
+			return resource.NonRetryableError(fmt.Errorf("builder instance %s launch failed. Error msg: %s", instanceId, helper.PString(instance.LatestOperationErrorMsg)))
+		}
+
+		if *instance.InstanceState == CVM_STATUS_RUNNING {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("builder instance status is %s, retry...", *instance.InstanceState))
+	})
+
+	return
+}
+
+// runImagePipelineBuildStep runs a build step on the builder instance, failing with its output when it does not succeed.
+func runImagePipelineBuildStep(ctx context.Context, tatService *svctat.TatService, instanceId string, index int, step map[string]interface{}) error {
+	timeout := step["timeout"].(int)
+	request := tat.NewRunCommandRequest()
+	request.Content = helper.String(base64.StdEncoding.EncodeToString([]byte(step["command"].(string))))
+	request.CommandType = helper.String(step["command_type"].(string))
+	request.InstanceIds = []*string{helper.String(instanceId)}
+	request.Timeout = helper.IntUint64(timeout)
+	request.SaveCommand = helper.Bool(false)
+	if v, ok := step["working_directory"].(string); ok && v != "" {
+		request.WorkingDirectory = helper.String(v)
+	}
+
+	if v, ok := step["username"].(string); ok && v != "" {
+		request.Username = helper.String(v)
+	}
+
+	var invocationId string
+	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		result, e := tatService.RunTatCommand(ctx, request)
+		if e != nil {
+			return tccommon.RetryError(e)
+		}
+
+		invocationId = result
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// TAT times the command out itself, the extra minute is for the task to be reported
+	task, err := tatService.WaitForTatInvocationTask(ctx, invocationId, instanceId, time.Duration(timeout)*time.Second+time.Minute)
+	if err != nil {
+		return fmt.Errorf("build step %d: %s", index, err.Error())
+	}
+
+	if *task.TaskStatus == svctat.TAT_TASK_STATUS_SUCCESS {
+		return nil
+	}
+
+	exitCode := int64(-1)
+	if task.TaskResult != nil && task.TaskResult.ExitCode != nil {
+		exitCode = *task.TaskResult.ExitCode
+	}
+
+	return fmt.Errorf("build step %d finished as %s with exit code %d, output:\n%s", index, *task.TaskStatus, exitCode, svctat.TatInvocationTaskOutput(task))
+}
+
+// deleteImagePipelineBuilder terminates the builder instance and waits for it to be gone.
+func deleteImagePipelineBuilder(ctx context.Context, service *CvmService, instanceId string) error {
+	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		e := service.DeleteInstance(ctx, instanceId)
+		if e != nil {
+			if ee, ok := e.(*sdkErrors.TencentCloudSDKError); ok && ee.Code == "InvalidInstanceState.Terminating" {
+				return nil
+			}
+
+			return tccommon.RetryError(e, "OperationDenied.InstanceOperationInProgress")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(5*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		instance, e := service.DescribeInstanceById(ctx, instanceId)
+		if e != nil {
+			return tccommon.RetryError(e, tccommon.InternalError)
+		}
+
+		if instance == nil {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("builder instance status is %s, retry...", *instance.InstanceState))
+	})
+}
+
+// modifyImagePipelineSharePermission shares the images in every region with the accounts, or cancels the sharing.
+func modifyImagePipelineSharePermission(ctx context.Context, service *CvmService, imageIds map[string]interface{}, permission string, accountIds []string) error {
+	regions := make([]string, 0, len(imageIds))
+	for imageRegion := range imageIds {
+		regions = append(regions, imageRegion)
+	}
+	sort.Strings(regions)
+
+	for _, imageRegion := range regions {
+		imageId := imageIds[imageRegion].(string)
+		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			e := service.ModifyImageSharePermissionByRegion(ctx, imageRegion, imageId, permission, accountIds)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
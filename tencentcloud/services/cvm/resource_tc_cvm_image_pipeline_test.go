@@ -0,0 +1,68 @@
+package cvm_test
+
+import (
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// go test -i; go test -test.run TestAccTencentCloudCvmImagePipelineResource_basic -v
+func TestAccTencentCloudCvmImagePipelineResource_basic(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			tcacctest.AccPreCheck(t)
+		},
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCvmImagePipeline(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tencentcloud_cvm_image_pipeline.example", "id"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cvm_image_pipeline.example", "image_id"),
+					resource.TestCheckResourceAttr("tencentcloud_cvm_image_pipeline.example", "image_ids.%", "2"),
+					resource.TestCheckResourceAttrSet("tencentcloud_cvm_image_pipeline.example", "image_ids.ap-shanghai"),
+					resource.TestCheckResourceAttr("tencentcloud_cvm_image_pipeline.example", "share_account_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCvmImagePipeline(t *testing.T) string {
+	return tcacctest.FixtureVpcVariable(t) + `
+data "tencentcloud_images" "base" {
+  image_type       = ["PUBLIC_IMAGE"]
+  image_name_regex = "OpenCloudOS Server"
+}
+
+data "tencentcloud_instance_types" "builder" {
+  availability_zone    = var.availability_zone
+  min_cpu_core_count   = 2
+  min_memory_size      = 4
+  instance_charge_type = "POSTPAID_BY_HOUR"
+  exclude_sold_out     = true
+  sort_by_price        = true
+}
+
+resource "tencentcloud_cvm_image_pipeline" "example" {
+  base_image_id = data.tencentcloud_images.base.images.0.image_id
+  image_name    = "tf-example-image-pipeline"
+
+  builder {
+    availability_zone = var.availability_zone
+    instance_type     = data.tencentcloud_instance_types.builder.instance_types.0.instance_type
+    vpc_id            = var.vpc_id
+    subnet_id         = var.subnet_id
+  }
+
+  build_steps {
+    command = "echo built by terraform > /etc/motd"
+  }
+
+  destination_regions = ["ap-shanghai"]
+}
+`
+}
@@ -15,9 +15,10 @@ import (
 
 func ResourceTencentCloudCvmLaunchTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmLaunchTemplateCreate,
-		Read:   resourceTencentCloudCvmLaunchTemplateRead,
-		Delete: resourceTencentCloudCvmLaunchTemplateDelete,
+		Create:   resourceTencentCloudCvmLaunchTemplateCreate,
+		Read:     resourceTencentCloudCvmLaunchTemplateRead,
+		Delete:   resourceTencentCloudCvmLaunchTemplateDelete,
+		Importer: helper.IdSpec{"launch_template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"launch_template_name": {
 				Required:    true,
@@ -273,6 +274,7 @@ func ResourceTencentCloudCvmLaunchTemplate() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Description: "The login password of instance.",
 						},
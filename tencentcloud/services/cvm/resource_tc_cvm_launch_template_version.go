@@ -18,12 +18,10 @@ import (
 
 func ResourceTencentCloudCvmLaunchTemplateVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmLaunchTemplateVersionCreate,
-		Read:   resourceTencentCloudCvmLaunchTemplateVersionRead,
-		Delete: resourceTencentCloudCvmLaunchTemplateVersionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCvmLaunchTemplateVersionCreate,
+		Read:     resourceTencentCloudCvmLaunchTemplateVersionRead,
+		Delete:   resourceTencentCloudCvmLaunchTemplateVersionDelete,
+		Importer: helper.IdSpec{"launch_template_id", "launch_template_version_number"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"placement": {
 				Required:    true,
@@ -339,6 +337,7 @@ func ResourceTencentCloudCvmLaunchTemplateVersion() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Computed:    true,
 							ForceNew:    true,
@@ -16,6 +16,7 @@ func ResourceTencentCloudCvmRebootInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudCvmRebootInstanceCreate,
 		Read:   resourceTencentCloudCvmRebootInstanceRead,
+		Update: resourceTencentCloudCvmRebootInstanceUpdate,
 		Delete: resourceTencentCloudCvmRebootInstanceDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -42,6 +43,9 @@ func ResourceTencentCloudCvmRebootInstance() *schema.Resource {
 				ConflictsWith: []string{"force_reboot"},
 				Description:   "Shutdown type. Valid values: `SOFT`: soft shutdown; `HARD`: hard shutdown; `SOFT_FIRST`: perform a soft shutdown first, and perform a hard shutdown if the soft shutdown fails. Default value: SOFT.",
 			},
+
+			tccommon.OPERATION_TRIGGERS:            tccommon.OperationTriggersSchema(),
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -82,6 +86,12 @@ func resourceTencentCloudCvmRebootInstanceCreate(d *schema.ResourceData, meta in
 
 	d.SetId(instanceId)
 
+	if tccommon.OperationWaitForCompletion(d) {
+		if err := waitForOperationFinished(d, meta, 2*tccommon.ReadRetryTimeout, CVM_LATEST_OPERATION_STATE_OPERATING, false); err != nil {
+			return err
+		}
+	}
+
 	return resourceTencentCloudCvmRebootInstanceRead(d, meta)
 }
 
@@ -92,6 +102,14 @@ func resourceTencentCloudCvmRebootInstanceRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceTencentCloudCvmRebootInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cvm_reboot_instance.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudCvmRebootInstanceRead(d, meta)
+}
+
 func resourceTencentCloudCvmRebootInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cvm_reboot_instance.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
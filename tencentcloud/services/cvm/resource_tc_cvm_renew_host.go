@@ -46,6 +46,8 @@ func ResourceTencentCloudCvmRenewHost() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
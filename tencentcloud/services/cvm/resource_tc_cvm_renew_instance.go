@@ -61,6 +61,8 @@ func ResourceTencentCloudCvmRenewInstance() *schema.Resource {
 					"- `FALSE`: Indicates that the subscription instance will be renewed and the elastic data disk attached to it will not be renewed\n" +
 					"Default value: TRUE.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
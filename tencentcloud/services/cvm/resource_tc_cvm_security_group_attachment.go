@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,12 +16,10 @@ import (
 
 func ResourceTencentCloudCvmSecurityGroupAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCvmSecurityGroupAttachmentCreate,
-		Read:   resourceTencentCloudCvmSecurityGroupAttachmentRead,
-		Delete: resourceTencentCloudCvmSecurityGroupAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCvmSecurityGroupAttachmentCreate,
+		Read:     resourceTencentCloudCvmSecurityGroupAttachmentRead,
+		Delete:   resourceTencentCloudCvmSecurityGroupAttachmentDelete,
+		Importer: helper.IdSpec{"instance_id", "security_group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
 				Required:    true,
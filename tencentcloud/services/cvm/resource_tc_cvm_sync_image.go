@@ -17,6 +17,7 @@ func ResourceTencentCloudCvmSyncImage() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudCvmSyncImageCreate,
 		Read:   resourceTencentCloudCvmSyncImageRead,
+		Update: resourceTencentCloudCvmSyncImageUpdate,
 		Delete: resourceTencentCloudCvmSyncImageDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -57,6 +58,10 @@ func ResourceTencentCloudCvmSyncImage() *schema.Resource {
 				Type:        schema.TypeBool,
 				Description: "Whether to return the ID of image created in the destination region.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -106,12 +111,14 @@ func resourceTencentCloudCvmSyncImageCreate(d *schema.ResourceData, meta interfa
 
 	d.SetId(imageId)
 
-	service := CvmService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := CvmService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"NORMAL"}, 20*tccommon.ReadRetryTimeout, time.Second, service.CvmSyncImagesStateRefreshFunc(d.Id(), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"NORMAL"}, 20*tccommon.ReadRetryTimeout, time.Second, service.CvmSyncImagesStateRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudCvmSyncImageRead(d, meta)
@@ -124,6 +131,14 @@ func resourceTencentCloudCvmSyncImageRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourceTencentCloudCvmSyncImageUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cvm_sync_image.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudCvmSyncImageRead(d, meta)
+}
+
 func resourceTencentCloudCvmSyncImageDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cvm_sync_image.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -18,6 +18,7 @@ func ResourceTencentCloudEipAddressTransform() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudEipAddressTransformCreate,
 		Read:   resourceTencentCloudEipAddressTransformRead,
+		Update: resourceTencentCloudEipAddressTransformUpdate,
 		Delete: resourceTencentCloudEipAddressTransformDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -27,6 +28,10 @@ func ResourceTencentCloudEipAddressTransform() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "the instance ID of a normal public network IP to be operated. eg:ins-23mk45jn.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -65,12 +70,14 @@ func resourceTencentCloudEipAddressTransformCreate(d *schema.ResourceData, meta
 	taskId := *response.Response.TaskId
 	d.SetId(instanceId)
 
-	service := svcvpc.NewVpcService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
+	if tccommon.OperationWaitForCompletion(d) {
+		service := svcvpc.NewVpcService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 1*tccommon.ReadRetryTimeout, time.Second, service.VpcIpv6AddressStateRefreshFunc(helper.UInt64ToStr(taskId), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 1*tccommon.ReadRetryTimeout, time.Second, service.VpcIpv6AddressStateRefreshFunc(helper.UInt64ToStr(taskId), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudEipAddressTransformRead(d, meta)
@@ -83,6 +90,14 @@ func resourceTencentCloudEipAddressTransformRead(d *schema.ResourceData, meta in
 	return nil
 }
 
+func resourceTencentCloudEipAddressTransformUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_eip_address_transform.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudEipAddressTransformRead(d, meta)
+}
+
 func resourceTencentCloudEipAddressTransformDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_eip_address_transform.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
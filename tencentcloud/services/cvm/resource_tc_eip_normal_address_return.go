@@ -25,6 +25,8 @@ func ResourceTencentCloudEipNormalAddressReturn() *schema.Resource {
 				},
 				Description: "The IP address of the EIP, example: 101.35.139.183.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -18,6 +18,7 @@ func ResourceTencentCloudEipPublicAddressAdjust() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudEipPublicAddressAdjustCreate,
 		Read:   resourceTencentCloudEipPublicAddressAdjustRead,
+		Update: resourceTencentCloudEipPublicAddressAdjustUpdate,
 		Delete: resourceTencentCloudEipPublicAddressAdjustDelete,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -32,6 +33,10 @@ func ResourceTencentCloudEipPublicAddressAdjust() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "A unique ID that identifies an EIP instance. The unique ID of EIP is in the form:`eip-erft45fu`.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -76,10 +81,12 @@ func resourceTencentCloudEipPublicAddressAdjustCreate(d *schema.ResourceData, me
 		return err
 	}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 1*tccommon.ReadRetryTimeout, time.Second, service.VpcIpv6AddressStateRefreshFunc(helper.UInt64ToStr(taskId), []string{}))
+	if tccommon.OperationWaitForCompletion(d) {
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 1*tccommon.ReadRetryTimeout, time.Second, service.VpcIpv6AddressStateRefreshFunc(helper.UInt64ToStr(taskId), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	d.SetId(instanceId + tccommon.FILED_SP + addressId)
@@ -93,6 +100,14 @@ func resourceTencentCloudEipPublicAddressAdjustRead(d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceTencentCloudEipPublicAddressAdjustUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_eip_public_address_adjust.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudEipPublicAddressAdjustRead(d, meta)
+}
+
 func resourceTencentCloudEipPublicAddressAdjustDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_eip_public_address_adjust.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
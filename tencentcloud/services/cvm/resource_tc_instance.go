@@ -15,6 +15,7 @@ import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	svccbs "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cbs"
 	svctag "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tag"
+	svctat "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tat"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -22,8 +23,10 @@ import (
 	cbs "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cbs/v20170312"
 	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -39,17 +42,50 @@ func ResourceTencentCloudInstance() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
 		},
+		CustomizeDiff: resourceTencentCloudInstanceCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"image_id": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The image to use for the instance. Modifications may lead to the reinstallation of the instance's operating system..",
+				Optional:    true,
+				Computed:    true,
+				Description: "The image to use for the instance. Modifications may lead to the reinstallation of the instance's operating system. Required unless `launch_template` provides it.",
 			},
 			"availability_zone": {
-				Type:        schema.TypeString,
-				Required:    true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: instanceTypeFallbackDiffSuppress("availability_zone"),
+				Description:      "The available zone for the CVM instance. Required unless `launch_template` provides it.",
+			},
+			"launch_template": {
+				Type:        schema.TypeList,
+				Optional:    true,
 				ForceNew:    true,
-				Description: "The available zone for the CVM instance.",
+				MaxItems:    1,
+				Description: "The launch template to create the instance from. Arguments set explicitly override the template, while arguments with a default value, such as `instance_name` and `system_disk_type`, only override it when they are set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "ID of the launch template.",
+						},
+						"version": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Description: "Version of the launch template. Defaults to the default version of the template at creation, which is recorded here.",
+						},
+						"default_version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The current default version of the launch template. It differs from `version` when the template has moved on since the instance was created.",
+						},
+					},
+				},
 			},
 			"dedicated_cluster_id": {
 				Type:        schema.TypeString,
@@ -65,18 +101,84 @@ func ResourceTencentCloudInstance() *schema.Resource {
 				Description:  "The number of instances to be purchased. Value range:[1,100]; default value: 1.",
 			},
 			"instance_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "Terraform-CVM-Instance",
-				ValidateFunc: tccommon.ValidateStringLengthInRange(2, 128),
-				Description:  "The name of the instance. The max length of instance_name is 128, and default value is `Terraform-CVM-Instance`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "Terraform-CVM-Instance",
+				ValidateFunc:     tccommon.ValidateStringLengthInRange(2, 128),
+				Description:      "The name of the instance. The max length of instance_name is 128, and default value is `Terraform-CVM-Instance`.",
+				DiffSuppressFunc: launchTemplateDefaultDiffSuppress,
 			},
 			"instance_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ValidateFunc: tccommon.ValidateInstanceType,
-				Description:  "The type of the instance.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     tccommon.ValidateInstanceType,
+				DiffSuppressFunc: instanceTypeFallbackDiffSuppress("instance_type"),
+				Description:      "The type of the instance. When launched from one of `instance_type_fallbacks`, this is the type actually launched.",
+			},
+			"instance_type_fallbacks": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Ordered fallbacks to try in turn when `instance_type`, or spot capacity, is sold out at creation. It only applies at creation, and the instance launched from a fallback, recorded in `launched_fallback`, is not replaced back to `instance_type` later unless `instance_type` is changed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: tccommon.ValidateInstanceType,
+							Description:  "The type of the instance to fall back to.",
+						},
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The available zone to fall back to. Defaults to `availability_zone`.",
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of a VPC subnet to fall back to, which must be set along with a different `availability_zone`. Defaults to `subnet_id`.",
+						},
+					},
+				},
+			},
+			"launched_fallback": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The fallback of `instance_type_fallbacks` the instance was launched from. Empty when it was launched as configured.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the instance launched, empty when the fallback kept the configured one.",
+						},
+						"availability_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The available zone launched in, empty when the fallback kept the configured one.",
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the VPC subnet launched in, empty when the fallback kept the configured one.",
+						},
+						"configured_instance_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The configured `instance_type` the fallback replaced.",
+						},
+						"configured_availability_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The configured `availability_zone` the fallback replaced.",
+						},
+						"configured_subnet_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The configured `subnet_id` the fallback replaced.",
+						},
+					},
+				},
 			},
 			"hostname": {
 				Type:        schema.TypeString,
@@ -84,10 +186,46 @@ func ResourceTencentCloudInstance() *schema.Resource {
 				Description: "The hostname of the instance. Windows instance: The name should be a combination of 2 to 15 characters comprised of letters (case insensitive), numbers, and hyphens (-). Period (.) is not supported, and the name cannot be a string of pure numbers. Other types (such as Linux) of instances: The name should be a combination of 2 to 60 characters, supporting multiple periods (.). The piece between two periods is composed of letters (case insensitive), numbers, and hyphens (-). Modifications may lead to the reinstallation of the instance's operating system.",
 			},
 			"project_id": {
-				Type:        schema.TypeInt,
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				Description:      "The project the instance belongs to, default to 0.",
+				DiffSuppressFunc: launchTemplateDefaultDiffSuppress,
+			},
+			"wait_for_ready": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				Default:     0,
-				Description: "The project the instance belongs to, default to 0.",
+				MaxItems:    1,
+				Description: "Wait at creation for the instance to be ready through its TAT agent, rather than only for it to be `RUNNING`, such as for cloud-init to finish. It only applies at creation.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tat_agent_online": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether to wait for the TAT agent of the instance to come online. A `command` waits for it regardless. Default is true.",
+						},
+						"command": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Readiness command, run through TAT until it exits with 0, such as `cloud-init status --wait`.",
+						},
+						"command_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      svctat.TAT_COMMAND_TYPE_SHELL,
+							ValidateFunc: tccommon.ValidateAllowedStringValue(svctat.TAT_COMMAND_TYPE),
+							Description:  "Type of `command`. Valid values: `SHELL`, `POWERSHELL`, `BAT`. Default is `SHELL`.",
+						},
+						"timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      600,
+							ValidateFunc: tccommon.ValidateIntegerMin(1),
+							Description:  "How long to wait for the instance to be ready, in seconds. The create fails with the output of `command` when it expires. Default is 600.",
+						},
+					},
+				},
 			},
 			"running_flag": {
 				Type:        schema.TypeBool,
@@ -112,11 +250,12 @@ func ResourceTencentCloudInstance() *schema.Resource {
 			},
 			// payment
 			"instance_charge_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      CVM_CHARGE_TYPE_POSTPAID,
-				ValidateFunc: tccommon.ValidateAllowedStringValue(CVM_CHARGE_TYPE),
-				Description:  "The charge type of instance. Valid values are `PREPAID`, `POSTPAID_BY_HOUR`, `SPOTPAID`, `CDHPAID` and `CDCPAID`. The default is `POSTPAID_BY_HOUR`. Note: TencentCloud International only supports `POSTPAID_BY_HOUR` and `CDHPAID`. `PREPAID` instance may not allow to delete before expired. `SPOTPAID` instance must set `spot_instance_type` and `spot_max_price` at the same time. `CDHPAID` instance must set `cdh_instance_type` and `cdh_host_id`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          CVM_CHARGE_TYPE_POSTPAID,
+				ValidateFunc:     tccommon.ValidateAllowedStringValue(CVM_CHARGE_TYPE),
+				Description:      "The charge type of instance. Valid values are `PREPAID`, `POSTPAID_BY_HOUR`, `SPOTPAID`, `CDHPAID` and `CDCPAID`. The default is `POSTPAID_BY_HOUR`. Note: TencentCloud International only supports `POSTPAID_BY_HOUR` and `CDHPAID`. `PREPAID` instance may not allow to delete before expired. `SPOTPAID` instance must set `spot_instance_type` and `spot_max_price` at the same time. `CDHPAID` instance must set `cdh_instance_type` and `cdh_host_id`.",
+				DiffSuppressFunc: launchTemplateDefaultDiffSuppress,
 			},
 			"instance_charge_type_prepaid_period": {
 				Type:         schema.TypeInt,
@@ -183,11 +322,12 @@ func ResourceTencentCloudInstance() *schema.Resource {
 				Description: "Maximum outgoing bandwidth to the public network, measured in Mbps (Mega bits per second). This value does not need to be set when `allocate_public_ip` is false.",
 			},
 			"allocate_public_ip": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				ForceNew:    true,
-				Description: "Associate a public IP address with an instance in a VPC or Classic. Boolean value, Default is false.",
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          false,
+				ForceNew:         true,
+				Description:      "Associate a public IP address with an instance in a VPC or Classic. Boolean value, Default is false.",
+				DiffSuppressFunc: launchTemplateDefaultDiffSuppress,
 			},
 			// vpc
 			"vpc_id": {
@@ -197,10 +337,11 @@ func ResourceTencentCloudInstance() *schema.Resource {
 				Description: "The ID of a VPC network. If you want to create instances in a VPC network, this parameter must be set.",
 			},
 			"subnet_id": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "The ID of a VPC subnet. If you want to create instances in a VPC network, this parameter must be set.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: instanceTypeFallbackDiffSuppress("subnet_id"),
+				Description:      "The ID of a VPC subnet. If you want to create instances in a VPC network, this parameter must be set.",
 			},
 			"private_ip": {
 				Type:        schema.TypeString,
@@ -229,17 +370,19 @@ func ResourceTencentCloudInstance() *schema.Resource {
 			},
 			// storage
 			"system_disk_type": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      CVM_DISK_TYPE_CLOUD_PREMIUM,
-				ValidateFunc: tccommon.ValidateAllowedStringValue(CVM_DISK_TYPE),
-				Description:  "System disk type. For more information on limits of system disk types, see [Storage Overview](https://intl.cloud.tencent.com/document/product/213/4952). Valid values: `LOCAL_BASIC`: local disk, `LOCAL_SSD`: local SSD disk, `CLOUD_BASIC`: cloud disk, `CLOUD_SSD`: cloud SSD disk, `CLOUD_PREMIUM`: Premium Cloud Storage, `CLOUD_BSSD`: Basic SSD, `CLOUD_HSSD`: Enhanced SSD, `CLOUD_TSSD`: Tremendous SSD. NOTE: If modified, the instance may force stop.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          CVM_DISK_TYPE_CLOUD_PREMIUM,
+				ValidateFunc:     tccommon.ValidateAllowedStringValue(CVM_DISK_TYPE),
+				Description:      "System disk type. For more information on limits of system disk types, see [Storage Overview](https://intl.cloud.tencent.com/document/product/213/4952). Valid values: `LOCAL_BASIC`: local disk, `LOCAL_SSD`: local SSD disk, `CLOUD_BASIC`: cloud disk, `CLOUD_SSD`: cloud SSD disk, `CLOUD_PREMIUM`: Premium Cloud Storage, `CLOUD_BSSD`: Basic SSD, `CLOUD_HSSD`: Enhanced SSD, `CLOUD_TSSD`: Tremendous SSD. NOTE: If modified, the instance may force stop.",
+				DiffSuppressFunc: launchTemplateDefaultDiffSuppress,
 			},
 			"system_disk_size": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     50,
-				Description: "Size of the system disk. unit is GB, Default is 50GB. If modified, the instance may force stop.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          50,
+				Description:      "Size of the system disk. unit is GB, Default is 50GB. If modified, the instance may force stop.",
+				DiffSuppressFunc: launchTemplateDefaultDiffSuppress,
 			},
 			"system_disk_id": {
 				Type:        schema.TypeString,
@@ -409,10 +552,11 @@ func ResourceTencentCloudInstance() *schema.Resource {
 				Description: "Indicate whether to force delete the instance. Default is `false`. If set true, the instance will be permanently deleted instead of being moved into the recycle bin. Note: only works for `PREPAID` instance.",
 			},
 			"disable_api_termination": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Whether the termination protection is enabled. Default is `false`. If set true, which means that this instance can not be deleted by an API action.",
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          false,
+				Description:      "Whether the termination protection is enabled. Default is `false`. If set true, which means that this instance can not be deleted by an API action.",
+				DiffSuppressFunc: launchTemplateDefaultDiffSuppress,
 			},
 			// role
 			"cam_role_name": {
@@ -481,10 +625,19 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 		cvmService = CvmService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 	)
 
+	_, launchTemplateOk := d.GetOk("launch_template")
 	request := cvm.NewRunInstancesRequest()
-	request.ImageId = helper.String(d.Get("image_id").(string))
-	request.Placement = &cvm.Placement{
-		Zone: helper.String(d.Get("availability_zone").(string)),
+	request.Placement = &cvm.Placement{}
+	if v, ok := d.GetOk("image_id"); ok {
+		request.ImageId = helper.String(v.(string))
+	} else if !launchTemplateOk {
+		return fmt.Errorf("image_id can not be empty without launch_template")
+	}
+
+	if v, ok := d.GetOk("availability_zone"); ok {
+		request.Placement.Zone = helper.String(v.(string))
+	} else if !launchTemplateOk {
+		return fmt.Errorf("availability_zone can not be empty without launch_template")
 	}
 
 	if v, ok := d.GetOk("dedicated_cluster_id"); ok {
@@ -762,41 +915,131 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 		request.TagSpecification = append(request.TagSpecification, &tagSpecification)
 	}
 
-	clientToken := helper.BuildToken()
-	request.ClientToken = &clientToken
+	if launchTemplateOk {
+		applyInstanceLaunchTemplate(d, request)
 
-	instanceId := ""
-	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check("create")
-		response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmClient().RunInstances(request)
-		if err != nil {
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			e, ok := err.(*sdkErrors.TencentCloudSDKError)
-			if ok && tccommon.IsContains(CVM_RETRYABLE_ERROR, e.Code) {
-				return resource.RetryableError(fmt.Errorf("cvm create error: %s, retrying", e.Error()))
+		// launch from a pinned version, which is recorded, so that a later change of the default version of the
+		// template shows up as drift
+		if request.LaunchTemplate.LaunchTemplateVersion == nil {
+			launchTemplateId := *request.LaunchTemplate.LaunchTemplateId
+			var launchTemplate *cvm.LaunchTemplateInfo
+			err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+				result, e := cvmService.DescribeCvmLaunchTemplateById(ctx, launchTemplateId)
+				if e != nil {
+					return tccommon.RetryError(e)
+				}
+
+				launchTemplate = result
+				return nil
+			})
+
+			if err != nil {
+				return err
+			}
+
+			if launchTemplate == nil || launchTemplate.DefaultVersionNumber == nil {
+				return fmt.Errorf("launch template %s not found", launchTemplateId)
 			}
 
-			return tccommon.RetryError(err)
+			request.LaunchTemplate.LaunchTemplateVersion = launchTemplate.DefaultVersionNumber
+			_ = d.Set("launch_template", []interface{}{map[string]interface{}{
+				"id":      launchTemplateId,
+				"version": int(*launchTemplate.DefaultVersionNumber),
+			}})
 		}
+	}
+
+	var (
+		instanceId = ""
+		err        error
+		fallbacks  = d.Get("instance_type_fallbacks").([]interface{})
+		zone       *string
+		subnetId   *string
+	)
+	if request.Placement != nil {
+		zone = request.Placement.Zone
+	}
+
+	if request.VirtualPrivateCloud != nil {
+		subnetId = request.VirtualPrivateCloud.SubnetId
+	}
+
+	configured := map[string]string{
+		"instance_type":     helper.PString(request.InstanceType),
+		"availability_zone": helper.PString(zone),
+		"subnet_id":         helper.PString(subnetId),
+	}
 
-		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
-		if len(response.Response.InstanceIdSet) < 1 {
-			err = fmt.Errorf("instance id is nil")
-			return resource.NonRetryableError(err)
+	attempt := 0
+	for ; ; attempt++ {
+		clientToken := helper.BuildToken()
+		request.ClientToken = &clientToken
+
+		err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			ratelimit.Check("create")
+			response, err := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseCvmClient().RunInstances(request)
+			if err != nil {
+				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+					logId, request.GetAction(), request.ToJsonString(), err.Error())
+				e, ok := err.(*sdkErrors.TencentCloudSDKError)
+				if ok && tccommon.IsContains(CVM_RETRYABLE_ERROR, e.Code) {
+					return resource.RetryableError(fmt.Errorf("cvm create error: %s, retrying", e.Error()))
+				}
+
+				return tccommon.RetryError(err)
+			}
+
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+			if len(response.Response.InstanceIdSet) < 1 {
+				err = fmt.Errorf("instance id is nil")
+				return resource.NonRetryableError(err)
+			}
+
+			instanceId = *response.Response.InstanceIdSet[0]
+			return nil
+		})
+
+		if err == nil {
+			break
 		}
 
-		instanceId = *response.Response.InstanceIdSet[0]
-		return nil
-	})
+		if attempt >= len(fallbacks) || !isInstanceSoldOutError(err) {
+			return err
+		}
 
-	if err != nil {
-		return err
+		fallback := fallbacks[attempt].(map[string]interface{})
+		log.Printf("[DEBUG]%s instance type %s is sold out, falling back to %s", logId, helper.PString(request.InstanceType), fallback["instance_type"].(string))
+		applyInstanceTypeFallback(request, fallback, zone, subnetId)
 	}
 
 	d.SetId(instanceId)
 
+	if attempt > 0 {
+		launched := map[string]string{
+			"instance_type":     helper.PString(request.InstanceType),
+			"availability_zone": "",
+			"subnet_id":         "",
+		}
+		if request.Placement != nil {
+			launched["availability_zone"] = helper.PString(request.Placement.Zone)
+		}
+
+		if request.VirtualPrivateCloud != nil {
+			launched["subnet_id"] = helper.PString(request.VirtualPrivateCloud.SubnetId)
+		}
+
+		fallback := make(map[string]interface{})
+		for key, value := range launched {
+			if value != configured[key] {
+				fallback[key] = value
+				fallback["configured_"+key] = configured[key]
+			}
+		}
+
+		_ = d.Set("launched_fallback", []interface{}{fallback})
+	}
+
 	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
 		if errRet != nil {
@@ -847,6 +1090,14 @@ func resourceTencentCloudInstanceCreate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	if v, ok := d.GetOk("wait_for_ready"); ok {
+		tatService := svctat.NewTatService(meta.(tccommon.ProviderMeta).GetAPIV3Conn())
+		err = waitForInstanceReady(ctx, &tatService, instanceId, v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+
 	if !(d.Get("running_flag").(bool)) {
 		stoppedMode := d.Get("stopped_mode").(string)
 		err = cvmService.StopInstance(ctx, instanceId, stoppedMode)
@@ -996,6 +1247,21 @@ func resourceTencentCloudInstanceRead(d *schema.ResourceData, meta interface{})
 	delete(tags, "tencentcloud:autoscaling:auto-scaling-group-id")
 	_ = d.Set("tags", tags)
 
+	// DescribeInstances does not report the launch template, so the template in the state is kept as launched from
+	if v, ok := d.GetOk("launch_template"); ok {
+		launchTemplate := v.([]interface{})[0].(map[string]interface{})
+		launchTemplateInfo, err := cvmService.DescribeCvmLaunchTemplateById(ctx, launchTemplate["id"].(string))
+		if err != nil {
+			return err
+		}
+
+		if launchTemplateInfo != nil && launchTemplateInfo.DefaultVersionNumber != nil {
+			launchTemplate["default_version"] = int(*launchTemplateInfo.DefaultVersionNumber)
+		}
+
+		_ = d.Set("launch_template", []interface{}{launchTemplate})
+	}
+
 	// set system_disk_name
 	if instance.SystemDisk.DiskId != nil && strings.HasPrefix(*instance.SystemDisk.DiskId, "disk-") {
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
@@ -1899,6 +2165,8 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		if err != nil {
 			return err
 		}
+
+		clearLaunchedFallback(d, "instance_type")
 	}
 
 	if d.HasChange("cdh_instance_type") {
@@ -1921,6 +2189,8 @@ func resourceTencentCloudInstanceUpdate(d *schema.ResourceData, meta interface{}
 		if err != nil {
 			return err
 		}
+
+		clearLaunchedFallback(d, "subnet_id")
 	}
 
 	if d.HasChange("tags") {
@@ -2464,41 +2734,17 @@ func waitForOperationFinished(d *schema.ResourceData, meta interface{}, timeout
 	client := meta.(tccommon.ProviderMeta).GetAPIV3Conn()
 	cvmService := CvmService{client}
 	instanceId := d.Id()
+
+	// an empty state means that the operation has not shown up yet
+	w := waiter.NewWaiter(fmt.Sprintf("instance %s operation", instanceId), []string{state, ""},
+		[]string{CVM_LATEST_OPERATION_STATE_SUCCESS}, []string{CVM_LATEST_OPERATION_STATE_FAILED}, timeout, cvmService.InstanceOperationRefreshFunc(instanceId))
 	// We cannot catch LatestOperationState change immediately after modification returns, we must wait for LatestOperationState update to expected.
 	if !immediately {
-		time.Sleep(time.Second * 10)
-	}
-
-	err := resource.Retry(timeout, func() *resource.RetryError {
-		instance, errRet := cvmService.DescribeInstanceById(ctx, instanceId)
-		if errRet != nil {
-			return tccommon.RetryError(errRet, tccommon.InternalError)
-		}
-
-		if instance == nil {
-			return resource.NonRetryableError(fmt.Errorf("%s not exists", instanceId))
-		}
-
-		if instance.LatestOperationState == nil {
-			return resource.RetryableError(fmt.Errorf("wait for operation update"))
-		}
-
-		if *instance.LatestOperationState == state {
-			return resource.RetryableError(fmt.Errorf("waiting for instance %s operation", instanceId))
-		}
-
-		if *instance.LatestOperationState == CVM_LATEST_OPERATION_STATE_FAILED {
-			return resource.NonRetryableError(fmt.Errorf("failed operation"))
-		}
-
-		return nil
-	})
-
-	if err != nil {
-		return err
+		w.Delay = 10 * time.Second
 	}
 
-	return nil
+	_, err := w.WaitForState(ctx)
+	return err
 }
 
 func waitIpRelease(ctx context.Context, vpcService vpc.VpcService, instance *cvm.Instance) error {
@@ -2544,3 +2790,294 @@ func getDataDiskHash(obj diskHash) string {
 	h.Write([]byte(fmt.Sprintf("%t", obj.encrypt)))
 	return hex.EncodeToString(h.Sum(nil))
 }
+
+func launchTemplateDefaultDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if _, ok := d.GetOk("launch_template"); !ok {
+		return false
+	}
+
+	// arguments with a default value are left to the launch template unless set
+	return !instanceArgumentConfigured(d, k)
+}
+
+func instanceArgumentConfigured(d *schema.ResourceData, key string) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return true
+	}
+
+	return !rawConfig.GetAttr(key).IsNull()
+}
+
+// applyInstanceLaunchTemplate launches the instance from the launch template, leaving the arguments which only carry
+// their default value to the template.
+func applyInstanceLaunchTemplate(d *schema.ResourceData, request *cvm.RunInstancesRequest) {
+	launchTemplate := d.Get("launch_template").([]interface{})[0].(map[string]interface{})
+	request.LaunchTemplate = &cvm.LaunchTemplate{
+		LaunchTemplateId: helper.String(launchTemplate["id"].(string)),
+	}
+
+	if v := launchTemplate["version"].(int); v > 0 {
+		request.LaunchTemplate.LaunchTemplateVersion = helper.IntUint64(v)
+	}
+
+	if !instanceArgumentConfigured(d, "instance_name") {
+		request.InstanceName = nil
+	}
+
+	if !instanceArgumentConfigured(d, "instance_charge_type") {
+		request.InstanceChargeType = nil
+	}
+
+	if !instanceArgumentConfigured(d, "system_disk_type") {
+		request.SystemDisk.DiskType = nil
+	}
+
+	if !instanceArgumentConfigured(d, "system_disk_size") {
+		request.SystemDisk.DiskSize = nil
+	}
+
+	if !instanceArgumentConfigured(d, "allocate_public_ip") {
+		request.InternetAccessible.PublicIpAssigned = nil
+	}
+
+	if !instanceArgumentConfigured(d, "keep_image_login") {
+		request.LoginSettings.KeepImageLogin = nil
+	}
+
+	if !instanceArgumentConfigured(d, "disable_api_termination") {
+		request.DisableApiTermination = nil
+	}
+
+	if !instanceArgumentConfigured(d, "disable_security_service") {
+		request.EnhancedService.SecurityService = nil
+	}
+
+	if !instanceArgumentConfigured(d, "disable_monitor_service") {
+		request.EnhancedService.MonitorService = nil
+	}
+
+	if !instanceArgumentConfigured(d, "disable_automation_service") {
+		request.EnhancedService.AutomationService = nil
+	}
+
+	// empty structs would otherwise override the template with nothing
+	if request.Placement.Zone == nil && request.Placement.ProjectId == nil && request.Placement.HostIds == nil {
+		request.Placement = nil
+	}
+
+	if *request.SystemDisk == (cvm.SystemDisk{}) {
+		request.SystemDisk = nil
+	}
+
+	if *request.InternetAccessible == (cvm.InternetAccessible{}) {
+		request.InternetAccessible = nil
+	}
+
+	if *request.EnhancedService == (cvm.EnhancedService{}) {
+		request.EnhancedService = nil
+	}
+
+	if request.LoginSettings.Password == nil && request.LoginSettings.KeyIds == nil && request.LoginSettings.KeepImageLogin == nil {
+		request.LoginSettings = nil
+	}
+}
+
+func isInstanceSoldOutError(err error) bool {
+	e, ok := err.(*sdkErrors.TencentCloudSDKError)
+	return ok && tccommon.IsContains(CVM_SOLD_OUT_ERROR, e.Code)
+}
+
+// resourceTencentCloudInstanceCustomizeDiff checks `instance_type_fallbacks`, skipping the values only known at apply.
+func resourceTencentCloudInstanceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	zone := ""
+	if d.NewValueKnown("availability_zone") {
+		zone = d.Get("availability_zone").(string)
+	}
+
+	for i, v := range d.Get("instance_type_fallbacks").([]interface{}) {
+		prefix := fmt.Sprintf("instance_type_fallbacks.%d.", i)
+		if v == nil || !d.NewValueKnown(prefix+"subnet_id") || !d.NewValueKnown(prefix+"availability_zone") {
+			continue
+		}
+
+		if err := checkInstanceTypeFallback(i, v.(map[string]interface{}), zone); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkInstanceTypeFallback requires a fallback `subnet_id` to come with an `availability_zone` other than the
+// configured zone, since a VPC subnet belongs to a single zone.
+func checkInstanceTypeFallback(index int, fallback map[string]interface{}, zone string) error {
+	if fallback["subnet_id"].(string) == "" {
+		return nil
+	}
+
+	fallbackZone := fallback["availability_zone"].(string)
+	if fallbackZone == "" {
+		return fmt.Errorf("`instance_type_fallbacks.%d.subnet_id` must be set along with `availability_zone`", index)
+	}
+
+	if fallbackZone == zone {
+		return fmt.Errorf("`instance_type_fallbacks.%d.availability_zone` must differ from `availability_zone` %s when `subnet_id` is set", index, zone)
+	}
+
+	return nil
+}
+
+// applyInstanceTypeFallback switches the request to fallback, keeping the configured zone and subnet unless it
+// overrides them.
+func applyInstanceTypeFallback(request *cvm.RunInstancesRequest, fallback map[string]interface{}, zone, subnetId *string) {
+	request.InstanceType = helper.String(fallback["instance_type"].(string))
+	if v := fallback["availability_zone"].(string); v != "" {
+		zone = helper.String(v)
+	}
+
+	if v := fallback["subnet_id"].(string); v != "" {
+		subnetId = helper.String(v)
+	}
+
+	if zone != nil {
+		if request.Placement == nil {
+			request.Placement = &cvm.Placement{}
+		}
+
+		request.Placement.Zone = zone
+	}
+
+	if subnetId != nil {
+		if request.VirtualPrivateCloud == nil {
+			request.VirtualPrivateCloud = &cvm.VirtualPrivateCloud{}
+		}
+
+		request.VirtualPrivateCloud.SubnetId = subnetId
+	}
+}
+
+// instanceTypeFallbackDiffSuppress keeps an instance launched from one of `instance_type_fallbacks` as is, rather
+// than changing key back to the configured value the fallback replaced. Any other change of key is still planned.
+func instanceTypeFallbackDiffSuppress(key string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if old == "" || new == "" {
+			return false
+		}
+
+		launched := d.Get("launched_fallback").([]interface{})
+		if len(launched) == 0 || launched[0] == nil {
+			return false
+		}
+
+		fallback := launched[0].(map[string]interface{})
+		return fallback[key] == old && fallback["configured_"+key] == new
+	}
+}
+
+// clearLaunchedFallback forgets the fallback of key in `launched_fallback` once key is changed to its configured value.
+func clearLaunchedFallback(d *schema.ResourceData, key string) {
+	launched := d.Get("launched_fallback").([]interface{})
+	if len(launched) == 0 || launched[0] == nil {
+		return
+	}
+
+	fallback := launched[0].(map[string]interface{})
+	fallback[key] = ""
+	fallback["configured_"+key] = ""
+	_ = d.Set("launched_fallback", []interface{}{fallback})
+}
+
+// waitForInstanceReady waits for the TAT agent of the instance to come online, then runs the readiness command of
+// `wait_for_ready` until it succeeds, failing with its last output on timeout.
+func waitForInstanceReady(ctx context.Context, tatService *svctat.TatService, instanceId string, readiness map[string]interface{}) error {
+	logId := tccommon.GetLogId(ctx)
+	timeout := time.Duration(readiness["timeout"].(int)) * time.Second
+	deadline := time.Now().Add(timeout)
+	command := readiness["command"].(string)
+
+	if readiness["tat_agent_online"].(bool) || command != "" {
+		if err := tatService.WaitForTatAgentOnline(ctx, instanceId, timeout); err != nil {
+			return fmt.Errorf("instance %s is not ready: %s", instanceId, err.Error())
+		}
+	}
+
+	if command == "" {
+		return nil
+	}
+
+	var (
+		lastTask         *tat.InvocationTask
+		lastInvocationId string
+	)
+	for time.Until(deadline) > 0 {
+		remaining := time.Until(deadline)
+		request := tat.NewRunCommandRequest()
+		request.Content = helper.String(base64.StdEncoding.EncodeToString([]byte(command)))
+		request.CommandType = helper.String(readiness["command_type"].(string))
+		request.InstanceIds = []*string{helper.String(instanceId)}
+		request.Timeout = helper.Int64Uint64(int64(remaining.Seconds()) + 1)
+		request.SaveCommand = helper.Bool(false)
+
+		var invocationId string
+		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			result, e := tatService.RunTatCommand(ctx, request)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			invocationId = result
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		lastInvocationId = invocationId
+		task, err := tatService.WaitForTatInvocationTask(ctx, invocationId, instanceId, remaining)
+		if err != nil {
+			if time.Until(deadline) > 0 {
+				return err
+			}
+
+			// out of time while the command still runs
+			break
+		}
+
+		if *task.TaskStatus == svctat.TAT_TASK_STATUS_SUCCESS {
+			return nil
+		}
+
+		lastTask = task
+		log.Printf("[DEBUG]%s readiness command of instance %s finished as %s, retrying", logId, instanceId, *task.TaskStatus)
+		if wait := time.Until(deadline); wait > 10*time.Second {
+			time.Sleep(10 * time.Second)
+		} else if wait > 0 {
+			time.Sleep(wait)
+		}
+	}
+
+	// the latest run may still be going, its output so far is more telling than the previous result
+	if lastInvocationId != "" && (lastTask == nil || lastTask.InvocationId == nil || *lastTask.InvocationId != lastInvocationId) {
+		task, err := tatService.DescribeTatInvocationTask(ctx, lastInvocationId, instanceId)
+		if err != nil {
+			log.Printf("[WARN]%s describe readiness command %s of instance %s failed: %s", logId, lastInvocationId, instanceId, err.Error())
+		} else if task != nil && task.TaskStatus != nil {
+			return fmt.Errorf("instance %s is not ready after %s, the readiness command is still %s, output so far:\n%s",
+				instanceId, timeout, *task.TaskStatus, svctat.TatInvocationTaskOutput(task))
+		}
+	}
+
+	if lastTask == nil {
+		return fmt.Errorf("instance %s is not ready after %s, the readiness command has not finished", instanceId, timeout)
+	}
+
+	exitCode := int64(-1)
+	if lastTask.TaskResult != nil && lastTask.TaskResult.ExitCode != nil {
+		exitCode = *lastTask.TaskResult.ExitCode
+	}
+
+	return fmt.Errorf("instance %s is not ready after %s, the readiness command last finished as %s with exit code %d, output:\n%s",
+		instanceId, timeout, *lastTask.TaskStatus, exitCode, svctat.TatInvocationTaskOutput(lastTask))
+}
@@ -0,0 +1,80 @@
+package cvm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	sdkErrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func newInstanceTypeFallback(instanceType, zone, subnetId string) map[string]interface{} {
+	return map[string]interface{}{
+		"instance_type":     instanceType,
+		"availability_zone": zone,
+		"subnet_id":         subnetId,
+	}
+}
+
+func TestIsInstanceSoldOutError(t *testing.T) {
+	assert.True(t, isInstanceSoldOutError(sdkErrors.NewTencentCloudSDKError("ResourceInsufficient.SpecifiedInstanceType", "sold out", "request-id")))
+	assert.True(t, isInstanceSoldOutError(sdkErrors.NewTencentCloudSDKError("ResourcesSoldOut.AvailableZone", "sold out", "request-id")))
+	assert.False(t, isInstanceSoldOutError(sdkErrors.NewTencentCloudSDKError("InvalidParameterValue", "invalid", "request-id")))
+	assert.False(t, isInstanceSoldOutError(fmt.Errorf("ResourceInsufficient.SpecifiedInstanceType")))
+	assert.False(t, isInstanceSoldOutError(nil))
+}
+
+func TestApplyInstanceTypeFallback(t *testing.T) {
+	request := cvm.NewRunInstancesRequest()
+	zone, subnetId := helper.String("ap-guangzhou-7"), helper.String("subnet-configured")
+
+	applyInstanceTypeFallback(request, newInstanceTypeFallback("S5.MEDIUM2", "", ""), zone, subnetId)
+	assert.Equal(t, "S5.MEDIUM2", *request.InstanceType)
+	assert.Equal(t, "ap-guangzhou-7", *request.Placement.Zone)
+	assert.Equal(t, "subnet-configured", *request.VirtualPrivateCloud.SubnetId)
+
+	applyInstanceTypeFallback(request, newInstanceTypeFallback("SA2.MEDIUM2", "ap-guangzhou-6", "subnet-fallback"), zone, subnetId)
+	assert.Equal(t, "SA2.MEDIUM2", *request.InstanceType)
+	assert.Equal(t, "ap-guangzhou-6", *request.Placement.Zone)
+	assert.Equal(t, "subnet-fallback", *request.VirtualPrivateCloud.SubnetId)
+
+	// a later fallback that keeps the zone goes back to the configured one, not the previous fallback's
+	applyInstanceTypeFallback(request, newInstanceTypeFallback("S5.MEDIUM4", "", ""), zone, subnetId)
+	assert.Equal(t, "ap-guangzhou-7", *request.Placement.Zone)
+	assert.Equal(t, "subnet-configured", *request.VirtualPrivateCloud.SubnetId)
+
+	request = cvm.NewRunInstancesRequest()
+	applyInstanceTypeFallback(request, newInstanceTypeFallback("S5.MEDIUM2", "", ""), nil, nil)
+	assert.Nil(t, request.Placement)
+	assert.Nil(t, request.VirtualPrivateCloud)
+}
+
+func TestInstanceTypeFallbackDiffSuppress(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceTencentCloudInstance().Schema, map[string]interface{}{})
+	suppress := instanceTypeFallbackDiffSuppress("instance_type")
+
+	assert.False(t, suppress("instance_type", "SA2.MEDIUM2", "S5.MEDIUM2", d))
+
+	assert.NoError(t, d.Set("launched_fallback", []interface{}{map[string]interface{}{
+		"instance_type":            "SA2.MEDIUM2",
+		"availability_zone":        "",
+		"subnet_id":                "",
+		"configured_instance_type": "S5.MEDIUM2",
+	}}))
+	assert.True(t, suppress("instance_type", "SA2.MEDIUM2", "S5.MEDIUM2", d))
+	assert.False(t, suppress("instance_type", "SA2.MEDIUM2", "S5.MEDIUM4", d))
+	assert.False(t, suppress("instance_type", "", "S5.MEDIUM2", d))
+	assert.False(t, instanceTypeFallbackDiffSuppress("availability_zone")("availability_zone", "ap-guangzhou-7", "ap-guangzhou-6", d))
+}
+
+func TestCheckInstanceTypeFallback(t *testing.T) {
+	assert.NoError(t, checkInstanceTypeFallback(0, newInstanceTypeFallback("S5.MEDIUM2", "", ""), "ap-guangzhou-7"))
+	assert.NoError(t, checkInstanceTypeFallback(0, newInstanceTypeFallback("S5.MEDIUM2", "ap-guangzhou-7", ""), "ap-guangzhou-7"))
+	assert.NoError(t, checkInstanceTypeFallback(0, newInstanceTypeFallback("S5.MEDIUM2", "ap-guangzhou-6", "subnet-fallback"), "ap-guangzhou-7"))
+	assert.Error(t, checkInstanceTypeFallback(1, newInstanceTypeFallback("S5.MEDIUM2", "", "subnet-fallback"), "ap-guangzhou-7"))
+	assert.Error(t, checkInstanceTypeFallback(1, newInstanceTypeFallback("S5.MEDIUM2", "ap-guangzhou-7", "subnet-fallback"), "ap-guangzhou-7"))
+}
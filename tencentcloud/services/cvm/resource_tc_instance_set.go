@@ -25,6 +25,9 @@ func ResourceTencentCloudInstanceSet() *schema.Resource {
 		Read:   resourceTencentCloudInstanceSetRead,
 		Update: resourceTencentCloudInstanceSetUpdate,
 		Delete: resourceTencentCloudInstanceSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudInstanceSetImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(600 * time.Second),
 			Read:   schema.DefaultTimeout(600 * time.Second),
@@ -511,6 +514,23 @@ func doResourceTencentCloudInstanceSetCreate(d *schema.ResourceData, meta interf
 	return nil
 }
 
+// resourceTencentCloudInstanceSetImport imports the instances whose IDs are joined by `#`, the resource ID encodes
+// them the way Create does.
+func resourceTencentCloudInstanceSetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	instanceIds := helper.IdParse(d.Id())
+	for _, instanceId := range instanceIds {
+		if instanceId == "" {
+			return nil, fmt.Errorf("invalid ID `%s`, expected format `instance_id#instance_id#...`", d.Id())
+		}
+	}
+
+	d.SetId(helper.StrListToStr(helper.StringsStringsPoint(instanceIds)))
+	_ = d.Set("instance_ids", instanceIds)
+	_ = d.Set("instance_count", len(instanceIds))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func doResourceTencentCloudInstanceSetRead(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_instance_set.read")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -1809,6 +1809,199 @@ resource "tencentcloud_instance" "foo" {
 
 `
 
+func TestAccTencentCloudInstanceResourceWithLaunchTemplate(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.AccPreCheck(t)
+		},
+		Providers:    acctest.AccProviders,
+		CheckDestroy: testAccCheckCvmInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCvmInstanceResource_WithLaunchTemplateCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCvmInstanceExists("tencentcloud_instance.foo"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.foo", "instance_status", "RUNNING"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.foo", "availability_zone", "ap-guangzhou-7"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.foo", "instance_name", "tf-ci-test-template"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.foo", "system_disk_size", "60"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.foo", "launch_template.0.version", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.foo", "launch_template.0.default_version", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCvmInstanceResource_WithLaunchTemplateCreate = `
+
+data "tencentcloud_images" "default" {
+    image_name_regex = "Final"
+    image_type = ["PUBLIC_IMAGE"]
+}
+data "tencentcloud_instance_types" "default" {
+    exclude_sold_out = true
+
+    filter {
+        name = "instance-family"
+        values = ["S1","S2","S3","S4","S5"]
+    }
+    filter {
+        name = "zone"
+        values = ["ap-guangzhou-7"]
+    }
+    cpu_core_count = 2
+    memory_size = 2
+}
+resource "tencentcloud_cvm_launch_template" "foo" {
+    launch_template_name = "tf-ci-test-instance"
+    image_id = data.tencentcloud_images.default.images.0.image_id
+    instance_type = data.tencentcloud_instance_types.default.instance_types.0.instance_type
+    instance_name = "tf-ci-test-template"
+
+    placement {
+        zone = "ap-guangzhou-7"
+        project_id = 0
+    }
+    system_disk {
+        disk_type = "CLOUD_PREMIUM"
+        disk_size = 50
+    }
+}
+resource "tencentcloud_instance" "foo" {
+    system_disk_size = 60
+
+    launch_template {
+        id = tencentcloud_cvm_launch_template.foo.id
+    }
+}
+
+`
+
+func TestAccTencentCloudInstanceResourceWithInstanceTypeFallbacks(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.AccPreCheck(t)
+		},
+		Providers:    acctest.AccProviders,
+		CheckDestroy: testAccCheckCvmInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCvmInstanceResource_WithInstanceTypeFallbacksCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCvmInstanceExists("tencentcloud_instance.foo"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.foo", "instance_status", "RUNNING"),
+					resource.TestCheckResourceAttrPair("tencentcloud_instance.foo", "instance_type", "data.tencentcloud_instance_types.default", "instance_types.0.instance_type"),
+					resource.TestCheckResourceAttrPair("tencentcloud_instance.foo", "launched_fallback.0.instance_type", "data.tencentcloud_instance_types.default", "instance_types.0.instance_type"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.foo", "launched_fallback.0.configured_instance_type", "S1.SMALL1"),
+				),
+			},
+			{
+				// the launched fallback does not show up as a diff
+				Config:   testAccCvmInstanceResource_WithInstanceTypeFallbacksCreate,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+const testAccCvmInstanceResource_WithInstanceTypeFallbacksCreate = `
+
+data "tencentcloud_images" "default" {
+    image_name_regex = "Final"
+    image_type = ["PUBLIC_IMAGE"]
+}
+data "tencentcloud_instance_types" "default" {
+    exclude_sold_out = true
+
+    filter {
+        name = "instance-family"
+        values = ["S1","S2","S3","S4","S5"]
+    }
+    filter {
+        name = "zone"
+        values = ["ap-guangzhou-7"]
+    }
+    cpu_core_count = 2
+    memory_size = 2
+}
+resource "tencentcloud_instance" "foo" {
+    image_id = data.tencentcloud_images.default.images.0.image_id
+    // a legacy instance type sold out in the zone, so the fallback is launched
+    instance_type = "S1.SMALL1"
+    system_disk_type = "CLOUD_PREMIUM"
+    instance_name = "tf-ci-test"
+    availability_zone = "ap-guangzhou-7"
+
+    instance_type_fallbacks {
+        instance_type = data.tencentcloud_instance_types.default.instance_types.0.instance_type
+    }
+}
+
+`
+
+func TestAccTencentCloudInstanceResourceWithWaitForReady(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.AccPreCheck(t)
+		},
+		Providers:    acctest.AccProviders,
+		CheckDestroy: testAccCheckCvmInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCvmInstanceResource_WithWaitForReadyCreate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCvmInstanceExists("tencentcloud_instance.foo"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.foo", "instance_status", "RUNNING"),
+					resource.TestCheckResourceAttr("tencentcloud_instance.foo", "wait_for_ready.0.tat_agent_online", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCvmInstanceResource_WithWaitForReadyCreate = `
+
+data "tencentcloud_images" "default" {
+    image_name_regex = "OpenCloudOS Server"
+    image_type = ["PUBLIC_IMAGE"]
+}
+data "tencentcloud_instance_types" "default" {
+    exclude_sold_out = true
+
+    filter {
+        name = "instance-family"
+        values = ["S1","S2","S3","S4","S5"]
+    }
+    filter {
+        name = "zone"
+        values = ["ap-guangzhou-7"]
+    }
+    cpu_core_count = 2
+    memory_size = 2
+}
+resource "tencentcloud_instance" "foo" {
+    image_id = data.tencentcloud_images.default.images.0.image_id
+    instance_type = data.tencentcloud_instance_types.default.instance_types.0.instance_type
+    system_disk_type = "CLOUD_PREMIUM"
+    instance_name = "tf-ci-test"
+    availability_zone = "ap-guangzhou-7"
+    user_data_raw = <<-EOT
+        #!/bin/bash
+        sleep 30 && touch /tmp/ready
+    EOT
+
+    wait_for_ready {
+        command = "test -f /tmp/ready"
+        timeout = 600
+    }
+}
+
+`
+
 func TestAccTencentCloudInstanceResourceWithSpotpaid(t *testing.T) {
 	t.Parallel()
 	resource.Test(t, resource.TestCase{
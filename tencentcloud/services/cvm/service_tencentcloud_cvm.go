@@ -6,7 +6,6 @@ import (
 	"log"
 	"sort"
 	"strings"
-	"sync"
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
@@ -18,7 +17,10 @@ import (
 	cvm "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cvm/v20170312"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/batcher"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/paginator"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -66,12 +68,28 @@ func (me *CvmService) DescribeInstanceSetByIds(ctx context.Context, instanceSetI
 }
 
 func (me *CvmService) DescribeInstanceById(ctx context.Context, instanceId string) (instance *cvm.Instance, errRet error) {
+	result, err := batcher.Shared(me.client, "cvm.DescribeInstances", me.describeInstancesByIds).Get(ctx, instanceId)
+	if err != nil {
+		errRet = err
+		return
+	}
+	if result != nil {
+		instance = result.(*cvm.Instance)
+	}
+	return
+}
+
+// describeInstancesByIds backs DescribeInstanceById, describing every coalesced instance id in one call.
+func (me *CvmService) describeInstancesByIds(ctx context.Context, instanceIds []string) (instances map[string]interface{}, errRet error) {
 	logId := tccommon.GetLogId(ctx)
 	request := cvm.NewDescribeInstancesRequest()
-	request.InstanceIds = []*string{&instanceId}
+	request.InstanceIds = helper.Strings(instanceIds)
+	request.Limit = helper.IntInt64(100)
 
 	var iacExtInfo connectivity.IacExtInfo
-	iacExtInfo.InstanceId = instanceId
+	if len(instanceIds) == 1 {
+		iacExtInfo.InstanceId = instanceIds[0]
+	}
 	ratelimit.Check(request.GetAction())
 	response, err := me.client.UseCvmClient(iacExtInfo).DescribeInstances(request)
 	if err != nil {
@@ -83,10 +101,12 @@ func (me *CvmService) DescribeInstanceById(ctx context.Context, instanceId strin
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-	if len(response.Response.InstanceSet) < 1 {
-		return
+	instances = make(map[string]interface{}, len(response.Response.InstanceSet))
+	for _, instance := range response.Response.InstanceSet {
+		if instance.InstanceId != nil {
+			instances[*instance.InstanceId] = instance
+		}
 	}
-	instance = response.Response.InstanceSet[0]
 	return
 }
 
@@ -106,115 +126,58 @@ func (me *CvmService) DescribeInstanceByFilter(ctx context.Context, instancesId
 		}
 	}
 
-	var offset int64 = 0
-	var pageSize int64 = 100
-	instances = make([]*cvm.Instance, 0)
-	for {
+	instances, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*cvm.Instance], error) {
 		request.Offset = &offset
-		request.Limit = &pageSize
+		request.Limit = &limit
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCvmClient().DescribeInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.InstanceSet) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		instances = append(instances, response.Response.InstanceSet...)
-		if len(response.Response.InstanceSet) < int(pageSize) {
-			break
-		}
-		offset += pageSize
-	}
+		return &paginator.Page[*cvm.Instance]{Items: response.Response.InstanceSet}, nil
+	})
 	return
 }
 
 func (me *CvmService) DescribeInstanceInParallelByFilter(ctx context.Context, filters map[string]string) (instances []*cvm.Instance, errRet error) {
 	logId := tccommon.GetLogId(ctx)
-	request := cvm.NewDescribeInstancesRequest()
-
-	request.Filters = make([]*cvm.Filter, 0, len(filters))
-	for k, v := range filters {
-		filter := cvm.Filter{
-			Name:   helper.String(k),
-			Values: []*string{helper.String(v)},
-		}
-		request.Filters = append(request.Filters, &filter)
-	}
 
-	response, err := me.client.UseCvmClient().DescribeInstances(request)
-	if err != nil {
-		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-			logId, request.GetAction(), request.ToJsonString(), err.Error())
-		errRet = err
-		return
-	}
-	if response == nil || len(response.Response.InstanceSet) < 1 {
-		return
-	}
-
-	total := response.Response.TotalCount
-
-	var limit = 100
-
-	num := int(*total) / limit
-
-	maxConcurrentNum := 50
-	//g := tccommon.NewGoRoutine(num + 1)
-	g := tccommon.NewGoRoutine(maxConcurrentNum)
-	wg := sync.WaitGroup{}
-
-	var instanceSetList = make([]interface{}, num+1)
-
-	for i := 0; i <= num; i++ {
-		wg.Add(1)
-		value := i
-		goFunc := func() {
-			offset := value * limit
-			request := cvm.NewDescribeInstancesRequest()
-			request.Filters = make([]*cvm.Filter, 0, len(filters))
-			for k, v := range filters {
-				filter := cvm.Filter{
-					Name:   helper.String(k),
-					Values: []*string{helper.String(v)},
-				}
-				request.Filters = append(request.Filters, &filter)
-			}
-
-			request.Offset = helper.IntInt64(offset)
-			request.Limit = helper.IntInt64(limit)
-
-			ratelimit.Check(request.GetAction())
-			response, err := me.client.UseCvmClient().DescribeInstances(request)
-			if err != nil {
-				log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-					logId, request.GetAction(), request.ToJsonString(), err.Error())
-				errRet = err
-				return
+	instances, errRet = paginator.OffsetConcurrently(ctx, paginator.DefaultLimit, 50, func(ctx context.Context, offset, limit int64) (*paginator.Page[*cvm.Instance], error) {
+		request := cvm.NewDescribeInstancesRequest()
+		request.Filters = make([]*cvm.Filter, 0, len(filters))
+		for k, v := range filters {
+			filter := cvm.Filter{
+				Name:   helper.String(k),
+				Values: []*string{helper.String(v)},
 			}
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
-
-			instanceSetList[value] = response.Response.InstanceSet
+			request.Filters = append(request.Filters, &filter)
+		}
+		request.Offset = &offset
+		request.Limit = &limit
 
-			wg.Done()
-			log.Printf("[DEBUG]%s thread %d finished", logId, value)
+		ratelimit.Check(request.GetAction())
+		response, err := me.client.UseCvmClient().DescribeInstances(request)
+		if err != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), err.Error())
+			return nil, err
 		}
-		g.Run(goFunc)
-	}
-	wg.Wait()
+		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-	log.Printf("[DEBUG]%s DescribeInstance requet finished", logId)
-	for _, v := range instanceSetList {
-		instances = append(instances, v.([]*cvm.Instance)...)
-	}
-	log.Printf("[DEBUG]%s transfer Instance finished", logId)
+		if response == nil || response.Response == nil {
+			return nil, nil
+		}
+		return &paginator.Page[*cvm.Instance]{Items: response.Response.InstanceSet, Total: response.Response.TotalCount}, nil
+	})
 	return
 }
 
@@ -531,14 +494,17 @@ func (me *CvmService) DescribeInstanceTypes(ctx context.Context, zone string) (i
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeInstanceTypeConfigs(request)
+	result, err := me.client.CachedDescribe(request, func() (interface{}, error) {
+		ratelimit.Check(request.GetAction())
+		return me.client.UseCvmClient().DescribeInstanceTypeConfigs(request)
+	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
 		errRet = err
 		return
 	}
+	response := result.(*cvm.DescribeInstanceTypeConfigsResponse)
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
@@ -562,14 +528,17 @@ func (me *CvmService) DescribeInstanceTypesByFilter(ctx context.Context, filters
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeInstanceTypeConfigs(request)
+	result, err := me.client.CachedDescribe(request, func() (interface{}, error) {
+		ratelimit.Check(request.GetAction())
+		return me.client.UseCvmClient().DescribeInstanceTypeConfigs(request)
+	})
 	if err != nil {
 		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), err.Error())
 		errRet = err
 		return
 	}
+	response := result.(*cvm.DescribeInstanceTypeConfigsResponse)
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
@@ -593,8 +562,10 @@ func (me *CvmService) DescribeInstancesSellTypeByFilter(ctx context.Context, fil
 		request.Filters = append(request.Filters, filter)
 	}
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseCvmClient().DescribeZoneInstanceConfigInfos(request)
+	result, err := me.client.CachedDescribe(request, func() (interface{}, error) {
+		ratelimit.Check(request.GetAction())
+		return me.client.UseCvmClient().DescribeZoneInstanceConfigInfos(request)
+	})
 	if err != nil {
 		//deal with not supported error
 		e, ok := err.(*sdkErrors.TencentCloudSDKError)
@@ -607,6 +578,7 @@ func (me *CvmService) DescribeInstancesSellTypeByFilter(ctx context.Context, fil
 		return
 	}
 
+	response := result.(*cvm.DescribeZoneInstanceConfigInfosResponse)
 	instanceTypes = response.Response.InstanceTypeQuotaSet
 	return
 }
@@ -655,32 +627,24 @@ func (me *CvmService) DescribeKeyPairByFilter(ctx context.Context, id, name stri
 		request.Filters = append(request.Filters, filter)
 	}
 
-	var offset int64 = 0
-	var pageSize int64 = 100
-	keyPairs = make([]*cvm.KeyPair, 0)
-	for {
+	keyPairs, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*cvm.KeyPair], error) {
 		request.Offset = &offset
-		request.Limit = &pageSize
+		request.Limit = &limit
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCvmClient().DescribeKeyPairs(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.KeyPairSet) < 1 {
-			break
-		}
-		keyPairs = append(keyPairs, response.Response.KeyPairSet...)
-		if len(response.Response.KeyPairSet) < int(pageSize) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		offset += pageSize
-	}
+		return &paginator.Page[*cvm.KeyPair]{Items: response.Response.KeyPairSet}, nil
+	})
 	return
 }
 
@@ -829,32 +793,24 @@ func (me *CvmService) DescribePlacementGroupByFilter(ctx context.Context, id, na
 		request.Name = &name
 	}
 
-	var offset int64 = 0
-	var pageSize int64 = 100
-	placementGroups = make([]*cvm.DisasterRecoverGroup, 0)
-	for {
+	placementGroups, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*cvm.DisasterRecoverGroup], error) {
 		request.Offset = &offset
-		request.Limit = &pageSize
+		request.Limit = &limit
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCvmClient().DescribeDisasterRecoverGroups(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.DisasterRecoverGroupSet) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		placementGroups = append(placementGroups, response.Response.DisasterRecoverGroupSet...)
-		if len(response.Response.DisasterRecoverGroupSet) < int(pageSize) {
-			break
-		}
-		offset += pageSize
-	}
+		return &paginator.Page[*cvm.DisasterRecoverGroup]{Items: response.Response.DisasterRecoverGroupSet}, nil
+	})
 	return
 }
 
@@ -974,32 +930,24 @@ func (me *CvmService) DescribeReservedInstanceByFilter(ctx context.Context, filt
 		request.Filters = append(request.Filters, &filter)
 	}
 
-	var offset int64 = 0
-	var pageSize int64 = 100
-	instances = make([]*cvmintl.ReservedInstances, 0)
-	for {
+	instances, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*cvmintl.ReservedInstances], error) {
 		request.Offset = &offset
-		request.Limit = &pageSize
+		request.Limit = &limit
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCvmIntlClient().DescribeReservedInstances(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.ReservedInstancesSet) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		instances = append(instances, response.Response.ReservedInstancesSet...)
-		if len(response.Response.ReservedInstancesSet) < int(pageSize) {
-			break
-		}
-		offset += pageSize
-	}
+		return &paginator.Page[*cvmintl.ReservedInstances]{Items: response.Response.ReservedInstancesSet}, nil
+	})
 	return
 }
 
@@ -1015,32 +963,24 @@ func (me *CvmService) DescribeReservedInstanceConfigs(ctx context.Context, filte
 		request.Filters = append(request.Filters, &filter)
 	}
 
-	var offset int64 = 0
-	var pageSize int64 = 100
-	configs = make([]*cvm.ReservedInstancesOffering, 0)
-	for {
+	configs, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*cvm.ReservedInstancesOffering], error) {
 		request.Offset = &offset
-		request.Limit = &pageSize
+		request.Limit = &limit
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseCvmClient().DescribeReservedInstancesOfferings(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.ReservedInstancesOfferingsSet) < 1 {
-			break
-		}
-		configs = append(configs, response.Response.ReservedInstancesOfferingsSet...)
-		if len(response.Response.ReservedInstancesOfferingsSet) < int(pageSize) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		offset += pageSize
-	}
+		return &paginator.Page[*cvm.ReservedInstancesOffering]{Items: response.Response.ReservedInstancesOfferingsSet}, nil
+	})
 	return
 }
 
@@ -1192,32 +1132,27 @@ func (me *CvmService) DescribeImagesByFilter(ctx context.Context, filters map[st
 	if instanceType != "" {
 		request.InstanceType = helper.String(instanceType)
 	}
-	var offset uint64 = 0
-	var pageSize uint64 = 100
-	images = make([]*cvm.Image, 0)
-	for {
-		request.Offset = &offset
-		request.Limit = &pageSize
-		ratelimit.Check(request.GetAction())
-		response, err := me.client.UseCvmClient().DescribeImages(request)
+	images, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*cvm.Image], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
+		result, err := me.client.CachedDescribe(request, func() (interface{}, error) {
+			ratelimit.Check(request.GetAction())
+			return me.client.UseCvmClient().DescribeImages(request)
+		})
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
+		response := result.(*cvm.DescribeImagesResponse)
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.ImageSet) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		images = append(images, response.Response.ImageSet...)
-		if len(response.Response.ImageSet) < int(pageSize) {
-			break
-		}
-		offset += pageSize
-	}
+		return &paginator.Page[*cvm.Image]{Items: response.Response.ImageSet}, nil
+	})
 
 	return
 }
@@ -1308,30 +1243,23 @@ func (me *CvmService) DescribeCvmHpcClusterById(ctx context.Context, hpcClusterI
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	instances := make([]*cvm.HpcClusterInfo, 0)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	instances, err := paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*cvm.HpcClusterInfo], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseCvmClient().DescribeHpcClusters(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.HpcClusterSet) < 1 {
-			break
-		}
-		instances = append(instances, response.Response.HpcClusterSet...)
-		if len(response.Response.HpcClusterSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
+		return &paginator.Page[*cvm.HpcClusterInfo]{Items: response.Response.HpcClusterSet}, nil
+	})
+	if err != nil {
+		errRet = err
+		return
 	}
 
 	if len(instances) < 1 {
@@ -1435,28 +1363,22 @@ func (me *CvmService) DescribeLaunchTemplateVersions(ctx context.Context, launch
 		}
 	}()
 
-	offect := 0
-	limit := 50
-	for {
+	launchTemplates, errRet = paginator.Offset(ctx, 50, func(ctx context.Context, offset, limit int64) (*paginator.Page[*cvm.LaunchTemplateVersionInfo], error) {
 		ratelimit.Check(request.GetAction())
-		request.Offset = helper.IntUint64(offect)
-		request.Limit = helper.IntUint64(limit)
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseCvmClient().DescribeLaunchTemplateVersions(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
-		if response == nil || response.Response == nil || len(response.Response.LaunchTemplateVersionSet) == 0 {
-			errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
-			return
-		}
-
-		launchTemplates = append(launchTemplates, response.Response.LaunchTemplateVersionSet...)
-
-		if len(response.Response.LaunchTemplateVersionSet) < limit {
-			break
+		if response == nil || response.Response == nil {
+			return nil, fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
 		}
+		return &paginator.Page[*cvm.LaunchTemplateVersionInfo]{Items: response.Response.LaunchTemplateVersionSet}, nil
+	})
+	if errRet == nil && len(launchTemplates) == 0 {
+		errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
 	}
 
 	return
@@ -1588,30 +1510,20 @@ func (me *CvmService) DescribeCvmChcHostsByFilter(ctx context.Context, param map
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset int64 = 0
-		limit  int64 = 20
-	)
-	for {
+	chcHosts, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*cvm.ChcHost], error) {
 		request.Offset = &offset
 		request.Limit = &limit
 		response, err := me.client.UseCvmClient().DescribeChcHosts(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.ChcHostSet) < 1 {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		chcHosts = append(chcHosts, response.Response.ChcHostSet...)
-		if len(response.Response.ChcHostSet) < int(limit) {
-			break
-		}
-
-		offset += limit
-	}
+		return &paginator.Page[*cvm.ChcHost]{Items: response.Response.ChcHostSet}, nil
+	})
 
 	return
 }
@@ -1925,3 +1837,222 @@ func flattenInstanceTagsMapping(list []*cvm.Tag) map[string]interface{} {
 	}
 	return result
 }
+
+// InstanceOperationRefreshFunc reports the latest operation of the instance, such as a resize or a reset, as a task
+// whose status is its LatestOperationState. The status is empty until the operation shows up.
+func (me *CvmService) InstanceOperationRefreshFunc(instanceId string) waiter.RefreshFunc {
+	return func(ctx context.Context) (*waiter.Task, error) {
+		instance, err := me.DescribeInstanceById(ctx, instanceId)
+		if err != nil {
+			return nil, err
+		}
+		if instance == nil {
+			return nil, fmt.Errorf("%s not exists", instanceId)
+		}
+
+		return &waiter.Task{
+			Status: helper.PString(instance.LatestOperationState),
+			Reason: helper.PString(instance.LatestOperationErrorMsg),
+			Result: instance,
+		}, nil
+	}
+}
+
+func (me *CvmService) InquiryPriceRunInstances(ctx context.Context, request *cvm.InquiryPriceRunInstancesRequest) (price *cvm.Price, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCvmClient().InquiryPriceRunInstances(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil {
+		return
+	}
+
+	price = response.Response.Price
+	return
+}
+
+// DescribeImageByRegion describes an image in the given region, returning nil when it is not found.
+func (me *CvmService) DescribeImageByRegion(ctx context.Context, region, imageId string) (image *cvm.Image, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	request := cvm.NewDescribeImagesRequest()
+	request.ImageIds = []*string{helper.String(imageId)}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, region [%s], request body [%s], reason[%s]\n", logId, request.GetAction(), region, request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCvmClientRegion(region).DescribeImages(request)
+	if err != nil {
+		if ee, ok := err.(*sdkErrors.TencentCloudSDKError); ok && ee.Code == "InvalidImageId.NotFound" {
+			return
+		}
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, region [%s], request body [%s], response body [%s]\n", logId, request.GetAction(), region, request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil || len(response.Response.ImageSet) < 1 {
+		return
+	}
+
+	image = response.Response.ImageSet[0]
+	return
+}
+
+// WaitForImageNormal waits for an image in the given region to be created or synchronized.
+func (me *CvmService) WaitForImageNormal(ctx context.Context, region, imageId string, timeout time.Duration) error {
+	_, err := waiter.NewWaiter(fmt.Sprintf("image %s in region %s", imageId, region), []string{IMAGE_STATE_CREATING, IMAGE_STATE_SYNCING},
+		[]string{IMAGE_STATE_NORMAL, IMAGE_STATE_USING}, []string{IMAGE_STATE_CREATEFAILED}, timeout, func(ctx context.Context) (*waiter.Task, error) {
+			image, err := me.DescribeImageByRegion(ctx, region, imageId)
+			if err != nil {
+				return nil, err
+			}
+
+			if image == nil || image.ImageState == nil {
+				return nil, nil
+			}
+
+			return &waiter.Task{Status: *image.ImageState, Result: image}, nil
+		}).WaitForState(ctx)
+
+	return err
+}
+
+// SyncImageToRegions copies an image to the destination regions, returning the IDs of the copies by region.
+func (me *CvmService) SyncImageToRegions(ctx context.Context, imageId string, regions []string) (imageIds map[string]string, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	request := cvm.NewSyncImagesRequest()
+	request.ImageIds = []*string{helper.String(imageId)}
+	request.DestinationRegions = helper.StringsStringsPoint(regions)
+	request.ImageSetRequired = helper.Bool(true)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCvmClient().SyncImages(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil {
+		errRet = fmt.Errorf("Response is null")
+		return
+	}
+
+	imageIds = make(map[string]string, len(response.Response.ImageSet))
+	for _, item := range response.Response.ImageSet {
+		if item.Region != nil && item.ImageId != nil {
+			imageIds[*item.Region] = *item.ImageId
+		}
+	}
+
+	return
+}
+
+// ModifyImageSharePermissionByRegion shares an image in the given region with the accounts, or cancels the sharing.
+func (me *CvmService) ModifyImageSharePermissionByRegion(ctx context.Context, region, imageId, permission string, accountIds []string) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	request := cvm.NewModifyImageSharePermissionRequest()
+	request.ImageId = helper.String(imageId)
+	request.Permission = helper.String(permission)
+	request.AccountIds = helper.StringsStringsPoint(accountIds)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, region [%s], request body [%s], reason[%s]\n", logId, request.GetAction(), region, request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCvmClientRegion(region).ModifyImageSharePermission(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, region [%s], request body [%s], response body [%s]\n", logId, request.GetAction(), region, request.ToJsonString(), response.ToJsonString())
+
+	return
+}
+
+// DescribeImageSharedAccountsByRegion returns the accounts an image in the given region is shared with.
+func (me *CvmService) DescribeImageSharedAccountsByRegion(ctx context.Context, region, imageId string) (accountIds []string, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	request := cvm.NewDescribeImageSharePermissionRequest()
+	request.ImageId = helper.String(imageId)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, region [%s], request body [%s], reason[%s]\n", logId, request.GetAction(), region, request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCvmClientRegion(region).DescribeImageSharePermission(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, region [%s], request body [%s], response body [%s]\n", logId, request.GetAction(), region, request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response == nil {
+		return
+	}
+
+	for _, permission := range response.Response.SharePermissionSet {
+		if permission.AccountId != nil {
+			accountIds = append(accountIds, *permission.AccountId)
+		}
+	}
+
+	return
+}
+
+// DeleteImageByRegion deletes an image in the given region.
+func (me *CvmService) DeleteImageByRegion(ctx context.Context, region, imageId string) (errRet error) {
+	logId := tccommon.GetLogId(ctx)
+	request := cvm.NewDeleteImagesRequest()
+	request.ImageIds = []*string{helper.String(imageId)}
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, region [%s], request body [%s], reason[%s]\n", logId, request.GetAction(), region, request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseCvmClientRegion(region).DeleteImages(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, region [%s], request body [%s], response body [%s]\n", logId, request.GetAction(), region, request.ToJsonString(), response.ToJsonString())
+
+	return
+}
@@ -18,12 +18,10 @@ import (
 
 func ResourceTencentCloudCwpLicenseBindAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCwpLicenseBindAttachmentCreate,
-		Read:   resourceTencentCloudCwpLicenseBindAttachmentRead,
-		Delete: resourceTencentCloudCwpLicenseBindAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCwpLicenseBindAttachmentCreate,
+		Read:     resourceTencentCloudCwpLicenseBindAttachmentRead,
+		Delete:   resourceTencentCloudCwpLicenseBindAttachmentDelete,
+		Importer: helper.IdSpec{"resource_id", "license_id", "quuid", "license_type"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
 				Required:    true,
@@ -19,13 +19,11 @@ import (
 
 func ResourceTencentCloudCwpLicenseOrder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCwpLicenseOrderCreate,
-		Read:   resourceTencentCloudCwpLicenseOrderRead,
-		Update: resourceTencentCloudCwpLicenseOrderUpdate,
-		Delete: resourceTencentCloudCwpLicenseOrderDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCwpLicenseOrderCreate,
+		Read:     resourceTencentCloudCwpLicenseOrderRead,
+		Update:   resourceTencentCloudCwpLicenseOrderUpdate,
+		Delete:   resourceTencentCloudCwpLicenseOrderDelete,
+		Importer: helper.IdSpec{"resource_id", "region_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"alias": {
 				Optional:    true,
@@ -147,7 +147,7 @@ func dataSourceTencentCloudCynosdbAccountsRead(d *schema.ResourceData, meta inte
 	_ = d.Set("account_set", tmpList)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -199,7 +199,7 @@ func dataSourceTencentCloudCynosdbClustersRead(d *schema.ResourceData, meta inte
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err = tccommon.WriteToFile(output.(string), clusterList); err != nil {
+		if err = tccommon.WriteToFile(output.(string), clusterList, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
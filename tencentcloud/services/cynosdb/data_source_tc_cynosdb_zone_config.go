@@ -125,7 +125,7 @@ func dataSourceTencentCynosdbZoneConfigRead(d *schema.ResourceData, meta interfa
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
 
-		if err := tccommon.WriteToFile(output.(string), result); err != nil {
+		if err := tccommon.WriteToFile(output.(string), result, tccommon.GetResultOutputOptions(d)); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
 			return err
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudCynosdbAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbAccountCreate,
-		Read:   resourceTencentCloudCynosdbAccountRead,
-		Update: resourceTencentCloudCynosdbAccountUpdate,
-		Delete: resourceTencentCloudCynosdbAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCynosdbAccountCreate,
+		Read:     resourceTencentCloudCynosdbAccountRead,
+		Update:   resourceTencentCloudCynosdbAccountUpdate,
+		Delete:   resourceTencentCloudCynosdbAccountDelete,
+		Importer: helper.IdSpec{"cluster_id", "account_name", "host"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudCynosdbAccountPrivileges() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbAccountPrivilegesCreate,
-		Read:   resourceTencentCloudCynosdbAccountPrivilegesRead,
-		Update: resourceTencentCloudCynosdbAccountPrivilegesUpdate,
-		Delete: resourceTencentCloudCynosdbAccountPrivilegesDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCynosdbAccountPrivilegesCreate,
+		Read:     resourceTencentCloudCynosdbAccountPrivilegesRead,
+		Update:   resourceTencentCloudCynosdbAccountPrivilegesUpdate,
+		Delete:   resourceTencentCloudCynosdbAccountPrivilegesDelete,
+		Importer: helper.IdSpec{"cluster_id", "account_name", "[host]"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
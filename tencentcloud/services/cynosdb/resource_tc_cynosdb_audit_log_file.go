@@ -18,9 +18,10 @@ import (
 
 func ResourceTencentCloudCynosdbAuditLogFile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbAuditLogFileCreate,
-		Read:   resourceTencentCloudCynosdbAuditLogFileRead,
-		Delete: resourceTencentCloudCynosdbAuditLogFileDelete,
+		Create:   resourceTencentCloudCynosdbAuditLogFileCreate,
+		Read:     resourceTencentCloudCynosdbAuditLogFileRead,
+		Delete:   resourceTencentCloudCynosdbAuditLogFileDelete,
+		Importer: helper.IdSpec{"instance_id", "file_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
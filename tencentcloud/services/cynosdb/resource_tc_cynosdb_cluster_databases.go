@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudCynosdbClusterDatabases() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbClusterDatabasesCreate,
-		Read:   resourceTencentCloudCynosdbClusterDatabasesRead,
-		Update: resourceTencentCloudCynosdbClusterDatabasesUpdate,
-		Delete: resourceTencentCloudCynosdbClusterDatabasesDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCynosdbClusterDatabasesCreate,
+		Read:     resourceTencentCloudCynosdbClusterDatabasesRead,
+		Update:   resourceTencentCloudCynosdbClusterDatabasesUpdate,
+		Delete:   resourceTencentCloudCynosdbClusterDatabasesDelete,
+		Importer: helper.IdSpec{"cluster_id", "db_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
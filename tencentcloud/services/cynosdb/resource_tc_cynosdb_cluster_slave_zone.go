@@ -28,9 +28,7 @@ func ResourceTencentCloudCynosdbClusterSlaveZone() *schema.Resource {
 			Update: schema.DefaultTimeout(300 * time.Second),
 			Delete: schema.DefaultTimeout(300 * time.Second),
 		},
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: helper.IdSpec{"cluster_id", "slave_zone"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
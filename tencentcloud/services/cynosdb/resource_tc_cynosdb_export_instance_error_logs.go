@@ -105,6 +105,8 @@ func ResourceTencentCloudCynosdbExportInstanceErrorLogs() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -73,6 +73,8 @@ func ResourceTencentCloudCynosdbExportInstanceSlowQueries() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Slow query export content.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
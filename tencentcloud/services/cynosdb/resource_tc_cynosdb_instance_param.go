@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudCynosdbInstanceParam() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbInstanceParamCreate,
-		Read:   resourceTencentCloudCynosdbInstanceParamRead,
-		Update: resourceTencentCloudCynosdbInstanceParamUpdate,
-		Delete: resourceTencentCloudCynosdbInstanceParamDelete,
+		Create:   resourceTencentCloudCynosdbInstanceParamCreate,
+		Read:     resourceTencentCloudCynosdbInstanceParamRead,
+		Update:   resourceTencentCloudCynosdbInstanceParamUpdate,
+		Delete:   resourceTencentCloudCynosdbInstanceParamDelete,
+		Importer: helper.IdSpec{"cluster_id", "instance_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudCynosdbParamTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbParamTemplateCreate,
-		Read:   resourceTencentCloudCynosdbParamTemplateRead,
-		Update: resourceTencentCloudCynosdbParamTemplateUpdate,
-		Delete: resourceTencentCloudCynosdbParamTemplateDelete,
+		Create:   resourceTencentCloudCynosdbParamTemplateCreate,
+		Read:     resourceTencentCloudCynosdbParamTemplateRead,
+		Update:   resourceTencentCloudCynosdbParamTemplateUpdate,
+		Delete:   resourceTencentCloudCynosdbParamTemplateDelete,
+		Importer: helper.IdSpec{"template_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"template_name": {
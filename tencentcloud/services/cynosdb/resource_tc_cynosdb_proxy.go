@@ -19,10 +19,11 @@ import (
 
 func ResourceTencentCloudCynosdbProxy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbProxyCreate,
-		Read:   resourceTencentCloudCynosdbProxyRead,
-		Update: resourceTencentCloudCynosdbProxyUpdate,
-		Delete: resourceTencentCloudCynosdbProxyDelete,
+		Create:   resourceTencentCloudCynosdbProxyCreate,
+		Read:     resourceTencentCloudCynosdbProxyRead,
+		Update:   resourceTencentCloudCynosdbProxyUpdate,
+		Delete:   resourceTencentCloudCynosdbProxyDelete,
+		Importer: helper.IdSpec{"cluster_id", "proxy_group_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudCynosdbProxyEndPoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbProxyEndPointCreate,
-		Read:   resourceTencentCloudCynosdbProxyEndPointRead,
-		Update: resourceTencentCloudCynosdbProxyEndPointUpdate,
-		Delete: resourceTencentCloudCynosdbProxyEndPointDelete,
+		Create:   resourceTencentCloudCynosdbProxyEndPointCreate,
+		Read:     resourceTencentCloudCynosdbProxyEndPointRead,
+		Update:   resourceTencentCloudCynosdbProxyEndPointUpdate,
+		Delete:   resourceTencentCloudCynosdbProxyEndPointDelete,
+		Importer: helper.IdSpec{"cluster_id", "proxy_group_id", "instance_group_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
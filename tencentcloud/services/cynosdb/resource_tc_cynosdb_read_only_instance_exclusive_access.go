@@ -19,6 +19,7 @@ func ResourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccess() *schema.Resour
 	return &schema.Resource{
 		Create: resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessCreate,
 		Read:   resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessRead,
+		Update: resourceTencentCloudCynosdbUpdateOnlyInstanceExclusiveAccessRead,
 		Delete: resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessDelete,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -65,6 +66,10 @@ func ResourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccess() *schema.Resour
 				},
 				Description: "Security Group.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -135,16 +140,18 @@ func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessCreate(d *schema.
 		return err
 	}
 
-	if response.Response == nil || response.Response.FlowId == nil {
-		log.Printf("[CRITAL]%s FlowId is null. Ingnore this operation.", logId)
-	} else {
-		flowId = response.Response.FlowId
+	if tccommon.OperationWaitForCompletion(d) {
+		if response.Response == nil || response.Response.FlowId == nil {
+			log.Printf("[CRITAL]%s FlowId is null. Ingnore this operation.", logId)
+		} else {
+			flowId = response.Response.FlowId
 
-		service := CynosdbService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-		conf := tccommon.BuildStateChangeConf([]string{}, []string{CYNOSDB_FLOW_STATUS_SUCCESSFUL}, 10*tccommon.ReadRetryTimeout, time.Second, service.CynosdbClusterSlaveZoneStateRefreshFunc(*flowId, []string{}))
+			service := CynosdbService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+			conf := tccommon.BuildStateChangeConf([]string{}, []string{CYNOSDB_FLOW_STATUS_SUCCESSFUL}, 10*tccommon.ReadRetryTimeout, time.Second, service.CynosdbClusterSlaveZoneStateRefreshFunc(*flowId, []string{}))
 
-		if _, e := conf.WaitForState(); e != nil {
-			return e
+			if _, e := conf.WaitForState(); e != nil {
+				return e
+			}
 		}
 	}
 
@@ -160,6 +167,14 @@ func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessRead(d *schema.Re
 	return nil
 }
 
+func resourceTencentCloudCynosdbUpdateOnlyInstanceExclusiveAccessRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_cynosdb_read_only_instance_exclusive_access.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessRead(d, meta)
+}
+
 func resourceTencentCloudCynosdbReadOnlyInstanceExclusiveAccessDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_cynosdb_read_only_instance_exclusive_access.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
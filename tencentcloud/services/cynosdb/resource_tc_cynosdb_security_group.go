@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudCynosdbSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbSecurityGroupCreate,
-		Update: resourceTencentCloudCynosdbSecurityGroupUpdate,
-		Read:   resourceTencentCloudCynosdbSecurityGroupRead,
-		Delete: resourceTencentCloudCynosdbSecurityGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCynosdbSecurityGroupCreate,
+		Update:   resourceTencentCloudCynosdbSecurityGroupUpdate,
+		Read:     resourceTencentCloudCynosdbSecurityGroupRead,
+		Delete:   resourceTencentCloudCynosdbSecurityGroupDelete,
+		Importer: helper.IdSpec{"cluster_id", "instance_group_type"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
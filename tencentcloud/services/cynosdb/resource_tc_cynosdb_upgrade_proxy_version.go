@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudCynosdbUpgradeProxyVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbUpgradeProxyVersionCreate,
-		Read:   resourceTencentCloudCynosdbUpgradeProxyVersionRead,
-		Update: resourceTencentCloudCynosdbUpgradeProxyVersionUpdate,
-		Delete: resourceTencentCloudCynosdbUpgradeProxyVersionDelete,
+		Create:   resourceTencentCloudCynosdbUpgradeProxyVersionCreate,
+		Read:     resourceTencentCloudCynosdbUpgradeProxyVersionRead,
+		Update:   resourceTencentCloudCynosdbUpgradeProxyVersionUpdate,
+		Delete:   resourceTencentCloudCynosdbUpgradeProxyVersionDelete,
+		Importer: helper.IdSpec{"cluster_id", "src_proxy_version"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
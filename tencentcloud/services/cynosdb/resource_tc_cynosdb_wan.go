@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudCynosdbWan() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudCynosdbWanCreate,
-		Read:   resourceTencentCloudCynosdbWanRead,
-		Update: resourceTencentCloudCynosdbWanUpdate,
-		Delete: resourceTencentCloudCynosdbWanDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudCynosdbWanCreate,
+		Read:     resourceTencentCloudCynosdbWanRead,
+		Update:   resourceTencentCloudCynosdbWanUpdate,
+		Delete:   resourceTencentCloudCynosdbWanDelete,
+		Importer: helper.IdSpec{"cluster_id", "instance_grp_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
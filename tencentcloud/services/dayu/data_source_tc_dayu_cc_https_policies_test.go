@@ -13,13 +13,15 @@ var testDataDayuCCHttpsPoliciesName = "data.tencentcloud_dayu_cc_https_policies.
 
 func TestAccTencentCloudDataDayuCCHttpsPolicies(t *testing.T) {
 	t.Parallel()
+	certificateId := tcacctest.FixtureCertificateId(t)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { tcacctest.AccPreCheck(t) },
 		Providers:    tcacctest.AccProviders,
 		CheckDestroy: testAccCheckDayuCCHttpsPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccTencentCloudDataDayuCCHttpsPoliciesBasic, tcacctest.DefaultDayuBgpIp, tcacctest.DefaultSshCertificate),
+				Config: fmt.Sprintf(testAccTencentCloudDataDayuCCHttpsPoliciesBasic, tcacctest.DefaultDayuBgpIp, certificateId),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDayuCCHttpsPolicyExists("tencentcloud_dayu_cc_https_policy.test_policy"),
 					resource.TestCheckResourceAttr(testDataDayuCCHttpsPoliciesName, "list.#", "1"),
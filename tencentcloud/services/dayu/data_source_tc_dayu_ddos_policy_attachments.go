@@ -109,7 +109,7 @@ func dataSourceTencentCloudDayuDdosPolicyAttachmentsRead(d *schema.ResourceData,
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), ddosPolicyAttachmentList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), ddosPolicyAttachmentList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
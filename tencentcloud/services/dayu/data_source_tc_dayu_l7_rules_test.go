@@ -13,13 +13,15 @@ var testDataDayuL7RulesName = "data.tencentcloud_dayu_l7_rules.id_test"
 
 func TestAccTencentCloudDataDayuL7Rules(t *testing.T) {
 	t.Parallel()
+	certificateId := tcacctest.FixtureCertificateId(t)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { tcacctest.AccPreCheck(t) },
 		Providers:    tcacctest.AccProviders,
 		CheckDestroy: testAccCheckDayuL7RuleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccTencentCloudDataDayuL7RulesBaic, tcacctest.DefaultDayuBgpIp, tcacctest.DefaultSshCertificate),
+				Config: fmt.Sprintf(testAccTencentCloudDataDayuL7RulesBaic, tcacctest.DefaultDayuBgpIp, certificateId),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDayuL7RuleExists("tencentcloud_dayu_l7_rule.test_rule"),
 					resource.TestCheckResourceAttr(testDataDayuL7RulesName, "list.#", "1"),
@@ -28,7 +30,7 @@ func TestAccTencentCloudDataDayuL7Rules(t *testing.T) {
 					resource.TestCheckResourceAttr(testDataDayuL7RulesName, "list.0.domain", "zhaoshaona.com"),
 					resource.TestCheckResourceAttr(testDataDayuL7RulesName, "list.0.source_type", "2"),
 					resource.TestCheckResourceAttr(testDataDayuL7RulesName, "list.0.protocol", "https"),
-					resource.TestCheckResourceAttr(testDataDayuL7RulesName, "list.0.ssl_id", tcacctest.DefaultSshCertificate),
+					resource.TestCheckResourceAttr(testDataDayuL7RulesName, "list.0.ssl_id", certificateId),
 					resource.TestCheckResourceAttrSet(testDataDayuL7RulesName, "list.0.status"),
 					resource.TestCheckResourceAttr(testDataDayuL7RulesName, "list.0.source_list.#", "2"),
 					resource.TestCheckResourceAttr(testDataDayuL7RulesName, "list.0.health_check_switch", "true"),
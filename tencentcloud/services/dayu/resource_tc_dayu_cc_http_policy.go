@@ -19,10 +19,11 @@ import (
 
 func ResourceTencentCloudDayuCCHttpPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuCCHttpPolicyCreate,
-		Read:   resourceTencentCloudDayuCCHttpPolicyRead,
-		Update: resourceTencentCloudDayuCCHttpPolicyUpdate,
-		Delete: resourceTencentCloudDayuCCHttpPolicyDelete,
+		Create:   resourceTencentCloudDayuCCHttpPolicyCreate,
+		Read:     resourceTencentCloudDayuCCHttpPolicyRead,
+		Update:   resourceTencentCloudDayuCCHttpPolicyUpdate,
+		Delete:   resourceTencentCloudDayuCCHttpPolicyDelete,
+		Importer: helper.IdSpec{"resource_type", "resource_id", "policy_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudDayuCCHttpsPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuCCHttpsPolicyCreate,
-		Read:   resourceTencentCloudDayuCCHttpsPolicyRead,
-		Update: resourceTencentCloudDayuCCHttpsPolicyUpdate,
-		Delete: resourceTencentCloudDayuCCHttpsPolicyDelete,
+		Create:   resourceTencentCloudDayuCCHttpsPolicyCreate,
+		Read:     resourceTencentCloudDayuCCHttpsPolicyRead,
+		Update:   resourceTencentCloudDayuCCHttpsPolicyUpdate,
+		Delete:   resourceTencentCloudDayuCCHttpsPolicyDelete,
+		Importer: helper.IdSpec{"resource_type", "resource_id", "policy_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -19,13 +19,15 @@ var testDayuCCHttpsPolicyResourceKey = testDayuCCHttpsPolicyResourceName + ".tes
 
 func TestAccTencentCloudDayuCCHttpsPolicyResource(t *testing.T) {
 	t.Parallel()
+	certificateId := tcacctest.FixtureCertificateId(t)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { tcacctest.AccPreCheck(t) },
 		Providers:    tcacctest.AccProviders,
 		CheckDestroy: testAccCheckDayuCCHttpsPolicyDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccDayuCCHttpsPolicy, tcacctest.DefaultDayuBgpIp, tcacctest.DefaultSshCertificate),
+				Config: fmt.Sprintf(testAccDayuCCHttpsPolicy, tcacctest.DefaultDayuBgpIp, certificateId),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDayuCCHttpsPolicyExists(testDayuCCHttpsPolicyResourceKey),
 					resource.TestCheckResourceAttrSet(testDayuCCHttpsPolicyResourceKey, "create_time"),
@@ -39,7 +41,7 @@ func TestAccTencentCloudDayuCCHttpsPolicyResource(t *testing.T) {
 				),
 			},
 			{
-				Config: fmt.Sprintf(testAccDayuCCHttpsPolicyUpdate, tcacctest.DefaultDayuBgpIp, tcacctest.DefaultSshCertificate),
+				Config: fmt.Sprintf(testAccDayuCCHttpsPolicyUpdate, tcacctest.DefaultDayuBgpIp, certificateId),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDayuCCHttpsPolicyExists(testDayuCCHttpsPolicyResourceKey),
 					resource.TestCheckResourceAttrSet(testDayuCCHttpsPolicyResourceKey, "create_time"),
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,10 +16,11 @@ import (
 
 func ResourceTencentCloudDayuDdosPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuDdosPolicyCreate,
-		Read:   resourceTencentCloudDayuDdosPolicyRead,
-		Update: resourceTencentCloudDayuDdosPolicyUpdate,
-		Delete: resourceTencentCloudDayuDdosPolicyDelete,
+		Create:   resourceTencentCloudDayuDdosPolicyCreate,
+		Read:     resourceTencentCloudDayuDdosPolicyRead,
+		Update:   resourceTencentCloudDayuDdosPolicyUpdate,
+		Delete:   resourceTencentCloudDayuDdosPolicyDelete,
+		Importer: helper.IdSpec{"resource_type", "policy_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,9 +16,10 @@ import (
 
 func ResourceTencentCloudDayuDdosPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuDdosPolicyAttachmentCreate,
-		Read:   resourceTencentCloudDayuDdosPolicyAttachmentRead,
-		Delete: resourceTencentCloudDayuDdosPolicyAttachmentDelete,
+		Create:   resourceTencentCloudDayuDdosPolicyAttachmentCreate,
+		Read:     resourceTencentCloudDayuDdosPolicyAttachmentRead,
+		Delete:   resourceTencentCloudDayuDdosPolicyAttachmentDelete,
+		Importer: helper.IdSpec{"resource_id", "resource_type", "policy_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
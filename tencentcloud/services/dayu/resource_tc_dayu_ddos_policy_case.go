@@ -19,10 +19,11 @@ import (
 
 func ResourceTencentCloudDayuDdosPolicyCase() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuDdosPolicyCaseCreate,
-		Read:   resourceTencentCloudDayuDdosPolicyCaseRead,
-		Update: resourceTencentCloudDayuDdosPolicyCaseUpdate,
-		Delete: resourceTencentCloudDayuDdosPolicyCaseDelete,
+		Create:   resourceTencentCloudDayuDdosPolicyCaseCreate,
+		Read:     resourceTencentCloudDayuDdosPolicyCaseRead,
+		Update:   resourceTencentCloudDayuDdosPolicyCaseUpdate,
+		Delete:   resourceTencentCloudDayuDdosPolicyCaseDelete,
+		Importer: helper.IdSpec{"resource_type", "scene_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"resource_type": {
@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudDayuL4Rule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuL4RuleCreate,
-		Read:   resourceTencentCloudDayuL4RuleRead,
-		Update: resourceTencentCloudDayuL4RuleUpdate,
-		Delete: resourceTencentCloudDayuL4RuleDelete,
+		Create:   resourceTencentCloudDayuL4RuleCreate,
+		Read:     resourceTencentCloudDayuL4RuleRead,
+		Update:   resourceTencentCloudDayuL4RuleUpdate,
+		Delete:   resourceTencentCloudDayuL4RuleDelete,
+		Importer: helper.IdSpec{"resource_type", "resource_id", "rule_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
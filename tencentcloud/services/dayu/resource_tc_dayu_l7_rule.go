@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudDayuL7Rule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuL7RuleCreate,
-		Read:   resourceTencentCloudDayuL7RuleRead,
-		Update: resourceTencentCloudDayuL7RuleUpdate,
-		Delete: resourceTencentCloudDayuL7RuleDelete,
+		Create:   resourceTencentCloudDayuL7RuleCreate,
+		Read:     resourceTencentCloudDayuL7RuleRead,
+		Update:   resourceTencentCloudDayuL7RuleUpdate,
+		Delete:   resourceTencentCloudDayuL7RuleDelete,
+		Importer: helper.IdSpec{"resource_type", "resource_id", "rule_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -20,13 +20,15 @@ var testDayuL7RuleResourceKey = testDayuL7RuleResourceName + ".test_rule"
 
 func TestAccTencentCloudDayuL7RuleResource(t *testing.T) {
 	t.Parallel()
+	certificateId := tcacctest.FixtureCertificateId(t)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { tcacctest.AccPreCheck(t) },
 		Providers:    tcacctest.AccProviders,
 		CheckDestroy: testAccCheckDayuL7RuleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: fmt.Sprintf(testAccDayuL7Rule, tcacctest.DefaultDayuBgpIp, tcacctest.DefaultSshCertificate),
+				Config: fmt.Sprintf(testAccDayuL7Rule, tcacctest.DefaultDayuBgpIp, certificateId),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDayuL7RuleExists(testDayuL7RuleResourceKey),
 					resource.TestCheckResourceAttrSet(testDayuL7RuleResourceKey, "rule_id"),
@@ -37,7 +39,7 @@ func TestAccTencentCloudDayuL7RuleResource(t *testing.T) {
 					resource.TestCheckResourceAttr(testDayuL7RuleResourceKey, "source_list.#", "2"),
 					resource.TestCheckResourceAttr(testDayuL7RuleResourceKey, "switch", "true"),
 					resource.TestCheckResourceAttr(testDayuL7RuleResourceKey, "protocol", "https"),
-					resource.TestCheckResourceAttr(testDayuL7RuleResourceKey, "ssl_id", tcacctest.DefaultSshCertificate),
+					resource.TestCheckResourceAttr(testDayuL7RuleResourceKey, "ssl_id", certificateId),
 					resource.TestCheckResourceAttr(testDayuL7RuleResourceKey, "health_check_code", "31"),
 					resource.TestCheckResourceAttr(testDayuL7RuleResourceKey, "health_check_switch", "true"),
 					resource.TestCheckResourceAttr(testDayuL7RuleResourceKey, "health_check_interval", "30"),
@@ -132,7 +132,7 @@ func dataSourceTencentCloudAntiddosOverviewDdosTrendRead(d *schema.ResourceData,
 	d.SetId(helper.BuildToken())
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), resultMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), resultMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -183,7 +183,7 @@ func dataSourceTencentCloudAntiddosOverviewIndexRead(d *schema.ResourceData, met
 	d.SetId(helper.BuildToken())
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), resultMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), resultMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
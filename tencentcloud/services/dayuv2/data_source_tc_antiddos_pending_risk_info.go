@@ -106,7 +106,7 @@ func dataSourceTencentCloudAntiddosPendingRiskInfoRead(d *schema.ResourceData, m
 	d.SetId(helper.BuildToken())
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), resultMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), resultMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
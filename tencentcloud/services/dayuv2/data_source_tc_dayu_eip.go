@@ -170,7 +170,7 @@ func dataSourceTencentCloudDayuEipRead(d *schema.ResourceData, meta interface{})
 	_ = d.Set("list", resultList)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return tccommon.WriteToFile(output.(string), resultList)
+		return tccommon.WriteToFile(output.(string), resultList, tccommon.GetResultOutputOptions(d))
 	}
 	return nil
 
@@ -253,7 +253,7 @@ func dataSourceTencentCloudDayuL7RulesReadV2(d *schema.ResourceData, meta interf
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return tccommon.WriteToFile(output.(string), resultList)
+		return tccommon.WriteToFile(output.(string), resultList, tccommon.GetResultOutputOptions(d))
 	}
 	return nil
 }
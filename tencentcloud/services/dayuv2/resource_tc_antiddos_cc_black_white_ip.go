@@ -19,12 +19,10 @@ import (
 
 func ResourceTencentCloudAntiddosCcBlackWhiteIp() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAntiddosCcBlackWhiteIpCreate,
-		Read:   resourceTencentCloudAntiddosCcBlackWhiteIpRead,
-		Delete: resourceTencentCloudAntiddosCcBlackWhiteIpDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAntiddosCcBlackWhiteIpCreate,
+		Read:     resourceTencentCloudAntiddosCcBlackWhiteIpRead,
+		Delete:   resourceTencentCloudAntiddosCcBlackWhiteIpDelete,
+		Importer: helper.IdSpec{"instance_id", "policy_id", "ip", "domain", "protocol"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -20,13 +20,11 @@ import (
 
 func ResourceTencentCloudAntiddosCcPrecisionPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAntiddosCcPrecisionPolicyCreate,
-		Read:   resourceTencentCloudAntiddosCcPrecisionPolicyRead,
-		Update: resourceTencentCloudAntiddosCcPrecisionPolicyUpdate,
-		Delete: resourceTencentCloudAntiddosCcPrecisionPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAntiddosCcPrecisionPolicyCreate,
+		Read:     resourceTencentCloudAntiddosCcPrecisionPolicyRead,
+		Update:   resourceTencentCloudAntiddosCcPrecisionPolicyUpdate,
+		Delete:   resourceTencentCloudAntiddosCcPrecisionPolicyDelete,
+		Importer: helper.IdSpec{"instance_id", "policy_id", "ip", "domain", "protocol"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
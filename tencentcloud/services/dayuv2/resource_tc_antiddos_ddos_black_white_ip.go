@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudAntiddosDdosBlackWhiteIp() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAntiddosDdosBlackWhiteIpCreate,
-		Read:   resourceTencentCloudAntiddosDdosBlackWhiteIpRead,
-		Update: resourceTencentCloudAntiddosDdosBlackWhiteIpUpdate,
-		Delete: resourceTencentCloudAntiddosDdosBlackWhiteIpDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAntiddosDdosBlackWhiteIpCreate,
+		Read:     resourceTencentCloudAntiddosDdosBlackWhiteIpRead,
+		Update:   resourceTencentCloudAntiddosDdosBlackWhiteIpUpdate,
+		Delete:   resourceTencentCloudAntiddosDdosBlackWhiteIpDelete,
+		Importer: helper.IdSpec{"instance_id", "ip"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -20,13 +20,11 @@ import (
 
 func ResourceTencentCloudAntiddosDdosGeoIpBlockConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAntiddosDdosGeoIpBlockConfigCreate,
-		Read:   resourceTencentCloudAntiddosDdosGeoIpBlockConfigRead,
-		Update: resourceTencentCloudAntiddosDdosGeoIpBlockConfigUpdate,
-		Delete: resourceTencentCloudAntiddosDdosGeoIpBlockConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAntiddosDdosGeoIpBlockConfigCreate,
+		Read:     resourceTencentCloudAntiddosDdosGeoIpBlockConfigRead,
+		Update:   resourceTencentCloudAntiddosDdosGeoIpBlockConfigUpdate,
+		Delete:   resourceTencentCloudAntiddosDdosGeoIpBlockConfigDelete,
+		Importer: helper.IdSpec{"instance_id", "config_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
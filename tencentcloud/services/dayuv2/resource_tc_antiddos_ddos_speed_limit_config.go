@@ -20,13 +20,11 @@ import (
 
 func ResourceTencentCloudAntiddosDdosSpeedLimitConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAntiddosDdosSpeedLimitConfigCreate,
-		Read:   resourceTencentCloudAntiddosDdosSpeedLimitConfigRead,
-		Update: resourceTencentCloudAntiddosDdosSpeedLimitConfigUpdate,
-		Delete: resourceTencentCloudAntiddosDdosSpeedLimitConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAntiddosDdosSpeedLimitConfigCreate,
+		Read:     resourceTencentCloudAntiddosDdosSpeedLimitConfigRead,
+		Update:   resourceTencentCloudAntiddosDdosSpeedLimitConfigUpdate,
+		Delete:   resourceTencentCloudAntiddosDdosSpeedLimitConfigDelete,
+		Importer: helper.IdSpec{"instance_id", "config_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -19,13 +19,11 @@ import (
 
 func ResourceTencentCloudAntiddosIpAlarmThresholdConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAntiddosIpAlarmThresholdConfigCreate,
-		Read:   resourceTencentCloudAntiddosIpAlarmThresholdConfigRead,
-		Update: resourceTencentCloudAntiddosIpAlarmThresholdConfigUpdate,
-		Delete: resourceTencentCloudAntiddosIpAlarmThresholdConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAntiddosIpAlarmThresholdConfigCreate,
+		Read:     resourceTencentCloudAntiddosIpAlarmThresholdConfigRead,
+		Update:   resourceTencentCloudAntiddosIpAlarmThresholdConfigUpdate,
+		Delete:   resourceTencentCloudAntiddosIpAlarmThresholdConfigDelete,
+		Importer: helper.IdSpec{"instance_id", "instance_ip", "alarm_type"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"alarm_type": {
@@ -18,12 +18,10 @@ import (
 
 func ResourceTencentCloudAntiddosPacketFilterConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAntiddosPacketFilterConfigCreate,
-		Read:   resourceTencentCloudAntiddosPacketFilterConfigRead,
-		Delete: resourceTencentCloudAntiddosPacketFilterConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAntiddosPacketFilterConfigCreate,
+		Read:     resourceTencentCloudAntiddosPacketFilterConfigRead,
+		Delete:   resourceTencentCloudAntiddosPacketFilterConfigDelete,
+		Importer: helper.IdSpec{"instance_id", "config_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -19,12 +19,10 @@ import (
 
 func ResourceTencentCloudAntiddosPortAclConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudAntiddosPortAclConfigCreate,
-		Read:   resourceTencentCloudAntiddosPortAclConfigRead,
-		Delete: resourceTencentCloudAntiddosPortAclConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudAntiddosPortAclConfigCreate,
+		Read:     resourceTencentCloudAntiddosPortAclConfigRead,
+		Delete:   resourceTencentCloudAntiddosPortAclConfigDelete,
+		Importer: helper.IdSpec{"instance_id", "config_json"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
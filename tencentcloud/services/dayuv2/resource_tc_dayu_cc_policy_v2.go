@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudDayuCCPolicyV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuCCPolicyV2Create,
-		Read:   resourceTencentCloudDayuCCPolicyV2Read,
-		Update: resourceTencentCloudDayuCCPolicyV2Update,
-		Delete: resourceTencentCloudDayuCCPolicyV2Delete,
+		Create:   resourceTencentCloudDayuCCPolicyV2Create,
+		Read:     resourceTencentCloudDayuCCPolicyV2Read,
+		Update:   resourceTencentCloudDayuCCPolicyV2Update,
+		Delete:   resourceTencentCloudDayuCCPolicyV2Delete,
+		Importer: helper.IdSpec{"instance_id", "business"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -20,9 +20,10 @@ import (
 
 func ResourceTencentCloudDayuDDosIpAttachmentV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuDDosIpAttachmentCreateV2,
-		Read:   resourceTencentCloudDayuDDosIpAttachmentReadV2,
-		Delete: resourceTencentCloudDayuDDosIpAttachmentDeleteV2,
+		Create:   resourceTencentCloudDayuDDosIpAttachmentCreateV2,
+		Read:     resourceTencentCloudDayuDDosIpAttachmentReadV2,
+		Delete:   resourceTencentCloudDayuDDosIpAttachmentDeleteV2,
+		Importer: helper.IdSpec{"bgp_instance_id", "bound_ips"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"bgp_instance_id": {
 				Required:    true,
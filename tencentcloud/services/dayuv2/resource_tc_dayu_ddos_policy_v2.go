@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudDayuDdosPolicyV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuDdosPolicyV2Create,
-		Read:   resourceTencentCloudDayuDdosPolicyV2Read,
-		Update: resourceTencentCloudDayuDdosPolicyV2Update,
-		Delete: resourceTencentCloudDayuDdosPolicyV2Delete,
+		Create:   resourceTencentCloudDayuDdosPolicyV2Create,
+		Read:     resourceTencentCloudDayuDdosPolicyV2Read,
+		Update:   resourceTencentCloudDayuDdosPolicyV2Update,
+		Delete:   resourceTencentCloudDayuDdosPolicyV2Delete,
+		Importer: helper.IdSpec{"resource_id", "business"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	svcantiddos "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/antiddos"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,9 +14,10 @@ import (
 
 func ResourceTencentCloudDayuEip() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuEipCreate,
-		Read:   resourceTencentCloudDayuEipRead,
-		Delete: resourceTencentCloudDayuEipDelete,
+		Create:   resourceTencentCloudDayuEipCreate,
+		Read:     resourceTencentCloudDayuEipRead,
+		Delete:   resourceTencentCloudDayuEipDelete,
+		Importer: helper.IdSpec{"resource_id", "eip"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
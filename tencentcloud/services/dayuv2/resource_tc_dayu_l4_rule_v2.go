@@ -12,14 +12,16 @@ import (
 	dayu "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/dayu/v20180709"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	svcdayu "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/dayu"
 )
 
 func ResourceTencentCloudDayuL4RuleV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuL4RuleCreateV2,
-		Read:   resourceTencentCloudDayuL4RuleReadV2,
-		Delete: resourceTencentCloudDayuL4RuleDeleteV2,
+		Create:   resourceTencentCloudDayuL4RuleCreateV2,
+		Read:     resourceTencentCloudDayuL4RuleReadV2,
+		Delete:   resourceTencentCloudDayuL4RuleDeleteV2,
+		Importer: helper.IdSpec{"business", "resource_id", "ip", "virtual_port"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"business": {
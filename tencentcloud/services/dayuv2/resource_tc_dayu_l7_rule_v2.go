@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	svcdayu "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/dayu"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -15,10 +16,11 @@ import (
 
 func ResourceTencentCloudDayuL7RuleV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDayuL7RuleCreateV2,
-		Read:   resourceTencentCloudDayuL7RuleReadV2,
-		Update: resourceTencentCloudDayuL7RuleUpdateV2,
-		Delete: resourceTencentCloudDayuL7RuleDeleteV2,
+		Create:   resourceTencentCloudDayuL7RuleCreateV2,
+		Read:     resourceTencentCloudDayuL7RuleReadV2,
+		Update:   resourceTencentCloudDayuL7RuleUpdateV2,
+		Delete:   resourceTencentCloudDayuL7RuleDeleteV2,
+		Importer: helper.IdSpec{"business", "domain", "protocol"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
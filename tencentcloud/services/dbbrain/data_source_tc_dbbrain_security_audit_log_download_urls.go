@@ -99,7 +99,7 @@ func dataSourceTencentCloudDbbrainSecurityAuditLogDownloadUrlsRead(d *schema.Res
 	d.SetId(strings.Join([]string{sagId, helper.Int64ToStr(int64(asyncReqId))}, tccommon.FILED_SP))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), urls); e != nil {
+		if e := tccommon.WriteToFile(output.(string), urls, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -162,7 +162,7 @@ func dataSourceTencentCloudDbbrainSlowLogUserSqlAdviceRead(d *schema.ResourceDat
 	d.SetId(id)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), result); e != nil {
+		if e := tccommon.WriteToFile(output.(string), result, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -211,7 +211,7 @@ func dataSourceTencentCloudDbbrainSqlFiltersRead(d *schema.ResourceData, meta in
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), itemList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), itemList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudDbbrainDbDiagReportTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDbbrainDbDiagReportTaskCreate,
-		Read:   resourceTencentCloudDbbrainDbDiagReportTaskRead,
-		Delete: resourceTencentCloudDbbrainDbDiagReportTaskDelete,
+		Create:   resourceTencentCloudDbbrainDbDiagReportTaskCreate,
+		Read:     resourceTencentCloudDbbrainDbDiagReportTaskRead,
+		Delete:   resourceTencentCloudDbbrainDbDiagReportTaskDelete,
+		Importer: helper.IdSpec{"async_request_id", "instance_id", "product"}.Importer(),
 		// contact_group, contact_person, send_mail_flag and product fileds can not query by read api
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -64,6 +64,8 @@ func ResourceTencentCloudDbbrainModifyDiagDbInstanceOperation() *schema.Resource
 				},
 				Description: "Specifies the ID of the instance whose inspection status is changed.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -18,12 +18,10 @@ import (
 
 func ResourceTencentCloudDbbrainSecurityAuditLogExportTask() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDbbrainSecurityAuditLogExportTaskRead,
-		Create: resourceTencentCloudDbbrainSecurityAuditLogExportTaskCreate,
-		Delete: resourceTencentCloudDbbrainSecurityAuditLogExportTaskDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Read:     resourceTencentCloudDbbrainSecurityAuditLogExportTaskRead,
+		Create:   resourceTencentCloudDbbrainSecurityAuditLogExportTaskCreate,
+		Delete:   resourceTencentCloudDbbrainSecurityAuditLogExportTaskDelete,
+		Importer: helper.IdSpec{"sec_audit_group_id", "async_request_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"sec_audit_group_id": {
 				Type:        schema.TypeString,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudDbbrainSqlFilter() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDbbrainSqlFilterRead,
-		Create: resourceTencentCloudDbbrainSqlFilterCreate,
-		Update: resourceTencentCloudDbbrainSqlFilterUpdate,
-		Delete: resourceTencentCloudDbbrainSqlFilterDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Read:     resourceTencentCloudDbbrainSqlFilterRead,
+		Create:   resourceTencentCloudDbbrainSqlFilterCreate,
+		Update:   resourceTencentCloudDbbrainSqlFilterUpdate,
+		Delete:   resourceTencentCloudDbbrainSqlFilterDelete,
+		Importer: helper.IdSpec{"instance_id", "filter_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -45,6 +43,7 @@ func ResourceTencentCloudDbbrainSqlFilter() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							Description: "password.",
 						},
@@ -17,9 +17,10 @@ import (
 
 func ResourceTencentCloudDbbrainTdsqlAuditLog() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDbbrainTdsqlAuditLogCreate,
-		Read:   resourceTencentCloudDbbrainTdsqlAuditLogRead,
-		Delete: resourceTencentCloudDbbrainTdsqlAuditLogDelete,
+		Create:   resourceTencentCloudDbbrainTdsqlAuditLogCreate,
+		Read:     resourceTencentCloudDbbrainTdsqlAuditLogRead,
+		Delete:   resourceTencentCloudDbbrainTdsqlAuditLogDelete,
+		Importer: helper.IdSpec{"async_request_id", "instance_id", "product"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"product": {
 				Required:    true,
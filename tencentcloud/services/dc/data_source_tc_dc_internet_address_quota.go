@@ -112,7 +112,7 @@ func dataSourceTencentCloudDcInternetAddressQuotaRead(d *schema.ResourceData, me
 	d.SetId(helper.Int64ToStr(*quota.Response.Ipv4BgpQuota))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
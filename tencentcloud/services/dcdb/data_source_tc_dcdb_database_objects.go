@@ -200,7 +200,7 @@ func dataSourceTencentCloudDcdbDatabaseObjectsRead(d *schema.ResourceData, meta
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), data); e != nil {
+		if e := tccommon.WriteToFile(output.(string), data, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -95,7 +95,7 @@ func dataSourceTencentCloudDcdbFileDownloadUrlRead(d *schema.ResourceData, meta
 	d.SetId(instanceId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), preSignedUrl); e != nil {
+		if e := tccommon.WriteToFile(output.(string), preSignedUrl, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
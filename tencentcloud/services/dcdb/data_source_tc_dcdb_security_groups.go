@@ -216,7 +216,7 @@ func dataSourceTencentCloudDcdbSecurityGroupsRead(d *schema.ResourceData, meta i
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), groupList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), groupList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -368,7 +368,7 @@ func dataSourceTencentCloudDcdbSlowLogsRead(d *schema.ResourceData, meta interfa
 	d.SetId(instanceId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), slowLogs); e != nil {
+		if e := tccommon.WriteToFile(output.(string), slowLogs, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
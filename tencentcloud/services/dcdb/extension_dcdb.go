@@ -11,3 +11,10 @@ const (
 	DCDB_DCN_FLAG_MASTER = 1
 	DCDB_DCN_FLAG_SLAVE  = 2
 )
+
+// DCDB flow status, 0:success; 1:failed, 2:running
+const (
+	DCDB_FLOW_STATUS_SUCCESS = "0"
+	DCDB_FLOW_STATUS_FAILED  = "1"
+	DCDB_FLOW_STATUS_RUNNING = "2"
+)
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudDcdbAccount() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDcdbAccountRead,
-		Create: resourceTencentCloudDcdbAccountCreate,
-		Update: resourceTencentCloudDcdbAccountUpdate,
-		Delete: resourceTencentCloudDcdbAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudDcdbAccountRead,
+		Create:   resourceTencentCloudDcdbAccountCreate,
+		Update:   resourceTencentCloudDcdbAccountUpdate,
+		Delete:   resourceTencentCloudDcdbAccountDelete,
+		Importer: helper.IdSpec{"instance_id", "user_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -371,6 +370,7 @@ func resourceTencentCloudDcdbAccountPrivilegesUpdate(d *schema.ResourceData, met
 	defer tccommon.InconsistentCheck(d, meta)()
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
 	request := dcdb.NewModifyAccountPrivilegesRequest()
 
@@ -534,9 +534,7 @@ func resourceTencentCloudDcdbAccountPrivilegesUpdate(d *schema.ResourceData, met
 
 	if flowId != nil {
 		// need to wait modify operation success
-		// 0:success; 1:failed, 2:running
-		conf := tccommon.BuildStateChangeConf([]string{}, []string{"0"}, 3*tccommon.ReadRetryTimeout, time.Second, service.DcdbDbInstanceStateRefreshFunc(flowId, []string{}))
-		if _, e := conf.WaitForState(); e != nil {
+		if e := service.WaitForFlow(ctx, flowId, 3*tccommon.ReadRetryTimeout); e != nil {
 			return e
 		}
 	}
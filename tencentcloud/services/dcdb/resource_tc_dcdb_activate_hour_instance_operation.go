@@ -24,6 +24,8 @@ func ResourceTencentCloudDcdbActivateHourInstanceOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "instance ID in the format of dcdbt-ow728lmc, which can be obtained through the `DescribeDCDBInstances` API.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -1,8 +1,8 @@
 package dcdb
 
 import (
+	"context"
 	"log"
-	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -17,6 +17,7 @@ func ResourceTencentCloudDcdbCancelDcnJobOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDcdbCancelDcnJobOperationCreate,
 		Read:   resourceTencentCloudDcdbCancelDcnJobOperationRead,
+		Update: resourceTencentCloudDcdbCancelDcnJobOperationUpdate,
 		Delete: resourceTencentCloudDcdbCancelDcnJobOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -25,6 +26,10 @@ func ResourceTencentCloudDcdbCancelDcnJobOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Instance ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -34,6 +39,7 @@ func resourceTencentCloudDcdbCancelDcnJobOperationCreate(d *schema.ResourceData,
 	defer tccommon.InconsistentCheck(d, meta)()
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
 	var (
 		request    = dcdb.NewCancelDcnJobRequest()
@@ -61,11 +67,11 @@ func resourceTencentCloudDcdbCancelDcnJobOperationCreate(d *schema.ResourceData,
 		return err
 	}
 
-	// need to wait flow success
-	// 0:success; 1:failed, 2:running
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"0"}, 3*tccommon.ReadRetryTimeout, time.Second, service.DcdbDbInstanceStateRefreshFunc(flowId, []string{"1"}))
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+	if tccommon.OperationWaitForCompletion(d) {
+		// need to wait flow success
+		if e := service.WaitForFlow(ctx, flowId, 3*tccommon.ReadRetryTimeout); e != nil {
+			return e
+		}
 	}
 
 	d.SetId(instanceId)
@@ -80,6 +86,14 @@ func resourceTencentCloudDcdbCancelDcnJobOperationRead(d *schema.ResourceData, m
 	return nil
 }
 
+func resourceTencentCloudDcdbCancelDcnJobOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dcdb_cancel_dcn_job_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDcdbCancelDcnJobOperationRead(d, meta)
+}
+
 func resourceTencentCloudDcdbCancelDcnJobOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dcdb_cancel_dcn_job_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudDcdbDbParameters() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcdbDbParametersCreate,
-		Read:   resourceTencentCloudDcdbDbParametersRead,
-		Update: resourceTencentCloudDcdbDbParametersUpdate,
-		Delete: resourceTencentCloudDcdbDbParametersDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudDcdbDbParametersCreate,
+		Read:     resourceTencentCloudDcdbDbParametersRead,
+		Update:   resourceTencentCloudDcdbDbParametersUpdate,
+		Delete:   resourceTencentCloudDcdbDbParametersDelete,
+		Importer: helper.IdSpec{"instance_id", "param_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -24,6 +24,8 @@ func ResourceTencentCloudDcdbFlushBinlogOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Instance ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
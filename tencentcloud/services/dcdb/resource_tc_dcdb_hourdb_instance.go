@@ -318,9 +318,7 @@ func resourceTencentCloudDcdbHourdbInstanceCreate(d *schema.ResourceData, meta i
 
 	if flowId != nil {
 		// need to wait init operation success
-		// 0:success; 1:failed, 2:running
-		conf := tccommon.BuildStateChangeConf([]string{}, []string{"0"}, 3*tccommon.ReadRetryTimeout, time.Second, service.DcdbDbInstanceStateRefreshFunc(helper.UInt64Int64(*flowId), []string{}))
-		if _, e := conf.WaitForState(); e != nil {
+		if e := service.WaitForFlow(ctx, helper.UInt64Int64(*flowId), 3*tccommon.ReadRetryTimeout); e != nil {
 			return e
 		}
 	}
@@ -24,6 +24,8 @@ func ResourceTencentCloudDcdbIsolateHourInstanceOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Instance ID list.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudDcdbSecurityGroupAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDcdbSecurityGroupAttachmentRead,
-		Create: resourceTencentCloudDcdbSecurityGroupAttachmentCreate,
-		Delete: resourceTencentCloudDcdbSecurityGroupAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudDcdbSecurityGroupAttachmentRead,
+		Create:   resourceTencentCloudDcdbSecurityGroupAttachmentCreate,
+		Delete:   resourceTencentCloudDcdbSecurityGroupAttachmentDelete,
+		Importer: helper.IdSpec{"instance_id", "security_group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"security_group_id": {
 				Type:        schema.TypeString,
@@ -1,8 +1,8 @@
 package dcdb
 
 import (
+	"context"
 	"log"
-	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -17,6 +17,7 @@ func ResourceTencentCloudDcdbSwitchDbInstanceHaOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDcdbSwitchDbInstanceHaOperationCreate,
 		Read:   resourceTencentCloudDcdbSwitchDbInstanceHaOperationRead,
+		Update: resourceTencentCloudDcdbSwitchDbInstanceHaOperationUpdate,
 		Delete: resourceTencentCloudDcdbSwitchDbInstanceHaOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -32,6 +33,10 @@ func ResourceTencentCloudDcdbSwitchDbInstanceHaOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Target AZ. The node with the lowest delay in the target AZ will be automatically promoted to primary node.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -41,6 +46,7 @@ func resourceTencentCloudDcdbSwitchDbInstanceHaOperationCreate(d *schema.Resourc
 	defer tccommon.InconsistentCheck(d, meta)()
 
 	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
 	var (
 		request    = dcdb.NewSwitchDBInstanceHARequest()
@@ -72,12 +78,12 @@ func resourceTencentCloudDcdbSwitchDbInstanceHaOperationCreate(d *schema.Resourc
 		return err
 	}
 
-	if flowId != nil {
-		// need to wait init operation success
-		// 0:success; 1:failed, 2:running
-		conf := tccommon.BuildStateChangeConf([]string{}, []string{"0"}, 3*tccommon.ReadRetryTimeout, time.Second, service.DcdbDbInstanceStateRefreshFunc(helper.UInt64Int64(*flowId), []string{}))
-		if _, e := conf.WaitForState(); e != nil {
-			return e
+	if tccommon.OperationWaitForCompletion(d) {
+		if flowId != nil {
+			// need to wait init operation success
+			if e := service.WaitForFlow(ctx, helper.UInt64Int64(*flowId), 3*tccommon.ReadRetryTimeout); e != nil {
+				return e
+			}
 		}
 	}
 
@@ -93,6 +99,14 @@ func resourceTencentCloudDcdbSwitchDbInstanceHaOperationRead(d *schema.ResourceD
 	return nil
 }
 
+func resourceTencentCloudDcdbSwitchDbInstanceHaOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dcdb_switch_db_instance_ha_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDcdbSwitchDbInstanceHaOperationRead(d, meta)
+}
+
 func resourceTencentCloudDcdbSwitchDbInstanceHaOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dcdb_switch_db_instance_ha_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -14,6 +14,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -693,30 +694,34 @@ func (me *DcdbService) DescribeDcdbFlowById(ctx context.Context, flowId *int64)
 	return
 }
 
-func (me *DcdbService) DcdbDbInstanceStateRefreshFunc(flowId *int64, failStates []string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		ctx := tccommon.ContextNil
-
+// FlowRefreshFunc reports the flow as a task. A zero flow ID means that there is no flow to wait for.
+func (me *DcdbService) FlowRefreshFunc(flowId *int64) waiter.RefreshFunc {
+	return func(ctx context.Context) (*waiter.Task, error) {
 		if *flowId == 0 {
-			return &dcdb.DescribeFlowResponseParams{}, "0", nil
+			return &waiter.Task{Status: DCDB_FLOW_STATUS_SUCCESS}, nil
 		}
 
 		object, err := me.DescribeDcdbFlowById(ctx, flowId)
-
 		if err != nil {
-			return nil, "", err
+			return nil, err
 		}
-
-		for _, str := range failStates {
-			if strings.Contains(str, helper.Int64ToStr(*object.Status)) {
-				return &dcdb.DescribeFlowResponseParams{}, "1", nil
-			}
+		if object == nil || object.Status == nil {
+			return nil, nil
 		}
 
-		return object, helper.Int64ToStr(*object.Status), nil
+		return &waiter.Task{Status: helper.Int64ToStr(*object.Status), Result: object}, nil
 	}
 }
 
+// WaitForFlow waits for the flow to succeed.
+func (me *DcdbService) WaitForFlow(ctx context.Context, flowId *int64, timeout time.Duration) error {
+	w := waiter.NewWaiter(fmt.Sprintf("dcdb flow %d", *flowId), []string{DCDB_FLOW_STATUS_RUNNING},
+		[]string{DCDB_FLOW_STATUS_SUCCESS}, []string{DCDB_FLOW_STATUS_FAILED}, timeout, me.FlowRefreshFunc(flowId))
+	w.Delay = time.Second
+	_, err := w.WaitForState(ctx)
+	return err
+}
+
 // tencentcloud_dcdb_account_privileges
 func (me *DcdbService) DescribeDcdbAccountPrivilegesById(ctx context.Context, ids string, dbName, aType, object, colName *string) (accountPrivileges *dcdb.DescribeAccountPrivilegesResponseParams, errRet error) {
 	logId := tccommon.GetLogId(ctx)
@@ -1156,9 +1161,7 @@ func (me *DcdbService) SetDcdbExtranetAccess(ctx context.Context, instanceId str
 
 	if flowId != nil {
 		// need to wait operation complete
-		// 0:success; 1:failed, 2:running
-		conf := tccommon.BuildStateChangeConf([]string{}, []string{"0"}, 2*tccommon.ReadRetryTimeout, time.Second, me.DcdbDbInstanceStateRefreshFunc(flowId, []string{"1"}))
-		if _, e := conf.WaitForState(); e != nil {
+		if e := me.WaitForFlow(ctx, flowId, 2*tccommon.ReadRetryTimeout); e != nil {
 			return e
 		}
 	}
@@ -1221,9 +1224,7 @@ func (me *DcdbService) SetNetworkVip(ctx context.Context, instanceId, vpcId, sub
 
 	if flowId != nil {
 		// need to wait operation complete
-		// 0:success; 1:failed, 2:running
-		conf := tccommon.BuildStateChangeConf([]string{}, []string{"0"}, 2*tccommon.ReadRetryTimeout, time.Second, me.DcdbDbInstanceStateRefreshFunc(flowId, []string{"1"}))
-		if _, e := conf.WaitForState(); e != nil {
+		if e := me.WaitForFlow(ctx, flowId, 2*tccommon.ReadRetryTimeout); e != nil {
 			return e
 		}
 	}
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudDcGatewayAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcGatewayAttachmentCreate,
-		Read:   resourceTencentCloudDcGatewayAttachmentRead,
-		Delete: resourceTencentCloudDcGatewayAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudDcGatewayAttachmentCreate,
+		Read:     resourceTencentCloudDcGatewayAttachmentRead,
+		Delete:   resourceTencentCloudDcGatewayAttachmentDelete,
+		Importer: helper.IdSpec{"vpc_id", "direct_connect_gateway_id", "nat_gateway_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Required:    true,
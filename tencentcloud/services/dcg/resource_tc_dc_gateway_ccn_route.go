@@ -7,6 +7,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,9 +15,10 @@ import (
 
 func ResourceTencentCloudDcGatewayCcnRouteInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDcGatewayCcnRouteCreate,
-		Read:   resourceTencentCloudDcGatewayCcnRouteRead,
-		Delete: resourceTencentCloudDcGatewayCcnRouteDelete,
+		Create:   resourceTencentCloudDcGatewayCcnRouteCreate,
+		Read:     resourceTencentCloudDcGatewayCcnRouteRead,
+		Delete:   resourceTencentCloudDcGatewayCcnRouteDelete,
+		Importer: helper.IdSpec{"dcg_id", "route_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"dcg_id": {
 				Type:        schema.TypeString,
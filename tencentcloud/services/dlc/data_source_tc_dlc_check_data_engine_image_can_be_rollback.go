@@ -95,7 +95,7 @@ func dataSourceTencentCloudDlcCheckDataEngineImageCanBeRollbackRead(d *schema.Re
 	d.SetId(dataEngineId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), data); e != nil {
+		if e := tccommon.WriteToFile(output.(string), data, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
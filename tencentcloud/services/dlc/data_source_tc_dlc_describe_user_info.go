@@ -879,7 +879,7 @@ func dataSourceTencentCloudDlcDescribeUserInfoRead(d *schema.ResourceData, meta
 	d.SetId(userId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), userDetailInfoMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), userDetailInfoMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -70,7 +70,7 @@ func dataSourceTencentCloudDlcDescribeUserTypeRead(d *schema.ResourceData, meta
 	d.SetId(userId + tccommon.FILED_SP + *userType)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), userType); e != nil {
+		if e := tccommon.WriteToFile(output.(string), userType, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudDlcAddUsersToWorkGroupAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDlcAddUsersToWorkGroupAttachmentCreate,
-		Read:   resourceTencentCloudDlcAddUsersToWorkGroupAttachmentRead,
-		Delete: resourceTencentCloudDlcAddUsersToWorkGroupAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudDlcAddUsersToWorkGroupAttachmentCreate,
+		Read:     resourceTencentCloudDlcAddUsersToWorkGroupAttachmentRead,
+		Delete:   resourceTencentCloudDlcAddUsersToWorkGroupAttachmentDelete,
+		Importer: helper.IdSpec{"work_group_id", "user_ids"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"add_info": {
 				Required:    true,
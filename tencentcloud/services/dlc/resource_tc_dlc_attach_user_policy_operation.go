@@ -123,6 +123,8 @@ func ResourceTencentCloudDlcAttachUserPolicyOperation() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
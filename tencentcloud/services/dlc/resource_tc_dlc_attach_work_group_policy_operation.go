@@ -123,6 +123,8 @@ func ResourceTencentCloudDlcAttachWorkGroupPolicyOperation() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudDlcDataEngine() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDlcDataEngineCreate,
-		Read:   resourceTencentCloudDlcDataEngineRead,
-		Update: resourceTencentCloudDlcDataEngineUpdate,
-		Delete: resourceTencentCloudDlcDataEngineDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudDlcDataEngineCreate,
+		Read:     resourceTencentCloudDlcDataEngineRead,
+		Update:   resourceTencentCloudDlcDataEngineUpdate,
+		Delete:   resourceTencentCloudDlcDataEngineDelete,
+		Importer: helper.IdSpec{"data_engine_name", "data_engine_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"engine_type": {
 				Required:    true,
@@ -123,6 +123,8 @@ func ResourceTencentCloudDlcDetachUserPolicyOperation() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -123,6 +123,8 @@ func ResourceTencentCloudDlcDetachWorkGroupPolicyOperation() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
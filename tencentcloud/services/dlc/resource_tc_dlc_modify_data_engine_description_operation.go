@@ -34,6 +34,8 @@ func ResourceTencentCloudDlcModifyDataEngineDescriptionOperation() *schema.Resou
 				Type:        schema.TypeString,
 				Description: "Engine description information, the maximum length is 250.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -34,6 +34,8 @@ func ResourceTencentCloudDlcModifyUserTypOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "User type, only support: ADMIN: ddministrator/COMMON: ordinary user.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -55,6 +55,8 @@ func ResourceTencentCloudDlcRenewDataEngineOperation() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "Automatic renewal flag, 0, initial state, automatic renewal is not performed by default. if the user has prepaid non-stop service privileges, automatic renewal will occur. 1: Automatic renewal. 2: make it clear that there will be no automatic renewal. if this parameter is not passed, the default value is 0.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -17,6 +17,7 @@ func ResourceTencentCloudDlcRestartDataEngineOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDlcRestartDataEngineCreateOperation,
 		Read:   resourceTencentCloudDlcRestartDataEngineReadOperation,
+		Update: resourceTencentCloudDlcRestartDataEngineUpdateOperation,
 		Delete: resourceTencentCloudDlcRestartDataEngineDeleteOperation,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -35,6 +36,10 @@ func ResourceTencentCloudDlcRestartDataEngineOperation() *schema.Resource {
 				Type:        schema.TypeBool,
 				Description: "Whether to force restart and ignore tasks.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -74,12 +79,14 @@ func resourceTencentCloudDlcRestartDataEngineCreateOperation(d *schema.ResourceD
 
 	d.SetId(dataEngineId)
 
-	service := DlcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := DlcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 5*tccommon.ReadRetryTimeout, time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 5*tccommon.ReadRetryTimeout, time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudDlcRestartDataEngineReadOperation(d, meta)
@@ -92,6 +99,14 @@ func resourceTencentCloudDlcRestartDataEngineReadOperation(d *schema.ResourceDat
 	return nil
 }
 
+func resourceTencentCloudDlcRestartDataEngineUpdateOperation(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dlc_restart_data_engine_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDlcRestartDataEngineReadOperation(d, meta)
+}
+
 func resourceTencentCloudDlcRestartDataEngineDeleteOperation(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dlc_restart_data_engine_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
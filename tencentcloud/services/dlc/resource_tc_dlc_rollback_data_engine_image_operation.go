@@ -41,6 +41,8 @@ func ResourceTencentCloudDlcRollbackDataEngineImageOperation() *schema.Resource
 				Type:        schema.TypeString,
 				Description: "Log record id after rollback.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
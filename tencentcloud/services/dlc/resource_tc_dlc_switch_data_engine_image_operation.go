@@ -17,6 +17,7 @@ func ResourceTencentCloudDlcSwitchDataEngineImageOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDlcSwitchDataEngineImageOperationCreate,
 		Read:   resourceTencentCloudDlcSwitchDataEngineImageOperationRead,
+		Update: resourceTencentCloudDlcSwitchDataEngineImageOperationUpdate,
 		Delete: resourceTencentCloudDlcSwitchDataEngineImageOperationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -35,6 +36,10 @@ func ResourceTencentCloudDlcSwitchDataEngineImageOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "New image version id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -74,12 +79,14 @@ func resourceTencentCloudDlcSwitchDataEngineImageOperationCreate(d *schema.Resou
 
 	d.SetId(dataEngineId)
 
-	service := DlcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := DlcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 5*tccommon.ReadRetryTimeout, time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 5*tccommon.ReadRetryTimeout, time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudDlcSwitchDataEngineImageOperationRead(d, meta)
@@ -92,6 +99,14 @@ func resourceTencentCloudDlcSwitchDataEngineImageOperationRead(d *schema.Resourc
 	return nil
 }
 
+func resourceTencentCloudDlcSwitchDataEngineImageOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dlc_switch_data_engine_image_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDlcSwitchDataEngineImageOperationRead(d, meta)
+}
+
 func resourceTencentCloudDlcSwitchDataEngineImageOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dlc_switch_data_engine_image_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
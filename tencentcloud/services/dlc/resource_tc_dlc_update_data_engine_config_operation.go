@@ -17,6 +17,7 @@ func ResourceTencentCloudDlcUpdateDataEngineConfigOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDlcUpdateDataEngineConfigOperationCreate,
 		Read:   resourceTencentCloudDlcUpdateDataEngineConfigOperationRead,
+		Update: resourceTencentCloudDlcUpdateDataEngineConfigOperationUpdate,
 		Delete: resourceTencentCloudDlcUpdateDataEngineConfigOperationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -35,6 +36,10 @@ func ResourceTencentCloudDlcUpdateDataEngineConfigOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Engine configuration command, supports UpdateSparkSQLLakefsPath (update native table configuration), UpdateSparkSQLResultPath (update result path configuration).",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -74,12 +79,14 @@ func resourceTencentCloudDlcUpdateDataEngineConfigOperationCreate(d *schema.Reso
 
 	d.SetId(dataEngineId)
 
-	service := DlcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := DlcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 5*tccommon.ReadRetryTimeout, time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 5*tccommon.ReadRetryTimeout, time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 	return resourceTencentCloudDlcUpdateDataEngineConfigOperationRead(d, meta)
 }
@@ -91,6 +98,14 @@ func resourceTencentCloudDlcUpdateDataEngineConfigOperationRead(d *schema.Resour
 	return nil
 }
 
+func resourceTencentCloudDlcUpdateDataEngineConfigOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dlc_update_data_engine_config_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDlcUpdateDataEngineConfigOperationRead(d, meta)
+}
+
 func resourceTencentCloudDlcUpdateDataEngineConfigOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dlc_update_data_engine_config_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -124,6 +124,8 @@ func ResourceTencentCloudDlcUpdateRowFilterOperation() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
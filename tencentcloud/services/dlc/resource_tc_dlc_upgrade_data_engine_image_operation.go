@@ -17,6 +17,7 @@ func ResourceTencentCloudDlcUpgradeDataEngineImageOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDlcUpgradeDataEngineImageOperationCreate,
 		Read:   resourceTencentCloudDlcUpgradeDataEngineImageOperationRead,
+		Update: resourceTencentCloudDlcUpgradeDataEngineImageOperationUpdate,
 		Delete: resourceTencentCloudDlcUpgradeDataEngineImageOperationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -28,6 +29,10 @@ func ResourceTencentCloudDlcUpgradeDataEngineImageOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Engine unique id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -63,12 +68,14 @@ func resourceTencentCloudDlcUpgradeDataEngineImageOperationCreate(d *schema.Reso
 
 	d.SetId(dataEngineId)
 
-	service := DlcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := DlcService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 5*tccommon.ReadRetryTimeout, time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 5*tccommon.ReadRetryTimeout, time.Second, service.DlcRestartDataEngineStateRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudDlcUpgradeDataEngineImageOperationRead(d, meta)
@@ -81,6 +88,14 @@ func resourceTencentCloudDlcUpgradeDataEngineImageOperationRead(d *schema.Resour
 	return nil
 }
 
+func resourceTencentCloudDlcUpgradeDataEngineImageOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dlc_upgrade_data_engine_image_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDlcUpgradeDataEngineImageOperationRead(d, meta)
+}
+
 func resourceTencentCloudDlcUpgradeDataEngineImageOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dlc_upgrade_data_engine_image_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
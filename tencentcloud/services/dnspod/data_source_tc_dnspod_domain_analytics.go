@@ -357,7 +357,7 @@ func dataSourceTencentCloudDnspodDomainAnalyticsRead(d *schema.ResourceData, met
 			"info":       info,
 			"data":       data,
 			"alias_data": aliasData,
-		})
+		}, tccommon.GetResultOutputOptions(d))
 		if e != nil {
 			return e
 		}
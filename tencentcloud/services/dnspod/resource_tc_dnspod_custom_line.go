@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudDnspodCustomLine() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDnspodCustomLineCreate,
-		Read:   resourceTencentCloudDnspodCustomLineRead,
-		Update: resourceTencentCloudDnspodCustomLineUpdate,
-		Delete: resourceTencentCloudDnspodCustomLineDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudDnspodCustomLineCreate,
+		Read:     resourceTencentCloudDnspodCustomLineRead,
+		Update:   resourceTencentCloudDnspodCustomLineUpdate,
+		Delete:   resourceTencentCloudDnspodCustomLineDelete,
+		Importer: helper.IdSpec{"domain", "name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Required:    true,
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudDnspodDomainAlias() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDnspodDomainAliasCreate,
-		Read:   resourceTencentCloudDnspodDomainAliasRead,
-		Delete: resourceTencentCloudDnspodDomainAliasDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudDnspodDomainAliasCreate,
+		Read:     resourceTencentCloudDnspodDomainAliasRead,
+		Delete:   resourceTencentCloudDnspodDomainAliasDelete,
+		Importer: helper.IdSpec{"domain", "domain_alias_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain_alias": {
 				Required:    true,
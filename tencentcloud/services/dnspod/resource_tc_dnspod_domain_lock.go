@@ -19,6 +19,10 @@ func ResourceTencentCloudDnspodDomainLock() *schema.Resource {
 		Create: resourceTencentCloudDnspodDomainLockCreate,
 		Read:   resourceTencentCloudDnspodDomainLockRead,
 		Delete: resourceTencentCloudDnspodDomainLockDelete,
+		// `lock_days` can not be described, so it is part of the imported ID only
+		Importer: helper.IdSpec{"domain", "lock_code", "lock_days"}.ImporterWithIdFunc(func(parts []string) string {
+			return strings.Join(parts[:2], tccommon.FILED_SP)
+		}, "domain", "lock_code", "lock_days"),
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Required:    true,
@@ -39,6 +39,8 @@ func ResourceTencentCloudDnspodDownloadSnapshotOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Snapshot download url.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -46,6 +46,8 @@ func ResourceTencentCloudDnspodModifyRecordGroupOperation() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "Domain ID. The parameter DomainId has a higher priority than the parameter Domain. If the parameter DomainId is passed, the parameter Domain will be ignored. You can find all Domains and DomainIds through the DescribeDomainList interface.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
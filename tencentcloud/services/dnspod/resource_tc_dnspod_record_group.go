@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudDnspodRecordGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDnspodRecordGroupCreate,
-		Read:   resourceTencentCloudDnspodRecordGroupRead,
-		Update: resourceTencentCloudDnspodRecordGroupUpdate,
-		Delete: resourceTencentCloudDnspodRecordGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudDnspodRecordGroupCreate,
+		Read:     resourceTencentCloudDnspodRecordGroupRead,
+		Update:   resourceTencentCloudDnspodRecordGroupUpdate,
+		Delete:   resourceTencentCloudDnspodRecordGroupDelete,
+		Importer: helper.IdSpec{"domain", "group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Required:    true,
@@ -44,6 +44,8 @@ func ResourceTencentCloudSubdomainValidateTxtValueOperation() *schema.Resource {
 				Computed:    true,
 				Description: "The record value of the TXT record needs to be added.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
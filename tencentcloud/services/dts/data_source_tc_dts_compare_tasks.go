@@ -705,7 +705,7 @@ func dataSourceTencentCloudDtsCompareTasksRead(d *schema.ResourceData, meta inte
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), itemList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), itemList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -67,12 +67,14 @@ func DataSourceTencentCloudDtsMigrateDbInstances() *schema.Resource {
 			"tmp_secret_key": {
 				Optional:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "temporary secret key, used across account.",
 			},
 
 			"tmp_token": {
 				Optional:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "temporary token, used across account.",
 			},
 
@@ -229,7 +231,7 @@ func dataSourceTencentCloudDtsMigrateDbInstancesRead(d *schema.ResourceData, met
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -391,6 +391,7 @@ func DataSourceTencentCloudDtsMigrateJobs() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "password.",
 												},
@@ -451,11 +452,13 @@ func DataSourceTencentCloudDtsMigrateJobs() *schema.Resource {
 												},
 												"tmp_secret_key": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "temporary secret key.",
 												},
 												"tmp_token": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "temporary token.",
 												},
@@ -548,6 +551,7 @@ func DataSourceTencentCloudDtsMigrateJobs() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "password.",
 												},
@@ -608,11 +612,13 @@ func DataSourceTencentCloudDtsMigrateJobs() *schema.Resource {
 												},
 												"tmp_secret_key": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "temporary secret key.",
 												},
 												"tmp_token": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "temporary token.",
 												},
@@ -1246,7 +1252,7 @@ func dataSourceTencentCloudDtsMigrateJobsRead(d *schema.ResourceData, meta inter
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), jobList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), jobList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
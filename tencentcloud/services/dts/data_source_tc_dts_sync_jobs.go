@@ -416,6 +416,7 @@ func DataSourceTencentCloudDtsSyncJobs() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "password.",
 									},
@@ -486,11 +487,13 @@ func DataSourceTencentCloudDtsSyncJobs() *schema.Resource {
 									},
 									"tmp_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "temporary secret key.",
 									},
 									"tmp_token": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "temporary token.",
 									},
@@ -550,6 +553,7 @@ func DataSourceTencentCloudDtsSyncJobs() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "password.",
 									},
@@ -620,11 +624,13 @@ func DataSourceTencentCloudDtsSyncJobs() *schema.Resource {
 									},
 									"tmp_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "temporary secret key.",
 									},
 									"tmp_token": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "temporary token.",
 									},
@@ -1362,7 +1368,7 @@ func dataSourceTencentCloudDtsSyncJobsRead(d *schema.ResourceData, meta interfac
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), jobList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), jobList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudDtsCompareTask() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDtsCompareTaskRead,
-		Create: resourceTencentCloudDtsCompareTaskCreate,
-		Update: resourceTencentCloudDtsCompareTaskUpdate,
-		Delete: resourceTencentCloudDtsCompareTaskDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Read:     resourceTencentCloudDtsCompareTaskRead,
+		Create:   resourceTencentCloudDtsCompareTaskCreate,
+		Update:   resourceTencentCloudDtsCompareTaskUpdate,
+		Delete:   resourceTencentCloudDtsCompareTaskDelete,
+		Importer: helper.IdSpec{"job_id", "compare_task_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Type:        schema.TypeString,
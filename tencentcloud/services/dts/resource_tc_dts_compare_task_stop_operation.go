@@ -31,6 +31,8 @@ func ResourceTencentCloudDtsCompareTaskStopOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Compare task id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -444,11 +444,13 @@ func ResourceTencentCloudDtsMigrateJob() *schema.Resource {
 									},
 									"tmp_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Temporary SecretKey, you can obtain the temporary key by GetFederationToken.",
 									},
 									"tmp_token": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Temporary token, you can obtain the temporary key by GetFederationToken.",
 									},
@@ -610,11 +612,13 @@ func ResourceTencentCloudDtsMigrateJob() *schema.Resource {
 									},
 									"tmp_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Temporary SecretKey, you can obtain the temporary key by GetFederationToken.",
 									},
 									"tmp_token": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Temporary token, you can obtain the temporary key by GetFederationToken.",
 									},
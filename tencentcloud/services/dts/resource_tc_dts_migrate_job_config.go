@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudDtsMigrateJobConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDtsMigrateJobConfigCreate,
-		Read:   resourceTencentCloudDtsMigrateJobConfigRead,
-		Update: resourceTencentCloudDtsMigrateJobConfigUpdate,
-		Delete: resourceTencentCloudDtsMigrateJobConfigDelete,
+		Create:   resourceTencentCloudDtsMigrateJobConfigCreate,
+		Read:     resourceTencentCloudDtsMigrateJobConfigRead,
+		Update:   resourceTencentCloudDtsMigrateJobConfigUpdate,
+		Delete:   resourceTencentCloudDtsMigrateJobConfigDelete,
+		Importer: helper.IdSpec{"job_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"job_id": {
 				Required:    true,
@@ -17,6 +17,7 @@ func ResourceTencentCloudDtsMigrateJobResumeOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDtsMigrateJobResumeOperationCreate,
 		Read:   resourceTencentCloudDtsMigrateJobResumeOperationRead,
+		Update: resourceTencentCloudDtsMigrateJobResumeOperationUpdate,
 		Delete: resourceTencentCloudDtsMigrateJobResumeOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
@@ -32,6 +33,10 @@ func ResourceTencentCloudDtsMigrateJobResumeOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "resume mode: 1.clearData-Clear target data; 2.overwrite-The task is executed in overwrite mode; 3.normal-No extra action. Note that clearData and overwrite are valid only for redis links, normal is valid only for non-Redis links.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -70,12 +75,14 @@ func resourceTencentCloudDtsMigrateJobResumeOperationCreate(d *schema.ResourceDa
 	}
 	d.SetId(jobId)
 
-	service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running", "readyComplete"}, 3*tccommon.ReadRetryTimeout, time.Second, service.DtsMigrateJobResumeOperationStateRefreshFunc(d.Id(), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"running", "readyComplete"}, 3*tccommon.ReadRetryTimeout, time.Second, service.DtsMigrateJobResumeOperationStateRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudDtsMigrateJobResumeOperationRead(d, meta)
@@ -88,6 +95,14 @@ func resourceTencentCloudDtsMigrateJobResumeOperationRead(d *schema.ResourceData
 	return nil
 }
 
+func resourceTencentCloudDtsMigrateJobResumeOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dts_migrate_job_resume_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDtsMigrateJobResumeOperationRead(d, meta)
+}
+
 func resourceTencentCloudDtsMigrateJobResumeOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dts_migrate_job_resume_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
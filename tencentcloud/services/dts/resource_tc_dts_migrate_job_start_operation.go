@@ -19,6 +19,7 @@ func ResourceTencentCloudDtsMigrateJobStartOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDtsMigrateJobStartOperationCreate,
 		Read:   resourceTencentCloudDtsMigrateJobStartOperationRead,
+		Update: resourceTencentCloudDtsMigrateJobStartOperationUpdate,
 		Delete: resourceTencentCloudDtsMigrateJobStartOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
@@ -27,6 +28,10 @@ func ResourceTencentCloudDtsMigrateJobStartOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Job Id from `tencentcloud_dts_migrate_job`.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -83,9 +88,11 @@ func resourceTencentCloudDtsMigrateJobStartOperationCreate(d *schema.ResourceDat
 		return err
 	}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running", "error"}, 3*tccommon.ReadRetryTimeout, time.Second, service.DtsMigrateJobStateRefreshFunc(jobId, []string{}))
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+	if tccommon.OperationWaitForCompletion(d) {
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"running", "error"}, 3*tccommon.ReadRetryTimeout, time.Second, service.DtsMigrateJobStateRefreshFunc(jobId, []string{}))
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	d.SetId(jobId)
@@ -99,6 +106,14 @@ func resourceTencentCloudDtsMigrateJobStartOperationRead(d *schema.ResourceData,
 	return nil
 }
 
+func resourceTencentCloudDtsMigrateJobStartOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dts_migrate_job_start_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDtsMigrateJobStartOperationRead(d, meta)
+}
+
 func resourceTencentCloudDtsMigrateJobStartOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dts_migrate_job_start_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,6 +17,7 @@ func ResourceTencentCloudDtsSyncCheckJobOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDtsSyncCheckJobOperationCreate,
 		Read:   resourceTencentCloudDtsSyncCheckJobOperationRead,
+		Update: resourceTencentCloudDtsSyncCheckJobOperationUpdate,
 		Delete: resourceTencentCloudDtsSyncCheckJobOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
@@ -25,6 +26,10 @@ func ResourceTencentCloudDtsSyncCheckJobOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Sync job id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -60,12 +65,14 @@ func resourceTencentCloudDtsSyncCheckJobOperationCreate(d *schema.ResourceData,
 
 	d.SetId(jobId)
 
-	service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"failed", "success"}, tccommon.ReadRetryTimeout, time.Second, service.DtsSyncCheckJobOperationStateRefreshFunc(d.Id(), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"failed", "success"}, tccommon.ReadRetryTimeout, time.Second, service.DtsSyncCheckJobOperationStateRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudDtsSyncCheckJobOperationRead(d, meta)
@@ -78,6 +85,14 @@ func resourceTencentCloudDtsSyncCheckJobOperationRead(d *schema.ResourceData, me
 	return nil
 }
 
+func resourceTencentCloudDtsSyncCheckJobOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_check_job_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDtsSyncCheckJobOperationRead(d, meta)
+}
+
 func resourceTencentCloudDtsSyncCheckJobOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_check_job_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -466,11 +466,13 @@ func ResourceTencentCloudDtsSyncConfig() *schema.Resource {
 						},
 						"tmp_secret_key": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Description: "Temporary key Key, required if it is a cross-account instance. Note: This field may return null, indicating that no valid value can be obtained.",
 						},
 						"tmp_token": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Description: "Temporary Token, required if it is a cross-account instance. Note: This field may return null, indicating that no valid value can be obtained.",
 						},
@@ -610,11 +612,13 @@ func ResourceTencentCloudDtsSyncConfig() *schema.Resource {
 						},
 						"tmp_secret_key": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Description: "Temporary key Key, required if it is a cross-account instance. Note: This field may return null, indicating that no valid value can be obtained.",
 						},
 						"tmp_token": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Description: "Temporary Token, required if it is a cross-account instance. Note: This field may return null, indicating that no valid value can be obtained.",
 						},
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudDtsSyncJob() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudDtsSyncJobRead,
-		Create: resourceTencentCloudDtsSyncJobCreate,
-		Delete: resourceTencentCloudDtsSyncJobDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Read:     resourceTencentCloudDtsSyncJobRead,
+		Create:   resourceTencentCloudDtsSyncJobCreate,
+		Delete:   resourceTencentCloudDtsSyncJobDelete,
+		Importer: helper.IdSpec{"job_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"pay_mode": {
 				Type:        schema.TypeString,
@@ -17,6 +17,7 @@ func ResourceTencentCloudDtsSyncJobContinueOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDtsSyncJobContinueOperationCreate,
 		Read:   resourceTencentCloudDtsSyncJobContinueOperationRead,
+		Update: resourceTencentCloudDtsSyncJobContinueOperationUpdate,
 		Delete: resourceTencentCloudDtsSyncJobContinueOperationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -28,6 +29,10 @@ func ResourceTencentCloudDtsSyncJobContinueOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Synchronization instance id (i.e. identifies a synchronization job).",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -63,12 +68,14 @@ func resourceTencentCloudDtsSyncJobContinueOperationCreate(d *schema.ResourceDat
 
 	d.SetId(jobId)
 
-	service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Running", []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Running", []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudDtsSyncJobContinueOperationRead(d, meta)
@@ -81,6 +88,14 @@ func resourceTencentCloudDtsSyncJobContinueOperationRead(d *schema.ResourceData,
 	return nil
 }
 
+func resourceTencentCloudDtsSyncJobContinueOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_job_continue_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDtsSyncJobContinueOperationRead(d, meta)
+}
+
 func resourceTencentCloudDtsSyncJobContinueOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_job_continue_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
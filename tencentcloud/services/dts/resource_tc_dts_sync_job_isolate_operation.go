@@ -17,6 +17,7 @@ func ResourceTencentCloudDtsSyncJobIsolateOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDtsSyncJobIsolateOperationCreate,
 		Read:   resourceTencentCloudDtsSyncJobIsolateOperationRead,
+		Update: resourceTencentCloudDtsSyncJobIsolateOperationUpdate,
 		Delete: resourceTencentCloudDtsSyncJobIsolateOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
@@ -25,6 +26,10 @@ func ResourceTencentCloudDtsSyncJobIsolateOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Synchronization instance id (i.e. identifies a synchronization job).",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -60,12 +65,14 @@ func resourceTencentCloudDtsSyncJobIsolateOperationCreate(d *schema.ResourceData
 
 	d.SetId(jobId)
 
-	service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Isolated"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "Isolated", []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"Isolated"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "Isolated", []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudDtsSyncJobIsolateOperationRead(d, meta)
@@ -78,6 +85,14 @@ func resourceTencentCloudDtsSyncJobIsolateOperationRead(d *schema.ResourceData,
 	return nil
 }
 
+func resourceTencentCloudDtsSyncJobIsolateOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_job_isolate_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDtsSyncJobIsolateOperationRead(d, meta)
+}
+
 func resourceTencentCloudDtsSyncJobIsolateOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_job_isolate_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,6 +17,7 @@ func ResourceTencentCloudDtsSyncJobPauseOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDtsSyncJobPauseOperationCreate,
 		Read:   resourceTencentCloudDtsSyncJobPauseOperationRead,
+		Update: resourceTencentCloudDtsSyncJobPauseOperationUpdate,
 		Delete: resourceTencentCloudDtsSyncJobPauseOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
@@ -25,6 +26,10 @@ func ResourceTencentCloudDtsSyncJobPauseOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Synchronization instance id (i.e. identifies a synchronization job).",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -60,12 +65,14 @@ func resourceTencentCloudDtsSyncJobPauseOperationCreate(d *schema.ResourceData,
 
 	d.SetId(jobId)
 
-	service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Paused"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Paused", []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"Paused"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Paused", []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudDtsSyncJobPauseOperationRead(d, meta)
@@ -78,6 +85,14 @@ func resourceTencentCloudDtsSyncJobPauseOperationRead(d *schema.ResourceData, me
 	return nil
 }
 
+func resourceTencentCloudDtsSyncJobPauseOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_job_pause_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDtsSyncJobPauseOperationRead(d, meta)
+}
+
 func resourceTencentCloudDtsSyncJobPauseOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_job_pause_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
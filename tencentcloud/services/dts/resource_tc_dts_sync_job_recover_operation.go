@@ -17,6 +17,7 @@ func ResourceTencentCloudDtsSyncJobRecoverOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDtsSyncJobRecoverOperationCreate,
 		Read:   resourceTencentCloudDtsSyncJobRecoverOperationRead,
+		Update: resourceTencentCloudDtsSyncJobRecoverOperationUpdate,
 		Delete: resourceTencentCloudDtsSyncJobRecoverOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
@@ -25,6 +26,10 @@ func ResourceTencentCloudDtsSyncJobRecoverOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Synchronization instance id (i.e. identifies a synchronization job).",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -60,18 +65,20 @@ func resourceTencentCloudDtsSyncJobRecoverOperationCreate(d *schema.ResourceData
 
 	d.SetId(jobId)
 
-	service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running", "Stopped"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Stopped", []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running", "Stopped"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Stopped", []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
-	}
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 
-	conf = tccommon.BuildStateChangeConf([]string{}, []string{"Normal"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "", []string{}))
+		conf = tccommon.BuildStateChangeConf([]string{}, []string{"Normal"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "", []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudDtsSyncJobRecoverOperationRead(d, meta)
@@ -84,6 +91,14 @@ func resourceTencentCloudDtsSyncJobRecoverOperationRead(d *schema.ResourceData,
 	return nil
 }
 
+func resourceTencentCloudDtsSyncJobRecoverOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_job_recover_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDtsSyncJobRecoverOperationRead(d, meta)
+}
+
 func resourceTencentCloudDtsSyncJobRecoverOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_job_recover_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,6 +17,7 @@ func ResourceTencentCloudDtsSyncJobResizeOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDtsSyncJobResizeOperationCreate,
 		Read:   resourceTencentCloudDtsSyncJobResizeOperationRead,
+		Update: resourceTencentCloudDtsSyncJobResizeOperationUpdate,
 		Delete: resourceTencentCloudDtsSyncJobResizeOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
@@ -32,6 +33,10 @@ func ResourceTencentCloudDtsSyncJobResizeOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Task specification.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -71,18 +76,20 @@ func resourceTencentCloudDtsSyncJobResizeOperationCreate(d *schema.ResourceData,
 
 	d.SetId(jobId)
 
-	service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running", "Stopped"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Stopped", []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running", "Stopped"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobStateRefreshFunc(d.Id(), "Stopped", []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
-	}
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 
-	conf = tccommon.BuildStateChangeConf([]string{}, []string{"Normal", "Isolated"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "Isolated", []string{}))
+		conf = tccommon.BuildStateChangeConf([]string{}, []string{"Normal", "Isolated"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobTradeStateRefreshFunc(d.Id(), "Isolated", []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudDtsSyncJobResizeOperationRead(d, meta)
@@ -95,6 +102,14 @@ func resourceTencentCloudDtsSyncJobResizeOperationRead(d *schema.ResourceData, m
 	return nil
 }
 
+func resourceTencentCloudDtsSyncJobResizeOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_job_resize_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDtsSyncJobResizeOperationRead(d, meta)
+}
+
 func resourceTencentCloudDtsSyncJobResizeOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_job_resize_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,6 +17,7 @@ func ResourceTencentCloudDtsSyncJobResumeOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudDtsSyncJobResumeOperationCreate,
 		Read:   resourceTencentCloudDtsSyncJobResumeOperationRead,
+		Update: resourceTencentCloudDtsSyncJobResumeOperationUpdate,
 		Delete: resourceTencentCloudDtsSyncJobResumeOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"job_id": {
@@ -25,6 +26,10 @@ func ResourceTencentCloudDtsSyncJobResumeOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Synchronization instance id (i.e. identifies a synchronization job).",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -60,12 +65,14 @@ func resourceTencentCloudDtsSyncJobResumeOperationCreate(d *schema.ResourceData,
 
 	d.SetId(jobId)
 
-	service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := DtsService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running", "Stopped", "Failed"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobResumeOperationStateRefreshFunc(d.Id(), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"Running", "Stopped", "Failed"}, 2*tccommon.ReadRetryTimeout, time.Second, service.DtsSyncJobResumeOperationStateRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudDtsSyncJobResumeOperationRead(d, meta)
@@ -78,6 +85,14 @@ func resourceTencentCloudDtsSyncJobResumeOperationRead(d *schema.ResourceData, m
 	return nil
 }
 
+func resourceTencentCloudDtsSyncJobResumeOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_job_resume_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudDtsSyncJobResumeOperationRead(d, meta)
+}
+
 func resourceTencentCloudDtsSyncJobResumeOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_dts_sync_job_resume_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
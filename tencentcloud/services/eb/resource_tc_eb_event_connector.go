@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudEbEventConnector() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEbEventConnectorCreate,
-		Read:   resourceTencentCloudEbEventConnectorRead,
-		Update: resourceTencentCloudEbEventConnectorUpdate,
-		Delete: resourceTencentCloudEbEventConnectorDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudEbEventConnectorCreate,
+		Read:     resourceTencentCloudEbEventConnectorRead,
+		Update:   resourceTencentCloudEbEventConnectorUpdate,
+		Delete:   resourceTencentCloudEbEventConnectorDelete,
+		Importer: helper.IdSpec{"event_bus_id", "connection_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"connection_description": {
 				Required:    true,
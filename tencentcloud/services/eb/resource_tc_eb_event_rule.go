@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudEbEventRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEbEventRuleCreate,
-		Read:   resourceTencentCloudEbEventRuleRead,
-		Update: resourceTencentCloudEbEventRuleUpdate,
-		Delete: resourceTencentCloudEbEventRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudEbEventRuleCreate,
+		Read:     resourceTencentCloudEbEventRuleRead,
+		Update:   resourceTencentCloudEbEventRuleUpdate,
+		Delete:   resourceTencentCloudEbEventRuleDelete,
+		Importer: helper.IdSpec{"event_bus_id", "rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"event_pattern": {
 				Required:    true,
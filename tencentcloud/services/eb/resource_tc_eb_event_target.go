@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudEbEventTarget() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEbEventTargetCreate,
-		Read:   resourceTencentCloudEbEventTargetRead,
-		Update: resourceTencentCloudEbEventTargetUpdate,
-		Delete: resourceTencentCloudEbEventTargetDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudEbEventTargetCreate,
+		Read:     resourceTencentCloudEbEventTargetRead,
+		Update:   resourceTencentCloudEbEventTargetUpdate,
+		Delete:   resourceTencentCloudEbEventTargetDelete,
+		Importer: helper.IdSpec{"event_bus_id", "rule_id", "target_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"event_bus_id": {
 				Required:    true,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudEbEventTransform() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEbEventTransformCreate,
-		Read:   resourceTencentCloudEbEventTransformRead,
-		Update: resourceTencentCloudEbEventTransformUpdate,
-		Delete: resourceTencentCloudEbEventTransformDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudEbEventTransformCreate,
+		Read:     resourceTencentCloudEbEventTransformRead,
+		Update:   resourceTencentCloudEbEventTransformUpdate,
+		Delete:   resourceTencentCloudEbEventTransformDelete,
+		Importer: helper.IdSpec{"event_bus_id", "rule_id", "transformation_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"event_bus_id": {
 				Required:    true,
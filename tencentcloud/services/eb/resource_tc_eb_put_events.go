@@ -61,6 +61,8 @@ func ResourceTencentCloudEbPutEvents() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "event bus Id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
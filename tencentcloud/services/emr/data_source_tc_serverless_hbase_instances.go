@@ -369,7 +369,7 @@ func dataSourceTencentCloudServerlessHbaseRead(d *schema.ResourceData, meta inte
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), instanceList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), instanceList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
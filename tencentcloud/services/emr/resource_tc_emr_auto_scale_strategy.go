@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudEmrAutoScaleStrategy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEmrAutoScaleStrategyCreate,
-		Read:   resourceTencentCloudEmrAutoScaleStrategyRead,
-		Update: resourceTencentCloudEmrAutoScaleStrategyUpdate,
-		Delete: resourceTencentCloudEmrAutoScaleStrategyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudEmrAutoScaleStrategyCreate,
+		Read:     resourceTencentCloudEmrAutoScaleStrategyRead,
+		Update:   resourceTencentCloudEmrAutoScaleStrategyUpdate,
+		Delete:   resourceTencentCloudEmrAutoScaleStrategyDelete,
+		Importer: helper.IdSpec{"instance_id", "strategy_type"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -295,6 +295,7 @@ func ResourceTencentCloudEmrCluster() *schema.Resource {
 						},
 						"cos_secret_key": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							ForceNew:    true,
 							Description: "Cos secretKey.",
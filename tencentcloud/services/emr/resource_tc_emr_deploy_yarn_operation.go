@@ -18,6 +18,7 @@ func ResourceTencentCloudEmrDeployYarnOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudEmrDeployYarnOperationCreate,
 		Read:   resourceTencentCloudEmrDeployYarnOperationRead,
+		Update: resourceTencentCloudEmrDeployYarnOperationUpdate,
 		Delete: resourceTencentCloudEmrDeployYarnOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -26,6 +27,10 @@ func ResourceTencentCloudEmrDeployYarnOperation() *schema.Resource {
 				ForceNew:    true,
 				Description: "EMR Instance ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -60,13 +65,15 @@ func resourceTencentCloudEmrDeployYarnOperationCreate(d *schema.ResourceData, me
 		return err
 	}
 
-	if flowId != nil {
-		emrService := EMRService{
-			client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
-		}
-		conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 10*tccommon.ReadRetryTimeout, time.Second, emrService.FlowStatusRefreshFunc(instanceId, strconv.FormatUint(*flowId, 10), F_KEY_FLOW_ID, []string{}))
-		if _, e := conf.WaitForState(); e != nil {
-			return e
+	if tccommon.OperationWaitForCompletion(d) {
+		if flowId != nil {
+			emrService := EMRService{
+				client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
+			}
+			conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 10*tccommon.ReadRetryTimeout, time.Second, emrService.FlowStatusRefreshFunc(instanceId, strconv.FormatUint(*flowId, 10), F_KEY_FLOW_ID, []string{}))
+			if _, e := conf.WaitForState(); e != nil {
+				return e
+			}
 		}
 	}
 
@@ -82,6 +89,14 @@ func resourceTencentCloudEmrDeployYarnOperationRead(d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceTencentCloudEmrDeployYarnOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_emr_deploy_yarn_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudEmrDeployYarnOperationRead(d, meta)
+}
+
 func resourceTencentCloudEmrDeployYarnOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_emr_deploy_yarn_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
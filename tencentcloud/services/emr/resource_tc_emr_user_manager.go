@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudEmrUserManager() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudEmrUserManagerCreate,
-		Read:   resourceTencentCloudEmrUserManagerRead,
-		Update: resourceTencentCloudEmrUserManagerUpdate,
-		Delete: resourceTencentCloudEmrUserManagerDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudEmrUserManagerCreate,
+		Read:     resourceTencentCloudEmrUserManagerRead,
+		Update:   resourceTencentCloudEmrUserManagerUpdate,
+		Delete:   resourceTencentCloudEmrUserManagerDelete,
+		Importer: helper.IdSpec{"instance_id", "user_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -45,6 +43,7 @@ func ResourceTencentCloudEmrUserManager() *schema.Resource {
 			},
 			"password": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Required:    true,
 				Description: "PassWord.",
 			},
@@ -43,6 +43,7 @@ func DataSourceTencentCloudElasticsearchDescribeIndexList() *schema.Resource {
 			"password": {
 				Optional:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Cluster access password.",
 			},
 
@@ -523,7 +524,7 @@ func dataSourceTencentCloudElasticsearchDescribeIndexListRead(d *schema.Resource
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -41,6 +41,8 @@ func ResourceTencentCloudElasticsearchDiagnoseInstance() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Indexes that need to be diagnosed. Wildcards are supported.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -20,13 +20,11 @@ import (
 
 func ResourceTencentCloudElasticsearchIndex() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudElasticsearchIndexCreate,
-		Read:   resourceTencentCloudElasticsearchIndexRead,
-		Update: resourceTencentCloudElasticsearchIndexUpdate,
-		Delete: resourceTencentCloudElasticsearchIndexDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudElasticsearchIndexCreate,
+		Read:     resourceTencentCloudElasticsearchIndexRead,
+		Update:   resourceTencentCloudElasticsearchIndexUpdate,
+		Delete:   resourceTencentCloudElasticsearchIndexDelete,
+		Importer: helper.IdSpec{"instance_id", "[index_type]", "index_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
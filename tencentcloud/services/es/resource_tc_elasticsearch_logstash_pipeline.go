@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudElasticsearchLogstashPipeline() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudElasticsearchLogstashPipelineCreate,
-		Read:   resourceTencentCloudElasticsearchLogstashPipelineRead,
-		Update: resourceTencentCloudElasticsearchLogstashPipelineUpdate,
-		Delete: resourceTencentCloudElasticsearchLogstashPipelineDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudElasticsearchLogstashPipelineCreate,
+		Read:     resourceTencentCloudElasticsearchLogstashPipelineRead,
+		Update:   resourceTencentCloudElasticsearchLogstashPipelineUpdate,
+		Delete:   resourceTencentCloudElasticsearchLogstashPipelineDelete,
+		Importer: helper.IdSpec{"instance_id", "pipeline_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
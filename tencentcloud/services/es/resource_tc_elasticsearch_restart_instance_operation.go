@@ -17,6 +17,7 @@ func ResourceTencentCloudElasticsearchRestartInstanceOperation() *schema.Resourc
 	return &schema.Resource{
 		Create: resourceTencentCloudElasticsearchRestartInstanceOperationCreate,
 		Read:   resourceTencentCloudElasticsearchRestartInstanceOperationRead,
+		Update: resourceTencentCloudElasticsearchRestartInstanceOperationUpdate,
 		Delete: resourceTencentCloudElasticsearchRestartInstanceOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -44,6 +45,10 @@ func ResourceTencentCloudElasticsearchRestartInstanceOperation() *schema.Resourc
 				Type:        schema.TypeInt,
 				Description: "Restart mode: 0 roll restart; 1 full restart.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -87,9 +92,11 @@ func resourceTencentCloudElasticsearchRestartInstanceOperationCreate(d *schema.R
 	elasticsearchService := ElasticsearchService{
 		client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
 	}
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 10*tccommon.ReadRetryTimeout, time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+	if tccommon.OperationWaitForCompletion(d) {
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 10*tccommon.ReadRetryTimeout, time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 	d.SetId(instanceId)
 
@@ -103,6 +110,14 @@ func resourceTencentCloudElasticsearchRestartInstanceOperationRead(d *schema.Res
 	return nil
 }
 
+func resourceTencentCloudElasticsearchRestartInstanceOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_restart_instance_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudElasticsearchRestartInstanceOperationRead(d, meta)
+}
+
 func resourceTencentCloudElasticsearchRestartInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_restart_instance_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
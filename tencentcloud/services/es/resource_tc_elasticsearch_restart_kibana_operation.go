@@ -17,6 +17,7 @@ func ResourceTencentCloudElasticsearchRestartKibanaOperation() *schema.Resource
 	return &schema.Resource{
 		Create: resourceTencentCloudElasticsearchRestartKibanaOperationCreate,
 		Read:   resourceTencentCloudElasticsearchRestartKibanaOperationRead,
+		Update: resourceTencentCloudElasticsearchRestartKibanaOperationUpdate,
 		Delete: resourceTencentCloudElasticsearchRestartKibanaOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -25,6 +26,10 @@ func ResourceTencentCloudElasticsearchRestartKibanaOperation() *schema.Resource
 				Type:        schema.TypeString,
 				Description: "Instance id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -60,9 +65,11 @@ func resourceTencentCloudElasticsearchRestartKibanaOperationCreate(d *schema.Res
 	elasticsearchService := ElasticsearchService{
 		client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
 	}
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 10*tccommon.ReadRetryTimeout, time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+	if tccommon.OperationWaitForCompletion(d) {
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 10*tccommon.ReadRetryTimeout, time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 	d.SetId(instanceId)
 
@@ -76,6 +83,14 @@ func resourceTencentCloudElasticsearchRestartKibanaOperationRead(d *schema.Resou
 	return nil
 }
 
+func resourceTencentCloudElasticsearchRestartKibanaOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_restart_kibana_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudElasticsearchRestartKibanaOperationRead(d, meta)
+}
+
 func resourceTencentCloudElasticsearchRestartKibanaOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_restart_kibana_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,6 +17,7 @@ func ResourceTencentCloudElasticsearchRestartLogstashInstanceOperation() *schema
 	return &schema.Resource{
 		Create: resourceTencentCloudElasticsearchRestartLogstashInstanceOperationCreate,
 		Read:   resourceTencentCloudElasticsearchRestartLogstashInstanceOperationRead,
+		Update: resourceTencentCloudElasticsearchRestartLogstashInstanceOperationUpdate,
 		Delete: resourceTencentCloudElasticsearchRestartLogstashInstanceOperationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -35,6 +36,10 @@ func ResourceTencentCloudElasticsearchRestartLogstashInstanceOperation() *schema
 				Type:        schema.TypeInt,
 				Description: "Restart type, 0 full restart, 1 rolling restart.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -74,10 +79,12 @@ func resourceTencentCloudElasticsearchRestartLogstashInstanceOperationCreate(d *
 
 	service := ElasticsearchService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 3*tccommon.ReadRetryTimeout, time.Second, service.ElasticsearchLogstashStateRefreshFunc(instanceId, []string{}))
+	if tccommon.OperationWaitForCompletion(d) {
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 3*tccommon.ReadRetryTimeout, time.Second, service.ElasticsearchLogstashStateRefreshFunc(instanceId, []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	d.SetId(instanceId)
@@ -92,6 +99,14 @@ func resourceTencentCloudElasticsearchRestartLogstashInstanceOperationRead(d *sc
 	return nil
 }
 
+func resourceTencentCloudElasticsearchRestartLogstashInstanceOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_restart_logstash_instance_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudElasticsearchRestartLogstashInstanceOperationRead(d, meta)
+}
+
 func resourceTencentCloudElasticsearchRestartLogstashInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_restart_logstash_instance_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
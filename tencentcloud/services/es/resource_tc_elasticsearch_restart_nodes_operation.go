@@ -17,6 +17,7 @@ func ResourceTencentCloudElasticsearchRestartNodesOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudElasticsearchRestartNodesOperationCreate,
 		Read:   resourceTencentCloudElasticsearchRestartNodesOperationRead,
+		Update: resourceTencentCloudElasticsearchRestartNodesOperationUpdate,
 		Delete: resourceTencentCloudElasticsearchRestartNodesOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -57,6 +58,10 @@ func ResourceTencentCloudElasticsearchRestartNodesOperation() *schema.Resource {
 				Type:        schema.TypeBool,
 				Description: "Node status, used in blue-green mode; off-line node blue-green is risky.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -113,9 +118,11 @@ func resourceTencentCloudElasticsearchRestartNodesOperationCreate(d *schema.Reso
 	elasticsearchService := ElasticsearchService{
 		client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
 	}
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 10*tccommon.ReadRetryTimeout, time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+	if tccommon.OperationWaitForCompletion(d) {
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 10*tccommon.ReadRetryTimeout, time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 	d.SetId(instanceId)
 
@@ -129,6 +136,14 @@ func resourceTencentCloudElasticsearchRestartNodesOperationRead(d *schema.Resour
 	return nil
 }
 
+func resourceTencentCloudElasticsearchRestartNodesOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_restart_nodes_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudElasticsearchRestartNodesOperationRead(d, meta)
+}
+
 func resourceTencentCloudElasticsearchRestartNodesOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_restart_nodes_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,6 +17,7 @@ func ResourceTencentCloudElasticsearchStartLogstashPipelineOperation() *schema.R
 	return &schema.Resource{
 		Create: resourceTencentCloudElasticsearchStartLogstashPipelineOperationCreate,
 		Read:   resourceTencentCloudElasticsearchStartLogstashPipelineOperationRead,
+		Update: resourceTencentCloudElasticsearchStartLogstashPipelineOperationUpdate,
 		Delete: resourceTencentCloudElasticsearchStartLogstashPipelineOperationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -35,6 +36,10 @@ func ResourceTencentCloudElasticsearchStartLogstashPipelineOperation() *schema.R
 				Type:        schema.TypeString,
 				Description: "Pipeline id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -76,10 +81,12 @@ func resourceTencentCloudElasticsearchStartLogstashPipelineOperationCreate(d *sc
 
 	service := ElasticsearchService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 3*tccommon.ReadRetryTimeout, time.Second, service.ElasticsearchLogstashPipelineStateRefreshFunc(instanceId, pipelineId, []string{}))
+	if tccommon.OperationWaitForCompletion(d) {
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"2"}, 3*tccommon.ReadRetryTimeout, time.Second, service.ElasticsearchLogstashPipelineStateRefreshFunc(instanceId, pipelineId, []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	d.SetId(instanceId + tccommon.FILED_SP + pipelineId)
@@ -94,6 +101,14 @@ func resourceTencentCloudElasticsearchStartLogstashPipelineOperationRead(d *sche
 	return nil
 }
 
+func resourceTencentCloudElasticsearchStartLogstashPipelineOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_start_logstash_pipeline_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudElasticsearchStartLogstashPipelineOperationRead(d, meta)
+}
+
 func resourceTencentCloudElasticsearchStartLogstashPipelineOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_start_logstash_pipeline_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,6 +17,7 @@ func ResourceTencentCloudElasticsearchStopLogstashPipelineOperation() *schema.Re
 	return &schema.Resource{
 		Create: resourceTencentCloudElasticsearchStopLogstashPipelineOperationCreate,
 		Read:   resourceTencentCloudElasticsearchStopLogstashPipelineOperationRead,
+		Update: resourceTencentCloudElasticsearchStopLogstashPipelineOperationUpdate,
 		Delete: resourceTencentCloudElasticsearchStopLogstashPipelineOperationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -35,6 +36,10 @@ func ResourceTencentCloudElasticsearchStopLogstashPipelineOperation() *schema.Re
 				Type:        schema.TypeString,
 				Description: "Pipeline id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -76,10 +81,12 @@ func resourceTencentCloudElasticsearchStopLogstashPipelineOperationCreate(d *sch
 
 	service := ElasticsearchService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"-2"}, 3*tccommon.ReadRetryTimeout, time.Second, service.ElasticsearchLogstashPipelineStateRefreshFunc(instanceId, pipelineId, []string{}))
+	if tccommon.OperationWaitForCompletion(d) {
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"-2"}, 3*tccommon.ReadRetryTimeout, time.Second, service.ElasticsearchLogstashPipelineStateRefreshFunc(instanceId, pipelineId, []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	d.SetId(instanceId + tccommon.FILED_SP + pipelineId)
@@ -94,6 +101,14 @@ func resourceTencentCloudElasticsearchStopLogstashPipelineOperationRead(d *schem
 	return nil
 }
 
+func resourceTencentCloudElasticsearchStopLogstashPipelineOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_stop_logstash_pipeline_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudElasticsearchStopLogstashPipelineOperationRead(d, meta)
+}
+
 func resourceTencentCloudElasticsearchStopLogstashPipelineOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_stop_logstash_pipeline_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,6 +17,7 @@ func ResourceTencentCloudElasticsearchUpdatePluginsOperation() *schema.Resource
 	return &schema.Resource{
 		Create: resourceTencentCloudElasticsearchUpdatePluginsOperationCreate,
 		Read:   resourceTencentCloudElasticsearchUpdatePluginsOperationRead,
+		Update: resourceTencentCloudElasticsearchUpdatePluginsOperationUpdate,
 		Delete: resourceTencentCloudElasticsearchUpdatePluginsOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -66,6 +67,10 @@ func ResourceTencentCloudElasticsearchUpdatePluginsOperation() *schema.Resource
 				Type:        schema.TypeInt,
 				Description: "Plugin type. 0: system plugin.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -131,9 +136,11 @@ func resourceTencentCloudElasticsearchUpdatePluginsOperationCreate(d *schema.Res
 	elasticsearchService := ElasticsearchService{
 		client: meta.(tccommon.ProviderMeta).GetAPIV3Conn(),
 	}
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 10*tccommon.ReadRetryTimeout, time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+	if tccommon.OperationWaitForCompletion(d) {
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"1"}, 10*tccommon.ReadRetryTimeout, time.Second, elasticsearchService.ElasticsearchInstanceRefreshFunc(instanceId, []string{}))
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	d.SetId(instanceId)
@@ -148,6 +155,14 @@ func resourceTencentCloudElasticsearchUpdatePluginsOperationRead(d *schema.Resou
 	return nil
 }
 
+func resourceTencentCloudElasticsearchUpdatePluginsOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_update_plugins_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudElasticsearchUpdatePluginsOperationRead(d, meta)
+}
+
 func resourceTencentCloudElasticsearchUpdatePluginsOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_elasticsearch_update_plugins_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
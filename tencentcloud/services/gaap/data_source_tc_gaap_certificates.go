@@ -166,7 +166,7 @@ func dataSourceTencentCloudGaapCertificatesRead(d *schema.ResourceData, m interf
 	d.SetId(helper.DataResourceIdsHash(ids))
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		if err := tccommon.WriteToFile(output.(string), certificates); err != nil {
+		if err := tccommon.WriteToFile(output.(string), certificates, tccommon.GetResultOutputOptions(d)); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%s]\n",
 				logId, output.(string), err.Error())
 			return err
@@ -150,7 +150,7 @@ func dataSourceTencentCloudGaapDomainErrorPageInfoListRead(d *schema.ResourceDat
 	d.SetId(helper.DataResourceIdsHash(idList))
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		if err := tccommon.WriteToFile(output.(string), list); err != nil {
+		if err := tccommon.WriteToFile(output.(string), list, tccommon.GetResultOutputOptions(d)); err != nil {
 			log.Printf("[CRITAL]%s output file[%s] fail, reason[%v]",
 				logId, output.(string), err)
 			return err
@@ -177,7 +177,7 @@ func dataSourceTencentCloudGaapListenerStatisticsRead(d *schema.ResourceData, me
 	d.SetId(listenerId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -644,7 +644,7 @@ func dataSourceTencentCloudGaapProxyDetailRead(d *schema.ResourceData, meta inte
 	d.SetId(proxyId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), proxyInfoMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), proxyInfoMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
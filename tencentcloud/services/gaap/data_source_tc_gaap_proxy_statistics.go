@@ -189,7 +189,7 @@ func dataSourceTencentCloudGaapProxyStatisticsRead(d *schema.ResourceData, meta
 	d.SetId(proxyId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
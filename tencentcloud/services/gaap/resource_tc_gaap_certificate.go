@@ -14,10 +14,11 @@ import (
 
 func ResourceTencentCloudGaapCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapCertificateCreate,
-		Read:   resourceTencentCloudGaapCertificateRead,
-		Update: resourceTencentCloudGaapCertificateUpdate,
-		Delete: resourceTencentCloudGaapCertificateDelete,
+		Create:   resourceTencentCloudGaapCertificateCreate,
+		Read:     resourceTencentCloudGaapCertificateRead,
+		Update:   resourceTencentCloudGaapCertificateUpdate,
+		Delete:   resourceTencentCloudGaapCertificateDelete,
+		Importer: helper.IdSpec{"certificate_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Type:         schema.TypeString,
@@ -12,9 +12,10 @@ import (
 
 func ResourceTencentCloudGaapDomainErrorPageInfo() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapDomainErrorPageInfoCreate,
-		Read:   resourceTencentCloudGaapDomainErrorPageInfoRead,
-		Delete: resourceTencentCloudGaapDomainErrorPageInfoDelete,
+		Create:   resourceTencentCloudGaapDomainErrorPageInfoCreate,
+		Read:     resourceTencentCloudGaapDomainErrorPageInfoRead,
+		Delete:   resourceTencentCloudGaapDomainErrorPageInfoDelete,
+		Importer: helper.IdSpec{"listener_id", "domain", "error_page_id"}.ImporterWithArguments("listener_id", "domain"),
 		Schema: map[string]*schema.Schema{
 			"listener_id": {
 				Type:        schema.TypeString,
@@ -20,13 +20,11 @@ import (
 
 func ResourceTencentCloudGaapGlobalDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapGlobalDomainCreate,
-		Read:   resourceTencentCloudGaapGlobalDomainRead,
-		Update: resourceTencentCloudGaapGlobalDomainUpdate,
-		Delete: resourceTencentCloudGaapGlobalDomainDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudGaapGlobalDomainCreate,
+		Read:     resourceTencentCloudGaapGlobalDomainRead,
+		Update:   resourceTencentCloudGaapGlobalDomainUpdate,
+		Delete:   resourceTencentCloudGaapGlobalDomainDelete,
+		Importer: helper.IdSpec{"project_id", "domain_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Required:    true,
@@ -19,13 +19,11 @@ import (
 
 func ResourceTencentCloudGaapGlobalDomainDns() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGaapGlobalDomainDnsCreate,
-		Read:   resourceTencentCloudGaapGlobalDomainDnsRead,
-		Update: resourceTencentCloudGaapGlobalDomainDnsUpdate,
-		Delete: resourceTencentCloudGaapGlobalDomainDnsDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudGaapGlobalDomainDnsCreate,
+		Read:     resourceTencentCloudGaapGlobalDomainDnsRead,
+		Update:   resourceTencentCloudGaapGlobalDomainDnsUpdate,
+		Delete:   resourceTencentCloudGaapGlobalDomainDnsDelete,
+		Importer: helper.IdSpec{"domain_id", "dns_record_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain_id": {
 				Required:    true,
@@ -16,9 +16,10 @@ import (
 
 func ResourceTencentCloudGwlbInstanceAssociateTargetGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudGwlbInstanceAssociateTargetGroupCreate,
-		Read:   resourceTencentCloudGwlbInstanceAssociateTargetGroupRead,
-		Delete: resourceTencentCloudGwlbInstanceAssociateTargetGroupDelete,
+		Create:   resourceTencentCloudGwlbInstanceAssociateTargetGroupCreate,
+		Read:     resourceTencentCloudGwlbInstanceAssociateTargetGroupRead,
+		Delete:   resourceTencentCloudGwlbInstanceAssociateTargetGroupDelete,
+		Importer: helper.IdSpec{"load_balancer_id", "target_group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
 				Type:        schema.TypeString,
@@ -39,6 +39,7 @@ func DataSourceTencentCloudKmsGetParametersForImport() *schema.Resource {
 			"import_token": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "The token required for importing key material is used as the parameter of ImportKeyMaterial.",
 			},
 			"parameters_valid_to": {
@@ -114,7 +115,7 @@ func dataSourceTencentCloudKmsGetParametersForImportRead(d *schema.ResourceData,
 	d.SetId(keyId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), d); e != nil {
+		if e := tccommon.WriteToFile(output.(string), d, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
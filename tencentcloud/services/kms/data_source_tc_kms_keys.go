@@ -229,7 +229,7 @@ func dataSourceTencentCloudKmsKeysRead(d *schema.ResourceData, meta interface{})
 		return e
 	}
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return tccommon.WriteToFile(output.(string), keyList)
+		return tccommon.WriteToFile(output.(string), keyList, tccommon.GetResultOutputOptions(d))
 	}
 	return nil
 }
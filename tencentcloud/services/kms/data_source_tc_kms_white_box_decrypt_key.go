@@ -74,7 +74,7 @@ func dataSourceTencentCloudKmsWhiteBoxDecryptKeyRead(d *schema.ResourceData, met
 	d.SetId(keyId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), d); e != nil {
+		if e := tccommon.WriteToFile(output.(string), d, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
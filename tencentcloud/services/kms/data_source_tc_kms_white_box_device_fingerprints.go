@@ -103,7 +103,7 @@ func dataSourceTencentCloudKmsWhiteBoxDeviceFingerprintsRead(d *schema.ResourceD
 	d.SetId(keyId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), d); e != nil {
+		if e := tccommon.WriteToFile(output.(string), d, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudKmsCloudResourceAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudKmsCloudResourceAttachmentCreate,
-		Read:   resourceTencentCloudKmsCloudResourceAttachmentRead,
-		Delete: resourceTencentCloudKmsCloudResourceAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudKmsCloudResourceAttachmentCreate,
+		Read:     resourceTencentCloudKmsCloudResourceAttachmentRead,
+		Delete:   resourceTencentCloudKmsCloudResourceAttachmentDelete,
+		Importer: helper.IdSpec{"key_id", "product_id", "resource_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"key_id": {
 				Required:    true,
@@ -45,6 +45,8 @@ func ResourceTencentCloudKmsOverwriteWhiteBoxDeviceFingerprints() *schema.Resour
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
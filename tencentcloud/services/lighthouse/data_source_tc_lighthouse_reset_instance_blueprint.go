@@ -338,7 +338,7 @@ func dataSourceTencentCloudLighthouseResetInstanceBlueprintRead(d *schema.Resour
 	_ = d.Set("reset_instance_blueprint_set", tmpList)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -114,7 +114,7 @@ func dataSourceTencentCloudLighthouseZoneRead(d *schema.ResourceData, meta inter
 	_ = d.Set("zone_info_set", tmpList)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -17,6 +17,7 @@ func ResourceTencentCloudLighthouseApplyDiskBackup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudLighthouseApplyDiskBackupCreate,
 		Read:   resourceTencentCloudLighthouseApplyDiskBackupRead,
+		Update: resourceTencentCloudLighthouseApplyDiskBackupUpdate,
 		Delete: resourceTencentCloudLighthouseApplyDiskBackupDelete,
 		Schema: map[string]*schema.Schema{
 			"disk_id": {
@@ -32,6 +33,10 @@ func ResourceTencentCloudLighthouseApplyDiskBackup() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Disk backup ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -73,12 +78,14 @@ func resourceTencentCloudLighthouseApplyDiskBackupCreate(d *schema.ResourceData,
 
 	d.SetId(diskId + tccommon.FILED_SP + diskBackupId)
 
-	service := LightHouseService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := LightHouseService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*tccommon.ReadRetryTimeout, time.Second, service.LighthouseApplyDiskBackupStateRefreshFunc(diskBackupId, []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*tccommon.ReadRetryTimeout, time.Second, service.LighthouseApplyDiskBackupStateRefreshFunc(diskBackupId, []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudLighthouseApplyDiskBackupRead(d, meta)
@@ -91,6 +98,14 @@ func resourceTencentCloudLighthouseApplyDiskBackupRead(d *schema.ResourceData, m
 	return nil
 }
 
+func resourceTencentCloudLighthouseApplyDiskBackupUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_lighthouse_apply_disk_backup.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudLighthouseApplyDiskBackupRead(d, meta)
+}
+
 func resourceTencentCloudLighthouseApplyDiskBackupDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_lighthouse_apply_disk_backup.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,6 +17,7 @@ func ResourceTencentCloudLighthouseApplyInstanceSnapshot() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudLighthouseApplyInstanceSnapshotCreate,
 		Read:   resourceTencentCloudLighthouseApplyInstanceSnapshotRead,
+		Update: resourceTencentCloudLighthouseApplyInstanceSnapshotUpdate,
 		Delete: resourceTencentCloudLighthouseApplyInstanceSnapshotDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -33,6 +34,10 @@ func ResourceTencentCloudLighthouseApplyInstanceSnapshot() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Snapshot ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -74,12 +79,14 @@ func resourceTencentCloudLighthouseApplyInstanceSnapshotCreate(d *schema.Resourc
 
 	d.SetId(instanceId + tccommon.FILED_SP + snapshotId)
 
-	service := LightHouseService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := LightHouseService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*tccommon.ReadRetryTimeout, time.Second, service.LighthouseApplySnapshotStateRefreshFunc(snapshotId, []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*tccommon.ReadRetryTimeout, time.Second, service.LighthouseApplySnapshotStateRefreshFunc(snapshotId, []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudLighthouseApplyInstanceSnapshotRead(d, meta)
@@ -92,6 +99,14 @@ func resourceTencentCloudLighthouseApplyInstanceSnapshotRead(d *schema.ResourceD
 	return nil
 }
 
+func resourceTencentCloudLighthouseApplyInstanceSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_lighthouse_apply_instance_snapshot.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudLighthouseApplyInstanceSnapshotRead(d, meta)
+}
+
 func resourceTencentCloudLighthouseApplyInstanceSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_lighthouse_apply_instance_snapshot.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudLighthouseDisk() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseDiskCreate,
-		Read:   resourceTencentCloudLighthouseDiskRead,
-		Update: resourceTencentCloudLighthouseDiskUpdate,
-		Delete: resourceTencentCloudLighthouseDiskDelete,
+		Create:   resourceTencentCloudLighthouseDiskCreate,
+		Read:     resourceTencentCloudLighthouseDiskRead,
+		Update:   resourceTencentCloudLighthouseDiskUpdate,
+		Delete:   resourceTencentCloudLighthouseDiskDelete,
+		Importer: helper.IdSpec{"disk_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone": {
 				Required:    true,
@@ -95,6 +95,7 @@ func ResourceTencentCloudLighthouseInstance() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Description: "Login password.",
 						},
@@ -39,6 +39,7 @@ func ResourceTencentCloudLighthouseKeyPair() *schema.Resource {
 			"private_key": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Key to private key.",
 			},
 			"created_time": {
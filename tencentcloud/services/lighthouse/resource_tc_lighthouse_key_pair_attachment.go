@@ -8,6 +8,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,12 +17,10 @@ import (
 
 func ResourceTencentCloudLighthouseKeyPairAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseKeyPairAttachmentCreate,
-		Read:   resourceTencentCloudLighthouseKeyPairAttachmentRead,
-		Delete: resourceTencentCloudLighthouseKeyPairAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudLighthouseKeyPairAttachmentCreate,
+		Read:     resourceTencentCloudLighthouseKeyPairAttachmentRead,
+		Delete:   resourceTencentCloudLighthouseKeyPairAttachmentDelete,
+		Importer: helper.IdSpec{"key_id", "instance_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"key_id": {
 				Required:    true,
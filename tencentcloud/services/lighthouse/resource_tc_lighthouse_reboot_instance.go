@@ -15,6 +15,7 @@ func ResourceTencentCloudLighthouseRebootInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudLighthouseRebootInstanceCreate,
 		Read:   resourceTencentCloudLighthouseRebootInstanceRead,
+		Update: resourceTencentCloudLighthouseRebootInstanceUpdate,
 		Delete: resourceTencentCloudLighthouseRebootInstanceDelete,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -23,6 +24,10 @@ func ResourceTencentCloudLighthouseRebootInstance() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Instance ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -55,12 +60,14 @@ func resourceTencentCloudLighthouseRebootInstanceCreate(d *schema.ResourceData,
 
 	d.SetId(instanceId)
 
-	service := LightHouseService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := LightHouseService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*tccommon.ReadRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(d.Id(), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*tccommon.ReadRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudLighthouseRebootInstanceRead(d, meta)
@@ -73,6 +80,14 @@ func resourceTencentCloudLighthouseRebootInstanceRead(d *schema.ResourceData, me
 	return nil
 }
 
+func resourceTencentCloudLighthouseRebootInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_lighthouse_reboot_instance.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudLighthouseRebootInstanceRead(d, meta)
+}
+
 func resourceTencentCloudLighthouseRebootInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_lighthouse_reboot_instance.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
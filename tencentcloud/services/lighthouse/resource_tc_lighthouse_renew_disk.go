@@ -17,6 +17,7 @@ func ResourceTencentCloudLighthouseRenewDisk() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudLighthouseRenewDiskCreate,
 		Read:   resourceTencentCloudLighthouseRenewDiskRead,
+		Update: resourceTencentCloudLighthouseRenewDiskUpdate,
 		Delete: resourceTencentCloudLighthouseRenewDiskDelete,
 		Schema: map[string]*schema.Schema{
 			"disk_id": {
@@ -64,6 +65,10 @@ func ResourceTencentCloudLighthouseRenewDisk() *schema.Resource {
 				Type:        schema.TypeBool,
 				Description: "Whether to automatically use the voucher. Not used by default.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -120,12 +125,14 @@ func resourceTencentCloudLighthouseRenewDiskCreate(d *schema.ResourceData, meta
 
 	d.SetId(diskId)
 
-	service := LightHouseService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := LightHouseService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*tccommon.ReadRetryTimeout, time.Second, service.LighthouseDiskLatestOperationRefreshFunc(d.Id(), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*tccommon.ReadRetryTimeout, time.Second, service.LighthouseDiskLatestOperationRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudLighthouseRenewDiskRead(d, meta)
@@ -138,6 +145,14 @@ func resourceTencentCloudLighthouseRenewDiskRead(d *schema.ResourceData, meta in
 	return nil
 }
 
+func resourceTencentCloudLighthouseRenewDiskUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_lighthouse_renew_disk.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudLighthouseRenewDiskRead(d, meta)
+}
+
 func resourceTencentCloudLighthouseRenewDiskDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_lighthouse_renew_disk.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudLighthouseSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudLighthouseSnapshotCreate,
-		Read:   resourceTencentCloudLighthouseSnapshotRead,
-		Update: resourceTencentCloudLighthouseSnapshotUpdate,
-		Delete: resourceTencentCloudLighthouseSnapshotDelete,
+		Create:   resourceTencentCloudLighthouseSnapshotCreate,
+		Read:     resourceTencentCloudLighthouseSnapshotRead,
+		Update:   resourceTencentCloudLighthouseSnapshotUpdate,
+		Delete:   resourceTencentCloudLighthouseSnapshotDelete,
+		Importer: helper.IdSpec{"snapshot_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
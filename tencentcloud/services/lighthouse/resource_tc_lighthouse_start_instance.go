@@ -15,6 +15,7 @@ func ResourceTencentCloudLighthouseStartInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudLighthouseStartInstanceCreate,
 		Read:   resourceTencentCloudLighthouseStartInstanceRead,
+		Update: resourceTencentCloudLighthouseStartInstanceUpdate,
 		Delete: resourceTencentCloudLighthouseStartInstanceDelete,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -23,6 +24,10 @@ func ResourceTencentCloudLighthouseStartInstance() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Instance ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -52,12 +57,14 @@ func resourceTencentCloudLighthouseStartInstanceCreate(d *schema.ResourceData, m
 
 	d.SetId(instanceId)
 
-	service := LightHouseService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+	if tccommon.OperationWaitForCompletion(d) {
+		service := LightHouseService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*tccommon.ReadRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(d.Id(), []string{}))
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"SUCCESS"}, 20*tccommon.ReadRetryTimeout, time.Second, service.LighthouseInstanceStateRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudLighthouseStartInstanceRead(d, meta)
@@ -70,6 +77,14 @@ func resourceTencentCloudLighthouseStartInstanceRead(d *schema.ResourceData, met
 	return nil
 }
 
+func resourceTencentCloudLighthouseStartInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_lighthouse_start_instance.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudLighthouseStartInstanceRead(d, meta)
+}
+
 func resourceTencentCloudLighthouseStartInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_lighthouse_start_instance.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -153,7 +153,7 @@ func dataSourceTencentCloudMariadbAccountsRead(d *schema.ResourceData, meta inte
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), userList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), userList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -301,7 +301,7 @@ func dataSourceTencentCloudMariadbDbInstancesRead(d *schema.ResourceData, meta i
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), instanceList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), instanceList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
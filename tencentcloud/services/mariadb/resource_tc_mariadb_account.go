@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudMariadbAccount() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMariadbAccountRead,
-		Create: resourceTencentCloudMariadbAccountCreate,
-		Update: resourceTencentCloudMariadbAccountUpdate,
-		Delete: resourceTencentCloudMariadbAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudMariadbAccountRead,
+		Create:   resourceTencentCloudMariadbAccountCreate,
+		Update:   resourceTencentCloudMariadbAccountUpdate,
+		Delete:   resourceTencentCloudMariadbAccountDelete,
+		Importer: helper.IdSpec{"instance_id", "user_name", "host"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
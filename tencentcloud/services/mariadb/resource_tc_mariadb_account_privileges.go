@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudMariadbAccountPrivileges() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbAccountPrivilegesCreate,
-		Read:   resourceTencentCloudMariadbAccountPrivilegesRead,
-		Update: resourceTencentCloudMariadbAccountPrivilegesUpdate,
-		Delete: resourceTencentCloudMariadbAccountPrivilegesDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMariadbAccountPrivilegesCreate,
+		Read:     resourceTencentCloudMariadbAccountPrivilegesRead,
+		Update:   resourceTencentCloudMariadbAccountPrivilegesUpdate,
+		Delete:   resourceTencentCloudMariadbAccountPrivilegesDelete,
+		Importer: helper.IdSpec{"instance_id", "user", "host"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
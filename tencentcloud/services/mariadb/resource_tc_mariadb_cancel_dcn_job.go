@@ -18,6 +18,7 @@ func ResourceTencentCloudMariadbCancelDcnJob() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMariadbCancelDcnJobCreate,
 		Read:   resourceTencentCloudMariadbCancelDcnJobRead,
+		Update: resourceTencentCloudMariadbCancelDcnJobUpdate,
 		Delete: resourceTencentCloudMariadbCancelDcnJobDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -27,6 +28,10 @@ func ResourceTencentCloudMariadbCancelDcnJob() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Instance ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -65,27 +70,29 @@ func resourceTencentCloudMariadbCancelDcnJobCreate(d *schema.ResourceData, meta
 		return err
 	}
 
-	err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := service.DescribeFlowById(ctx, flowId)
-		if e != nil {
-			return tccommon.RetryError(e)
-		}
-
-		if *result.Status == MARIADB_TASK_SUCCESS {
-			return nil
-		} else if *result.Status == MARIADB_TASK_RUNNING {
-			return resource.RetryableError(fmt.Errorf("operate mariadb cancelDcnJob status is running"))
-		} else if *result.Status == MARIADB_TASK_FAIL {
-			return resource.NonRetryableError(fmt.Errorf("operate mariadb cancelDcnJob status is fail"))
-		} else {
-			e = fmt.Errorf("operate mariadb cancelDcnJob status illegal")
-			return resource.NonRetryableError(e)
+	if tccommon.OperationWaitForCompletion(d) {
+		err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			result, e := service.DescribeFlowById(ctx, flowId)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			if *result.Status == MARIADB_TASK_SUCCESS {
+				return nil
+			} else if *result.Status == MARIADB_TASK_RUNNING {
+				return resource.RetryableError(fmt.Errorf("operate mariadb cancelDcnJob status is running"))
+			} else if *result.Status == MARIADB_TASK_FAIL {
+				return resource.NonRetryableError(fmt.Errorf("operate mariadb cancelDcnJob status is fail"))
+			} else {
+				e = fmt.Errorf("operate mariadb cancelDcnJob status illegal")
+				return resource.NonRetryableError(e)
+			}
+		})
+
+		if err != nil {
+			log.Printf("[CRITAL]%s operate mariadb cancelDcnJob task failed, reason:%+v", logId, err)
+			return err
 		}
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s operate mariadb cancelDcnJob task failed, reason:%+v", logId, err)
-		return err
 	}
 
 	d.SetId(instanceId)
@@ -100,6 +107,14 @@ func resourceTencentCloudMariadbCancelDcnJobRead(d *schema.ResourceData, meta in
 	return nil
 }
 
+func resourceTencentCloudMariadbCancelDcnJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mariadb_cancel_dcn_job.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMariadbCancelDcnJobRead(d, meta)
+}
+
 func resourceTencentCloudMariadbCancelDcnJobDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mariadb_cancel_dcn_job.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,10 +16,11 @@ import (
 
 func ResourceTencentCloudMariadbOperateHourDbInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMariadbActivateHourDbInstanceCreate,
-		Read:   resourceTencentCloudMariadbActivateHourDbInstanceRead,
-		Update: resourceTencentCloudMariadbActivateHourDbInstanceUpdate,
-		Delete: resourceTencentCloudMariadbActivateHourDbInstanceDelete,
+		Create:   resourceTencentCloudMariadbActivateHourDbInstanceCreate,
+		Read:     resourceTencentCloudMariadbActivateHourDbInstanceRead,
+		Update:   resourceTencentCloudMariadbActivateHourDbInstanceUpdate,
+		Delete:   resourceTencentCloudMariadbActivateHourDbInstanceDelete,
+		Importer: helper.IdSpec{"instance_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
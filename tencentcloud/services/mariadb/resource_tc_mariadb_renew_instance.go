@@ -18,6 +18,7 @@ func ResourceTencentCloudMariadbRenewInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMariadbRenewInstanceCreate,
 		Read:   resourceTencentCloudMariadbRenewInstanceRead,
+		Update: resourceTencentCloudMariadbRenewInstanceUpdate,
 		Delete: resourceTencentCloudMariadbRenewInstanceDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -34,6 +35,10 @@ func ResourceTencentCloudMariadbRenewInstance() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "Renewal duration, unit: month.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -75,34 +80,36 @@ func resourceTencentCloudMariadbRenewInstanceCreate(d *schema.ResourceData, meta
 		return err
 	}
 
-	// check order
-	OrderRequest := mariadb.NewDescribeOrdersRequest()
-	OrderRequest.DealNames = common.StringPtrs([]string{dealName})
-	err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		resp, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseMariadbClient().DescribeOrders(OrderRequest)
-		if e != nil {
-			return resource.RetryableError(err)
-		}
-
-		if resp == nil || resp.Response == nil {
-			e = fmt.Errorf("TencentCloud SDK returns nil response, %s", request.GetAction())
-			return resource.RetryableError(e)
+	if tccommon.OperationWaitForCompletion(d) {
+		// check order
+		OrderRequest := mariadb.NewDescribeOrdersRequest()
+		OrderRequest.DealNames = common.StringPtrs([]string{dealName})
+		err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			resp, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseMariadbClient().DescribeOrders(OrderRequest)
+			if e != nil {
+				return resource.RetryableError(err)
+			}
+
+			if resp == nil || resp.Response == nil {
+				e = fmt.Errorf("TencentCloud SDK returns nil response, %s", request.GetAction())
+				return resource.RetryableError(e)
+			}
+
+			if *resp.Response.TotalCount == 0 {
+				e = fmt.Errorf("TencentCloud SDK returns empty deal")
+				return resource.RetryableError(e)
+			} else if len(resp.Response.Deals) > 1 {
+				e = fmt.Errorf("TencentCloud SDK returns more than one deal")
+				return resource.RetryableError(e)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			log.Printf("[CRITAL]%s operate mariadb renewInstance task failed, reason:%+v", logId, err)
+			return err
 		}
-
-		if *resp.Response.TotalCount == 0 {
-			e = fmt.Errorf("TencentCloud SDK returns empty deal")
-			return resource.RetryableError(e)
-		} else if len(resp.Response.Deals) > 1 {
-			e = fmt.Errorf("TencentCloud SDK returns more than one deal")
-			return resource.RetryableError(e)
-		}
-
-		return nil
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s operate mariadb renewInstance task failed, reason:%+v", logId, err)
-		return err
 	}
 
 	d.SetId(instanceId)
@@ -117,6 +124,14 @@ func resourceTencentCloudMariadbRenewInstanceRead(d *schema.ResourceData, meta i
 	return nil
 }
 
+func resourceTencentCloudMariadbRenewInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mariadb_renew_instance.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMariadbRenewInstanceRead(d, meta)
+}
+
 func resourceTencentCloudMariadbRenewInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mariadb_renew_instance.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
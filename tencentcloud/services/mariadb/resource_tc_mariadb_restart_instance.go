@@ -19,6 +19,7 @@ func ResourceTencentCloudMariadbRestartInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMariadbRestartInstanceCreate,
 		Read:   resourceTencentCloudMariadbRestartInstanceRead,
+		Update: resourceTencentCloudMariadbRestartInstanceUpdate,
 		Delete: resourceTencentCloudMariadbRestartInstanceDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -34,6 +35,10 @@ func ResourceTencentCloudMariadbRestartInstance() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "expected restart time.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -77,28 +82,30 @@ func resourceTencentCloudMariadbRestartInstanceCreate(d *schema.ResourceData, me
 		return err
 	}
 
-	// wait
-	err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := service.DescribeFlowById(ctx, flowId)
-		if e != nil {
-			return tccommon.RetryError(e)
-		}
-
-		if *result.Status == MARIADB_TASK_SUCCESS {
-			return nil
-		} else if *result.Status == MARIADB_TASK_RUNNING {
-			return resource.RetryableError(fmt.Errorf("operate mariadb restartInstance status is running"))
-		} else if *result.Status == MARIADB_TASK_FAIL {
-			return resource.NonRetryableError(fmt.Errorf("operate mariadb restartInstance status is fail"))
-		} else {
-			e = fmt.Errorf("operate mariadb restartInstance status illegal")
-			return resource.NonRetryableError(e)
+	if tccommon.OperationWaitForCompletion(d) {
+		// wait
+		err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			result, e := service.DescribeFlowById(ctx, flowId)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			if *result.Status == MARIADB_TASK_SUCCESS {
+				return nil
+			} else if *result.Status == MARIADB_TASK_RUNNING {
+				return resource.RetryableError(fmt.Errorf("operate mariadb restartInstance status is running"))
+			} else if *result.Status == MARIADB_TASK_FAIL {
+				return resource.NonRetryableError(fmt.Errorf("operate mariadb restartInstance status is fail"))
+			} else {
+				e = fmt.Errorf("operate mariadb restartInstance status illegal")
+				return resource.NonRetryableError(e)
+			}
+		})
+
+		if err != nil {
+			log.Printf("[CRITAL]%s operate mariadb restartInstance task failed, reason:%+v", logId, err)
+			return err
 		}
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s operate mariadb restartInstance task failed, reason:%+v", logId, err)
-		return err
 	}
 
 	d.SetId(instanceId)
@@ -113,6 +120,14 @@ func resourceTencentCloudMariadbRestartInstanceRead(d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceTencentCloudMariadbRestartInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mariadb_restart_instance.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMariadbRestartInstanceRead(d, meta)
+}
+
 func resourceTencentCloudMariadbRestartInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mariadb_restart_instance.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudMariadbSecurityGroups() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMariadbSecurityGroupsRead,
-		Create: resourceTencentCloudMariadbSecurityGroupsCreate,
-		Update: resourceTencentCloudMariadbSecurityGroupsUpdate,
-		Delete: resourceTencentCloudMariadbSecurityGroupsDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudMariadbSecurityGroupsRead,
+		Create:   resourceTencentCloudMariadbSecurityGroupsCreate,
+		Update:   resourceTencentCloudMariadbSecurityGroupsUpdate,
+		Delete:   resourceTencentCloudMariadbSecurityGroupsDelete,
+		Importer: helper.IdSpec{"instance_id", "security_group_id", "product"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -18,6 +18,7 @@ func ResourceTencentCloudMariadbSwitchHA() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMariadbSwitchHACreate,
 		Read:   resourceTencentCloudMariadbSwitchHARead,
+		Update: resourceTencentCloudMariadbSwitchHAUpdate,
 		Delete: resourceTencentCloudMariadbSwitchHADelete,
 
 		Schema: map[string]*schema.Schema{
@@ -33,6 +34,10 @@ func ResourceTencentCloudMariadbSwitchHA() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Target AZ. The node with the lowest delay in the target AZ will be automatically promoted to primary node.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -76,28 +81,30 @@ func resourceTencentCloudMariadbSwitchHACreate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	// wait
-	err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := service.DescribeFlowById(ctx, flowId)
-		if e != nil {
-			return tccommon.RetryError(e)
-		}
-
-		if *result.Status == MARIADB_TASK_SUCCESS {
-			return nil
-		} else if *result.Status == MARIADB_TASK_RUNNING {
-			return resource.RetryableError(fmt.Errorf("operate mariadb switchHA status is running"))
-		} else if *result.Status == MARIADB_TASK_FAIL {
-			return resource.NonRetryableError(fmt.Errorf("operate mariadb switchHA status is fail"))
-		} else {
-			e = fmt.Errorf("operate mariadb switchHA status illegal")
-			return resource.NonRetryableError(e)
+	if tccommon.OperationWaitForCompletion(d) {
+		// wait
+		err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			result, e := service.DescribeFlowById(ctx, flowId)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			if *result.Status == MARIADB_TASK_SUCCESS {
+				return nil
+			} else if *result.Status == MARIADB_TASK_RUNNING {
+				return resource.RetryableError(fmt.Errorf("operate mariadb switchHA status is running"))
+			} else if *result.Status == MARIADB_TASK_FAIL {
+				return resource.NonRetryableError(fmt.Errorf("operate mariadb switchHA status is fail"))
+			} else {
+				e = fmt.Errorf("operate mariadb switchHA status illegal")
+				return resource.NonRetryableError(e)
+			}
+		})
+
+		if err != nil {
+			log.Printf("[CRITAL]%s operate mariadb switchHA task failed, reason:%+v", logId, err)
+			return err
 		}
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s operate mariadb switchHA task failed, reason:%+v", logId, err)
-		return err
 	}
 
 	d.SetId(instanceId)
@@ -112,6 +119,14 @@ func resourceTencentCloudMariadbSwitchHARead(d *schema.ResourceData, meta interf
 	return nil
 }
 
+func resourceTencentCloudMariadbSwitchHAUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mariadb_switch_ha.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMariadbSwitchHARead(d, meta)
+}
+
 func resourceTencentCloudMariadbSwitchHADelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mariadb_switch_ha.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -94,6 +94,7 @@ func ResourceTencentCloudMdlStreamLiveInput() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Description: "The password, which is used for authentication.Note: This field may return `null`, indicating that no valid value was found.",
 						},
@@ -120,7 +120,7 @@ func dataSourceTencentCloudMongodbInstanceSlowLogRead(d *schema.ResourceData, me
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), slowLogs); e != nil {
+		if e := tccommon.WriteToFile(output.(string), slowLogs, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -111,7 +111,7 @@ func dataSourceTencentCloudMongodbInstanceUrlsRead(d *schema.ResourceData, meta
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), urlsList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), urlsList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
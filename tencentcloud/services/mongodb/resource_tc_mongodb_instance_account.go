@@ -20,13 +20,11 @@ import (
 
 func ResourceTencentCloudMongodbInstanceAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMongodbInstanceAccountCreate,
-		Read:   resourceTencentCloudMongodbInstanceAccountRead,
-		Update: resourceTencentCloudMongodbInstanceAccountUpdate,
-		Delete: resourceTencentCloudMongodbInstanceAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMongodbInstanceAccountCreate,
+		Read:     resourceTencentCloudMongodbInstanceAccountRead,
+		Update:   resourceTencentCloudMongodbInstanceAccountUpdate,
+		Delete:   resourceTencentCloudMongodbInstanceAccountDelete,
+		Importer: helper.IdSpec{"instance_id", "user_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
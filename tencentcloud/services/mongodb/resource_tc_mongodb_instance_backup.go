@@ -18,6 +18,7 @@ func ResourceTencentCloudMongodbInstanceBackup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudMongodbInstanceBackupCreate,
 		Read:   resourceTencentCloudMongodbInstanceBackupRead,
+		Update: resourceTencentCloudMongodbInstanceBackupUpdate,
 		Delete: resourceTencentCloudMongodbInstanceBackupDelete,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(3 * time.Minute),
@@ -43,6 +44,10 @@ func ResourceTencentCloudMongodbInstanceBackup() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "backup notes.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -88,14 +93,16 @@ func resourceTencentCloudMongodbInstanceBackupCreate(d *schema.ResourceData, met
 	taskId = *response.Response.AsyncRequestId
 	d.SetId(taskId)
 
-	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+	if tccommon.OperationWaitForCompletion(d) {
+		ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
 
-	service := MongodbService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		service := MongodbService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	timeout := d.Timeout(schema.TimeoutCreate)
-	if response != nil && response.Response != nil {
-		if err = service.DescribeAsyncRequestInfo(ctx, taskId, timeout); err != nil {
-			return err
+		timeout := d.Timeout(schema.TimeoutCreate)
+		if response != nil && response.Response != nil {
+			if err = service.DescribeAsyncRequestInfo(ctx, taskId, timeout); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -109,6 +116,14 @@ func resourceTencentCloudMongodbInstanceBackupRead(d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceTencentCloudMongodbInstanceBackupUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_mongodb_instance_backup.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudMongodbInstanceBackupRead(d, meta)
+}
+
 func resourceTencentCloudMongodbInstanceBackupDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_mongodb_instance_backup.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -16,12 +16,10 @@ import (
 
 func ResourceTencentCloudMongodbInstanceBackupDownloadTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMongodbInstanceBackupDownloadTaskCreate,
-		Read:   resourceTencentCloudMongodbInstanceBackupDownloadTaskRead,
-		Delete: resourceTencentCloudMongodbInstanceBackupDownloadTaskDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMongodbInstanceBackupDownloadTaskCreate,
+		Read:     resourceTencentCloudMongodbInstanceBackupDownloadTaskRead,
+		Delete:   resourceTencentCloudMongodbInstanceBackupDownloadTaskDelete,
+		Importer: helper.IdSpec{"instance_id", "backup_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
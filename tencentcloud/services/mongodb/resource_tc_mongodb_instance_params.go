@@ -15,10 +15,11 @@ import (
 
 func ResourceTencentCloudMongodbInstanceParams() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMongodbInstanceParamsCreate,
-		Read:   resourceTencentCloudMongodbInstanceParamsRead,
-		Update: resourceTencentCloudMongodbInstanceParamsUpdate,
-		Delete: resourceTencentCloudMongodbInstanceParamsDelete,
+		Create:   resourceTencentCloudMongodbInstanceParamsCreate,
+		Read:     resourceTencentCloudMongodbInstanceParamsRead,
+		Update:   resourceTencentCloudMongodbInstanceParamsUpdate,
+		Delete:   resourceTencentCloudMongodbInstanceParamsDelete,
+		Importer: helper.IdSpec{"instance_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -382,7 +382,7 @@ func dataSourceTencentMonitorAlarmNoticesRead(d *schema.ResourceData, meta inter
 		return err
 	}
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return tccommon.WriteToFile(output.(string), alarmNotices)
+		return tccommon.WriteToFile(output.(string), alarmNotices, tccommon.GetResultOutputOptions(d))
 	}
 	return nil
 }
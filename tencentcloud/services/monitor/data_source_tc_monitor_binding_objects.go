@@ -94,7 +94,7 @@ func dataSourceTencentMonitorBindingObjectRead(d *schema.ResourceData, meta inte
 	}
 	d.SetId(fmt.Sprintf("%d", id))
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return tccommon.WriteToFile(output.(string), list)
+		return tccommon.WriteToFile(output.(string), list, tccommon.GetResultOutputOptions(d))
 	}
 	return nil
 }
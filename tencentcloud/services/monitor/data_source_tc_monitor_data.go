@@ -152,7 +152,7 @@ func dataSourceTencentMonitorDataRead(d *schema.ResourceData, meta interface{})
 	id := fmt.Sprintf("%x", md.Sum(nil))
 	d.SetId(id)
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return tccommon.WriteToFile(output.(string), list)
+		return tccommon.WriteToFile(output.(string), list, tccommon.GetResultOutputOptions(d))
 	}
 	return nil
 }
@@ -348,7 +348,7 @@ func dataSourceTencentMonitorPolicyConditionRead(d *schema.ResourceData, meta in
 		return err
 	}
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return tccommon.WriteToFile(output.(string), policyViewList)
+		return tccommon.WriteToFile(output.(string), policyViewList, tccommon.GetResultOutputOptions(d))
 	}
 	return nil
 }
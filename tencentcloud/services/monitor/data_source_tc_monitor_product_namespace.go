@@ -124,7 +124,7 @@ func dataSourceTencentMonitorProductNamespaceRead(d *schema.ResourceData, meta i
 	}
 	d.SetId(fmt.Sprintf("product_namespace_%s", filterName))
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return tccommon.WriteToFile(output.(string), list)
+		return tccommon.WriteToFile(output.(string), list, tccommon.GetResultOutputOptions(d))
 	}
 	return nil
 }
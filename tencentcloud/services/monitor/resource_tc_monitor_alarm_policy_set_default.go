@@ -32,6 +32,8 @@ func ResourceTencentCloudMonitorAlarmPolicySetDefault() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Policy id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
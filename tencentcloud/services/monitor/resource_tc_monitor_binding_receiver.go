@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudMonitorBindingAlarmReceiver() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentMonitorBindingAlarmReceiverCreate,
-		Read:   resourceTencentMonitorBindingAlarmReceiverRead,
-		Update: resourceTencentMonitorBindingAlarmReceiverUpdate,
-		Delete: resourceTencentMonitorBindingAlarmReceiverDelete,
+		Create:   resourceTencentMonitorBindingAlarmReceiverCreate,
+		Read:     resourceTencentMonitorBindingAlarmReceiverRead,
+		Update:   resourceTencentMonitorBindingAlarmReceiverUpdate,
+		Delete:   resourceTencentMonitorBindingAlarmReceiverDelete,
+		Importer: helper.IdSpec{"group_id"}.ImporterWithArguments("group_id"),
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Type:        schema.TypeInt,
@@ -98,6 +98,7 @@ func DataSourceTencentCloudMpsMediaMetaData() *schema.Resource {
 									},
 									"s3_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "The key required to access the AWS S3 object.",
 									},
@@ -446,7 +447,7 @@ func dataSourceTencentCloudMpsMediaMetaDataRead(d *schema.ResourceData, meta int
 	d.SetId(helper.DataResourceIdHash(string(id)))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), mediaMetaDataMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), mediaMetaDataMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
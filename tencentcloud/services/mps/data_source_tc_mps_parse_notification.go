@@ -69,7 +69,7 @@ func dataSourceTencentCloudMpsParseNotificationRead(d *schema.ResourceData, meta
 	d.SetId(taskId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), result); e != nil {
+		if e := tccommon.WriteToFile(output.(string), result, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
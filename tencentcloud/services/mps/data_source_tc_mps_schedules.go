@@ -137,6 +137,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 												},
 												"s3_secret_key": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "The key of the AWS S3 bucket.Note: This field may return null, indicating that no valid values can be obtained.",
 												},
@@ -163,6 +164,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 															},
 															"s3_secret_key": {
 																Type:        schema.TypeString,
+																Sensitive:   true,
 																Computed:    true,
 																Description: "The key required to read from/write to the SQS queue.",
 															},
@@ -589,6 +591,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																								},
 																								"s3_secret_key": {
 																									Type:        schema.TypeString,
+																									Sensitive:   true,
 																									Computed:    true,
 																									Description: "The key required to access the AWS S3 object.",
 																								},
@@ -691,6 +694,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																											},
 																											"s3_secret_key": {
 																												Type:        schema.TypeString,
+																												Sensitive:   true,
 																												Computed:    true,
 																												Description: "The key required to access the AWS S3 object.",
 																											},
@@ -826,6 +830,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																														},
 																														"s3_secret_key": {
 																															Type:        schema.TypeString,
+																															Sensitive:   true,
 																															Computed:    true,
 																															Description: "The key required to access the AWS S3 object.",
 																														},
@@ -986,6 +991,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																					},
 																					"s3_secret_key": {
 																						Type:        schema.TypeString,
+																						Sensitive:   true,
 																						Computed:    true,
 																						Description: "The key required to upload files to the AWS S3 object.",
 																					},
@@ -1117,6 +1123,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																								},
 																								"s3_secret_key": {
 																									Type:        schema.TypeString,
+																									Sensitive:   true,
 																									Computed:    true,
 																									Description: "The key required to access the AWS S3 object.",
 																								},
@@ -1203,6 +1210,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																								},
 																								"s3_secret_key": {
 																									Type:        schema.TypeString,
+																									Sensitive:   true,
 																									Computed:    true,
 																									Description: "The key required to access the AWS S3 object.",
 																								},
@@ -1292,6 +1300,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																					},
 																					"s3_secret_key": {
 																						Type:        schema.TypeString,
+																						Sensitive:   true,
 																						Computed:    true,
 																						Description: "The key required to upload files to the AWS S3 object.",
 																					},
@@ -1448,6 +1457,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																														},
 																														"s3_secret_key": {
 																															Type:        schema.TypeString,
+																															Sensitive:   true,
 																															Computed:    true,
 																															Description: "The key required to access the AWS S3 object.",
 																														},
@@ -1554,6 +1564,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																					},
 																					"s3_secret_key": {
 																						Type:        schema.TypeString,
+																						Sensitive:   true,
 																						Computed:    true,
 																						Description: "The key required to upload files to the AWS S3 object.",
 																					},
@@ -1731,6 +1742,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																														},
 																														"s3_secret_key": {
 																															Type:        schema.TypeString,
+																															Sensitive:   true,
 																															Computed:    true,
 																															Description: "The key required to access the AWS S3 object.",
 																														},
@@ -1837,6 +1849,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																					},
 																					"s3_secret_key": {
 																						Type:        schema.TypeString,
+																						Sensitive:   true,
 																						Computed:    true,
 																						Description: "The key required to upload files to the AWS S3 object.",
 																					},
@@ -1947,6 +1960,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																					},
 																					"s3_secret_key": {
 																						Type:        schema.TypeString,
+																						Sensitive:   true,
 																						Computed:    true,
 																						Description: "The key required to upload files to the AWS S3 object.",
 																					},
@@ -2129,6 +2143,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																														},
 																														"s3_secret_key": {
 																															Type:        schema.TypeString,
+																															Sensitive:   true,
 																															Computed:    true,
 																															Description: "The key required to access the AWS S3 object.",
 																														},
@@ -2235,6 +2250,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																					},
 																					"s3_secret_key": {
 																						Type:        schema.TypeString,
+																						Sensitive:   true,
 																						Computed:    true,
 																						Description: "The key required to upload files to the AWS S3 object.",
 																					},
@@ -2347,6 +2363,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 																								},
 																								"s3_secret_key": {
 																									Type:        schema.TypeString,
+																									Sensitive:   true,
 																									Computed:    true,
 																									Description: "The key required to access the AWS S3 object.",
 																								},
@@ -2468,6 +2485,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 												},
 												"s3_secret_key": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "The key required to upload files to the AWS S3 object.",
 												},
@@ -2546,6 +2564,7 @@ func DataSourceTencentCloudMpsSchedules() *schema.Resource {
 												},
 												"s3_secret_key": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Computed:    true,
 													Description: "The key required to read from/write to the SQS queue.",
 												},
@@ -4584,7 +4603,7 @@ func dataSourceTencentCloudMpsSchedulesRead(d *schema.ResourceData, meta interfa
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
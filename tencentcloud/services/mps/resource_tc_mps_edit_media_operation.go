@@ -106,6 +106,7 @@ func ResourceTencentCloudMpsEditMediaOperation() *schema.Resource {
 												},
 												"s3_secret_key": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Optional:    true,
 													Description: "The key required to access the AWS S3 object.",
 												},
@@ -186,6 +187,7 @@ func ResourceTencentCloudMpsEditMediaOperation() *schema.Resource {
 									},
 									"s3_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "The key required to upload files to the AWS S3 object.",
 									},
@@ -292,6 +294,7 @@ func ResourceTencentCloudMpsEditMediaOperation() *schema.Resource {
 									},
 									"s3_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "The key required to read from/write to the SQS queue.",
 									},
@@ -322,6 +325,8 @@ func ResourceTencentCloudMpsEditMediaOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The source context which is used to pass through the user request information. The task flow status change callback will return the value of this field. It can contain up to 1,000 characters.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
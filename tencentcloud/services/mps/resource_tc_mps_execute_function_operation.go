@@ -31,6 +31,8 @@ func ResourceTencentCloudMpsExecuteFunctionOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "API parameter. Parameter format will depend on the actual function definition.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudMpsInput() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsInputCreate,
-		Read:   resourceTencentCloudMpsInputRead,
-		Update: resourceTencentCloudMpsInputUpdate,
-		Delete: resourceTencentCloudMpsInputDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMpsInputCreate,
+		Read:     resourceTencentCloudMpsInputRead,
+		Update:   resourceTencentCloudMpsInputUpdate,
+		Delete:   resourceTencentCloudMpsInputDelete,
+		Importer: helper.IdSpec{"flow_id", "input_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"flow_id": {
 				Required:    true,
@@ -31,6 +31,8 @@ func ResourceTencentCloudMpsManageTaskOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Video processing task ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
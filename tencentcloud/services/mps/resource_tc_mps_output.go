@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudMpsOutput() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMpsOutputCreate,
-		Read:   resourceTencentCloudMpsOutputRead,
-		Update: resourceTencentCloudMpsOutputUpdate,
-		Delete: resourceTencentCloudMpsOutputDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMpsOutputCreate,
+		Read:     resourceTencentCloudMpsOutputRead,
+		Update:   resourceTencentCloudMpsOutputUpdate,
+		Delete:   resourceTencentCloudMpsOutputDelete,
+		Importer: helper.IdSpec{"flow_id", "output_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"flow_id": {
 				Required:    true,
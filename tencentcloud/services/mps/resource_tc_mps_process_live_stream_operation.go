@@ -124,6 +124,7 @@ func ResourceTencentCloudMpsProcessLiveStreamOperation() *schema.Resource {
 									},
 									"s3_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "The key required to upload files to the AWS S3 object.",
 									},
@@ -239,6 +240,8 @@ func ResourceTencentCloudMpsProcessLiveStreamOperation() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "The scheme ID.Note 1: About `OutputStorage` and `OutputDir`:If an output storage and directory are specified for a subtask of the scheme, those output settings will be applied.If an output storage and directory are not specified for the subtasks of a scheme, the output parameters passed in the `ProcessMedia` API will be applied.Note 2: If `TaskNotifyConfig` is specified, the specified settings will be used instead of the default callback settings of the scheme.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
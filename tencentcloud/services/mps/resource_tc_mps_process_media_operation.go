@@ -100,6 +100,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 									},
 									"s3_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "The key required to access the AWS S3 object.",
 									},
@@ -167,6 +168,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 									},
 									"s3_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "The key required to upload files to the AWS S3 object.",
 									},
@@ -600,6 +602,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 																		},
 																		"s3_secret_key": {
 																			Type:        schema.TypeString,
+																			Sensitive:   true,
 																			Optional:    true,
 																			Description: "The key required to access the AWS S3 object.",
 																		},
@@ -706,6 +709,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 																					},
 																					"s3_secret_key": {
 																						Type:        schema.TypeString,
+																						Sensitive:   true,
 																						Optional:    true,
 																						Description: "The key required to access the AWS S3 object.",
 																					},
@@ -847,6 +851,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 																								},
 																								"s3_secret_key": {
 																									Type:        schema.TypeString,
+																									Sensitive:   true,
 																									Optional:    true,
 																									Description: "The key required to access the AWS S3 object.",
 																								},
@@ -1010,6 +1015,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 															},
 															"s3_secret_key": {
 																Type:        schema.TypeString,
+																Sensitive:   true,
 																Optional:    true,
 																Description: "The key required to upload files to the AWS S3 object.",
 															},
@@ -1146,6 +1152,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 																		},
 																		"s3_secret_key": {
 																			Type:        schema.TypeString,
+																			Sensitive:   true,
 																			Optional:    true,
 																			Description: "The key required to access the AWS S3 object.",
 																		},
@@ -1235,6 +1242,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 																		},
 																		"s3_secret_key": {
 																			Type:        schema.TypeString,
+																			Sensitive:   true,
 																			Optional:    true,
 																			Description: "The key required to access the AWS S3 object.",
 																		},
@@ -1327,6 +1335,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 															},
 															"s3_secret_key": {
 																Type:        schema.TypeString,
+																Sensitive:   true,
 																Optional:    true,
 																Description: "The key required to upload files to the AWS S3 object.",
 															},
@@ -1497,6 +1506,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 																								},
 																								"s3_secret_key": {
 																									Type:        schema.TypeString,
+																									Sensitive:   true,
 																									Optional:    true,
 																									Description: "The key required to access the AWS S3 object.",
 																								},
@@ -1606,6 +1616,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 															},
 															"s3_secret_key": {
 																Type:        schema.TypeString,
+																Sensitive:   true,
 																Optional:    true,
 																Description: "The key required to upload files to the AWS S3 object.",
 															},
@@ -1790,6 +1801,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 																								},
 																								"s3_secret_key": {
 																									Type:        schema.TypeString,
+																									Sensitive:   true,
 																									Optional:    true,
 																									Description: "The key required to access the AWS S3 object.",
 																								},
@@ -1899,6 +1911,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 															},
 															"s3_secret_key": {
 																Type:        schema.TypeString,
+																Sensitive:   true,
 																Optional:    true,
 																Description: "The key required to upload files to the AWS S3 object.",
 															},
@@ -2013,6 +2026,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 															},
 															"s3_secret_key": {
 																Type:        schema.TypeString,
+																Sensitive:   true,
 																Optional:    true,
 																Description: "The key required to upload files to the AWS S3 object.",
 															},
@@ -2202,6 +2216,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 																								},
 																								"s3_secret_key": {
 																									Type:        schema.TypeString,
+																									Sensitive:   true,
 																									Optional:    true,
 																									Description: "The key required to access the AWS S3 object.",
 																								},
@@ -2311,6 +2326,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 															},
 															"s3_secret_key": {
 																Type:        schema.TypeString,
+																Sensitive:   true,
 																Optional:    true,
 																Description: "The key required to upload files to the AWS S3 object.",
 															},
@@ -2427,6 +2443,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 																		},
 																		"s3_secret_key": {
 																			Type:        schema.TypeString,
+																			Sensitive:   true,
 																			Optional:    true,
 																			Description: "The key required to access the AWS S3 object.",
 																		},
@@ -2591,6 +2608,7 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 									},
 									"s3_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "The key required to read from/write to the SQS queue.",
 									},
@@ -2628,6 +2646,8 @@ func ResourceTencentCloudMpsProcessMediaOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The task type. `Online` (default): A task that is executed immediately. `Offline`: A task that is executed when the system is idle (within three days by default).",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -111,6 +111,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 									},
 									"s3_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "The key of the AWS S3 bucket.Note: This field may return null, indicating that no valid values can be obtained.",
 									},
@@ -138,6 +139,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 												},
 												"s3_secret_key": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Optional:    true,
 													Description: "The key required to read from/write to the SQS queue.",
 												},
@@ -600,6 +602,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																					},
 																					"s3_secret_key": {
 																						Type:        schema.TypeString,
+																						Sensitive:   true,
 																						Optional:    true,
 																						Description: "The key required to access the AWS S3 object.",
 																					},
@@ -706,6 +709,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																								},
 																								"s3_secret_key": {
 																									Type:        schema.TypeString,
+																									Sensitive:   true,
 																									Optional:    true,
 																									Description: "The key required to access the AWS S3 object.Note: This field may return null, indicating that no valid value can be obtained.",
 																								},
@@ -848,6 +852,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																											},
 																											"s3_secret_key": {
 																												Type:        schema.TypeString,
+																												Sensitive:   true,
 																												Optional:    true,
 																												Description: "The key required to access the AWS S3 object.",
 																											},
@@ -1011,6 +1016,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																		},
 																		"s3_secret_key": {
 																			Type:        schema.TypeString,
+																			Sensitive:   true,
 																			Optional:    true,
 																			Description: "The key required to upload files to the AWS S3 object.",
 																		},
@@ -1147,6 +1153,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																					},
 																					"s3_secret_key": {
 																						Type:        schema.TypeString,
+																						Sensitive:   true,
 																						Optional:    true,
 																						Description: "The key required to access the AWS S3 object.",
 																					},
@@ -1236,6 +1243,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																					},
 																					"s3_secret_key": {
 																						Type:        schema.TypeString,
+																						Sensitive:   true,
 																						Optional:    true,
 																						Description: "The key required to access the AWS S3 object.",
 																					},
@@ -1329,6 +1337,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																		},
 																		"s3_secret_key": {
 																			Type:        schema.TypeString,
+																			Sensitive:   true,
 																			Optional:    true,
 																			Description: "The key required to upload files to the AWS S3 object.",
 																		},
@@ -1493,6 +1502,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																											},
 																											"s3_secret_key": {
 																												Type:        schema.TypeString,
+																												Sensitive:   true,
 																												Optional:    true,
 																												Description: "The key required to access the AWS S3 object.",
 																											},
@@ -1602,6 +1612,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																		},
 																		"s3_secret_key": {
 																			Type:        schema.TypeString,
+																			Sensitive:   true,
 																			Optional:    true,
 																			Description: "The key required to upload files to the AWS S3 object.",
 																		},
@@ -1788,6 +1799,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																											},
 																											"s3_secret_key": {
 																												Type:        schema.TypeString,
+																												Sensitive:   true,
 																												Optional:    true,
 																												Description: "The key required to access the AWS S3 object.",
 																											},
@@ -1897,6 +1909,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																		},
 																		"s3_secret_key": {
 																			Type:        schema.TypeString,
+																			Sensitive:   true,
 																			Optional:    true,
 																			Description: "The key required to upload files to the AWS S3 object.",
 																		},
@@ -2012,6 +2025,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																		},
 																		"s3_secret_key": {
 																			Type:        schema.TypeString,
+																			Sensitive:   true,
 																			Optional:    true,
 																			Description: "The key required to upload files to the AWS S3 object.",
 																		},
@@ -2203,6 +2217,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																											},
 																											"s3_secret_key": {
 																												Type:        schema.TypeString,
+																												Sensitive:   true,
 																												Optional:    true,
 																												Description: "The key required to access the AWS S3 object.",
 																											},
@@ -2312,6 +2327,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																		},
 																		"s3_secret_key": {
 																			Type:        schema.TypeString,
+																			Sensitive:   true,
 																			Optional:    true,
 																			Description: "The key required to upload files to the AWS S3 object.",
 																		},
@@ -2428,6 +2444,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 																					},
 																					"s3_secret_key": {
 																						Type:        schema.TypeString,
+																						Sensitive:   true,
 																						Optional:    true,
 																						Description: "The key required to access the AWS S3 object.Note: This field may return null, indicating that no valid value can be obtained.",
 																					},
@@ -2556,6 +2573,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 									},
 									"s3_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "The key required to upload files to the AWS S3 object.",
 									},
@@ -2641,6 +2659,7 @@ func ResourceTencentCloudMpsSchedule() *schema.Resource {
 									},
 									"s3_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "The key required to read from/write to the SQS queue.",
 									},
@@ -30,6 +30,8 @@ func ResourceTencentCloudMpsStartFlowOperation() *schema.Resource {
 				Type:        schema.TypeBool,
 				Description: "`true`: start mps stream link flow; `false`: stop.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -100,6 +100,7 @@ func ResourceTencentCloudMpsWithdrawsWatermarkOperation() *schema.Resource {
 									},
 									"s3_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "The key required to access the AWS S3 object.",
 									},
@@ -177,6 +178,7 @@ func ResourceTencentCloudMpsWithdrawsWatermarkOperation() *schema.Resource {
 									},
 									"s3_secret_key": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "The key required to read from/write to the SQS queue.",
 									},
@@ -193,6 +195,8 @@ func ResourceTencentCloudMpsWithdrawsWatermarkOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The source context which is used to pass through the user request information. The task flow status change callback will return the value of this field.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
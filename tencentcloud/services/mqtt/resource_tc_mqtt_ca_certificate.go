@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudMqttCaCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMqttCaCertificateCreate,
-		Read:   resourceTencentCloudMqttCaCertificateRead,
-		Update: resourceTencentCloudMqttCaCertificateUpdate,
-		Delete: resourceTencentCloudMqttCaCertificateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMqttCaCertificateCreate,
+		Read:     resourceTencentCloudMqttCaCertificateRead,
+		Update:   resourceTencentCloudMqttCaCertificateUpdate,
+		Delete:   resourceTencentCloudMqttCaCertificateDelete,
+		Importer: helper.IdSpec{"instance_id", "ca_sn"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
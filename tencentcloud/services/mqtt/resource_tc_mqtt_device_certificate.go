@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudMqttDeviceCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMqttDeviceCertificateCreate,
-		Read:   resourceTencentCloudMqttDeviceCertificateRead,
-		Update: resourceTencentCloudMqttDeviceCertificateUpdate,
-		Delete: resourceTencentCloudMqttDeviceCertificateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMqttDeviceCertificateCreate,
+		Read:     resourceTencentCloudMqttDeviceCertificateRead,
+		Update:   resourceTencentCloudMqttDeviceCertificateUpdate,
+		Delete:   resourceTencentCloudMqttDeviceCertificateDelete,
+		Importer: helper.IdSpec{"instance_id", "device_certificate_sn"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudMqttInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: ResourceTencentCloudMqttInstanceCreate,
-		Read:   ResourceTencentCloudMqttInstanceRead,
-		Update: ResourceTencentCloudMqttInstanceUpdate,
-		Delete: ResourceTencentCloudMqttInstanceDelete,
+		Create:   ResourceTencentCloudMqttInstanceCreate,
+		Read:     ResourceTencentCloudMqttInstanceRead,
+		Update:   ResourceTencentCloudMqttInstanceUpdate,
+		Delete:   ResourceTencentCloudMqttInstanceDelete,
+		Importer: helper.IdSpec{"instance_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_type": {
 				Type:        schema.TypeString,
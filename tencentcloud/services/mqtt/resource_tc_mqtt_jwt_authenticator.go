@@ -45,6 +45,7 @@ func ResourceTencentCloudMqttJwtAuthenticator() *schema.Resource {
 
 			"secret": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Optional:    true,
 				Description: "Secret.",
 			},
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudMqttTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMqttTopicCreate,
-		Read:   resourceTencentCloudMqttTopicRead,
-		Update: resourceTencentCloudMqttTopicUpdate,
-		Delete: resourceTencentCloudMqttTopicDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMqttTopicCreate,
+		Read:     resourceTencentCloudMqttTopicRead,
+		Update:   resourceTencentCloudMqttTopicUpdate,
+		Delete:   resourceTencentCloudMqttTopicDelete,
+		Importer: helper.IdSpec{"instance_id", "topic"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
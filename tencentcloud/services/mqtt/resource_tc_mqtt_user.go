@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudMqttUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMqttUserCreate,
-		Read:   resourceTencentCloudMqttUserRead,
-		Update: resourceTencentCloudMqttUserUpdate,
-		Delete: resourceTencentCloudMqttUserDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMqttUserCreate,
+		Read:     resourceTencentCloudMqttUserRead,
+		Update:   resourceTencentCloudMqttUserUpdate,
+		Delete:   resourceTencentCloudMqttUserDelete,
+		Importer: helper.IdSpec{"instance_id", "user_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
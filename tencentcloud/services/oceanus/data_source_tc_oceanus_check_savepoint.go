@@ -115,7 +115,7 @@ func dataSourceTencentCloudOceanusCheckSavepointRead(d *schema.ResourceData, met
 	d.SetId(serialId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), d); e != nil {
+		if e := tccommon.WriteToFile(output.(string), d, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
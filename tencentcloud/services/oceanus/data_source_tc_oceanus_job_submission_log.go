@@ -271,7 +271,7 @@ func dataSourceTencentCloudOceanusJobSubmissionLogRead(d *schema.ResourceData, m
 	d.SetId(jobId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), d); e != nil {
+		if e := tccommon.WriteToFile(output.(string), d, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
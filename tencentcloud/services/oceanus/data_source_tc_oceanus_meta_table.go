@@ -138,7 +138,7 @@ func dataSourceTencentCloudOceanusMetaTableRead(d *schema.ResourceData, meta int
 	d.SetId(workSpaceId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), d); e != nil {
+		if e := tccommon.WriteToFile(output.(string), d, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
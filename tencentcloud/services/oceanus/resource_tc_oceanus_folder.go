@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudOceanusFolder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOceanusFolderCreate,
-		Read:   resourceTencentCloudOceanusFolderRead,
-		Update: resourceTencentCloudOceanusFolderUpdate,
-		Delete: resourceTencentCloudOceanusFolderDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudOceanusFolderCreate,
+		Read:     resourceTencentCloudOceanusFolderRead,
+		Update:   resourceTencentCloudOceanusFolderUpdate,
+		Delete:   resourceTencentCloudOceanusFolderDelete,
+		Importer: helper.IdSpec{"work_space_id", "folder_id", "folder_type"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"folder_name": {
 				Required:    true,
@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudOceanusJob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOceanusJobCreate,
-		Read:   resourceTencentCloudOceanusJobRead,
-		Update: resourceTencentCloudOceanusJobUpdate,
-		Delete: resourceTencentCloudOceanusJobDelete,
+		Create:   resourceTencentCloudOceanusJobCreate,
+		Read:     resourceTencentCloudOceanusJobRead,
+		Update:   resourceTencentCloudOceanusJobUpdate,
+		Delete:   resourceTencentCloudOceanusJobDelete,
+		Importer: helper.IdSpec{"job_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
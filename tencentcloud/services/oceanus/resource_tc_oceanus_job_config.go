@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudOceanusJobConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOceanusJobConfigCreate,
-		Read:   resourceTencentCloudOceanusJobConfigRead,
-		Update: resourceTencentCloudOceanusJobConfigUpdate,
-		Delete: resourceTencentCloudOceanusJobConfigDelete,
+		Create:   resourceTencentCloudOceanusJobConfigCreate,
+		Read:     resourceTencentCloudOceanusJobConfigRead,
+		Update:   resourceTencentCloudOceanusJobConfigUpdate,
+		Delete:   resourceTencentCloudOceanusJobConfigDelete,
+		Importer: helper.IdSpec{"job_id", "version"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"job_id": {
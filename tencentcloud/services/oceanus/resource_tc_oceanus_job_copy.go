@@ -16,9 +16,10 @@ import (
 
 func ResourceTencentCloudOceanusJobCopy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOceanusJobCopyCreate,
-		Read:   resourceTencentCloudOceanusJobCopyRead,
-		Delete: resourceTencentCloudOceanusJobCopyDelete,
+		Create:   resourceTencentCloudOceanusJobCopyCreate,
+		Read:     resourceTencentCloudOceanusJobCopyRead,
+		Delete:   resourceTencentCloudOceanusJobCopyDelete,
+		Importer: helper.IdSpec{"job_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"source_id": {
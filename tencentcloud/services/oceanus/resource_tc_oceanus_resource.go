@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudOceanusResource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOceanusResourceCreate,
-		Read:   resourceTencentCloudOceanusResourceRead,
-		Update: resourceTencentCloudOceanusResourceUpdate,
-		Delete: resourceTencentCloudOceanusResourceDelete,
+		Create:   resourceTencentCloudOceanusResourceCreate,
+		Read:     resourceTencentCloudOceanusResourceRead,
+		Update:   resourceTencentCloudOceanusResourceUpdate,
+		Delete:   resourceTencentCloudOceanusResourceDelete,
+		Importer: helper.IdSpec{"resource_id", "version"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"resource_loc": {
@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudOceanusResourceConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOceanusResourceConfigCreate,
-		Read:   resourceTencentCloudOceanusResourceConfigRead,
-		Update: resourceTencentCloudOceanusResourceConfigUpdate,
-		Delete: resourceTencentCloudOceanusResourceConfigDelete,
+		Create:   resourceTencentCloudOceanusResourceConfigCreate,
+		Read:     resourceTencentCloudOceanusResourceConfigRead,
+		Update:   resourceTencentCloudOceanusResourceConfigUpdate,
+		Delete:   resourceTencentCloudOceanusResourceConfigDelete,
+		Importer: helper.IdSpec{"resource_id", "version"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
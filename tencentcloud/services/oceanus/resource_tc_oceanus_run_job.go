@@ -76,6 +76,8 @@ func ResourceTencentCloudOceanusRunJob() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Workspace SerialId.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -46,6 +46,8 @@ func ResourceTencentCloudOceanusStopJob() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Workspace SerialId.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
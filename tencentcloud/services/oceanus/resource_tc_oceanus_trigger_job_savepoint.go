@@ -37,6 +37,8 @@ func ResourceTencentCloudOceanusTriggerJobSavepoint() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Workspace SerialId.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
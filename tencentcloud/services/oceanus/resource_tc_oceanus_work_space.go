@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudOceanusWorkSpace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOceanusWorkSpaceCreate,
-		Read:   resourceTencentCloudOceanusWorkSpaceRead,
-		Update: resourceTencentCloudOceanusWorkSpaceUpdate,
-		Delete: resourceTencentCloudOceanusWorkSpaceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudOceanusWorkSpaceCreate,
+		Read:     resourceTencentCloudOceanusWorkSpaceRead,
+		Update:   resourceTencentCloudOceanusWorkSpaceUpdate,
+		Delete:   resourceTencentCloudOceanusWorkSpaceDelete,
+		Importer: helper.IdSpec{"work_space_id", "work_space_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"work_space_name": {
 				Required:    true,
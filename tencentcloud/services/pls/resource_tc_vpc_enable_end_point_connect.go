@@ -41,6 +41,8 @@ func ResourceTencentCloudVpcEnableEndPointConnect() *schema.Resource {
 				Type:        schema.TypeBool,
 				Description: "Whether to accept endpoint connection requests. `true`: Accept automatically. `false`: Do not automatically accept.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudVpcEndPointServiceWhiteList() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcEndPointServiceWhiteListCreate,
-		Read:   resourceTencentCloudVpcEndPointServiceWhiteListRead,
-		Update: resourceTencentCloudVpcEndPointServiceWhiteListUpdate,
-		Delete: resourceTencentCloudVpcEndPointServiceWhiteListDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudVpcEndPointServiceWhiteListCreate,
+		Read:     resourceTencentCloudVpcEndPointServiceWhiteListRead,
+		Update:   resourceTencentCloudVpcEndPointServiceWhiteListUpdate,
+		Delete:   resourceTencentCloudVpcEndPointServiceWhiteListDelete,
+		Importer: helper.IdSpec{"user_uin", "end_point_service_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"user_uin": {
 				Required:    true,
@@ -168,7 +168,7 @@ func dataSourceTencentCloudPostgresqlBackupDownloadUrlsRead(d *schema.ResourceDa
 	d.SetId(helper.DataResourceIdHash(id))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), backupDownloadURL); e != nil {
+		if e := tccommon.WriteToFile(output.(string), backupDownloadURL, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
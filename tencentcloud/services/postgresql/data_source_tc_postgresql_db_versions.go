@@ -168,7 +168,7 @@ func dataSourceTencentCloudPostgresqlDbVersionsRead(d *schema.ResourceData, meta
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), versionSetList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), versionSetList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -144,7 +144,7 @@ func datasourceTencentCloudPostgresqlXlogsRead(d *schema.ResourceData, meta inte
 	}
 
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return tccommon.WriteToFile(output.(string), list)
+		return tccommon.WriteToFile(output.(string), list, tccommon.GetResultOutputOptions(d))
 	}
 
 	return nil
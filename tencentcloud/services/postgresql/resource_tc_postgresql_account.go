@@ -15,13 +15,11 @@ import (
 
 func ResourceTencentCloudPostgresqlAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlAccountCreate,
-		Read:   resourceTencentCloudPostgresqlAccountRead,
-		Update: resourceTencentCloudPostgresqlAccountUpdate,
-		Delete: resourceTencentCloudPostgresqlAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudPostgresqlAccountCreate,
+		Read:     resourceTencentCloudPostgresqlAccountRead,
+		Update:   resourceTencentCloudPostgresqlAccountUpdate,
+		Delete:   resourceTencentCloudPostgresqlAccountDelete,
+		Importer: helper.IdSpec{"db_instance_id", "user_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
 				Required:    true,
@@ -36,10 +34,24 @@ func ResourceTencentCloudPostgresqlAccount() *schema.Resource {
 				Description: "Instance username, which can contain 1-16 letters, digits, and underscore (_); can&amp;amp;#39;t be postgres; can&amp;amp;#39;t start with numbers, pg_, and tencentdb_.",
 			},
 			"password": {
-				Required:    true,
+				Optional:     true,
+				Type:         schema.TypeString,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+				Description:  "Password, which can contain 8-32 letters, digits, and symbols (()`~!@#$%^&amp;amp;amp;*-+=_|{}[]:;&amp;amp;#39;&amp;amp;lt;&amp;amp;gt;,.?/); can&amp;amp;#39;t start with slash /. Use `password_wo` instead to keep it out of the state.",
+			},
+			"password_wo": {
+				Optional:    true,
 				Type:        schema.TypeString,
 				Sensitive:   true,
-				Description: "Password, which can contain 8-32 letters, digits, and symbols (()`~!@#$%^&amp;amp;amp;*-+=_|{}[]:;&amp;amp;#39;&amp;amp;lt;&amp;amp;gt;,.?/); can&amp;amp;#39;t start with slash /.",
+				WriteOnly:   true,
+				Description: "Write-only password, which is never stored in the plan or state. Requires Terraform 1.11 or later. Bump `password_wo_version` to rotate it.",
+			},
+			"password_wo_version": {
+				Optional:     true,
+				Type:         schema.TypeInt,
+				RequiredWith: []string{"password_wo"},
+				Description:  "Version of `password_wo`. Changing it updates the password to the current value of `password_wo`.",
 			},
 			"type": {
 				Required:     true,
@@ -84,8 +96,12 @@ func resourceTencentCloudPostgresqlAccountCreate(d *schema.ResourceData, meta in
 		request.UserName = helper.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("password"); ok {
-		request.Password = helper.String(v.(string))
+	password, err := tccommon.GetSecretWithWriteOnly(d, "password")
+	if err != nil {
+		return err
+	}
+	if password != "" {
+		request.Password = helper.String(password)
 	}
 
 	if v, ok := d.GetOk("type"); ok {
@@ -96,7 +112,7 @@ func resourceTencentCloudPostgresqlAccountCreate(d *schema.ResourceData, meta in
 		request.Remark = helper.String(v.(string))
 	}
 
-	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UsePostgresqlClient().CreateAccount(request)
 		if e != nil {
 			return tccommon.RetryError(e)
@@ -216,11 +232,16 @@ func resourceTencentCloudPostgresqlAccountUpdate(d *schema.ResourceData, meta in
 	dBInstanceId := idSplit[0]
 	userName := idSplit[1]
 
-	if d.HasChange("password") {
+	if tccommon.HasSecretChange(d, "password") {
+		password, err := tccommon.GetSecretWithWriteOnly(d, "password")
+		if err != nil {
+			return err
+		}
+
 		pwdRequest.DBInstanceId = &dBInstanceId
 		pwdRequest.UserName = &userName
-		pwdRequest.Password = helper.String(d.Get("password").(string))
-		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		pwdRequest.Password = helper.String(password)
+		err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UsePostgresqlClient().ResetAccountPassword(pwdRequest)
 			if e != nil {
 				return tccommon.RetryError(e)
@@ -101,6 +101,8 @@ func ResourceTencentCloudPostgresqlAccountPrivilegesOperation() *schema.Resource
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
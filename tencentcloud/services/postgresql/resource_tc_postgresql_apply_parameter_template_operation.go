@@ -15,6 +15,7 @@ func ResourceTencentCloudPostgresqlApplyParameterTemplateOperation() *schema.Res
 	return &schema.Resource{
 		Create: resourceTencentCloudPostgresqlApplyParameterTemplateOperationCreate,
 		Read:   resourceTencentCloudPostgresqlApplyParameterTemplateOperationRead,
+		Update: resourceTencentCloudPostgresqlApplyParameterTemplateOperationUpdate,
 		Delete: resourceTencentCloudPostgresqlApplyParameterTemplateOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
@@ -29,6 +30,10 @@ func ResourceTencentCloudPostgresqlApplyParameterTemplateOperation() *schema.Res
 				Type:        schema.TypeString,
 				Description: "Template ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -99,9 +104,11 @@ func resourceTencentCloudPostgresqlApplyParameterTemplateOperationCreate(d *sche
 		return err
 	}
 
-	err = service.CheckDBInstanceStatus(ctx, dbInstanceId)
-	if err != nil {
-		return err
+	if tccommon.OperationWaitForCompletion(d) {
+		err = service.CheckDBInstanceStatus(ctx, dbInstanceId)
+		if err != nil {
+			return err
+		}
 	}
 
 	d.SetId(dbInstanceId + tccommon.FILED_SP + templateId)
@@ -116,6 +123,14 @@ func resourceTencentCloudPostgresqlApplyParameterTemplateOperationRead(d *schema
 	return nil
 }
 
+func resourceTencentCloudPostgresqlApplyParameterTemplateOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_postgresql_apply_parameter_template_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudPostgresqlApplyParameterTemplateOperationRead(d, meta)
+}
+
 func resourceTencentCloudPostgresqlApplyParameterTemplateOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_postgresql_apply_parameter_template_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
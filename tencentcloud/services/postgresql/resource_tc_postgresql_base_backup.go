@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudPostgresqlBaseBackup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlBaseBackupCreate,
-		Read:   resourceTencentCloudPostgresqlBaseBackupRead,
-		Update: resourceTencentCloudPostgresqlBaseBackupUpdate,
-		Delete: resourceTencentCloudPostgresqlBaseBackupDelete,
+		Create:   resourceTencentCloudPostgresqlBaseBackupCreate,
+		Read:     resourceTencentCloudPostgresqlBaseBackupRead,
+		Update:   resourceTencentCloudPostgresqlBaseBackupUpdate,
+		Delete:   resourceTencentCloudPostgresqlBaseBackupDelete,
+		Importer: helper.IdSpec{"db_instance_id", "base_backup_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
 				Required:    true,
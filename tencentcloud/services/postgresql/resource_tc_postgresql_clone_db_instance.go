@@ -18,6 +18,7 @@ func ResourceTencentCloudPostgresqlCloneDbInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudPostgresqlCloneDbInstanceCreate,
 		Read:   resourceTencentCloudPostgresqlCloneDbInstanceRead,
+		Update: resourceTencentCloudPostgresqlCloneDbInstanceUpdate,
 		Delete: resourceTencentCloudPostgresqlCloneDbInstanceDelete,
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
@@ -174,6 +175,10 @@ func ResourceTencentCloudPostgresqlCloneDbInstance() *schema.Resource {
 				ForceNew:    true,
 				Description: "Primary-standby sync mode, which supports:\nSemi-sync: Semi-sync\nAsync: Asynchronous\nDefault value for the primary instance: Semi-sync\nDefault value for the read-only instance: Async.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -306,15 +311,17 @@ func resourceTencentCloudPostgresqlCloneDbInstanceCreate(d *schema.ResourceData,
 
 	dBInstanceId = *response.Response.DBInstanceId
 
-	if _, err := (&resource.StateChangeConf{
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-		Pending:    []string{},
-		Refresh:    resourcePostgresqlCloneDbInstanceCreateStateRefreshFunc_0_0(ctx, dBInstanceId),
-		Target:     []string{"running"},
-		Timeout:    1800 * time.Second,
-	}).WaitForStateContext(ctx); err != nil {
-		return err
+	if tccommon.OperationWaitForCompletion(d) {
+		if _, err := (&resource.StateChangeConf{
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+			Pending:    []string{},
+			Refresh:    resourcePostgresqlCloneDbInstanceCreateStateRefreshFunc_0_0(ctx, dBInstanceId),
+			Target:     []string{"running"},
+			Timeout:    1800 * time.Second,
+		}).WaitForStateContext(ctx); err != nil {
+			return err
+		}
 	}
 	d.SetId(dBInstanceId)
 
@@ -328,6 +335,14 @@ func resourceTencentCloudPostgresqlCloneDbInstanceRead(d *schema.ResourceData, m
 	return nil
 }
 
+func resourceTencentCloudPostgresqlCloneDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_postgresql_clone_db_instance.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudPostgresqlCloneDbInstanceRead(d, meta)
+}
+
 func resourceTencentCloudPostgresqlCloneDbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_postgresql_clone_db_instance.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -32,6 +32,8 @@ func ResourceTencentCloudPostgresqlDeleteLogBackupOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Log backup ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
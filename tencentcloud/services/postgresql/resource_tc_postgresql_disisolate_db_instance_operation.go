@@ -18,6 +18,7 @@ func ResourceTencentCloudPostgresqlDisisolateDbInstanceOperation() *schema.Resou
 	return &schema.Resource{
 		Create: resourceTencentCloudPostgresqlDisisolateDbInstanceOperationCreate,
 		Read:   resourceTencentCloudPostgresqlDisisolateDbInstanceOperationRead,
+		Update: resourceTencentCloudPostgresqlDisisolateDbInstanceOperationUpdate,
 		Delete: resourceTencentCloudPostgresqlDisisolateDbInstanceOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"db_instance_id_set": {
@@ -53,6 +54,10 @@ func ResourceTencentCloudPostgresqlDisisolateDbInstanceOperation() *schema.Resou
 				},
 				Description: "Voucher ID list.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -116,10 +121,12 @@ func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationCreate(d *schema
 
 	service := PostgresqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running"}, 10*tccommon.ReadRetryTimeout, 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(firstInstanceId, []string{}))
+	if tccommon.OperationWaitForCompletion(d) {
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"running"}, 10*tccommon.ReadRetryTimeout, 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(firstInstanceId, []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudPostgresqlDisisolateDbInstanceOperationRead(d, meta)
@@ -132,6 +139,14 @@ func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationRead(d *schema.R
 	return nil
 }
 
+func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_postgresql_disisolate_db_instance_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudPostgresqlDisisolateDbInstanceOperationRead(d, meta)
+}
+
 func resourceTencentCloudPostgresqlDisisolateDbInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_postgresql_disisolate_db_instance_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
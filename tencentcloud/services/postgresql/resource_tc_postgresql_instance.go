@@ -172,10 +172,25 @@ func ResourceTencentCloudPostgresqlInstance() *schema.Resource {
 			},
 			"root_password": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
+				ExactlyOneOf: []string{"root_password", "root_password_wo"},
 				ValidateFunc: tccommon.ValidateMysqlPassword,
-				Description:  "Password of root account. This parameter can be specified when you purchase master instances, but it should be ignored when you purchase read-only instances or disaster recovery instances.",
+				Description:  "Password of root account. This parameter can be specified when you purchase master instances, but it should be ignored when you purchase read-only instances or disaster recovery instances. Use `root_password_wo` instead to keep it out of the state.",
+			},
+			"root_password_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ValidateFunc: tccommon.ValidateMysqlPassword,
+				Description:  "Write-only password of root account, which is never stored in the plan or state. Requires Terraform 1.11 or later. Bump `root_password_wo_version` to rotate it.",
+			},
+			"root_password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"root_password_wo"},
+				Description:  "Version of `root_password_wo`. Changing it updates the root password to the current value of `root_password_wo`.",
 			},
 			"charset": {
 				Type:         schema.TypeString,
@@ -373,11 +388,15 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 		storage        = d.Get("storage").(int)
 		memory         = d.Get("memory").(int) // Memory only used for query specCode which contains memory info
 		username       = d.Get("root_user").(string)
-		password       = d.Get("root_password").(string)
 		charset        = d.Get("charset").(string)
 		nodeSet        = d.Get("db_node_set").(*schema.Set).List()
 	)
 
+	password, err := tccommon.GetSecretWithWriteOnly(d, "root_password")
+	if err != nil {
+		return err
+	}
+
 	// the sdk asks to set value with 1 when paytype is postpaid
 
 	var instanceId, majorVersion, specVersion, specCode string
@@ -585,7 +604,7 @@ func resourceTencentCloudPostgresqlInstanceCreate(d *schema.ResourceData, meta i
 	//internal version: replace setTag end, please do not modify this annotation and refrain from inserting any code between the beginning and end lines of the annotation.
 
 	// check creation done
-	err := resource.Retry(20*tccommon.ReadRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(20*tccommon.ReadRetryTimeout, func() *resource.RetryError {
 		instance, has, err := postgresqlService.DescribePostgresqlInstanceById(ctx, instanceId)
 		if err != nil {
 			return tccommon.RetryError(err)
@@ -1425,10 +1444,14 @@ func resourceTencentCloudPostgresqlInstanceUpdate(d *schema.ResourceData, meta i
 	}
 
 	// update root password
-	if d.HasChange("root_password") {
+	if tccommon.HasSecretChange(d, "root_password") {
+		password, err := tccommon.GetSecretWithWriteOnly(d, "root_password")
+		if err != nil {
+			return err
+		}
 		// to avoid other updating process conflicts with updating password, set the password updating with the last step, there is no way to figure out whether changing password is done
 		outErr = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-			inErr = postgresqlService.SetPostgresqlInstanceRootPassword(ctx, instanceId, d.Get("root_user").(string), d.Get("root_password").(string))
+			inErr = postgresqlService.SetPostgresqlInstanceRootPassword(ctx, instanceId, d.Get("root_user").(string), password)
 			if inErr != nil {
 				return tccommon.RetryError(inErr)
 			}
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudPostgresqlInstanceNetworkAccess() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlInstanceNetworkAccessCreate,
-		Read:   resourceTencentCloudPostgresqlInstanceNetworkAccessRead,
-		Delete: resourceTencentCloudPostgresqlInstanceNetworkAccessDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudPostgresqlInstanceNetworkAccessCreate,
+		Read:     resourceTencentCloudPostgresqlInstanceNetworkAccessRead,
+		Delete:   resourceTencentCloudPostgresqlInstanceNetworkAccessDelete,
+		Importer: helper.IdSpec{"db_instance_id", "vpc_id", "subnet_id", "vip"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
 				Type:        schema.TypeString,
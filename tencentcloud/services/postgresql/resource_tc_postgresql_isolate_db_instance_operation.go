@@ -16,6 +16,7 @@ func ResourceTencentCloudPostgresqlIsolateDbInstanceOperation() *schema.Resource
 	return &schema.Resource{
 		Create: resourceTencentCloudPostgresqlIsolateDbInstanceOperationCreate,
 		Read:   resourceTencentCloudPostgresqlIsolateDbInstanceOperationRead,
+		Update: resourceTencentCloudPostgresqlIsolateDbInstanceOperationUpdate,
 		Delete: resourceTencentCloudPostgresqlIsolateDbInstanceOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"db_instance_id_set": {
@@ -27,6 +28,10 @@ func ResourceTencentCloudPostgresqlIsolateDbInstanceOperation() *schema.Resource
 				},
 				Description: "List of resource IDs. Note that currently you cannot isolate multiple instances at the same time. Only one instance ID can be passed in here.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -72,10 +77,12 @@ func resourceTencentCloudPostgresqlIsolateDbInstanceOperationCreate(d *schema.Re
 
 	service := PostgresqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"isolated"}, 10*tccommon.ReadRetryTimeout, 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(firstInstanceId, []string{}))
+	if tccommon.OperationWaitForCompletion(d) {
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"isolated"}, 10*tccommon.ReadRetryTimeout, 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(firstInstanceId, []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudPostgresqlIsolateDbInstanceOperationRead(d, meta)
@@ -88,6 +95,14 @@ func resourceTencentCloudPostgresqlIsolateDbInstanceOperationRead(d *schema.Reso
 	return nil
 }
 
+func resourceTencentCloudPostgresqlIsolateDbInstanceOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_postgresql_isolate_db_instance_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudPostgresqlIsolateDbInstanceOperationRead(d, meta)
+}
+
 func resourceTencentCloudPostgresqlIsolateDbInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_postgresql_isolate_db_instance_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
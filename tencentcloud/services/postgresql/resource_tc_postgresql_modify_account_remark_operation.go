@@ -38,6 +38,8 @@ func ResourceTencentCloudPostgresqlModifyAccountRemarkOperation() *schema.Resour
 				Type:        schema.TypeString,
 				Description: "New remarks corresponding to user `UserName`.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -34,6 +34,8 @@ func ResourceTencentCloudPostgresqlModifySwitchTimePeriodOperation() *schema.Res
 				Type:        schema.TypeInt,
 				Description: "Valid value: `0` (switch immediately).",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
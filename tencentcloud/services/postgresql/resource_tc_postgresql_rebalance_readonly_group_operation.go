@@ -24,6 +24,8 @@ func ResourceTencentCloudPostgresqlRebalanceReadonlyGroupOperation() *schema.Res
 				Type:        schema.TypeString,
 				Description: "readonly Group ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
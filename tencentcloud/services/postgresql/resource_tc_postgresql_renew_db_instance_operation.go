@@ -17,6 +17,7 @@ func ResourceTencentCloudPostgresqlRenewDbInstanceOperation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudPostgresqlRenewDbInstanceOperationCreate,
 		Read:   resourceTencentCloudPostgresqlRenewDbInstanceOperationRead,
+		Update: resourceTencentCloudPostgresqlRenewDbInstanceOperationUpdate,
 		Delete: resourceTencentCloudPostgresqlRenewDbInstanceOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
@@ -49,6 +50,10 @@ func ResourceTencentCloudPostgresqlRenewDbInstanceOperation() *schema.Resource {
 				},
 				Description: "Voucher ID list (only one voucher can be specified currently).",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -104,10 +109,12 @@ func resourceTencentCloudPostgresqlRenewDbInstanceOperationCreate(d *schema.Reso
 
 	service := PostgresqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running"}, 10*tccommon.ReadRetryTimeout, 5*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(d.Id(), []string{}))
+	if tccommon.OperationWaitForCompletion(d) {
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"running"}, 10*tccommon.ReadRetryTimeout, 5*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudPostgresqlRenewDbInstanceOperationRead(d, meta)
@@ -120,6 +127,14 @@ func resourceTencentCloudPostgresqlRenewDbInstanceOperationRead(d *schema.Resour
 	return nil
 }
 
+func resourceTencentCloudPostgresqlRenewDbInstanceOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_postgresql_renew_db_instance_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudPostgresqlRenewDbInstanceOperationRead(d, meta)
+}
+
 func resourceTencentCloudPostgresqlRenewDbInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_postgresql_renew_db_instance_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
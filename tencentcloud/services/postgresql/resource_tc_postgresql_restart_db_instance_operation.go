@@ -17,6 +17,7 @@ func ResourceTencentCloudPostgresqlRestartDbInstanceOperation() *schema.Resource
 	return &schema.Resource{
 		Create: resourceTencentCloudPostgresqlRestartDbInstanceOperationCreate,
 		Read:   resourceTencentCloudPostgresqlRestartDbInstanceOperationRead,
+		Update: resourceTencentCloudPostgresqlRestartDbInstanceOperationUpdate,
 		Delete: resourceTencentCloudPostgresqlRestartDbInstanceOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"db_instance_id": {
@@ -25,6 +26,10 @@ func ResourceTencentCloudPostgresqlRestartDbInstanceOperation() *schema.Resource
 				Type:        schema.TypeString,
 				Description: "dbInstance ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -62,10 +67,12 @@ func resourceTencentCloudPostgresqlRestartDbInstanceOperationCreate(d *schema.Re
 
 	service := PostgresqlService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
 
-	conf := tccommon.BuildStateChangeConf([]string{}, []string{"running"}, 2*tccommon.ReadRetryTimeout, 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(d.Id(), []string{}))
+	if tccommon.OperationWaitForCompletion(d) {
+		conf := tccommon.BuildStateChangeConf([]string{}, []string{"running"}, 2*tccommon.ReadRetryTimeout, 10*time.Second, service.PostgresqlDbInstanceOperationStateRefreshFunc(d.Id(), []string{}))
 
-	if _, e := conf.WaitForState(); e != nil {
-		return e
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
 	}
 
 	return resourceTencentCloudPostgresqlRestartDbInstanceOperationRead(d, meta)
@@ -78,6 +85,14 @@ func resourceTencentCloudPostgresqlRestartDbInstanceOperationRead(d *schema.Reso
 	return nil
 }
 
+func resourceTencentCloudPostgresqlRestartDbInstanceOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_postgresql_restart_db_instance_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudPostgresqlRestartDbInstanceOperationRead(d, meta)
+}
+
 func resourceTencentCloudPostgresqlRestartDbInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_postgresql_restart_db_instance_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudPostgresqlSecurityGroupConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPostgresqlSecurityGroupConfigCreate,
-		Read:   resourceTencentCloudPostgresqlSecurityGroupConfigRead,
-		Update: resourceTencentCloudPostgresqlSecurityGroupConfigUpdate,
-		Delete: resourceTencentCloudPostgresqlSecurityGroupConfigDelete,
+		Create:   resourceTencentCloudPostgresqlSecurityGroupConfigCreate,
+		Read:     resourceTencentCloudPostgresqlSecurityGroupConfigRead,
+		Update:   resourceTencentCloudPostgresqlSecurityGroupConfigUpdate,
+		Delete:   resourceTencentCloudPostgresqlSecurityGroupConfigDelete,
+		Importer: helper.IdSpec{"db_instance_id", "read_only_group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"security_group_id_set": {
 				Required: true,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudPrivateDnsRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudDPrivateDnsRecordCreate,
-		Read:   resourceTencentCloudDPrivateDnsRecordRead,
-		Update: resourceTencentCloudDPrivateDnsRecordUpdate,
-		Delete: resourceTencentCloudDPrivateDnsRecordDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudDPrivateDnsRecordCreate,
+		Read:     resourceTencentCloudDPrivateDnsRecordRead,
+		Update:   resourceTencentCloudDPrivateDnsRecordUpdate,
+		Delete:   resourceTencentCloudDPrivateDnsRecordDelete,
+		Importer: helper.IdSpec{"zone_id", "record_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudPrivateDnsZoneVpcAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudPrivateDnsZoneVpcAttachmentCreate,
-		Read:   resourceTencentCloudPrivateDnsZoneVpcAttachmentRead,
-		Delete: resourceTencentCloudPrivateDnsZoneVpcAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudPrivateDnsZoneVpcAttachmentCreate,
+		Read:     resourceTencentCloudPrivateDnsZoneVpcAttachmentRead,
+		Delete:   resourceTencentCloudPrivateDnsZoneVpcAttachmentDelete,
+		Importer: helper.IdSpec{"zone_id", "uniq_vpc_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Required:    true,
@@ -23,6 +23,8 @@ func ResourceTencentCloudSubscribePrivateZoneService() *schema.Resource {
 				Computed:    true,
 				Description: "Private domain resolution service activation status.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudPtsAlertChannel() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudPtsAlertChannelRead,
-		Create: resourceTencentCloudPtsAlertChannelCreate,
-		Update: resourceTencentCloudPtsAlertChannelUpdate,
-		Delete: resourceTencentCloudPtsAlertChannelDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudPtsAlertChannelRead,
+		Create:   resourceTencentCloudPtsAlertChannelCreate,
+		Update:   resourceTencentCloudPtsAlertChannelUpdate,
+		Delete:   resourceTencentCloudPtsAlertChannelDelete,
+		Importer: helper.IdSpec{"project_id", "notice_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"notice_id": {
 				Type:        schema.TypeString,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudPtsCronJob() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudPtsCronJobRead,
-		Create: resourceTencentCloudPtsCronJobCreate,
-		Update: resourceTencentCloudPtsCronJobUpdate,
-		Delete: resourceTencentCloudPtsCronJobDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudPtsCronJobRead,
+		Create:   resourceTencentCloudPtsCronJobCreate,
+		Update:   resourceTencentCloudPtsCronJobUpdate,
+		Delete:   resourceTencentCloudPtsCronJobDelete,
+		Importer: helper.IdSpec{"project_id", "cron_job_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
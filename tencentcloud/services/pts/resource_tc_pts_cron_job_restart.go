@@ -32,6 +32,8 @@ func ResourceTencentCloudPtsCronJobRestart() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Cron job ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudPtsFile() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudPtsFileRead,
-		Create: resourceTencentCloudPtsFileCreate,
-		Update: resourceTencentCloudPtsFileUpdate,
-		Delete: resourceTencentCloudPtsFileDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudPtsFileRead,
+		Create:   resourceTencentCloudPtsFileCreate,
+		Update:   resourceTencentCloudPtsFileUpdate,
+		Delete:   resourceTencentCloudPtsFileDelete,
+		Importer: helper.IdSpec{"project_id", "file_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"file_id": {
 				Type:        schema.TypeString,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudPtsJob() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudPtsJobRead,
-		Create: resourceTencentCloudPtsJobCreate,
-		Update: resourceTencentCloudPtsJobUpdate,
-		Delete: resourceTencentCloudPtsJobDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudPtsJobRead,
+		Create:   resourceTencentCloudPtsJobCreate,
+		Update:   resourceTencentCloudPtsJobUpdate,
+		Delete:   resourceTencentCloudPtsJobDelete,
+		Importer: helper.IdSpec{"project_id", "scenario_id", "job_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"scenario_id": {
 				Type:        schema.TypeString,
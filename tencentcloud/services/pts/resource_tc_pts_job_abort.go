@@ -46,6 +46,8 @@ func ResourceTencentCloudPtsJobAbort() *schema.Resource {
 				Type:        schema.TypeInt,
 				Description: "The reason for aborting the job.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
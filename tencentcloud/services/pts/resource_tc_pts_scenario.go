@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudPtsScenario() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudPtsScenarioRead,
-		Create: resourceTencentCloudPtsScenarioCreate,
-		Update: resourceTencentCloudPtsScenarioUpdate,
-		Delete: resourceTencentCloudPtsScenarioDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudPtsScenarioRead,
+		Create:   resourceTencentCloudPtsScenarioCreate,
+		Update:   resourceTencentCloudPtsScenarioUpdate,
+		Delete:   resourceTencentCloudPtsScenarioDelete,
+		Importer: helper.IdSpec{"project_id", "scenario_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
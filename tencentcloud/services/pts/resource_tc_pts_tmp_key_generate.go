@@ -56,17 +56,21 @@ func ResourceTencentCloudPtsTmpKeyGenerate() *schema.Resource {
 						},
 						"tmp_secret_key": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "Temporary secret key.",
 						},
 						"token": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "Temporary token.",
 						},
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -216,7 +216,7 @@ func dataSourceTencentCloudRumProjectRead(d *schema.ResourceData, meta interface
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), projectSetList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), projectSetList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
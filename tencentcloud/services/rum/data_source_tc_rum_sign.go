@@ -31,6 +31,7 @@ func DataSourceTencentCloudRumSign() *schema.Resource {
 			"secret_key": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Temporary access key.",
 			},
 
@@ -43,6 +44,7 @@ func DataSourceTencentCloudRumSign() *schema.Resource {
 			"session_token": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Temporary access key token.",
 			},
 
@@ -126,7 +128,7 @@ func dataSourceTencentCloudRumSignRead(d *schema.ResourceData, meta interface{})
 	d.SetId(helper.DataResourceIdsHash([]string{token}))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), result); e != nil {
+		if e := tccommon.WriteToFile(output.(string), result, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
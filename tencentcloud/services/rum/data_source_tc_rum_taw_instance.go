@@ -293,7 +293,7 @@ func dataSourceTencentCloudRumTawInstanceRead(d *schema.ResourceData, meta inter
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), instanceSetList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), instanceSetList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
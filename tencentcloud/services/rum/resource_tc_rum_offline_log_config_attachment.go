@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudRumOfflineLogConfigAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudRumOfflineLogConfigAttachmentRead,
-		Create: resourceTencentCloudRumOfflineLogConfigAttachmentCreate,
-		Delete: resourceTencentCloudRumOfflineLogConfigAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudRumOfflineLogConfigAttachmentRead,
+		Create:   resourceTencentCloudRumOfflineLogConfigAttachmentCreate,
+		Delete:   resourceTencentCloudRumOfflineLogConfigAttachmentDelete,
+		Importer: helper.IdSpec{"project_key", "unique_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"project_key": {
 				Type:        schema.TypeString,
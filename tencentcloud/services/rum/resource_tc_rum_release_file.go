@@ -44,12 +44,10 @@ import (
 
 func ResourceTencentCloudRumReleaseFile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudRumReleaseFileCreate,
-		Read:   resourceTencentCloudRumReleaseFileRead,
-		Delete: resourceTencentCloudRumReleaseFileDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudRumReleaseFileCreate,
+		Read:     resourceTencentCloudRumReleaseFileRead,
+		Delete:   resourceTencentCloudRumReleaseFileDelete,
+		Importer: helper.IdSpec{"project_id", "release_file_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Required:    true,
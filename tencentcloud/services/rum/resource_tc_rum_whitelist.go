@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudRumWhitelist() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudRumWhitelistRead,
-		Create: resourceTencentCloudRumWhitelistCreate,
-		Update: resourceTencentCloudRumWhitelistUpdate,
-		Delete: resourceTencentCloudRumWhitelistDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudRumWhitelistRead,
+		Create:   resourceTencentCloudRumWhitelistCreate,
+		Update:   resourceTencentCloudRumWhitelistUpdate,
+		Delete:   resourceTencentCloudRumWhitelistDelete,
+		Importer: helper.IdSpec{"instance_id", "wid"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
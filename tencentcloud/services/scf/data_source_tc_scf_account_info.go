@@ -439,7 +439,7 @@ func dataSourceTencentCloudScfAccountInfoRead(d *schema.ResourceData, meta inter
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), accountInfoMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), accountInfoMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -109,7 +109,7 @@ func dataSourceTencentCloudScfAsyncEventStatusRead(d *schema.ResourceData, meta
 	d.SetId(invokeRequestId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), asyncEventStatusMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), asyncEventStatusMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
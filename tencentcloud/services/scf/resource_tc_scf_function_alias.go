@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudScfFunctionAlias() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfFunctionAliasCreate,
-		Read:   resourceTencentCloudScfFunctionAliasRead,
-		Update: resourceTencentCloudScfFunctionAliasUpdate,
-		Delete: resourceTencentCloudScfFunctionAliasDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudScfFunctionAliasCreate,
+		Read:     resourceTencentCloudScfFunctionAliasRead,
+		Update:   resourceTencentCloudScfFunctionAliasUpdate,
+		Delete:   resourceTencentCloudScfFunctionAliasDelete,
+		Importer: helper.IdSpec{"[namespace]", "function_name", "name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Required:    true,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudScfFunctionEventInvokeConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfFunctionEventInvokeConfigCreate,
-		Read:   resourceTencentCloudScfFunctionEventInvokeConfigRead,
-		Update: resourceTencentCloudScfFunctionEventInvokeConfigUpdate,
-		Delete: resourceTencentCloudScfFunctionEventInvokeConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudScfFunctionEventInvokeConfigCreate,
+		Read:     resourceTencentCloudScfFunctionEventInvokeConfigRead,
+		Update:   resourceTencentCloudScfFunctionEventInvokeConfigUpdate,
+		Delete:   resourceTencentCloudScfFunctionEventInvokeConfigDelete,
+		Importer: helper.IdSpec{"function_name", "namespace"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudScfFunctionVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfFunctionVersionCreate,
-		Read:   resourceTencentCloudScfFunctionVersionRead,
-		Delete: resourceTencentCloudScfFunctionVersionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudScfFunctionVersionCreate,
+		Read:     resourceTencentCloudScfFunctionVersionRead,
+		Delete:   resourceTencentCloudScfFunctionVersionDelete,
+		Importer: helper.IdSpec{"function_name", "[namespace]", "function_version"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
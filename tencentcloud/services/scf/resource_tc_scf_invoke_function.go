@@ -66,6 +66,8 @@ func ResourceTencentCloudScfInvokeFunction() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Traffic routing config in json format, e.g., {k:v}. Please note that both k and v must be strings. Up to 1024 bytes allowed.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -50,13 +50,11 @@ func LayerContent() map[string]*schema.Schema {
 
 func ResourceTencentCloudScfLayer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfLayerCreate,
-		Read:   resourceTencentCloudScfLayerRead,
-		Update: resourceTencentCloudScfLayerUpdate,
-		Delete: resourceTencentCloudScfLayerDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudScfLayerCreate,
+		Read:     resourceTencentCloudScfLayerRead,
+		Update:   resourceTencentCloudScfLayerUpdate,
+		Delete:   resourceTencentCloudScfLayerDelete,
+		Importer: helper.IdSpec{"layer_name", "layer_version"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"layer_name": {
@@ -18,9 +18,10 @@ import (
 
 func ResourceTencentCloudScfProvisionedConcurrencyConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfProvisionedConcurrencyConfigCreate,
-		Read:   resourceTencentCloudScfProvisionedConcurrencyConfigRead,
-		Delete: resourceTencentCloudScfProvisionedConcurrencyConfigDelete,
+		Create:   resourceTencentCloudScfProvisionedConcurrencyConfigCreate,
+		Read:     resourceTencentCloudScfProvisionedConcurrencyConfigRead,
+		Delete:   resourceTencentCloudScfProvisionedConcurrencyConfigDelete,
+		Importer: helper.IdSpec{"function_name", "qualifier", "namespace"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudScfReservedConcurrencyConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfReservedConcurrencyConfigCreate,
-		Read:   resourceTencentCloudScfReservedConcurrencyConfigRead,
-		Delete: resourceTencentCloudScfReservedConcurrencyConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudScfReservedConcurrencyConfigCreate,
+		Read:     resourceTencentCloudScfReservedConcurrencyConfigRead,
+		Delete:   resourceTencentCloudScfReservedConcurrencyConfigDelete,
+		Importer: helper.IdSpec{"namespace", "function_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
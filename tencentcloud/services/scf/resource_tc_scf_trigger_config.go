@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudScfTriggerConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudScfTriggerConfigCreate,
-		Read:   resourceTencentCloudScfTriggerConfigRead,
-		Update: resourceTencentCloudScfTriggerConfigUpdate,
-		Delete: resourceTencentCloudScfTriggerConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudScfTriggerConfigCreate,
+		Read:     resourceTencentCloudScfTriggerConfigRead,
+		Update:   resourceTencentCloudScfTriggerConfigUpdate,
+		Delete:   resourceTencentCloudScfTriggerConfigDelete,
+		Importer: helper.IdSpec{"function_name", "namespace", "trigger_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"function_name": {
 				Required:    true,
@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudSmsSign() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudSmsSignRead,
-		Create: resourceTencentCloudSmsSignCreate,
-		Update: resourceTencentCloudSmsSignUpdate,
-		Delete: resourceTencentCloudSmsSignDelete,
+		Read:     resourceTencentCloudSmsSignRead,
+		Create:   resourceTencentCloudSmsSignCreate,
+		Update:   resourceTencentCloudSmsSignUpdate,
+		Delete:   resourceTencentCloudSmsSignDelete,
+		Importer: helper.IdSpec{"sign_id", "international"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"sign_name": {
 				Type:        schema.TypeString,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudSmsTemplate() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudSmsTemplateRead,
-		Create: resourceTencentCloudSmsTemplateCreate,
-		Update: resourceTencentCloudSmsTemplateUpdate,
-		Delete: resourceTencentCloudSmsTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudSmsTemplateRead,
+		Create:   resourceTencentCloudSmsTemplateCreate,
+		Update:   resourceTencentCloudSmsTemplateUpdate,
+		Delete:   resourceTencentCloudSmsTemplateDelete,
+		Importer: helper.IdSpec{"template_id", "international"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"template_name": {
 				Type:        schema.TypeString,
@@ -118,7 +118,7 @@ func dataSourceTencentSqlserverAccountsRead(d *schema.ResourceData, meta interfa
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return tccommon.WriteToFile(output.(string), list)
+		return tccommon.WriteToFile(output.(string), list, tccommon.GetResultOutputOptions(d))
 	}
 
 	return nil
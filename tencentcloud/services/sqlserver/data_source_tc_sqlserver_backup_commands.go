@@ -128,7 +128,7 @@ func dataSourceTencentCloudSqlserverBackupCommandsRead(d *schema.ResourceData, m
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), command); e != nil {
+		if e := tccommon.WriteToFile(output.(string), command, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
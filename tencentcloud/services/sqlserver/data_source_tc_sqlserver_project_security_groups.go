@@ -263,7 +263,7 @@ func dataSourceTencentCloudSqlserverProjectSecurityGroupsRead(d *schema.Resource
 	d.SetId(projectId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -54,11 +54,13 @@ func DataSourceTencentCloudSqlserverUploadIncrementalInfo() *schema.Resource {
 			"tmp_secret_key": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Temporary key (Key).",
 			},
 			"x_cos_security_token": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Temporary key (Token).",
 			},
 			"start_time": {
@@ -155,7 +157,7 @@ func dataSourceTencentCloudSqlserverUploadIncrementalInfoRead(d *schema.Resource
 	d.SetId(instanceId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), d); e != nil {
+		if e := tccommon.WriteToFile(output.(string), d, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,13 +14,11 @@ import (
 
 func ResourceTencentCloudSqlserverAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverAccountCreate,
-		Read:   resourceTencentCloudSqlserverAccountRead,
-		Update: resourceTencentCloudSqlserverAccountUpdate,
-		Delete: resourceTencentCLoudSqlserverAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverAccountCreate,
+		Read:     resourceTencentCloudSqlserverAccountRead,
+		Update:   resourceTencentCloudSqlserverAccountUpdate,
+		Delete:   resourceTencentCLoudSqlserverAccountDelete,
+		Importer: helper.IdSpec{"instance_id", "name"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
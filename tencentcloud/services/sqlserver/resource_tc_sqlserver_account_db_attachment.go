@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,13 +14,11 @@ import (
 
 func ResourceTencentCloudSqlserverAccountDBAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverAccountDBAttachmentCreate,
-		Read:   resourceTencentCloudSqlserverAccountDBAttachmentRead,
-		Update: resourceTencentCloudSqlserverAccountDBAttachmentUpdate,
-		Delete: resourceTencentCLoudSqlserverAccountDBAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverAccountDBAttachmentCreate,
+		Read:     resourceTencentCloudSqlserverAccountDBAttachmentRead,
+		Update:   resourceTencentCloudSqlserverAccountDBAttachmentUpdate,
+		Delete:   resourceTencentCLoudSqlserverAccountDBAttachmentDelete,
+		Importer: helper.IdSpec{"instance_id", "account_name", "db_name"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudSqlserverBusinessIntelligenceFile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverBusinessIntelligenceFileCreate,
-		Read:   resourceTencentCloudSqlserverBusinessIntelligenceFileRead,
-		Delete: resourceTencentCloudSqlserverBusinessIntelligenceFileDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverBusinessIntelligenceFileCreate,
+		Read:     resourceTencentCloudSqlserverBusinessIntelligenceFileRead,
+		Delete:   resourceTencentCloudSqlserverBusinessIntelligenceFileDelete,
+		Importer: helper.IdSpec{"instance_id", "file_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
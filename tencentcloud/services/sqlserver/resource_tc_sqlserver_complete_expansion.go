@@ -17,6 +17,7 @@ func ResourceTencentCloudSqlserverCompleteExpansion() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudSqlserverCompleteExpansionCreate,
 		Read:   resourceTencentCloudSqlserverCompleteExpansionRead,
+		Update: resourceTencentCloudSqlserverCompleteExpansionUpdate,
 		Delete: resourceTencentCloudSqlserverCompleteExpansionDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -26,6 +27,10 @@ func ResourceTencentCloudSqlserverCompleteExpansion() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "ID of imported target instance.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -71,28 +76,30 @@ func resourceTencentCloudSqlserverCompleteExpansionCreate(d *schema.ResourceData
 		return err
 	}
 
-	flowRequest.FlowId = &flowId
-	err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseSqlserverClient().DescribeFlowStatus(flowRequest)
-		if e != nil {
-			return tccommon.RetryError(e)
+	if tccommon.OperationWaitForCompletion(d) {
+		flowRequest.FlowId = &flowId
+		err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseSqlserverClient().DescribeFlowStatus(flowRequest)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+
+			if *result.Response.Status == SQLSERVER_TASK_SUCCESS {
+				return nil
+			} else if *result.Response.Status == SQLSERVER_TASK_RUNNING {
+				return resource.RetryableError(fmt.Errorf("sqlserver completeExpansion status is running"))
+			} else if *result.Response.Status == int64(SQLSERVER_TASK_FAIL) {
+				return resource.NonRetryableError(fmt.Errorf("sqlserver completeExpansion status is fail"))
+			} else {
+				e = fmt.Errorf("sqlserver completeExpansion status illegal")
+				return resource.NonRetryableError(e)
+			}
+		})
+
+		if err != nil {
+			log.Printf("[CRITAL]%s create sqlserver completeExpansion failed, reason:%+v", logId, err)
+			return err
 		}
-
-		if *result.Response.Status == SQLSERVER_TASK_SUCCESS {
-			return nil
-		} else if *result.Response.Status == SQLSERVER_TASK_RUNNING {
-			return resource.RetryableError(fmt.Errorf("sqlserver completeExpansion status is running"))
-		} else if *result.Response.Status == int64(SQLSERVER_TASK_FAIL) {
-			return resource.NonRetryableError(fmt.Errorf("sqlserver completeExpansion status is fail"))
-		} else {
-			e = fmt.Errorf("sqlserver completeExpansion status illegal")
-			return resource.NonRetryableError(e)
-		}
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s create sqlserver completeExpansion failed, reason:%+v", logId, err)
-		return err
 	}
 
 	d.SetId(instanceId)
@@ -107,6 +114,14 @@ func resourceTencentCloudSqlserverCompleteExpansionRead(d *schema.ResourceData,
 	return nil
 }
 
+func resourceTencentCloudSqlserverCompleteExpansionUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_sqlserver_complete_expansion.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudSqlserverCompleteExpansionRead(d, meta)
+}
+
 func resourceTencentCloudSqlserverCompleteExpansionDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_sqlserver_complete_expansion.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudSqlserverConfigDatabaseCDC() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverConfigDatabaseCDCCreate,
-		Read:   resourceTencentCloudSqlserverConfigDatabaseCDCRead,
-		Update: resourceTencentCloudSqlserverConfigDatabaseCDCUpdate,
-		Delete: resourceTencentCloudSqlserverConfigDatabaseCDCDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverConfigDatabaseCDCCreate,
+		Read:     resourceTencentCloudSqlserverConfigDatabaseCDCRead,
+		Update:   resourceTencentCloudSqlserverConfigDatabaseCDCUpdate,
+		Delete:   resourceTencentCloudSqlserverConfigDatabaseCDCDelete,
+		Importer: helper.IdSpec{"instance_id", "db_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"db_name": {
 				Required:    true,
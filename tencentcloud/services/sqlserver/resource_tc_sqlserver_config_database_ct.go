@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudSqlserverConfigDatabaseCT() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverConfigDatabaseCTCreate,
-		Read:   resourceTencentCloudSqlserverConfigDatabaseCTRead,
-		Update: resourceTencentCloudSqlserverConfigDatabaseCTUpdate,
-		Delete: resourceTencentCloudSqlserverConfigDatabaseCTDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverConfigDatabaseCTCreate,
+		Read:     resourceTencentCloudSqlserverConfigDatabaseCTRead,
+		Update:   resourceTencentCloudSqlserverConfigDatabaseCTUpdate,
+		Delete:   resourceTencentCloudSqlserverConfigDatabaseCTDelete,
+		Importer: helper.IdSpec{"instance_id", "db_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"db_name": {
 				Required:    true,
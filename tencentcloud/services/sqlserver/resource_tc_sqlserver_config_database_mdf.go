@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,13 +16,11 @@ import (
 
 func ResourceTencentCloudSqlserverConfigDatabaseMdf() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverConfigDatabaseMdfCreate,
-		Read:   resourceTencentCloudSqlserverConfigDatabaseMdfRead,
-		Update: resourceTencentCloudSqlserverConfigDatabaseMdfUpdate,
-		Delete: resourceTencentCloudSqlserverConfigDatabaseMdfDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverConfigDatabaseMdfCreate,
+		Read:     resourceTencentCloudSqlserverConfigDatabaseMdfRead,
+		Update:   resourceTencentCloudSqlserverConfigDatabaseMdfUpdate,
+		Delete:   resourceTencentCloudSqlserverConfigDatabaseMdfDelete,
+		Importer: helper.IdSpec{"instance_id", "db_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"db_name": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudSqlserverConfigInstanceRoGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverConfigInstanceRoGroupCreate,
-		Read:   resourceTencentCloudSqlserverConfigInstanceRoGroupRead,
-		Update: resourceTencentCloudSqlserverConfigInstanceRoGroupUpdate,
-		Delete: resourceTencentCloudSqlserverConfigInstanceRoGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverConfigInstanceRoGroupCreate,
+		Read:     resourceTencentCloudSqlserverConfigInstanceRoGroupRead,
+		Update:   resourceTencentCloudSqlserverConfigInstanceRoGroupUpdate,
+		Delete:   resourceTencentCloudSqlserverConfigInstanceRoGroupDelete,
+		Importer: helper.IdSpec{"instance_id", "read_only_group_id", "auto_weight", "balance_weight"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudSqlserverDatabaseTDE() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverDatabaseTDECreate,
-		Read:   resourceTencentCloudSqlserverDatabaseTDERead,
-		Update: resourceTencentCloudSqlserverDatabaseTDEUpdate,
-		Delete: resourceTencentCloudSqlserverDatabaseTDEDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverDatabaseTDECreate,
+		Read:     resourceTencentCloudSqlserverDatabaseTDERead,
+		Update:   resourceTencentCloudSqlserverDatabaseTDEUpdate,
+		Delete:   resourceTencentCloudSqlserverDatabaseTDEDelete,
+		Importer: helper.IdSpec{"instance_id", "db_name_list"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudSqlserverFullBackupMigration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverFullBackupMigrationCreate,
-		Read:   resourceTencentCloudSqlserverFullBackupMigrationRead,
-		Update: resourceTencentCloudSqlserverFullBackupMigrationUpdate,
-		Delete: resourceTencentCloudSqlserverFullBackupMigrationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverFullBackupMigrationCreate,
+		Read:     resourceTencentCloudSqlserverFullBackupMigrationRead,
+		Update:   resourceTencentCloudSqlserverFullBackupMigrationUpdate,
+		Delete:   resourceTencentCloudSqlserverFullBackupMigrationDelete,
+		Importer: helper.IdSpec{"instance_id", "backup_migration_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
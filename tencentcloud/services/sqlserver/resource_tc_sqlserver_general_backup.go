@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudSqlserverGeneralBackup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverGeneralBackupCreate,
-		Read:   resourceTencentCloudSqlserverGeneralBackupRead,
-		Update: resourceTencentCloudSqlserverGeneralBackupUpdate,
-		Delete: resourceTencentCloudSqlserverGeneralBackupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverGeneralBackupCreate,
+		Read:     resourceTencentCloudSqlserverGeneralBackupRead,
+		Update:   resourceTencentCloudSqlserverGeneralBackupUpdate,
+		Delete:   resourceTencentCloudSqlserverGeneralBackupDelete,
+		Importer: helper.IdSpec{"instance_id", "backup_id", "flow_id", "start_time", "end_time", "file_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"strategy": {
 				Type:         schema.TypeInt,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudSqlserverGeneralClone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverGeneralCloneCreate,
-		Read:   resourceTencentCloudSqlserverGeneralCloneRead,
-		Update: resourceTencentCloudSqlserverGeneralCloneUpdate,
-		Delete: resourceTencentCloudSqlserverGeneralCloneDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverGeneralCloneCreate,
+		Read:     resourceTencentCloudSqlserverGeneralCloneRead,
+		Update:   resourceTencentCloudSqlserverGeneralCloneUpdate,
+		Delete:   resourceTencentCloudSqlserverGeneralCloneDelete,
+		Importer: helper.IdSpec{"instance_id", "old_name", "new_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
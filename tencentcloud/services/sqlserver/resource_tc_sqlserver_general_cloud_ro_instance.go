@@ -19,10 +19,11 @@ import (
 
 func ResourceTencentCloudSqlserverGeneralCloudRoInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverGeneralCloudRoInstanceCreate,
-		Read:   resourceTencentCloudSqlserverGeneralCloudRoInstanceRead,
-		Update: resourceTencentCloudSqlserverGeneralCloudRoInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverGeneralCloudRoInstanceDelete,
+		Create:   resourceTencentCloudSqlserverGeneralCloudRoInstanceCreate,
+		Read:     resourceTencentCloudSqlserverGeneralCloudRoInstanceRead,
+		Update:   resourceTencentCloudSqlserverGeneralCloudRoInstanceUpdate,
+		Delete:   resourceTencentCloudSqlserverGeneralCloudRoInstanceDelete,
+		Importer: helper.IdSpec{"instance_id", "ro_instance_id"}.Importer(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(CreateDefaultTimeout * time.Second),
 			Read:   schema.DefaultTimeout(ReadDefaultTimeout * time.Second),
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudSqlserverIncreBackupMigration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverIncreBackupMigrationCreate,
-		Read:   resourceTencentCloudSqlserverIncreBackupMigrationRead,
-		Update: resourceTencentCloudSqlserverIncreBackupMigrationUpdate,
-		Delete: resourceTencentCloudSqlserverIncreBackupMigrationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverIncreBackupMigrationCreate,
+		Read:     resourceTencentCloudSqlserverIncreBackupMigrationRead,
+		Update:   resourceTencentCloudSqlserverIncreBackupMigrationUpdate,
+		Delete:   resourceTencentCloudSqlserverIncreBackupMigrationDelete,
+		Importer: helper.IdSpec{"instance_id", "backup_migration_id", "incremental_migration_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
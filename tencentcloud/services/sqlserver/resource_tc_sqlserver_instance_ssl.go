@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudSqlserverInstanceSsl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverInstanceSslCreate,
-		Read:   resourceTencentCloudSqlserverInstanceSslRead,
-		Update: resourceTencentCloudSqlserverInstanceSslUpdate,
-		Delete: resourceTencentCloudSqlserverInstanceSslDelete,
+		Create:   resourceTencentCloudSqlserverInstanceSslCreate,
+		Read:     resourceTencentCloudSqlserverInstanceSslRead,
+		Update:   resourceTencentCloudSqlserverInstanceSslUpdate,
+		Delete:   resourceTencentCloudSqlserverInstanceSslDelete,
+		Importer: helper.IdSpec{"instance_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
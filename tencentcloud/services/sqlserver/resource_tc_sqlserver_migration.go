@@ -77,6 +77,7 @@ func ResourceTencentCloudSqlserverMigration() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Description: "Password, MigrateType=1 or MigrateType=2.",
 						},
@@ -100,6 +101,7 @@ func ResourceTencentCloudSqlserverMigration() *schema.Resource {
 						},
 						"url_password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Description: "The source backup password for offline migration, MigrateType=4 or MigrateType=5.",
 						},
@@ -126,6 +128,7 @@ func ResourceTencentCloudSqlserverMigration() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Description: "Password of the migration target instance.",
 						},
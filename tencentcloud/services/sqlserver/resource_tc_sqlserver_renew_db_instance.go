@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudSqlserverRenewDBInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverRenewDBInstanceCreate,
-		Read:   resourceTencentCloudSqlserverRenewDBInstanceRead,
-		Update: resourceTencentCloudSqlserverRenewDBInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverRenewDBInstanceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverRenewDBInstanceCreate,
+		Read:     resourceTencentCloudSqlserverRenewDBInstanceRead,
+		Update:   resourceTencentCloudSqlserverRenewDBInstanceUpdate,
+		Delete:   resourceTencentCloudSqlserverRenewDBInstanceDelete,
+		Importer: helper.IdSpec{"instance_id", "period"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudSqlserverRestoreInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverRestoreInstanceCreate,
-		Read:   resourceTencentCloudSqlserverRestoreInstanceRead,
-		Update: resourceTencentCloudSqlserverRestoreInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverRestoreInstanceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverRestoreInstanceCreate,
+		Read:     resourceTencentCloudSqlserverRestoreInstanceRead,
+		Update:   resourceTencentCloudSqlserverRestoreInstanceUpdate,
+		Delete:   resourceTencentCloudSqlserverRestoreInstanceDelete,
+		Importer: helper.IdSpec{"instance_id", "backup_id", "old_name_list", "new_name_list"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
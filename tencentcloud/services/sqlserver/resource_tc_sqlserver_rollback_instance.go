@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudSqlserverRollbackInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverRollbackInstanceCreate,
-		Read:   resourceTencentCloudSqlserverRollbackInstanceRead,
-		Update: resourceTencentCloudSqlserverRollbackInstanceUpdate,
-		Delete: resourceTencentCloudSqlserverRollbackInstanceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSqlserverRollbackInstanceCreate,
+		Read:     resourceTencentCloudSqlserverRollbackInstanceRead,
+		Update:   resourceTencentCloudSqlserverRollbackInstanceUpdate,
+		Delete:   resourceTencentCloudSqlserverRollbackInstanceDelete,
+		Importer: helper.IdSpec{"instance_id", "time", "old_name_list", "new_name_list"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
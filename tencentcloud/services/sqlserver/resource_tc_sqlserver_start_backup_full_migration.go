@@ -18,6 +18,7 @@ func ResourceTencentCloudSqlserverStartBackupFullMigration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudSqlserverStartBackupFullMigrationCreate,
 		Read:   resourceTencentCloudSqlserverStartBackupFullMigrationRead,
+		Update: resourceTencentCloudSqlserverStartBackupFullMigrationUpdate,
 		Delete: resourceTencentCloudSqlserverStartBackupFullMigrationDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -33,6 +34,10 @@ func ResourceTencentCloudSqlserverStartBackupFullMigration() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Backup import task ID, returned by the CreateBackupMigration interface.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -75,37 +80,39 @@ func resourceTencentCloudSqlserverStartBackupFullMigrationCreate(d *schema.Resou
 		return err
 	}
 
-	// wait
-	err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := service.DescribeCloneStatusByFlowId(ctx, int64(flowId))
-		if e != nil {
-			return tccommon.RetryError(e)
-		}
+	if tccommon.OperationWaitForCompletion(d) {
+		// wait
+		err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			result, e := service.DescribeCloneStatusByFlowId(ctx, int64(flowId))
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
 
-		if result == nil {
-			e = fmt.Errorf("sqlserver startBackupFullMigration instanceId %s flowId %d not exists", instanceId, flowId)
-			return resource.NonRetryableError(e)
-		}
+			if result == nil {
+				e = fmt.Errorf("sqlserver startBackupFullMigration instanceId %s flowId %d not exists", instanceId, flowId)
+				return resource.NonRetryableError(e)
+			}
 
-		if *result.Status == SQLSERVER_TASK_RUNNING {
-			return resource.RetryableError(fmt.Errorf("sqlserver startBackupFullMigration task status is running"))
-		}
+			if *result.Status == SQLSERVER_TASK_RUNNING {
+				return resource.RetryableError(fmt.Errorf("sqlserver startBackupFullMigration task status is running"))
+			}
 
-		if *result.Status == SQLSERVER_TASK_SUCCESS {
-			return nil
-		}
+			if *result.Status == SQLSERVER_TASK_SUCCESS {
+				return nil
+			}
 
-		if *result.Status == SQLSERVER_TASK_FAIL {
-			return resource.NonRetryableError(fmt.Errorf("sqlserver startBackupFullMigration task status is failed"))
-		}
+			if *result.Status == SQLSERVER_TASK_FAIL {
+				return resource.NonRetryableError(fmt.Errorf("sqlserver startBackupFullMigration task status is failed"))
+			}
 
-		e = fmt.Errorf("sqlserver startBackupFullMigration task status is %v, we won't wait for it finish", *result.Status)
-		return resource.NonRetryableError(e)
-	})
+			e = fmt.Errorf("sqlserver startBackupFullMigration task status is %v, we won't wait for it finish", *result.Status)
+			return resource.NonRetryableError(e)
+		})
 
-	if err != nil {
-		log.Printf("[CRITAL]%s sqlserver startBackupFullMigration task fail, reason:%s\n ", logId, err.Error())
-		return err
+		if err != nil {
+			log.Printf("[CRITAL]%s sqlserver startBackupFullMigration task fail, reason:%s\n ", logId, err.Error())
+			return err
+		}
 	}
 
 	d.SetId(instanceId)
@@ -120,6 +127,14 @@ func resourceTencentCloudSqlserverStartBackupFullMigrationRead(d *schema.Resourc
 	return nil
 }
 
+func resourceTencentCloudSqlserverStartBackupFullMigrationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_sqlserver_start_backup_full_migration.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudSqlserverStartBackupFullMigrationRead(d, meta)
+}
+
 func resourceTencentCloudSqlserverStartBackupFullMigrationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_sqlserver_start_backup_full_migration.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -18,6 +18,7 @@ func ResourceTencentCloudSqlserverStartBackupIncrementalMigration() *schema.Reso
 	return &schema.Resource{
 		Create: resourceTencentCloudSqlserverStartBackupIncrementalMigrationCreate,
 		Read:   resourceTencentCloudSqlserverStartBackupIncrementalMigrationRead,
+		Update: resourceTencentCloudSqlserverStartBackupIncrementalMigrationUpdate,
 		Delete: resourceTencentCloudSqlserverStartBackupIncrementalMigrationDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -39,6 +40,10 @@ func ResourceTencentCloudSqlserverStartBackupIncrementalMigration() *schema.Reso
 				Type:        schema.TypeString,
 				Description: "Incremental backup import task ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -85,37 +90,39 @@ func resourceTencentCloudSqlserverStartBackupIncrementalMigrationCreate(d *schem
 		return err
 	}
 
-	// wait
-	err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		result, e := service.DescribeCloneStatusByFlowId(ctx, int64(flowId))
-		if e != nil {
-			return tccommon.RetryError(e)
-		}
+	if tccommon.OperationWaitForCompletion(d) {
+		// wait
+		err = resource.Retry(10*tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			result, e := service.DescribeCloneStatusByFlowId(ctx, int64(flowId))
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
 
-		if result == nil {
-			e = fmt.Errorf("sqlserver startBackupIncrementalMigration instanceId %s flowId %d not exists", instanceId, flowId)
-			return resource.NonRetryableError(e)
-		}
+			if result == nil {
+				e = fmt.Errorf("sqlserver startBackupIncrementalMigration instanceId %s flowId %d not exists", instanceId, flowId)
+				return resource.NonRetryableError(e)
+			}
 
-		if *result.Status == SQLSERVER_TASK_RUNNING {
-			return resource.RetryableError(fmt.Errorf("sqlserver startBackupIncrementalMigration task status is running"))
-		}
+			if *result.Status == SQLSERVER_TASK_RUNNING {
+				return resource.RetryableError(fmt.Errorf("sqlserver startBackupIncrementalMigration task status is running"))
+			}
 
-		if *result.Status == SQLSERVER_TASK_SUCCESS {
-			return nil
-		}
+			if *result.Status == SQLSERVER_TASK_SUCCESS {
+				return nil
+			}
 
-		if *result.Status == SQLSERVER_TASK_FAIL {
-			return resource.NonRetryableError(fmt.Errorf("sqlserver startBackupIncrementalMigration task status is failed"))
-		}
+			if *result.Status == SQLSERVER_TASK_FAIL {
+				return resource.NonRetryableError(fmt.Errorf("sqlserver startBackupIncrementalMigration task status is failed"))
+			}
 
-		e = fmt.Errorf("sqlserver startBackupIncrementalMigration task status is %v, we won't wait for it finish", *result.Status)
-		return resource.NonRetryableError(e)
-	})
+			e = fmt.Errorf("sqlserver startBackupIncrementalMigration task status is %v, we won't wait for it finish", *result.Status)
+			return resource.NonRetryableError(e)
+		})
 
-	if err != nil {
-		log.Printf("[CRITAL]%s sqlserver startBackupIncrementalMigration task fail, reason:%s\n ", logId, err.Error())
-		return err
+		if err != nil {
+			log.Printf("[CRITAL]%s sqlserver startBackupIncrementalMigration task fail, reason:%s\n ", logId, err.Error())
+			return err
+		}
 	}
 
 	d.SetId(instanceId)
@@ -130,6 +137,14 @@ func resourceTencentCloudSqlserverStartBackupIncrementalMigrationRead(d *schema.
 	return nil
 }
 
+func resourceTencentCloudSqlserverStartBackupIncrementalMigrationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_sqlserver_start_backup_incremental_migration.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudSqlserverStartBackupIncrementalMigrationRead(d, meta)
+}
+
 func resourceTencentCloudSqlserverStartBackupIncrementalMigrationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_sqlserver_start_backup_incremental_migration.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
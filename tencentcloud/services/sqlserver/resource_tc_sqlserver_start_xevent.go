@@ -45,6 +45,8 @@ func ResourceTencentCloudSqlserverStartXevent() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
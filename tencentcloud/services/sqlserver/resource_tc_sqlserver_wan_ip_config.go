@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudSqlserverWanIpConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSqlserverWanIpConfigCreate,
-		Read:   resourceTencentCloudSqlserverWanIpConfigRead,
-		Update: resourceTencentCloudSqlserverWanIpConfigUpdate,
-		Delete: resourceTencentCloudSqlserverWanIpConfigDelete,
+		Create:   resourceTencentCloudSqlserverWanIpConfigCreate,
+		Read:     resourceTencentCloudSqlserverWanIpConfigRead,
+		Update:   resourceTencentCloudSqlserverWanIpConfigUpdate,
+		Delete:   resourceTencentCloudSqlserverWanIpConfigDelete,
+		Importer: helper.IdSpec{"instance_id", "[ro_group_id]"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -334,6 +334,7 @@ func DataSourceTencentCloudSslDescribeCertificate() *schema.Resource {
 									},
 									"key_password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Private key password.Note: This field may return NULL, indicating that the valid value cannot be obtained.",
 									},
@@ -911,7 +912,7 @@ func dataSourceTencentCloudSslDescribeCertificateRead(d *schema.ResourceData, me
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), sslResponseMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), sslResponseMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -252,7 +252,7 @@ func dataSourceTencentCloudSslDescribeManagersRead(d *schema.ResourceData, meta
 	d.SetId(companyId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -29,6 +29,8 @@ func ResourceTencentCloudSslCheckCertificateChainOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The certificate chain to check.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -83,6 +83,8 @@ func ResourceTencentCloudSslCheckCertificateDomainVerificationOperation() *schem
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -27,6 +27,8 @@ func ResourceTencentCloudSslCompleteCertificateOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Certificate ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -2,6 +2,7 @@ package ssl
 
 import (
 	"log"
+	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -16,6 +17,7 @@ func ResourceTencentCloudSslDeployCertificateInstanceOperation() *schema.Resourc
 	return &schema.Resource{
 		Create: resourceTencentCloudSslDeployCertificateInstanceCreate,
 		Read:   resourceTencentCloudSslDeployCertificateInstanceRead,
+		Update: resourceTencentCloudSslDeployCertificateInstanceUpdate,
 		Delete: resourceTencentCloudSslDeployCertificateInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -51,6 +53,10 @@ func ResourceTencentCloudSslDeployCertificateInstanceOperation() *schema.Resourc
 				Type:        schema.TypeInt,
 				Description: "Deployment cloud resource status: Live: -1: The domain name is not associated with a certificate.1:  Domain name https is enabled.0:  Domain name https is closed.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -104,6 +110,14 @@ func resourceTencentCloudSslDeployCertificateInstanceCreate(d *schema.ResourceDa
 	deployRecordId = *response.Response.DeployRecordId
 	d.SetId(helper.UInt64ToStr(deployRecordId))
 
+	if tccommon.OperationWaitForCompletion(d) {
+		service := SslService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		conf := tccommon.BuildStateChangeConf([]string{"running"}, []string{"success"}, 10*tccommon.ReadRetryTimeout, time.Second, service.SslDeployRecordStateRefreshFunc(d.Id(), false))
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
+	}
+
 	return resourceTencentCloudSslDeployCertificateInstanceRead(d, meta)
 }
 
@@ -114,6 +128,14 @@ func resourceTencentCloudSslDeployCertificateInstanceRead(d *schema.ResourceData
 	return nil
 }
 
+func resourceTencentCloudSslDeployCertificateInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_ssl_deploy_certificate_instance_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudSslDeployCertificateInstanceRead(d, meta)
+}
+
 func resourceTencentCloudSslDeployCertificateInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_ssl_deploy_certificate_instance_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -2,6 +2,7 @@ package ssl
 
 import (
 	"log"
+	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -16,6 +17,7 @@ func ResourceTencentCloudSslDeployCertificateRecordRetryOperation() *schema.Reso
 	return &schema.Resource{
 		Create: resourceTencentCloudSslDeployCertificateRecordRetryCreate,
 		Read:   resourceTencentCloudSslDeployCertificateRecordRetryRead,
+		Update: resourceTencentCloudSslDeployCertificateRecordRetryUpdate,
 		Delete: resourceTencentCloudSslDeployCertificateRecordRetryDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -34,6 +36,10 @@ func ResourceTencentCloudSslDeployCertificateRecordRetryOperation() *schema.Reso
 				Type:        schema.TypeInt,
 				Description: "Deployment record details ID to be retried.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -73,6 +79,14 @@ func resourceTencentCloudSslDeployCertificateRecordRetryCreate(d *schema.Resourc
 
 	d.SetId(helper.UInt64ToStr(deployRecordId))
 
+	if tccommon.OperationWaitForCompletion(d) {
+		service := SslService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		conf := tccommon.BuildStateChangeConf([]string{"running"}, []string{"success"}, 10*tccommon.ReadRetryTimeout, time.Second, service.SslDeployRecordStateRefreshFunc(d.Id(), false))
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
+	}
+
 	return resourceTencentCloudSslDeployCertificateRecordRetryRead(d, meta)
 }
 
@@ -83,6 +97,14 @@ func resourceTencentCloudSslDeployCertificateRecordRetryRead(d *schema.ResourceD
 	return nil
 }
 
+func resourceTencentCloudSslDeployCertificateRecordRetryUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_ssl_deploy_certificate_record_retry_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudSslDeployCertificateRecordRetryRead(d, meta)
+}
+
 func resourceTencentCloudSslDeployCertificateRecordRetryDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_ssl_deploy_certificate_record_retry_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
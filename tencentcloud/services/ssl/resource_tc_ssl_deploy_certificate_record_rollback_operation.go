@@ -2,6 +2,7 @@ package ssl
 
 import (
 	"log"
+	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -16,6 +17,7 @@ func ResourceTencentCloudSslDeployCertificateRecordRollbackOperation() *schema.R
 	return &schema.Resource{
 		Create: resourceTencentCloudSslDeployCertificateRecordRollbackCreate,
 		Read:   resourceTencentCloudSslDeployCertificateRecordRollbackRead,
+		Update: resourceTencentCloudSslDeployCertificateRecordRollbackUpdate,
 		Delete: resourceTencentCloudSslDeployCertificateRecordRollbackDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -27,6 +29,10 @@ func ResourceTencentCloudSslDeployCertificateRecordRollbackOperation() *schema.R
 				Type:        schema.TypeInt,
 				Description: "Deployment record ID to be rollback.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -65,6 +71,14 @@ func resourceTencentCloudSslDeployCertificateRecordRollbackCreate(d *schema.Reso
 	}
 	d.SetId(helper.Int64ToStr(deployRecordId))
 
+	if tccommon.OperationWaitForCompletion(d) {
+		service := SslService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		conf := tccommon.BuildStateChangeConf([]string{"running"}, []string{"success"}, 10*tccommon.ReadRetryTimeout, time.Second, service.SslDeployRecordStateRefreshFunc(d.Id(), false))
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
+	}
+
 	return resourceTencentCloudSslDeployCertificateRecordRollbackRead(d, meta)
 }
 
@@ -75,6 +89,14 @@ func resourceTencentCloudSslDeployCertificateRecordRollbackRead(d *schema.Resour
 	return nil
 }
 
+func resourceTencentCloudSslDeployCertificateRecordRollbackUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_ssl_deploy_certificate_record_rollback_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudSslDeployCertificateRecordRollbackRead(d, meta)
+}
+
 func resourceTencentCloudSslDeployCertificateRecordRollbackDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_ssl_deploy_certificate_record_rollback_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -35,6 +35,8 @@ func ResourceTencentCloudSslDownloadCertificateOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Certificate ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -129,6 +129,7 @@ func ResourceTencentCloudSSLFreeCertificate() *schema.Resource {
 			},
 			"certificate_private_key": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "Certificate private key.",
 			},
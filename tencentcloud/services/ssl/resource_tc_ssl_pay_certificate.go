@@ -20,13 +20,11 @@ import (
 
 func ResourceTencentCloudSSLInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSSLInstanceCreate,
-		Read:   resourceTencentCloudSSLInstanceRead,
-		Update: resourceTencentCloudSSLInstanceUpdate,
-		Delete: resourceTencentCloudSSLInstanceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSSLInstanceCreate,
+		Read:     resourceTencentCloudSSLInstanceRead,
+		Update:   resourceTencentCloudSSLInstanceUpdate,
+		Delete:   resourceTencentCloudSSLInstanceDelete,
+		Importer: helper.IdSpec{"certificate_id", "product_id", "domain_num", "time_span"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"product_id": {
@@ -229,6 +227,7 @@ func ResourceTencentCloudSSLInstance() *schema.Resource {
 						},
 						"key_password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Description: "Private key password.",
 						},
@@ -53,6 +53,7 @@ func ResourceTencentCloudSslReplaceCertificateOperation() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "KEY Password.",
 			},
 
@@ -76,6 +77,8 @@ func ResourceTencentCloudSslReplaceCertificateOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "CSR encryption parameter, when CsrEncryptAlgo is RSA, you can choose 2048, 4096, etc., and the default is 2048; when CsrEncryptAlgo is ECC, you can choose prime256v1, secp384r1, etc., and the default is prime256v1;.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
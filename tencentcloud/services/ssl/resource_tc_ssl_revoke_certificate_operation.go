@@ -35,6 +35,8 @@ func ResourceTencentCloudSslRevokeCertificateOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Reasons for revoking certificate.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -3,6 +3,7 @@ package ssl
 import (
 	"fmt"
 	"log"
+	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -17,6 +18,7 @@ func ResourceTencentCloudSslUpdateCertificateInstanceOperation() *schema.Resourc
 	return &schema.Resource{
 		Create: resourceTencentCloudSslUpdateCertificateInstanceOperationCreate,
 		Read:   resourceTencentCloudSslUpdateCertificateInstanceOperationRead,
+		Update: resourceTencentCloudSslUpdateCertificateInstanceOperationUpdate,
 		Delete: resourceTencentCloudSslUpdateCertificateInstanceOperationDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -113,6 +115,10 @@ func ResourceTencentCloudSslUpdateCertificateInstanceOperation() *schema.Resourc
 				Type:        schema.TypeInt,
 				Description: "Project ID, if you choose to upload the certificate, you can configure this parameter.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -215,6 +221,14 @@ func resourceTencentCloudSslUpdateCertificateInstanceOperationCreate(d *schema.R
 	deployRecordId = *response.Response.DeployRecordId
 	d.SetId(helper.UInt64ToStr(deployRecordId))
 
+	if tccommon.OperationWaitForCompletion(d) {
+		service := SslService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		conf := tccommon.BuildStateChangeConf([]string{"running"}, []string{"success"}, 10*tccommon.ReadRetryTimeout, time.Second, service.SslDeployRecordStateRefreshFunc(d.Id(), true))
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
+	}
+
 	return resourceTencentCloudSslUpdateCertificateInstanceOperationRead(d, meta)
 }
 
@@ -225,6 +239,14 @@ func resourceTencentCloudSslUpdateCertificateInstanceOperationRead(d *schema.Res
 	return nil
 }
 
+func resourceTencentCloudSslUpdateCertificateInstanceOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_ssl_update_certificate_instance_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudSslUpdateCertificateInstanceOperationRead(d, meta)
+}
+
 func resourceTencentCloudSslUpdateCertificateInstanceOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_ssl_update_certificate_instance_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -2,6 +2,7 @@ package ssl
 
 import (
 	"log"
+	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -16,6 +17,7 @@ func ResourceTencentCloudSslUpdateCertificateRecordRetryOperation() *schema.Reso
 	return &schema.Resource{
 		Create: resourceTencentCloudSslUpdateCertificateRecordRetryCreate,
 		Read:   resourceTencentCloudSslUpdateCertificateRecordRetryRead,
+		Update: resourceTencentCloudSslUpdateCertificateRecordRetryUpdate,
 		Delete: resourceTencentCloudSslUpdateCertificateRecordRetryDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -34,6 +36,10 @@ func ResourceTencentCloudSslUpdateCertificateRecordRetryOperation() *schema.Reso
 				Type:        schema.TypeInt,
 				Description: "Deployment record details ID to be retried.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -73,6 +79,14 @@ func resourceTencentCloudSslUpdateCertificateRecordRetryCreate(d *schema.Resourc
 
 	d.SetId(helper.IntToStr(deployRecordId))
 
+	if tccommon.OperationWaitForCompletion(d) {
+		service := SslService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		conf := tccommon.BuildStateChangeConf([]string{"running"}, []string{"success"}, 10*tccommon.ReadRetryTimeout, time.Second, service.SslDeployRecordStateRefreshFunc(d.Id(), true))
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
+	}
+
 	return resourceTencentCloudSslUpdateCertificateRecordRetryRead(d, meta)
 }
 
@@ -83,6 +97,14 @@ func resourceTencentCloudSslUpdateCertificateRecordRetryRead(d *schema.ResourceD
 	return nil
 }
 
+func resourceTencentCloudSslUpdateCertificateRecordRetryUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_ssl_update_certificate_record_retry_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudSslUpdateCertificateRecordRetryRead(d, meta)
+}
+
 func resourceTencentCloudSslUpdateCertificateRecordRetryDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_ssl_update_certificate_record_retry_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
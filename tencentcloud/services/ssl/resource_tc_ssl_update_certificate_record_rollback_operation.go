@@ -2,6 +2,7 @@ package ssl
 
 import (
 	"log"
+	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -16,6 +17,7 @@ func ResourceTencentCloudSslUpdateCertificateRecordRollbackOperation() *schema.R
 	return &schema.Resource{
 		Create: resourceTencentCloudSslUpdateCertificateRecordRollbackCreate,
 		Read:   resourceTencentCloudSslUpdateCertificateRecordRollbackRead,
+		Update: resourceTencentCloudSslUpdateCertificateRecordRollbackUpdate,
 		Delete: resourceTencentCloudSslUpdateCertificateRecordRollbackDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -27,6 +29,10 @@ func ResourceTencentCloudSslUpdateCertificateRecordRollbackOperation() *schema.R
 				Type:        schema.TypeString,
 				Description: "Deployment record ID to be rolled back.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -65,6 +71,14 @@ func resourceTencentCloudSslUpdateCertificateRecordRollbackCreate(d *schema.Reso
 	}
 	d.SetId(helper.Int64ToStr(deployRecordId))
 
+	if tccommon.OperationWaitForCompletion(d) {
+		service := SslService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		conf := tccommon.BuildStateChangeConf([]string{"running"}, []string{"success"}, 10*tccommon.ReadRetryTimeout, time.Second, service.SslDeployRecordStateRefreshFunc(d.Id(), true))
+		if _, e := conf.WaitForState(); e != nil {
+			return e
+		}
+	}
+
 	return resourceTencentCloudSslUpdateCertificateRecordRollbackRead(d, meta)
 }
 
@@ -75,6 +89,14 @@ func resourceTencentCloudSslUpdateCertificateRecordRollbackRead(d *schema.Resour
 	return nil
 }
 
+func resourceTencentCloudSslUpdateCertificateRecordRollbackUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_ssl_update_certificate_record_rollback_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudSslUpdateCertificateRecordRollbackRead(d, meta)
+}
+
 func resourceTencentCloudSslUpdateCertificateRecordRollbackDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_ssl_update_certificate_record_rollback_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -34,6 +34,8 @@ func ResourceTencentCloudSslUploadRevokeLetterOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The format of the base64-encoded certificate confirmation letter file should be jpg, jpeg, png, or pdf, and the size should be between 1kb and 1.4M.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
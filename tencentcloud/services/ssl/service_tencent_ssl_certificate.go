@@ -6,6 +6,7 @@ import (
 	"log"
 	"math"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	ssl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssl/v20191205"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
@@ -924,11 +925,11 @@ func (me *SslService) DescribeSslDescribeHostDeployRecordDetailByFilter(
 			if response.Response.SuccessTotalCount != nil {
 				successTotalCount = response.Response.SuccessTotalCount
 			}
-			if response.Response.SuccessTotalCount != nil {
+			if response.Response.FailedTotalCount != nil {
 				failedTotalCount = response.Response.FailedTotalCount
 			}
-			if response.Response.SuccessTotalCount != nil {
-				runningTotalCount = response.Response.SuccessTotalCount
+			if response.Response.RunningTotalCount != nil {
+				runningTotalCount = response.Response.RunningTotalCount
 			}
 		}
 		if len(response.Response.DeployRecordDetailList) < int(limit) {
@@ -1257,11 +1258,11 @@ func (me *SslService) DescribeSslDescribeHostUpdateRecordDetailByFilter(
 			if response.Response.SuccessTotalCount != nil {
 				successTotalCount = response.Response.SuccessTotalCount
 			}
-			if response.Response.SuccessTotalCount != nil {
+			if response.Response.FailedTotalCount != nil {
 				failedTotalCount = response.Response.FailedTotalCount
 			}
-			if response.Response.SuccessTotalCount != nil {
-				runningTotalCount = response.Response.SuccessTotalCount
+			if response.Response.RunningTotalCount != nil {
+				runningTotalCount = response.Response.RunningTotalCount
 			}
 		}
 		if len(response.Response.RecordDetailList) < int(limit) {
@@ -1273,6 +1274,34 @@ func (me *SslService) DescribeSslDescribeHostUpdateRecordDetailByFilter(
 	return
 }
 
+// SslDeployRecordStateRefreshFunc refreshes the state of a deploy record, or of an update record when update is true:
+// `running` while some instances are still being deployed, `success` once all are, and an error when any failed.
+func (me *SslService) SslDeployRecordStateRefreshFunc(deployRecordId string, update bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var (
+			ctx                   = tccommon.ContextNil
+			param                 = map[string]interface{}{"DeployRecordId": &deployRecordId}
+			failedCount, runCount *int64
+			err                   error
+		)
+		if update {
+			_, _, failedCount, runCount, err = me.DescribeSslDescribeHostUpdateRecordDetailByFilter(ctx, param)
+		} else {
+			_, _, failedCount, runCount, err = me.DescribeSslDescribeHostDeployRecordDetailByFilter(ctx, param)
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if runCount != nil && *runCount > 0 {
+			return deployRecordId, "running", nil
+		}
+		if failedCount != nil && *failedCount > 0 {
+			return nil, "", fmt.Errorf("deploy record %s failed on %d instances", deployRecordId, *failedCount)
+		}
+		return deployRecordId, "success", nil
+	}
+}
+
 func (me *SslService) DescribeSslDescribeHostVodInstanceListByFilter(ctx context.Context, param map[string]interface{}) (describeHostVodInstanceList []*ssl.VodInstanceDetail, errRet error) {
 	var (
 		logId   = tccommon.GetLogId(ctx)
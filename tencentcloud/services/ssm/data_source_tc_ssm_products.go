@@ -62,7 +62,7 @@ func dataSourceTencentCloudSsmProductsRead(d *schema.ResourceData, meta interfac
 	d.SetId(helper.StrListToStr(products))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), products); e != nil {
+		if e := tccommon.WriteToFile(output.(string), products, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -46,11 +46,13 @@ func DataSourceTencentCloudSsmSecretVersions() *schema.Resource {
 						},
 						"secret_binary": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "The base64-encoded binary secret.",
 						},
 						"secret_string": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "The string text of secret.",
 						},
@@ -152,7 +154,7 @@ func dataSourceTencentCloudSsmSecretVersionsRead(d *schema.ResourceData, meta in
 	}
 
 	if output, ok := d.GetOk("result_output_file"); ok && output.(string) != "" {
-		return tccommon.WriteToFile(output.(string), secretVersionList)
+		return tccommon.WriteToFile(output.(string), secretVersionList, tccommon.GetResultOutputOptions(d))
 	}
 
 	return nil
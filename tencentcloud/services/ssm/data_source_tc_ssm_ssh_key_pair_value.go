@@ -36,6 +36,7 @@ func DataSourceTencentCloudSsmSshKeyPairValue() *schema.Resource {
 			"private_key": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Private key plain text, encoded using base64.",
 			},
 			"project_id": {
@@ -125,7 +126,7 @@ func dataSourceTencentCloudSsmSshKeyPairValueRead(d *schema.ResourceData, meta i
 	d.SetId(sshKeyID)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), d); e != nil {
+		if e := tccommon.WriteToFile(output.(string), d, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
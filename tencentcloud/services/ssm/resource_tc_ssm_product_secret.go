@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudSsmProductSecret() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSsmProductSecretCreate,
-		Read:   resourceTencentCloudSsmProductSecretRead,
-		Update: resourceTencentCloudSsmProductSecretUpdate,
-		Delete: resourceTencentCloudSsmProductSecretDelete,
+		Create:   resourceTencentCloudSsmProductSecretCreate,
+		Read:     resourceTencentCloudSsmProductSecretRead,
+		Update:   resourceTencentCloudSsmProductSecretUpdate,
+		Delete:   resourceTencentCloudSsmProductSecretDelete,
+		Importer: helper.IdSpec{"secret_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"secret_name": {
 				Required:    true,
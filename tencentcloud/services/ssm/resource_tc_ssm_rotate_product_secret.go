@@ -17,6 +17,7 @@ func ResourceTencentCloudSsmRotateProductSecret() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudSsmRotateProductSecretCreate,
 		Read:   resourceTencentCloudSsmRotateProductSecretRead,
+		Update: resourceTencentCloudSsmRotateProductSecretUpdate,
 		Delete: resourceTencentCloudSsmRotateProductSecretDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -26,6 +27,10 @@ func ResourceTencentCloudSsmRotateProductSecret() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Secret name.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -67,27 +72,29 @@ func resourceTencentCloudSsmRotateProductSecretCreate(d *schema.ResourceData, me
 
 	d.SetId(secretName)
 
-	// wait
-	flowId = *response.Response.FlowID
-	asyncRequest.FlowID = &flowId
-	err = resource.Retry(tccommon.WriteRetryTimeout*3, func() *resource.RetryError {
-		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseSsmClient().DescribeAsyncRequestInfo(asyncRequest)
-		if e != nil {
-			return tccommon.RetryError(e)
-		} else {
-			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
-		}
-
-		if *result.Response.TaskStatus == 1 {
-			return nil
+	if tccommon.OperationWaitForCompletion(d) {
+		// wait
+		flowId = *response.Response.FlowID
+		asyncRequest.FlowID = &flowId
+		err = resource.Retry(tccommon.WriteRetryTimeout*3, func() *resource.RetryError {
+			result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseSsmClient().DescribeAsyncRequestInfo(asyncRequest)
+			if e != nil {
+				return tccommon.RetryError(e)
+			} else {
+				log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+			}
+
+			if *result.Response.TaskStatus == 1 {
+				return nil
+			}
+
+			return resource.RetryableError(fmt.Errorf("operate ssm describeAsyncRequestInfo status is %d", *result.Response.TaskStatus))
+		})
+
+		if err != nil {
+			log.Printf("[CRITAL]%s operate ssm describeAsyncRequestInfo failed, reason:%+v", logId, err)
+			return err
 		}
-
-		return resource.RetryableError(fmt.Errorf("operate ssm describeAsyncRequestInfo status is %d", *result.Response.TaskStatus))
-	})
-
-	if err != nil {
-		log.Printf("[CRITAL]%s operate ssm describeAsyncRequestInfo failed, reason:%+v", logId, err)
-		return err
 	}
 
 	return resourceTencentCloudSsmRotateProductSecretRead(d, meta)
@@ -100,6 +107,14 @@ func resourceTencentCloudSsmRotateProductSecretRead(d *schema.ResourceData, meta
 	return nil
 }
 
+func resourceTencentCloudSsmRotateProductSecretUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_ssm_rotate_product_secret.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudSsmRotateProductSecretRead(d, meta)
+}
+
 func resourceTencentCloudSsmRotateProductSecretDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_ssm_rotate_product_secret.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,13 +16,11 @@ import (
 
 func ResourceTencentCloudSsmSecretVersion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudSsmSecretVersionCreate,
-		Read:   resourceTencentCloudSsmSecretVersionRead,
-		Update: resourceTencentCloudSsmSecretVersionUpdate,
-		Delete: resourceTencentCloudSsmSecretVersionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudSsmSecretVersionCreate,
+		Read:     resourceTencentCloudSsmSecretVersionRead,
+		Update:   resourceTencentCloudSsmSecretVersionUpdate,
+		Delete:   resourceTencentCloudSsmSecretVersionDelete,
+		Importer: helper.IdSpec{"secret_name", "version_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"secret_name": {
@@ -39,14 +38,32 @@ func ResourceTencentCloudSsmSecretVersion() *schema.Resource {
 			"secret_binary": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"secret_string"},
-				Description:  "The base64-encoded binary secret. secret_binary and secret_string must be set only one, and the maximum support is 4096 bytes. When secret status is `Disabled`, this field will not update anymore.",
+				Sensitive:    true,
+				ExactlyOneOf: []string{"secret_binary", "secret_string", "secret_string_wo"},
+				Description:  "The base64-encoded binary secret. secret_binary, secret_string and secret_string_wo must be set only one, and the maximum support is 4096 bytes. When secret status is `Disabled`, this field will not update anymore.",
 			},
 			"secret_string": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"secret_binary"},
-				Description:  "The string text of secret. secret_binary and secret_string must be set only one, and the maximum support is 4096 bytes. When secret status is `Disabled`, this field will not update anymore.",
+				Sensitive:    true,
+				ExactlyOneOf: []string{"secret_binary", "secret_string", "secret_string_wo"},
+				Description:  "The string text of secret. secret_binary, secret_string and secret_string_wo must be set only one, and the maximum support is 4096 bytes. When secret status is `Disabled`, this field will not update anymore. Use `secret_string_wo` instead to keep it out of the state.",
+			},
+			"secret_string_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"secret_binary", "secret_string", "secret_string_wo"},
+				RequiredWith: []string{"secret_string_wo_version"},
+				Description:  "Write-only string text of secret, which is never stored in the plan or state. Requires Terraform 1.11 or later. Bump `secret_string_wo_version` to update it. When secret status is `Disabled`, this field will not update anymore.",
+			},
+			"secret_string_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"secret_string_wo"},
+				ValidateFunc: tccommon.ValidateIntegerMin(1),
+				Description:  "Version of `secret_string_wo`. Changing it updates the secret to the current value of `secret_string_wo`.",
 			},
 		},
 	}
@@ -70,8 +87,12 @@ func resourceTencentCloudSsmSecretVersionCreate(d *schema.ResourceData, meta int
 		param["secret_binary"] = v.(string)
 	}
 
-	if v, ok := d.GetOk("secret_string"); ok {
-		param["secret_string"] = v.(string)
+	secretString, err := tccommon.GetSecretWithWriteOnly(d, "secret_string")
+	if err != nil {
+		return err
+	}
+	if secretString != "" {
+		param["secret_string"] = secretString
 	}
 
 	outErr = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
@@ -167,7 +188,10 @@ func resourceTencentCloudSsmSecretVersionRead(d *schema.ResourceData, meta inter
 		_ = d.Set("secret_name", secretVersionInfo.secretName)
 		_ = d.Set("version_id", secretVersionInfo.versionId)
 		_ = d.Set("secret_binary", secretVersionInfo.secretBinary)
-		_ = d.Set("secret_string", secretVersionInfo.secretString)
+		// the secret written by secret_string_wo must not be read back into the state
+		if _, ok := d.GetOkExists("secret_string_wo_version"); !ok {
+			_ = d.Set("secret_string", secretVersionInfo.secretString)
+		}
 	}
 
 	return nil
@@ -214,11 +238,15 @@ func resourceTencentCloudSsmSecretVersionUpdate(d *schema.ResourceData, meta int
 			param["secret_binary"] = v.(string)
 		}
 
-		if v, ok := d.GetOk("secret_string"); ok {
-			param["secret_string"] = v.(string)
+		secretString, err := tccommon.GetSecretWithWriteOnly(d, "secret_string")
+		if err != nil {
+			return err
+		}
+		if secretString != "" {
+			param["secret_string"] = secretString
 		}
 
-		if d.HasChange("secret_binary") || d.HasChange("secret_string") {
+		if d.HasChange("secret_binary") || tccommon.HasSecretChange(d, "secret_string") {
 			err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 				e := ssmService.UpdateSecret(ctx, param)
 				if e != nil {
@@ -0,0 +1,232 @@
+package sts
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	sts "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/sts/v20180813"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+const STS_TEMPORARY_CREDENTIAL_DEFAULT_SESSION_NAME = "terraform"
+
+var (
+	_ ephemeral.EphemeralResource                   = &stsTemporaryCredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &stsTemporaryCredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &stsTemporaryCredentialEphemeralResource{}
+)
+
+func NewStsTemporaryCredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &stsTemporaryCredentialEphemeralResource{}
+}
+
+type stsTemporaryCredentialEphemeralResource struct {
+	meta tccommon.ProviderMeta
+}
+
+type stsTemporaryCredentialModel struct {
+	RoleArn         types.String `tfsdk:"role_arn"`
+	SessionName     types.String `tfsdk:"session_name"`
+	Policy          types.String `tfsdk:"policy"`
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+	ExternalId      types.String `tfsdk:"external_id"`
+	TmpSecretId     types.String `tfsdk:"tmp_secret_id"`
+	TmpSecretKey    types.String `tfsdk:"tmp_secret_key"`
+	Token           types.String `tfsdk:"token"`
+	Expiration      types.String `tfsdk:"expiration"`
+	ExpiredTime     types.Int64  `tfsdk:"expired_time"`
+}
+
+func (r *stsTemporaryCredentialEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sts_temporary_credential"
+}
+
+func (r *stsTemporaryCredentialEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this ephemeral resource to apply for temporary credentials, which are never persisted in the plan or state. With `role_arn` the credentials of the role are applied by AssumeRole, otherwise the credentials of a federated user are applied by GetFederationToken.",
+		Attributes: map[string]schema.Attribute{
+			"role_arn": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ARN of the role to assume. If it is not set, the credentials of a federated user named `session_name` are applied instead.",
+			},
+			"session_name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The session name when assuming `role_arn`, or the name of the federated user. Default is `" + STS_TEMPORARY_CREDENTIAL_DEFAULT_SESSION_NAME + "`.",
+			},
+			"policy": schema.StringAttribute{
+				Optional:    true,
+				Description: "A more restrictive policy for the temporary credentials. It is required by GetFederationToken to grant any permission. Notice: more syntax references, please refer to: [policies syntax logic](https://intl.cloud.tencent.com/document/product/598/10603).",
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The validity period of the temporary credentials in seconds. It ranges from 0 to 43200 with `role_arn`, and default is 7200; otherwise it ranges from 0 to 7200, and default is 1800.",
+			},
+			"external_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "External role ID, which can be obtained by clicking the role name in the CAM console. Only valid with `role_arn`.",
+			},
+			"tmp_secret_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Temporary secret ID.",
+			},
+			"tmp_secret_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Temporary secret key.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Temporary token.",
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiration time of the temporary credentials in ISO 8601 format (UTC).",
+			},
+			"expired_time": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Expiration time of the temporary credentials as a Unix timestamp.",
+			},
+		},
+	}
+}
+
+func (r *stsTemporaryCredentialEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// the provider is not configured yet during validation
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(tccommon.ProviderMeta)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected tccommon.ProviderMeta, got %T", req.ProviderData))
+		return
+	}
+	r.meta = meta
+}
+
+func (r *stsTemporaryCredentialEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var config stsTemporaryCredentialModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ExternalId.IsNull() && !config.ExternalId.IsUnknown() && config.RoleArn.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("external_id"), "Invalid external_id", "`external_id` is only valid with `role_arn`.")
+	}
+
+	maxDuration := int64(43200)
+	if config.RoleArn.IsNull() {
+		maxDuration = 7200
+	}
+	if duration := config.DurationSeconds.ValueInt64(); duration < 0 || duration > maxDuration {
+		resp.Diagnostics.AddAttributeError(path.Root("duration_seconds"), "Invalid duration_seconds",
+			fmt.Sprintf("`duration_seconds` must be in range [0, %d], got %d.", maxDuration, duration))
+	}
+}
+
+func (r *stsTemporaryCredentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	defer tccommon.LogElapsed("ephemeral.tencentcloud_sts_temporary_credential.open")()
+
+	var data stsTemporaryCredentialModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.meta == nil {
+		resp.Diagnostics.AddError("Provider not configured", "the provider must be configured before opening tencentcloud_sts_temporary_credential")
+		return
+	}
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+	ctx = context.WithValue(ctx, tccommon.LogIdKey, logId)
+	service := StsService{client: r.meta.GetAPIV3Conn()}
+
+	sessionName := STS_TEMPORARY_CREDENTIAL_DEFAULT_SESSION_NAME
+	if v := data.SessionName.ValueString(); v != "" {
+		sessionName = v
+	}
+
+	var (
+		credentials *sts.Credentials
+		expiration  *string
+		expiredTime int64
+	)
+	if roleArn := data.RoleArn.ValueString(); roleArn != "" {
+		request := sts.NewAssumeRoleRequest()
+		request.RoleArn = helper.String(roleArn)
+		request.RoleSessionName = helper.String(sessionName)
+		if v := data.Policy.ValueString(); v != "" {
+			request.Policy = helper.String(url.QueryEscape(v))
+		}
+		if !data.DurationSeconds.IsNull() {
+			request.DurationSeconds = helper.Int64Uint64(data.DurationSeconds.ValueInt64())
+		}
+		if v := data.ExternalId.ValueString(); v != "" {
+			request.ExternalId = helper.String(v)
+		}
+
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			result, e := service.AssumeRole(ctx, request)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			credentials, expiration = result.Credentials, result.Expiration
+			if result.ExpiredTime != nil {
+				expiredTime = *result.ExpiredTime
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s assume role %s failed, reason:%+v", logId, roleArn, err)
+			resp.Diagnostics.AddError("AssumeRole failed", err.Error())
+			return
+		}
+	} else {
+		request := sts.NewGetFederationTokenRequest()
+		request.Name = helper.String(sessionName)
+		if v := data.Policy.ValueString(); v != "" {
+			request.Policy = helper.String(url.QueryEscape(v))
+		}
+		if !data.DurationSeconds.IsNull() {
+			request.DurationSeconds = helper.Int64Uint64(data.DurationSeconds.ValueInt64())
+		}
+
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			result, e := service.GetFederationToken(ctx, request)
+			if e != nil {
+				return tccommon.RetryError(e)
+			}
+			credentials, expiration = result.Credentials, result.Expiration
+			if result.ExpiredTime != nil {
+				expiredTime = int64(*result.ExpiredTime)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("[CRITAL]%s get federation token of %s failed, reason:%+v", logId, sessionName, err)
+			resp.Diagnostics.AddError("GetFederationToken failed", err.Error())
+			return
+		}
+	}
+
+	data.SessionName = types.StringValue(sessionName)
+	data.TmpSecretId = types.StringPointerValue(credentials.TmpSecretId)
+	data.TmpSecretKey = types.StringPointerValue(credentials.TmpSecretKey)
+	data.Token = types.StringPointerValue(credentials.Token)
+	data.Expiration = types.StringPointerValue(expiration)
+	data.ExpiredTime = types.Int64Value(expiredTime)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
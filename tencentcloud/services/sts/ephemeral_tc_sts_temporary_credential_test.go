@@ -0,0 +1,42 @@
+package sts_test
+
+import (
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// ephemeral resources require Terraform 1.10 and later
+// go test -i; go test -test.run TestAccTencentCloudStsTemporaryCredentialEphemeralResource -v
+func TestAccTencentCloudStsTemporaryCredentialEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { tcacctest.AccPreCheck(t) },
+		ProtoV5ProviderFactories: tcacctest.AccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemeralStsTemporaryCredential,
+			},
+		},
+	})
+}
+
+const testAccEphemeralStsTemporaryCredential = `
+
+ephemeral "tencentcloud_sts_temporary_credential" "federation" {
+  session_name     = "tf-acc-test"
+  duration_seconds = 900
+  policy = jsonencode({
+    version = "2.0"
+    statement = [{
+      effect   = "allow"
+      action   = ["cvm:DescribeInstances"]
+      resource = ["*"]
+    }]
+  })
+}
+
+`
@@ -2,6 +2,7 @@ package sts
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
@@ -42,3 +43,57 @@ func (me *StsService) DescribeStsCallerIdentityByFilter(ctx context.Context) (ca
 	callerIdentity = response.Response
 	return
 }
+
+// AssumeRole applies for the temporary credentials of a role, the response body is not logged as it carries the credentials
+func (me *StsService) AssumeRole(ctx context.Context, request *sts.AssumeRoleRequest) (result *sts.AssumeRoleResponseParams, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseStsClient().AssumeRole(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s]\n", logId, request.GetAction(), request.ToJsonString())
+
+	if response == nil || response.Response == nil || response.Response.Credentials == nil {
+		errRet = fmt.Errorf("api[%s] returned no credentials", request.GetAction())
+		return
+	}
+	result = response.Response
+	return
+}
+
+// GetFederationToken applies for the temporary credentials of a federated user, the response body is not logged as it carries the credentials
+func (me *StsService) GetFederationToken(ctx context.Context, request *sts.GetFederationTokenRequest) (result *sts.GetFederationTokenResponseParams, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
+				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseStsClient().GetFederationToken(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s]\n", logId, request.GetAction(), request.ToJsonString())
+
+	if response == nil || response.Response == nil || response.Response.Credentials == nil {
+		errRet = fmt.Errorf("api[%s] returned no credentials", request.GetAction())
+		return
+	}
+	result = response.Response
+	return
+}
@@ -18,12 +18,10 @@ import (
 
 func ResourceTencentCloudTag() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTagResourceCreate,
-		Read:   resourceTencentCloudTagResourceRead,
-		Delete: resourceTencentCloudTagResourceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTagResourceCreate,
+		Read:     resourceTencentCloudTagResourceRead,
+		Delete:   resourceTencentCloudTagResourceDelete,
+		Importer: helper.IdSpec{"tag_key", "tag_value"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"tag_key": {
 				Required:    true,
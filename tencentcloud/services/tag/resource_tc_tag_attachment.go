@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudTagAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTagAttachmentCreate,
-		Read:   resourceTencentCloudTagAttachmentRead,
-		Delete: resourceTencentCloudTagAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTagAttachmentCreate,
+		Read:     resourceTencentCloudTagAttachmentRead,
+		Delete:   resourceTencentCloudTagAttachmentDelete,
+		Importer: helper.IdSpec{"tag_key", "tag_value", "resource"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"tag_key": {
 				Required:    true,
@@ -283,7 +283,7 @@ func dataSourceTencentCloudTatCommandRead(d *schema.ResourceData, meta interface
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), commandSetList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), commandSetList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
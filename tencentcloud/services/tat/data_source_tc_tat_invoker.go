@@ -227,7 +227,7 @@ func dataSourceTencentCloudTatInvokerRead(d *schema.ResourceData, meta interface
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), invokerSetList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), invokerSetList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -0,0 +1,59 @@
+package tat
+
+import "time"
+
+const (
+	TAT_AGENT_STATUS_ONLINE  = "Online"
+	TAT_AGENT_STATUS_OFFLINE = "Offline"
+
+	TAT_COMMAND_TYPE_SHELL      = "SHELL"
+	TAT_COMMAND_TYPE_POWERSHELL = "POWERSHELL"
+	TAT_COMMAND_TYPE_BAT        = "BAT"
+
+	TAT_TASK_STATUS_SUCCESS = "SUCCESS"
+
+	// states of a whole invocation, as tracked by the provider
+	TAT_INVOCATION_STATUS_RUNNING  = "RUNNING"
+	TAT_INVOCATION_STATUS_FINISHED = "FINISHED"
+
+	TAT_FAILURE_POLICY_FAIL_ON_ANY = "FAIL_ON_ANY"
+	TAT_FAILURE_POLICY_FAIL_ON_ALL = "FAIL_ON_ALL"
+	TAT_FAILURE_POLICY_IGNORE      = "IGNORE"
+)
+
+// TAT_INVOCATION_WAIT_MARGIN is added to the command timeout when waiting for an invocation, to cover its delivery
+// to the instances and the report of its results.
+const TAT_INVOCATION_WAIT_MARGIN = 5 * time.Minute
+
+var TAT_COMMAND_TYPE = []string{
+	TAT_COMMAND_TYPE_SHELL,
+	TAT_COMMAND_TYPE_POWERSHELL,
+	TAT_COMMAND_TYPE_BAT,
+}
+
+var TAT_FAILURE_POLICY = []string{
+	TAT_FAILURE_POLICY_FAIL_ON_ANY,
+	TAT_FAILURE_POLICY_FAIL_ON_ALL,
+	TAT_FAILURE_POLICY_IGNORE,
+}
+
+// Invocation task states before the command has finished
+var TAT_TASK_STATUS_PENDING = []string{
+	"PENDING",
+	"DELIVERING",
+	"DELIVER_DELAYED",
+	"RUNNING",
+	"CANCELLING",
+}
+
+// Invocation task states once the command has finished, successfully or not
+var TAT_TASK_STATUS_FINISHED = []string{
+	TAT_TASK_STATUS_SUCCESS,
+	"FAILED",
+	"TIMEOUT",
+	"TASK_TIMEOUT",
+	"DELIVER_FAILED",
+	"START_FAILED",
+	"CANCELLED",
+	"TERMINATED",
+}
@@ -0,0 +1,316 @@
+package tat
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tat "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tat/v20201028"
+
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+)
+
+func ResourceTencentCloudTatCommandExecution() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTencentCloudTatCommandExecutionCreate,
+		Read:   resourceTencentCloudTatCommandExecutionRead,
+		Delete: resourceTencentCloudTatCommandExecutionDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"command_id": {
+				Required:    true,
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Description: "Command ID.",
+			},
+
+			"instance_ids": {
+				Required:    true,
+				ForceNew:    true,
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the instances to run the command on. Supported instance types: CVM, LIGHTHOUSE.",
+			},
+
+			"parameters": {
+				Optional:    true,
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Description: "Custom parameters of the command, as a JSON encoded string such as `{\"varA\": \"222\"}`. The default parameters of the command are used for the ones not provided.",
+			},
+
+			"username": {
+				Optional:    true,
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Description: "The username used to execute the command on the instances. By default, the user root is used on Linux and the user System is used on Windows.",
+			},
+
+			"working_directory": {
+				Optional:    true,
+				ForceNew:    true,
+				Type:        schema.TypeString,
+				Description: "Command execution path. Defaults to the working directory of the command.",
+			},
+
+			"timeout": {
+				Optional:     true,
+				ForceNew:     true,
+				Type:         schema.TypeInt,
+				ValidateFunc: tccommon.ValidateIntegerInRange(1, 86400),
+				Description:  "Command timeout period, in seconds. Value range: [1, 86400]. Defaults to the timeout of the command. The apply waits for the command until this timeout plus 5 minutes when that is longer than the create timeout.",
+			},
+
+			"failure_policy": {
+				Optional:     true,
+				ForceNew:     true,
+				Type:         schema.TypeString,
+				Default:      TAT_FAILURE_POLICY_FAIL_ON_ANY,
+				ValidateFunc: tccommon.ValidateAllowedStringValue(TAT_FAILURE_POLICY),
+				Description:  "When to fail the apply on failed tasks. `FAIL_ON_ANY`: when the command fails on any instance; `FAIL_ON_ALL`: only when it fails on all instances; `IGNORE`: never, leaving the results to `tasks`. Default is `FAIL_ON_ANY`. A failed apply runs the command again on the next apply.",
+			},
+
+			"output_limit": {
+				Optional:     true,
+				ForceNew:     true,
+				Type:         schema.TypeInt,
+				Default:      4096,
+				ValidateFunc: tccommon.ValidateIntegerInRange(0, 24576),
+				Description:  "Max length in bytes of the output kept in `tasks`. Longer outputs are truncated from the start, keeping the end of the output. Default is 4096.",
+			},
+
+			"triggers": {
+				Optional:    true,
+				ForceNew:    true,
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values which run the command again when changed, such as the version of a deployment.",
+			},
+
+			"tasks": {
+				Computed:    true,
+				Type:        schema.TypeList,
+				Description: "Result of the command on each instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Instance ID.",
+						},
+						"invocation_task_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Invocation task ID.",
+						},
+						"task_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Task status, such as `SUCCESS`, `FAILED`, `TIMEOUT` and `DELIVER_FAILED`.",
+						},
+						"exit_code": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Exit code of the command.",
+						},
+						"output": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Decoded output of the command, truncated to `output_limit`.",
+						},
+						"output_truncated": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether `output` is truncated, by `output_limit` or by TAT itself.",
+						},
+						"error_info": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error message of a task which failed to run.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTencentCloudTatCommandExecutionCreate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_tat_command_execution.create")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	var (
+		logId        = tccommon.GetLogId(tccommon.ContextNil)
+		ctx          = context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+		service      = TatService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		request      = tat.NewInvokeCommandRequest()
+		response     = tat.NewInvokeCommandResponse()
+		invocationId string
+	)
+	request.CommandId = helper.String(d.Get("command_id").(string))
+	request.InstanceIds = helper.InterfacesStringsPoint(d.Get("instance_ids").(*schema.Set).List())
+
+	if v, ok := d.GetOk("parameters"); ok {
+		request.Parameters = helper.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("username"); ok {
+		request.Username = helper.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("working_directory"); ok {
+		request.WorkingDirectory = helper.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("timeout"); ok {
+		request.Timeout = helper.IntUint64(v.(int))
+	}
+
+	// a long running command is waited for until its own timeout, rather than failing the apply while it still runs
+	timeout := d.Timeout(schema.TimeoutCreate)
+	commandTimeout := request.Timeout
+	if commandTimeout == nil {
+		command, err := service.DescribeTatCommand(ctx, *request.CommandId)
+		if err != nil {
+			return err
+		}
+
+		if command != nil {
+			commandTimeout = command.Timeout
+		}
+	}
+
+	if commandTimeout != nil {
+		if v := time.Duration(*commandTimeout)*time.Second + TAT_INVOCATION_WAIT_MARGIN; v > timeout {
+			timeout = v
+		}
+	}
+
+	err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+		result, e := meta.(tccommon.ProviderMeta).GetAPIV3Conn().UseTatClient().InvokeCommand(request)
+		if e != nil {
+			return tccommon.RetryError(e)
+		} else {
+			log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), result.ToJsonString())
+		}
+		response = result
+		return nil
+	})
+	if err != nil {
+		log.Printf("[CRITAL]%s create tat command execution failed, reason:%+v", logId, err)
+		return err
+	}
+
+	invocationId = *response.Response.InvocationId
+	d.SetId(invocationId)
+
+	tasks, err := service.WaitForTatInvocation(ctx, invocationId, len(request.InstanceIds), timeout)
+	if err != nil {
+		return err
+	}
+
+	_ = d.Set("tasks", flattenTatCommandExecutionTasks(tasks, d.Get("output_limit").(int)))
+
+	if err := tatCommandExecutionFailure(tasks, d.Get("failure_policy").(string)); err != nil {
+		return fmt.Errorf("tat invocation %s failed, %s", invocationId, err.Error())
+	}
+
+	return resourceTencentCloudTatCommandExecutionRead(d, meta)
+}
+
+func resourceTencentCloudTatCommandExecutionRead(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_tat_command_execution.read")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	logId := tccommon.GetLogId(tccommon.ContextNil)
+
+	ctx := context.WithValue(context.TODO(), tccommon.LogIdKey, logId)
+
+	service := TatService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+
+	invocationId := d.Id()
+
+	tasks, err := service.DescribeTatInvocationTaskByFilter(ctx, map[string]interface{}{
+		"filters": []*tat.Filter{
+			{Name: helper.String("invocation-id"), Values: []*string{helper.String(invocationId)}},
+		},
+		"HideOutput": helper.Bool(false),
+	})
+	if err != nil {
+		return err
+	}
+
+	// the execution is kept after TAT expires its records, since removing it would run the command again
+	if len(tasks) < 1 {
+		log.Printf("[WARN]%s records of tat invocation [%s] not found, keeping the last results.\n", logId, invocationId)
+		return nil
+	}
+
+	_ = d.Set("tasks", flattenTatCommandExecutionTasks(tasks, d.Get("output_limit").(int)))
+
+	return nil
+}
+
+func resourceTencentCloudTatCommandExecutionDelete(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_tat_command_execution.delete")()
+
+	// a finished invocation can not be undone, so it is only removed from the state
+	return nil
+}
+
+func flattenTatCommandExecutionTasks(tasks []*tat.InvocationTask, outputLimit int) []interface{} {
+	result := make([]interface{}, 0, len(tasks))
+	for _, task := range tasks {
+		output := TatInvocationTaskOutput(task)
+		truncated := task.TaskResult != nil && task.TaskResult.Dropped != nil && *task.TaskResult.Dropped > 0
+		if len(output) > outputLimit {
+			output = strings.ToValidUTF8(output[len(output)-outputLimit:], "")
+			truncated = true
+		}
+
+		item := map[string]interface{}{
+			"instance_id":        helper.PString(task.InstanceId),
+			"invocation_task_id": helper.PString(task.InvocationTaskId),
+			"task_status":        helper.PString(task.TaskStatus),
+			"output":             output,
+			"output_truncated":   truncated,
+			"error_info":         helper.PString(task.ErrorInfo),
+		}
+		if task.TaskResult != nil && task.TaskResult.ExitCode != nil {
+			item["exit_code"] = int(*task.TaskResult.ExitCode)
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// tatCommandExecutionFailure reports the failed tasks when they fail the execution according to policy.
+func tatCommandExecutionFailure(tasks []*tat.InvocationTask, policy string) error {
+	var failed []string
+	for _, task := range tasks {
+		if helper.PString(task.TaskStatus) == TAT_TASK_STATUS_SUCCESS {
+			continue
+		}
+
+		failed = append(failed, fmt.Sprintf("%s: %s", helper.PString(task.InstanceId), helper.PString(task.TaskStatus)))
+	}
+
+	switch {
+	case len(failed) == 0 || policy == TAT_FAILURE_POLICY_IGNORE:
+		return nil
+	case policy == TAT_FAILURE_POLICY_FAIL_ON_ALL && len(failed) < len(tasks):
+		return nil
+	}
+
+	return fmt.Errorf("the command failed on %d of %d instances: %s", len(failed), len(tasks), strings.Join(failed, ", "))
+}
@@ -0,0 +1,100 @@
+package tat_test
+
+import (
+	"fmt"
+	"testing"
+
+	tcacctest "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// go test -i; go test -test.run TestAccTencentCloudTatCommandExecutionResource_basic -v
+func TestAccTencentCloudTatCommandExecutionResource_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { tcacctest.AccPreCheck(t) },
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTatCommandExecution(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tencentcloud_tat_command_execution.command_execution", "id"),
+					resource.TestCheckResourceAttr("tencentcloud_tat_command_execution.command_execution", "tasks.#", "1"),
+					resource.TestCheckResourceAttr("tencentcloud_tat_command_execution.command_execution", "tasks.0.instance_id", tcacctest.FixtureInstanceId(t)),
+					resource.TestCheckResourceAttr("tencentcloud_tat_command_execution.command_execution", "tasks.0.task_status", "SUCCESS"),
+					resource.TestCheckResourceAttr("tencentcloud_tat_command_execution.command_execution", "tasks.0.exit_code", "0"),
+					resource.TestCheckResourceAttr("tencentcloud_tat_command_execution.command_execution", "tasks.0.output", "ready\n"),
+					resource.TestCheckResourceAttr("tencentcloud_tat_command_execution.command_execution", "tasks.0.output_truncated", "false"),
+				),
+			},
+		},
+	})
+}
+
+// go test -i; go test -test.run TestAccTencentCloudTatCommandExecutionResource_failurePolicy -v
+func TestAccTencentCloudTatCommandExecutionResource_failurePolicy(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { tcacctest.AccPreCheck(t) },
+		Providers: tcacctest.AccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTatCommandExecutionFailureIgnored(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("tencentcloud_tat_command_execution.command_execution", "tasks.0.task_status", "FAILED"),
+					resource.TestCheckResourceAttr("tencentcloud_tat_command_execution.command_execution", "tasks.0.exit_code", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTatCommandExecutionVar(t *testing.T) string {
+	return fmt.Sprintf(`
+variable "instance_id" {
+  default = "%s"
+}
+`, tcacctest.FixtureInstanceId(t))
+}
+
+func testAccTatCommandExecution(t *testing.T) string {
+	return testAccTatCommandExecutionVar(t) + `
+
+resource "tencentcloud_tat_command" "command" {
+  command_name = "tf-test-command-execution"
+  content      = "echo ready"
+  command_type = "SHELL"
+  timeout      = 60
+}
+
+resource "tencentcloud_tat_command_execution" "command_execution" {
+  command_id   = tencentcloud_tat_command.command.id
+  instance_ids = [var.instance_id]
+
+  triggers = {
+    run = "1"
+  }
+}
+`
+}
+
+func testAccTatCommandExecutionFailureIgnored(t *testing.T) string {
+	return testAccTatCommandExecutionVar(t) + `
+
+resource "tencentcloud_tat_command" "command" {
+  command_name = "tf-test-command-execution-failure"
+  content      = "exit 3"
+  command_type = "SHELL"
+  timeout      = 60
+}
+
+resource "tencentcloud_tat_command_execution" "command_execution" {
+  command_id     = tencentcloud_tat_command.command.id
+  instance_ids   = [var.instance_id]
+  failure_policy = "IGNORE"
+}
+`
+}
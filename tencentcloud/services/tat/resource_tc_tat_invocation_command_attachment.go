@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudTatInvocationCommandAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTatInvocationCommandAttachmentCreate,
-		Read:   resourceTencentCloudTatInvocationCommandAttachmentRead,
-		Delete: resourceTencentCloudTatInvocationCommandAttachmentDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Create:   resourceTencentCloudTatInvocationCommandAttachmentCreate,
+		Read:     resourceTencentCloudTatInvocationCommandAttachmentRead,
+		Delete:   resourceTencentCloudTatInvocationCommandAttachmentDelete,
+		Importer: helper.IdSpec{"invocation_id", "instance_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"content": {
 				Required:    true,
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudTatInvocationInvokeAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTatInvocationInvokeAttachmentCreate,
-		Read:   resourceTencentCloudTatInvocationInvokeAttachmentRead,
-		Delete: resourceTencentCloudTatInvocationInvokeAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTatInvocationInvokeAttachmentCreate,
+		Read:     resourceTencentCloudTatInvocationInvokeAttachmentRead,
+		Delete:   resourceTencentCloudTatInvocationInvokeAttachmentDelete,
+		Importer: helper.IdSpec{"invocation_id", "instance_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
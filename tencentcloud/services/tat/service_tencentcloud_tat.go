@@ -2,7 +2,10 @@ package tat
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
 	"log"
+	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 
@@ -10,6 +13,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -623,3 +627,149 @@ func (me *TatService) DescribeTatInvocationTaskByFilter(ctx context.Context, par
 
 	return
 }
+
+// WaitForTatAgentOnline waits for the TAT agent of the instance to come online, such as after the instance boots.
+func (me *TatService) WaitForTatAgentOnline(ctx context.Context, instanceId string, timeout time.Duration) error {
+	_, err := waiter.NewWaiter(fmt.Sprintf("tat agent of instance %s", instanceId), []string{TAT_AGENT_STATUS_OFFLINE},
+		[]string{TAT_AGENT_STATUS_ONLINE}, nil, timeout, func(ctx context.Context) (*waiter.Task, error) {
+			agents, err := me.DescribeTatAgentByFilter(ctx, map[string]interface{}{
+				"InstanceIds": []*string{helper.String(instanceId)},
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			// the agent is not reported until it first registers
+			if len(agents) < 1 || agents[0].AgentStatus == nil {
+				return &waiter.Task{Status: TAT_AGENT_STATUS_OFFLINE}, nil
+			}
+
+			return &waiter.Task{Status: *agents[0].AgentStatus, Result: agents[0]}, nil
+		}).WaitForState(ctx)
+
+	return err
+}
+
+// RunTatCommand runs a command without saving it, returning the invocation ID.
+func (me *TatService) RunTatCommand(ctx context.Context, request *tat.RunCommandRequest) (invocationId string, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	defer func() {
+		if errRet != nil {
+			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n", logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+		}
+	}()
+
+	ratelimit.Check(request.GetAction())
+
+	response, err := me.client.UseTatClient().RunCommand(request)
+	if err != nil {
+		errRet = err
+		return
+	}
+	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+
+	if response == nil || response.Response.InvocationId == nil {
+		errRet = fmt.Errorf("invocation id is nil")
+		return
+	}
+
+	invocationId = *response.Response.InvocationId
+	return
+}
+
+// WaitForTatInvocationTask waits for the command of an invocation to finish on the instance, returning its task,
+// output included, whether it succeeded or not.
+func (me *TatService) WaitForTatInvocationTask(ctx context.Context, invocationId, instanceId string, timeout time.Duration) (*tat.InvocationTask, error) {
+	task, err := waiter.NewWaiter(fmt.Sprintf("tat invocation %s on instance %s", invocationId, instanceId), TAT_TASK_STATUS_PENDING,
+		TAT_TASK_STATUS_FINISHED, nil, timeout, func(ctx context.Context) (*waiter.Task, error) {
+			task, err := me.DescribeTatInvocationTask(ctx, invocationId, instanceId)
+			if err != nil {
+				return nil, err
+			}
+
+			if task == nil || task.TaskStatus == nil {
+				return nil, nil
+			}
+
+			return &waiter.Task{Status: *task.TaskStatus, Result: task}, nil
+		}).WaitForState(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return task.Result.(*tat.InvocationTask), nil
+}
+
+// DescribeTatInvocationTask returns the task of an invocation on the instance, output included so far, or nil
+// before it is delivered.
+func (me *TatService) DescribeTatInvocationTask(ctx context.Context, invocationId, instanceId string) (*tat.InvocationTask, error) {
+	tasks, err := me.DescribeTatInvocationTaskByFilter(ctx, map[string]interface{}{
+		"filters": []*tat.Filter{
+			{Name: helper.String("invocation-id"), Values: []*string{helper.String(invocationId)}},
+			{Name: helper.String("instance-id"), Values: []*string{helper.String(instanceId)}},
+		},
+		"HideOutput": helper.Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tasks) < 1 {
+		return nil, nil
+	}
+
+	return tasks[0], nil
+}
+
+// WaitForTatInvocation waits for the command of an invocation to finish on all of its taskCount instances,
+// returning their tasks, outputs included.
+func (me *TatService) WaitForTatInvocation(ctx context.Context, invocationId string, taskCount int, timeout time.Duration) ([]*tat.InvocationTask, error) {
+	task, err := waiter.NewWaiter(fmt.Sprintf("tat invocation %s", invocationId), []string{TAT_INVOCATION_STATUS_RUNNING},
+		[]string{TAT_INVOCATION_STATUS_FINISHED}, nil, timeout, func(ctx context.Context) (*waiter.Task, error) {
+			tasks, err := me.DescribeTatInvocationTaskByFilter(ctx, map[string]interface{}{
+				"filters": []*tat.Filter{
+					{Name: helper.String("invocation-id"), Values: []*string{helper.String(invocationId)}},
+				},
+				"HideOutput": helper.Bool(false),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			finished := 0
+			for _, task := range tasks {
+				if task.TaskStatus != nil && tccommon.IsContains(TAT_TASK_STATUS_FINISHED, *task.TaskStatus) {
+					finished++
+				}
+			}
+
+			// tasks show up once they are delivered
+			if len(tasks) < taskCount || finished < len(tasks) {
+				return &waiter.Task{Status: TAT_INVOCATION_STATUS_RUNNING, Progress: waiter.Percent(int64(finished), int64(taskCount)), Result: tasks}, nil
+			}
+
+			return &waiter.Task{Status: TAT_INVOCATION_STATUS_FINISHED, Result: tasks}, nil
+		}).WaitForState(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return task.Result.([]*tat.InvocationTask), nil
+}
+
+// TatInvocationTaskOutput decodes the output of an invocation task.
+func TatInvocationTaskOutput(task *tat.InvocationTask) string {
+	if task == nil || task.TaskResult == nil || task.TaskResult.Output == nil {
+		return ""
+	}
+
+	output, err := base64.StdEncoding.DecodeString(*task.TaskResult.Output)
+	if err != nil {
+		return *task.TaskResult.Output
+	}
+
+	return string(output)
+}
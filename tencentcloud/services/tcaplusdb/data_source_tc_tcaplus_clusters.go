@@ -61,6 +61,7 @@ func DataSourceTencentCloudTcaplusClusters() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "Access password of the TcaplusDB cluster.",
 						},
@@ -155,7 +156,7 @@ func dataSourceTencentCloudTcaplusClustersRead(d *schema.ResourceData, meta inte
 	}
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		return tccommon.WriteToFile(output.(string), list)
+		return tccommon.WriteToFile(output.(string), list, tccommon.GetResultOutputOptions(d))
 	}
 	return nil
 
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,10 +15,11 @@ import (
 
 func ResourceTencentCloudTcaplusTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcaplusTableCreate,
-		Read:   resourceTencentCloudTcaplusTableRead,
-		Update: resourceTencentCloudTcaplusTableUpdate,
-		Delete: resourceTencentCloudTcaplusTableDelete,
+		Create:   resourceTencentCloudTcaplusTableCreate,
+		Read:     resourceTencentCloudTcaplusTableRead,
+		Update:   resourceTencentCloudTcaplusTableUpdate,
+		Delete:   resourceTencentCloudTcaplusTableDelete,
+		Importer: helper.IdSpec{"cluster_id", "table_instance_id"}.ImporterWithArguments("cluster_id"),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
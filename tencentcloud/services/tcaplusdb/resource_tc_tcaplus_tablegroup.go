@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +18,10 @@ func ResourceTencentCloudTcaplusTableGroup() *schema.Resource {
 		Read:   resourceTencentCloudTcaplusTableGroupRead,
 		Update: resourceTencentCloudTcaplusTableGroupUpdate,
 		Delete: resourceTencentCloudTcaplusTableGroupDelete,
+		// the ID of a table group joins the cluster ID and the table group ID with `:`
+		Importer: helper.IdSpec{"cluster_id", "tablegroup_id"}.ImporterWithIdFunc(func(parts []string) string {
+			return fmt.Sprintf("%s:%s", parts[0], parts[1])
+		}, "cluster_id"),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
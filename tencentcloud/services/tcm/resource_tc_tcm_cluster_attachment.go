@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudTcmClusterAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTcmClusterAttachmentRead,
-		Create: resourceTencentCloudTcmClusterAttachmentCreate,
-		Delete: resourceTencentCloudTcmClusterAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTcmClusterAttachmentRead,
+		Create:   resourceTencentCloudTcmClusterAttachmentCreate,
+		Delete:   resourceTencentCloudTcmClusterAttachmentDelete,
+		Importer: helper.IdSpec{"mesh_id", "cluster_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"mesh_id": {
 				Type:        schema.TypeString,
@@ -194,6 +194,7 @@ func ResourceTencentCloudTcmMesh() *schema.Resource {
 												},
 												"password": {
 													Type:        schema.TypeString,
+													Sensitive:   true,
 													Optional:    true,
 													Computed:    true,
 													Description: "Password of the prometheus, used in basic authentication type.",
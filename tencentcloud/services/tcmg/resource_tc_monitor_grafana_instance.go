@@ -61,6 +61,7 @@ func ResourceTencentCloudMonitorGrafanaInstance() *schema.Resource {
 
 			"grafana_init_password": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Optional:    true,
 				Computed:    true,
 				Description: "Grafana server admin password.",
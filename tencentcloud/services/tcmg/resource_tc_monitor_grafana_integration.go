@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudMonitorGrafanaIntegration() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorGrafanaIntegrationRead,
-		Create: resourceTencentCloudMonitorGrafanaIntegrationCreate,
-		Update: resourceTencentCloudMonitorGrafanaIntegrationUpdate,
-		Delete: resourceTencentCloudMonitorGrafanaIntegrationDelete,
+		Read:     resourceTencentCloudMonitorGrafanaIntegrationRead,
+		Create:   resourceTencentCloudMonitorGrafanaIntegrationCreate,
+		Update:   resourceTencentCloudMonitorGrafanaIntegrationUpdate,
+		Delete:   resourceTencentCloudMonitorGrafanaIntegrationDelete,
+		Importer: helper.IdSpec{"integration_id", "instance_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudMonitorGrafanaNotificationChannel() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorGrafanaNotificationChannelRead,
-		Create: resourceTencentCloudMonitorGrafanaNotificationChannelCreate,
-		Update: resourceTencentCloudMonitorGrafanaNotificationChannelUpdate,
-		Delete: resourceTencentCloudMonitorGrafanaNotificationChannelDelete,
+		Read:     resourceTencentCloudMonitorGrafanaNotificationChannelRead,
+		Create:   resourceTencentCloudMonitorGrafanaNotificationChannelCreate,
+		Update:   resourceTencentCloudMonitorGrafanaNotificationChannelUpdate,
+		Delete:   resourceTencentCloudMonitorGrafanaNotificationChannelDelete,
+		Importer: helper.IdSpec{"channel_id", "instance_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
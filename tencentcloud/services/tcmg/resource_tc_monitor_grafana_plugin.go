@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudMonitorGrafanaPlugin() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorGrafanaPluginRead,
-		Create: resourceTencentCloudMonitorGrafanaPluginCreate,
-		Update: resourceTencentCloudMonitorGrafanaPluginUpdate,
-		Delete: resourceTencentCloudMonitorGrafanaPluginDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudMonitorGrafanaPluginRead,
+		Create:   resourceTencentCloudMonitorGrafanaPluginCreate,
+		Update:   resourceTencentCloudMonitorGrafanaPluginUpdate,
+		Delete:   resourceTencentCloudMonitorGrafanaPluginDelete,
+		Importer: helper.IdSpec{"instance_id", "plugin_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
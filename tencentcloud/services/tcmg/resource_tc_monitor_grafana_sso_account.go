@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudMonitorGrafanaSsoAccount() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorGrafanaSsoAccountRead,
-		Create: resourceTencentCloudMonitorGrafanaSsoAccountCreate,
-		Update: resourceTencentCloudMonitorGrafanaSsoAccountUpdate,
-		Delete: resourceTencentCloudMonitorGrafanaSsoAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudMonitorGrafanaSsoAccountRead,
+		Create:   resourceTencentCloudMonitorGrafanaSsoAccountCreate,
+		Update:   resourceTencentCloudMonitorGrafanaSsoAccountUpdate,
+		Delete:   resourceTencentCloudMonitorGrafanaSsoAccountDelete,
+		Importer: helper.IdSpec{"instance_id", "user_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
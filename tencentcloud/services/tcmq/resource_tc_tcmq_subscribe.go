@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTcmqSubscribe() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcmqSubscribeCreate,
-		Read:   resourceTencentCloudTcmqSubscribeRead,
-		Update: resourceTencentCloudTcmqSubscribeUpdate,
-		Delete: resourceTencentCloudTcmqSubscribeDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTcmqSubscribeCreate,
+		Read:     resourceTencentCloudTcmqSubscribeRead,
+		Update:   resourceTencentCloudTcmqSubscribeUpdate,
+		Delete:   resourceTencentCloudTcmqSubscribeDelete,
+		Importer: helper.IdSpec{"topic_name", "subscription_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"topic_name": {
 				Required:    true,
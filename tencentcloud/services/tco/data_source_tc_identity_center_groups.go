@@ -214,7 +214,7 @@ func dataSourceTencentCloudIdentityCenterGroupsRead(d *schema.ResourceData, meta
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), groupsList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), groupsList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
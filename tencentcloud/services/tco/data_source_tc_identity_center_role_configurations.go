@@ -193,7 +193,7 @@ func dataSourceTencentCloudIdentityCenterRoleConfigurationsRead(d *schema.Resour
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), roleConfigurationsList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), roleConfigurationsList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
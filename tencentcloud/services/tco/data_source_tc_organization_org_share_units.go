@@ -186,7 +186,7 @@ func dataSourceTencentCloudOrganizationOrgShareUnitsRead(d *schema.ResourceData,
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), itemsList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), itemsList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
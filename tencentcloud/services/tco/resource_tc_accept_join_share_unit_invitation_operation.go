@@ -24,6 +24,8 @@ func ResourceTencentCloudAcceptJoinShareUnitInvitationOperation() *schema.Resour
 				ForceNew:    true,
 				Description: "Shared unit ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudIdentityCenterGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudIdentityCenterGroupCreate,
-		Read:   resourceTencentCloudIdentityCenterGroupRead,
-		Update: resourceTencentCloudIdentityCenterGroupUpdate,
-		Delete: resourceTencentCloudIdentityCenterGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudIdentityCenterGroupCreate,
+		Read:     resourceTencentCloudIdentityCenterGroupRead,
+		Update:   resourceTencentCloudIdentityCenterGroupUpdate,
+		Delete:   resourceTencentCloudIdentityCenterGroupDelete,
+		Importer: helper.IdSpec{"zone_id", "group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudIdentityCenterRoleConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudIdentityCenterRoleConfigurationCreate,
-		Read:   resourceTencentCloudIdentityCenterRoleConfigurationRead,
-		Update: resourceTencentCloudIdentityCenterRoleConfigurationUpdate,
-		Delete: resourceTencentCloudIdentityCenterRoleConfigurationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudIdentityCenterRoleConfigurationCreate,
+		Read:     resourceTencentCloudIdentityCenterRoleConfigurationRead,
+		Update:   resourceTencentCloudIdentityCenterRoleConfigurationUpdate,
+		Delete:   resourceTencentCloudIdentityCenterRoleConfigurationDelete,
+		Importer: helper.IdSpec{"zone_id", "role_configuration_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
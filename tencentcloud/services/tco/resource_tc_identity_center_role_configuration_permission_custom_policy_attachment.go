@@ -16,12 +16,10 @@ import (
 
 func ResourceTencentCloudIdentityCenterRoleConfigurationPermissionCustomPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudIdentityCenterRoleConfigurationPermissionCustomPolicyAttachmentCreate,
-		Read:   resourceTencentCloudIdentityCenterRoleConfigurationPermissionCustomPolicyAttachmentRead,
-		Delete: resourceTencentCloudIdentityCenterRoleConfigurationPermissionCustomPolicyAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudIdentityCenterRoleConfigurationPermissionCustomPolicyAttachmentCreate,
+		Read:     resourceTencentCloudIdentityCenterRoleConfigurationPermissionCustomPolicyAttachmentRead,
+		Delete:   resourceTencentCloudIdentityCenterRoleConfigurationPermissionCustomPolicyAttachmentDelete,
+		Importer: helper.IdSpec{"zone_id", "role_configuration_id", "role_policy_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudIdentityCenterRoleConfigurationPermissionPolicyAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudIdentityCenterRoleConfigurationPermissionPolicyAttachmentCreate,
-		Read:   resourceTencentCloudIdentityCenterRoleConfigurationPermissionPolicyAttachmentRead,
-		Delete: resourceTencentCloudIdentityCenterRoleConfigurationPermissionPolicyAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudIdentityCenterRoleConfigurationPermissionPolicyAttachmentCreate,
+		Read:     resourceTencentCloudIdentityCenterRoleConfigurationPermissionPolicyAttachmentRead,
+		Delete:   resourceTencentCloudIdentityCenterRoleConfigurationPermissionPolicyAttachmentDelete,
+		Importer: helper.IdSpec{"zone_id", "role_configuration_id", "role_policy_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
@@ -16,12 +16,10 @@ import (
 
 func ResourceTencentCloudIdentityCenterScimCredential() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudIdentityCenterScimCredentialCreate,
-		Read:   resourceTencentCloudIdentityCenterScimCredentialRead,
-		Delete: resourceTencentCloudIdentityCenterScimCredentialDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudIdentityCenterScimCredentialCreate,
+		Read:     resourceTencentCloudIdentityCenterScimCredentialRead,
+		Delete:   resourceTencentCloudIdentityCenterScimCredentialDelete,
+		Importer: helper.IdSpec{"zone_id", "credential_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
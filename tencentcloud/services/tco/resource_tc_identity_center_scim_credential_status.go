@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudIdentityCenterScimCredentialStatus() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudIdentityCenterScimCredentialStatusCreate,
-		Read:   resourceTencentCloudIdentityCenterScimCredentialStatusRead,
-		Update: resourceTencentCloudIdentityCenterScimCredentialStatusUpdate,
-		Delete: resourceTencentCloudIdentityCenterScimCredentialStatusDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudIdentityCenterScimCredentialStatusCreate,
+		Read:     resourceTencentCloudIdentityCenterScimCredentialStatusRead,
+		Update:   resourceTencentCloudIdentityCenterScimCredentialStatusUpdate,
+		Delete:   resourceTencentCloudIdentityCenterScimCredentialStatusDelete,
+		Importer: helper.IdSpec{"zone_id", "credential_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
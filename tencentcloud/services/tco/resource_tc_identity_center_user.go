@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudIdentityCenterUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudIdentityCenterUserCreate,
-		Read:   resourceTencentCloudIdentityCenterUserRead,
-		Update: resourceTencentCloudIdentityCenterUserUpdate,
-		Delete: resourceTencentCloudIdentityCenterUserDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudIdentityCenterUserCreate,
+		Read:     resourceTencentCloudIdentityCenterUserRead,
+		Update:   resourceTencentCloudIdentityCenterUserUpdate,
+		Delete:   resourceTencentCloudIdentityCenterUserDelete,
+		Importer: helper.IdSpec{"zone_id", "user_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
@@ -16,12 +16,10 @@ import (
 
 func ResourceTencentCloudIdentityCenterUserGroupAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudIdentityCenterUserGroupAttachmentCreate,
-		Read:   resourceTencentCloudIdentityCenterUserGroupAttachmentRead,
-		Delete: resourceTencentCloudIdentityCenterUserGroupAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudIdentityCenterUserGroupAttachmentCreate,
+		Read:     resourceTencentCloudIdentityCenterUserGroupAttachmentRead,
+		Delete:   resourceTencentCloudIdentityCenterUserGroupAttachmentDelete,
+		Importer: helper.IdSpec{"zone_id", "group_id", "user_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
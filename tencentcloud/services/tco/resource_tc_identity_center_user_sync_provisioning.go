@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudIdentityCenterUserSyncProvisioning() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudIdentityCenterUserSyncProvisioningCreate,
-		Read:   resourceTencentCloudIdentityCenterUserSyncProvisioningRead,
-		Update: resourceTencentCloudIdentityCenterUserSyncProvisioningUpdate,
-		Delete: resourceTencentCloudIdentityCenterUserSyncProvisioningDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudIdentityCenterUserSyncProvisioningCreate,
+		Read:     resourceTencentCloudIdentityCenterUserSyncProvisioningRead,
+		Update:   resourceTencentCloudIdentityCenterUserSyncProvisioningUpdate,
+		Delete:   resourceTencentCloudIdentityCenterUserSyncProvisioningDelete,
+		Importer: helper.IdSpec{"zone_id", "user_provisioning_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
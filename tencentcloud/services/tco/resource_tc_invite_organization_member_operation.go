@@ -126,6 +126,8 @@ func ResourceTencentCloudInviteOrganizationMemberOperation() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -29,6 +29,8 @@ func ResourceTencentCloudOpenIdentityCenterOperation() *schema.Resource {
 				Computed:    true,
 				Description: "Space ID. z-Prefix starts with 12 random numbers/lowercase letters followed by.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudOrganizationOrgManagePolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOrganizationOrgManagePolicyCreate,
-		Read:   resourceTencentCloudOrganizationOrgManagePolicyRead,
-		Update: resourceTencentCloudOrganizationOrgManagePolicyUpdate,
-		Delete: resourceTencentCloudOrganizationOrgManagePolicyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudOrganizationOrgManagePolicyCreate,
+		Read:     resourceTencentCloudOrganizationOrgManagePolicyRead,
+		Update:   resourceTencentCloudOrganizationOrgManagePolicyUpdate,
+		Delete:   resourceTencentCloudOrganizationOrgManagePolicyDelete,
+		Importer: helper.IdSpec{"policy_id", "policy_type"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Required:    true,
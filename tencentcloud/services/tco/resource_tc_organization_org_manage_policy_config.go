@@ -16,12 +16,10 @@ import (
 
 func ResourceTencentCloudOrganizationOrgManagePolicyConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOrganizationOrgManagePolicyConfigCreate,
-		Read:   resourceTencentCloudOrganizationOrgManagePolicyConfigRead,
-		Delete: resourceTencentCloudOrganizationOrgManagePolicyConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudOrganizationOrgManagePolicyConfigCreate,
+		Read:     resourceTencentCloudOrganizationOrgManagePolicyConfigRead,
+		Delete:   resourceTencentCloudOrganizationOrgManagePolicyConfigDelete,
+		Importer: helper.IdSpec{"organization_id", "policy_type"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"organization_id": {
 				Required:    true,
@@ -16,12 +16,10 @@ import (
 
 func ResourceTencentCloudOrganizationOrgManagePolicyTarget() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOrganizationOrgManagePolicyTargetCreate,
-		Read:   resourceTencentCloudOrganizationOrgManagePolicyTargetRead,
-		Delete: resourceTencentCloudOrganizationOrgManagePolicyTargetDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudOrganizationOrgManagePolicyTargetCreate,
+		Read:     resourceTencentCloudOrganizationOrgManagePolicyTargetRead,
+		Delete:   resourceTencentCloudOrganizationOrgManagePolicyTargetDelete,
+		Importer: helper.IdSpec{"policy_type", "policy_id", "target_type", "target_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"target_id": {
 				Required:    true,
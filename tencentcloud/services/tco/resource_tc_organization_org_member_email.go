@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudOrganizationOrgMemberEmail() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOrganizationOrgMemberEmailCreate,
-		Read:   resourceTencentCloudOrganizationOrgMemberEmailRead,
-		Update: resourceTencentCloudOrganizationOrgMemberEmailUpdate,
-		Delete: resourceTencentCloudOrganizationOrgMemberEmailDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudOrganizationOrgMemberEmailCreate,
+		Read:     resourceTencentCloudOrganizationOrgMemberEmailRead,
+		Update:   resourceTencentCloudOrganizationOrgMemberEmailUpdate,
+		Delete:   resourceTencentCloudOrganizationOrgMemberEmailDelete,
+		Importer: helper.IdSpec{"member_uin", "bind_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"member_uin": {
 				Required:    true,
@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudOrganizationOrgShareUnit() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOrganizationOrgShareUnitCreate,
-		Read:   resourceTencentCloudOrganizationOrgShareUnitRead,
-		Update: resourceTencentCloudOrganizationOrgShareUnitUpdate,
-		Delete: resourceTencentCloudOrganizationOrgShareUnitDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudOrganizationOrgShareUnitCreate,
+		Read:     resourceTencentCloudOrganizationOrgShareUnitRead,
+		Update:   resourceTencentCloudOrganizationOrgShareUnitUpdate,
+		Delete:   resourceTencentCloudOrganizationOrgShareUnitDelete,
+		Importer: helper.IdSpec{"area", "unit_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Required:    true,
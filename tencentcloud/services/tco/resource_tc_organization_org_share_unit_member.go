@@ -16,12 +16,10 @@ import (
 
 func ResourceTencentCloudOrganizationOrgShareUnitMember() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOrganizationOrgShareUnitMemberCreate,
-		Read:   resourceTencentCloudOrganizationOrgShareUnitMemberRead,
-		Delete: resourceTencentCloudOrganizationOrgShareUnitMemberDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudOrganizationOrgShareUnitMemberCreate,
+		Read:     resourceTencentCloudOrganizationOrgShareUnitMemberRead,
+		Delete:   resourceTencentCloudOrganizationOrgShareUnitMemberDelete,
+		Importer: helper.IdSpec{"area", "unit_id", "share_member_uin"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"unit_id": {
 				Required:    true,
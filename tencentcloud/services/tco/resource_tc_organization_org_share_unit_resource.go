@@ -16,12 +16,10 @@ import (
 
 func ResourceTencentCloudOrganizationOrgShareUnitResource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudOrganizationOrgShareUnitResourceCreate,
-		Read:   resourceTencentCloudOrganizationOrgShareUnitResourceRead,
-		Delete: resourceTencentCloudOrganizationOrgShareUnitResourceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudOrganizationOrgShareUnitResourceCreate,
+		Read:     resourceTencentCloudOrganizationOrgShareUnitResourceRead,
+		Delete:   resourceTencentCloudOrganizationOrgShareUnitResourceDelete,
+		Importer: helper.IdSpec{"unit_id", "area", "share_resource_type", "product_resource_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"unit_id": {
 				Type:        schema.TypeString,
@@ -18,12 +18,10 @@ import (
 
 func ResourceTencentCloudOrganizationPolicySubAccountAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudOrganizationPolicySubAccountAttachmentRead,
-		Create: resourceTencentCloudOrganizationPolicySubAccountAttachmentCreate,
-		Delete: resourceTencentCloudOrganizationPolicySubAccountAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudOrganizationPolicySubAccountAttachmentRead,
+		Create:   resourceTencentCloudOrganizationPolicySubAccountAttachmentCreate,
+		Delete:   resourceTencentCloudOrganizationPolicySubAccountAttachmentDelete,
+		Importer: helper.IdSpec{"policy_id", "member_uin", "org_sub_account_uin"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"policy_id": {
 				Type:        schema.TypeInt,
@@ -27,6 +27,8 @@ func ResourceTencentCloudOrganizationQuitOrganizationOperation() *schema.Resourc
 				Type:        schema.TypeInt,
 				Description: "Organization ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -19,6 +19,7 @@ func ResourceTencentCloudProvisionRoleConfigurationOperation() *schema.Resource
 	return &schema.Resource{
 		Create: resourceTencentCloudProvisionRoleConfigurationOperationCreate,
 		Read:   resourceTencentCloudProvisionRoleConfigurationOperationRead,
+		Update: resourceTencentCloudProvisionRoleConfigurationOperationUpdate,
 		Delete: resourceTencentCloudProvisionRoleConfigurationOperationDelete,
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
@@ -48,6 +49,10 @@ func ResourceTencentCloudProvisionRoleConfigurationOperation() *schema.Resource
 				ForceNew:    true,
 				Description: "UIN of the target account of the Tencent Cloud Organization.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -103,18 +108,20 @@ func resourceTencentCloudProvisionRoleConfigurationOperationCreate(d *schema.Res
 		return err
 	}
 
-	if response.Response.Task == nil || response.Response.Task.TaskId == nil {
-		return fmt.Errorf("can not find taskId")
-	}
-	if _, err := (&resource.StateChangeConf{
-		Delay:      10 * time.Second,
-		MinTimeout: 3 * time.Second,
-		Pending:    []string{},
-		Refresh:    resourceProvisionRoleConfigurationOperationCreateStateRefreshFunc_0_0(ctx, zoneId, *response.Response.Task.TaskId),
-		Target:     []string{"Success"},
-		Timeout:    600 * time.Second,
-	}).WaitForStateContext(ctx); err != nil {
-		return err
+	if tccommon.OperationWaitForCompletion(d) {
+		if response.Response.Task == nil || response.Response.Task.TaskId == nil {
+			return fmt.Errorf("can not find taskId")
+		}
+		if _, err := (&resource.StateChangeConf{
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+			Pending:    []string{},
+			Refresh:    resourceProvisionRoleConfigurationOperationCreateStateRefreshFunc_0_0(ctx, zoneId, *response.Response.Task.TaskId),
+			Target:     []string{"Success"},
+			Timeout:    600 * time.Second,
+		}).WaitForStateContext(ctx); err != nil {
+			return err
+		}
 	}
 	d.SetId(strings.Join([]string{zoneId, roleConfigurationId}, tccommon.FILED_SP))
 
@@ -130,6 +137,14 @@ func resourceTencentCloudProvisionRoleConfigurationOperationRead(d *schema.Resou
 	return nil
 }
 
+func resourceTencentCloudProvisionRoleConfigurationOperationUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_provision_role_configuration_operation.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudProvisionRoleConfigurationOperationRead(d, meta)
+}
+
 func resourceTencentCloudProvisionRoleConfigurationOperationDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_provision_role_configuration_operation.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -24,6 +24,8 @@ func ResourceTencentCloudRejectJoinShareUnitInvitationOperation() *schema.Resour
 				ForceNew:    true,
 				Description: "Shared unit ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
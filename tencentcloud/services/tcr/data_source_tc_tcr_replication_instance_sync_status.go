@@ -185,7 +185,7 @@ func dataSourceTencentCloudTcrReplicationInstanceSyncStatusRead(d *schema.Resour
 	d.SetId(helper.DataResourceIdHash(replicationRegistryId))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), result); e != nil {
+		if e := tccommon.WriteToFile(output.(string), result, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -49,6 +49,8 @@ func ResourceTencentCloudTcrCreateImageSignatureOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "image version name.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
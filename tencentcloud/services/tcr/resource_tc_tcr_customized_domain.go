@@ -19,13 +19,11 @@ import (
 
 func ResourceTencentCloudTcrCustomizedDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrCustomizedDomainCreate,
-		Read:   resourceTencentCloudTcrCustomizedDomainRead,
-		Update: resourceTencentCloudTcrCustomizedDomainUpdate,
-		Delete: resourceTencentCloudTcrCustomizedDomainDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTcrCustomizedDomainCreate,
+		Read:     resourceTencentCloudTcrCustomizedDomainRead,
+		Update:   resourceTencentCloudTcrCustomizedDomainUpdate,
+		Delete:   resourceTencentCloudTcrCustomizedDomainDelete,
+		Importer: helper.IdSpec{"registry_id", "domain_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
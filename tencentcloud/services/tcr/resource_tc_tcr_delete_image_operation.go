@@ -46,6 +46,8 @@ func ResourceTencentCloudTcrDeleteImageOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "namespace name.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
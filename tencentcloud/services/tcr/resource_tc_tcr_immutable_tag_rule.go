@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudTcrImmutableTagRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrImmutableTagRuleCreate,
-		Read:   resourceTencentCloudTcrImmutableTagRuleRead,
-		Update: resourceTencentCloudTcrImmutableTagRuleUpdate,
-		Delete: resourceTencentCloudTcrImmutableTagRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTcrImmutableTagRuleCreate,
+		Read:     resourceTencentCloudTcrImmutableTagRuleRead,
+		Update:   resourceTencentCloudTcrImmutableTagRuleUpdate,
+		Delete:   resourceTencentCloudTcrImmutableTagRuleDelete,
+		Importer: helper.IdSpec{"registry_id", "namespace_name", "rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
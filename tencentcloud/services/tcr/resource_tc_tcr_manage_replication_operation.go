@@ -111,6 +111,7 @@ func ResourceTencentCloudTcrManageReplicationOperation() *schema.Resource {
 						},
 						"peer_registry_token": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Required:    true,
 							Description: "access permanent token of the instance to be synchronized.",
 						},
@@ -122,6 +123,8 @@ func ResourceTencentCloudTcrManageReplicationOperation() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
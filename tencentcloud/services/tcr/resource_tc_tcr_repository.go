@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,13 +14,11 @@ import (
 
 func ResourceTencentCloudTcrRepository() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrRepositoryCreate,
-		Read:   resourceTencentCloudTcrRepositoryRead,
-		Update: resourceTencentCloudTcrRepositoryUpdate,
-		Delete: resourceTencentCLoudTcrRepositoryDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTcrRepositoryCreate,
+		Read:     resourceTencentCloudTcrRepositoryRead,
+		Update:   resourceTencentCloudTcrRepositoryUpdate,
+		Delete:   resourceTencentCLoudTcrRepositoryDelete,
+		Importer: helper.IdSpec{"instance_id", "namespace_name", "name"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
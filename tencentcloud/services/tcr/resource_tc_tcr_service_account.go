@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudTcrServiceAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrServiceAccountCreate,
-		Read:   resourceTencentCloudTcrServiceAccountRead,
-		Update: resourceTencentCloudTcrServiceAccountUpdate,
-		Delete: resourceTencentCloudTcrServiceAccountDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTcrServiceAccountCreate,
+		Read:     resourceTencentCloudTcrServiceAccountRead,
+		Update:   resourceTencentCloudTcrServiceAccountUpdate,
+		Delete:   resourceTencentCloudTcrServiceAccountDelete,
+		Importer: helper.IdSpec{"registry_id", "name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
@@ -89,6 +87,7 @@ func ResourceTencentCloudTcrServiceAccount() *schema.Resource {
 			"password": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Password of the service account.",
 			},
 
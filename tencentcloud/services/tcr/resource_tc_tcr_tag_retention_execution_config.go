@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudTcrTagRetentionExecutionConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrTagRetentionExecutionConfigCreate,
-		Read:   resourceTencentCloudTcrTagRetentionExecutionConfigRead,
-		Update: resourceTencentCloudTcrTagRetentionExecutionConfigUpdate,
-		Delete: resourceTencentCloudTcrTagRetentionExecutionConfigDelete,
+		Create:   resourceTencentCloudTcrTagRetentionExecutionConfigCreate,
+		Read:     resourceTencentCloudTcrTagRetentionExecutionConfigRead,
+		Update:   resourceTencentCloudTcrTagRetentionExecutionConfigUpdate,
+		Delete:   resourceTencentCloudTcrTagRetentionExecutionConfigDelete,
+		Importer: helper.IdSpec{"registry_id", "retention_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTcrTagRetentionRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrTagRetentionRuleCreate,
-		Read:   resourceTencentCloudTcrTagRetentionRuleRead,
-		Update: resourceTencentCloudTcrTagRetentionRuleUpdate,
-		Delete: resourceTencentCloudTcrTagRetentionRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTcrTagRetentionRuleCreate,
+		Read:     resourceTencentCloudTcrTagRetentionRuleRead,
+		Update:   resourceTencentCloudTcrTagRetentionRuleUpdate,
+		Delete:   resourceTencentCloudTcrTagRetentionRuleDelete,
+		Importer: helper.IdSpec{"registry_id", "namespace_name", "retention_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
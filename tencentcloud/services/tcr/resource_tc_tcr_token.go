@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,13 +14,11 @@ import (
 
 func ResourceTencentCloudTcrToken() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrTokenCreate,
-		Read:   resourceTencentCloudTcrTokenRead,
-		Update: resourceTencentCloudTcrTokenUpdate,
-		Delete: resourceTencentCLoudTcrTokenDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTcrTokenCreate,
+		Read:     resourceTencentCloudTcrTokenRead,
+		Update:   resourceTencentCloudTcrTokenUpdate,
+		Delete:   resourceTencentCLoudTcrTokenDelete,
+		Importer: helper.IdSpec{"instance_id", "token_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -48,6 +47,7 @@ func ResourceTencentCloudTcrToken() *schema.Resource {
 			},
 			"token": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "The content of the token.",
 			},
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudTcrWebhookTrigger() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTcrWebhookTriggerCreate,
-		Read:   resourceTencentCloudTcrWebhookTriggerRead,
-		Update: resourceTencentCloudTcrWebhookTriggerUpdate,
-		Delete: resourceTencentCloudTcrWebhookTriggerDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTcrWebhookTriggerCreate,
+		Read:     resourceTencentCloudTcrWebhookTriggerRead,
+		Update:   resourceTencentCloudTcrWebhookTriggerUpdate,
+		Delete:   resourceTencentCloudTcrWebhookTriggerDelete,
+		Importer: helper.IdSpec{"registry_id", "namespace_name", "trigger_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"registry_id": {
 				Required:    true,
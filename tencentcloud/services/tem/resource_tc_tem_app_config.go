@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTemAppConfig() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTemAppConfigRead,
-		Create: resourceTencentCloudTemAppConfigCreate,
-		Update: resourceTencentCloudTemAppConfigUpdate,
-		Delete: resourceTencentCloudTemAppConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTemAppConfigRead,
+		Create:   resourceTencentCloudTemAppConfigCreate,
+		Update:   resourceTencentCloudTemAppConfigUpdate,
+		Delete:   resourceTencentCloudTemAppConfigDelete,
+		Importer: helper.IdSpec{"environment_id", "name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
 				Type:        schema.TypeString,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTemApplication() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTemApplicationRead,
-		Create: resourceTencentCloudTemApplicationCreate,
-		Update: resourceTencentCloudTemApplicationUpdate,
-		Delete: resourceTencentCloudTemApplicationDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Read:     resourceTencentCloudTemApplicationRead,
+		Create:   resourceTencentCloudTemApplicationCreate,
+		Update:   resourceTencentCloudTemApplicationUpdate,
+		Delete:   resourceTencentCloudTemApplicationDelete,
+		Importer: helper.IdSpec{"application_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"application_name": {
 				Type:        schema.TypeString,
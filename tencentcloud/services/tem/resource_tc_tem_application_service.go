@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTemApplicationService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTemApplicationServiceCreate,
-		Read:   resourceTencentCloudTemApplicationServiceRead,
-		Update: resourceTencentCloudTemApplicationServiceUpdate,
-		Delete: resourceTencentCloudTemApplicationServiceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTemApplicationServiceCreate,
+		Read:     resourceTencentCloudTemApplicationServiceRead,
+		Update:   resourceTencentCloudTemApplicationServiceUpdate,
+		Delete:   resourceTencentCloudTemApplicationServiceDelete,
+		Importer: helper.IdSpec{"environment_id", "application_id", "service_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
 				Required:    true,
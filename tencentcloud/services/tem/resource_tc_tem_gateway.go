@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTemGateway() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTemGatewayRead,
-		Create: resourceTencentCloudTemGatewayCreate,
-		Update: resourceTencentCloudTemGatewayUpdate,
-		Delete: resourceTencentCloudTemGatewayDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTemGatewayRead,
+		Create:   resourceTencentCloudTemGatewayCreate,
+		Update:   resourceTencentCloudTemGatewayUpdate,
+		Delete:   resourceTencentCloudTemGatewayDelete,
+		Importer: helper.IdSpec{"environment_id", "ingress_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"ingress": {
 				Type:        schema.TypeList,
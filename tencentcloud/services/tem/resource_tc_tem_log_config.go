@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTemLogConfig() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTemLogConfigRead,
-		Create: resourceTencentCloudTemLogConfigCreate,
-		Update: resourceTencentCloudTemLogConfigUpdate,
-		Delete: resourceTencentCloudTemLogConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTemLogConfigRead,
+		Create:   resourceTencentCloudTemLogConfigCreate,
+		Update:   resourceTencentCloudTemLogConfigUpdate,
+		Delete:   resourceTencentCloudTemLogConfigDelete,
+		Importer: helper.IdSpec{"environment_id", "application_id", "name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
 				Type:        schema.TypeString,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTemScaleRule() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTemScaleRuleRead,
-		Create: resourceTencentCloudTemScaleRuleCreate,
-		Update: resourceTencentCloudTemScaleRuleUpdate,
-		Delete: resourceTencentCloudTemScaleRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTemScaleRuleRead,
+		Create:   resourceTencentCloudTemScaleRuleCreate,
+		Update:   resourceTencentCloudTemScaleRuleUpdate,
+		Delete:   resourceTencentCloudTemScaleRuleDelete,
+		Importer: helper.IdSpec{"environment_id", "application_id", "scale_rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
 				Type:        schema.TypeString,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTemWorkload() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTemWorkloadRead,
-		Create: resourceTencentCloudTemWorkloadCreate,
-		Update: resourceTencentCloudTemWorkloadUpdate,
-		Delete: resourceTencentCloudTemWorkloadDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTemWorkloadRead,
+		Create:   resourceTencentCloudTemWorkloadCreate,
+		Update:   resourceTencentCloudTemWorkloadUpdate,
+		Delete:   resourceTencentCloudTemWorkloadDelete,
+		Importer: helper.IdSpec{"environment_id", "application_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"application_id": {
 				Type:        schema.TypeString,
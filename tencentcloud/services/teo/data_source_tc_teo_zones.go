@@ -721,7 +721,7 @@ func dataSourceTencentCloudTeoZonesRead(d *schema.ResourceData, meta interface{}
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), zonesList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), zonesList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTeoAccelerationDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoAccelerationDomainCreate,
-		Read:   resourceTencentCloudTeoAccelerationDomainRead,
-		Update: resourceTencentCloudTeoAccelerationDomainUpdate,
-		Delete: resourceTencentCloudTeoAccelerationDomainDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoAccelerationDomainCreate,
+		Read:     resourceTencentCloudTeoAccelerationDomainRead,
+		Update:   resourceTencentCloudTeoAccelerationDomainUpdate,
+		Delete:   resourceTencentCloudTeoAccelerationDomainDelete,
+		Importer: helper.IdSpec{"zone_id", "domain_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
@@ -47,13 +47,11 @@ import (
 
 func ResourceTencentCloudTeoApplicationProxy() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTeoApplicationProxyRead,
-		Create: resourceTencentCloudTeoApplicationProxyCreate,
-		Update: resourceTencentCloudTeoApplicationProxyUpdate,
-		Delete: resourceTencentCloudTeoApplicationProxyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTeoApplicationProxyRead,
+		Create:   resourceTencentCloudTeoApplicationProxyCreate,
+		Update:   resourceTencentCloudTeoApplicationProxyUpdate,
+		Delete:   resourceTencentCloudTeoApplicationProxyDelete,
+		Importer: helper.IdSpec{"zone_id", "proxy_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
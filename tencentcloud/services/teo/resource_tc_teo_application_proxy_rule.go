@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTeoApplicationProxyRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoApplicationProxyRuleCreate,
-		Read:   resourceTencentCloudTeoApplicationProxyRuleRead,
-		Update: resourceTencentCloudTeoApplicationProxyRuleUpdate,
-		Delete: resourceTencentCloudTeoApplicationProxyRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoApplicationProxyRuleCreate,
+		Read:     resourceTencentCloudTeoApplicationProxyRuleRead,
+		Update:   resourceTencentCloudTeoApplicationProxyRuleUpdate,
+		Delete:   resourceTencentCloudTeoApplicationProxyRuleDelete,
+		Importer: helper.IdSpec{"zone_id", "proxy_id", "rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
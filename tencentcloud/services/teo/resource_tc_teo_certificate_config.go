@@ -10,17 +10,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 )
 
 func ResourceTencentCloudTeoCertificateConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoCertificateConfigCreate,
-		Read:   resourceTencentCloudTeoCertificateConfigRead,
-		Update: resourceTencentCloudTeoCertificateConfigUpdate,
-		Delete: resourceTencentCloudTeoCertificateConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoCertificateConfigCreate,
+		Read:     resourceTencentCloudTeoCertificateConfigRead,
+		Update:   resourceTencentCloudTeoCertificateConfigUpdate,
+		Delete:   resourceTencentCloudTeoCertificateConfigDelete,
+		Importer: helper.IdSpec{"zone_id", "host"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
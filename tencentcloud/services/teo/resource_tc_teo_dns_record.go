@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudTeoDnsRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoDnsRecordCreate,
-		Read:   resourceTencentCloudTeoDnsRecordRead,
-		Update: resourceTencentCloudTeoDnsRecordUpdate,
-		Delete: resourceTencentCloudTeoDnsRecordDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoDnsRecordCreate,
+		Read:     resourceTencentCloudTeoDnsRecordRead,
+		Update:   resourceTencentCloudTeoDnsRecordUpdate,
+		Delete:   resourceTencentCloudTeoDnsRecordDelete,
+		Importer: helper.IdSpec{"zone_id", "record_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
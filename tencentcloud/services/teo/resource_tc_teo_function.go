@@ -21,13 +21,11 @@ import (
 
 func ResourceTencentCloudTeoFunction() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoFunctionCreate,
-		Read:   resourceTencentCloudTeoFunctionRead,
-		Update: resourceTencentCloudTeoFunctionUpdate,
-		Delete: resourceTencentCloudTeoFunctionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoFunctionCreate,
+		Read:     resourceTencentCloudTeoFunctionRead,
+		Update:   resourceTencentCloudTeoFunctionUpdate,
+		Delete:   resourceTencentCloudTeoFunctionDelete,
+		Importer: helper.IdSpec{"zone_id", "function_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
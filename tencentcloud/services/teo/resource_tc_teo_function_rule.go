@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudTeoFunctionRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoFunctionRuleCreate,
-		Read:   resourceTencentCloudTeoFunctionRuleRead,
-		Update: resourceTencentCloudTeoFunctionRuleUpdate,
-		Delete: resourceTencentCloudTeoFunctionRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoFunctionRuleCreate,
+		Read:     resourceTencentCloudTeoFunctionRuleRead,
+		Update:   resourceTencentCloudTeoFunctionRuleUpdate,
+		Delete:   resourceTencentCloudTeoFunctionRuleDelete,
+		Importer: helper.IdSpec{"zone_id", "function_id", "rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
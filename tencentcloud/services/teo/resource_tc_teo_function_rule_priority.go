@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTeoFunctionRulePriority() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoFunctionRulePriorityCreate,
-		Read:   resourceTencentCloudTeoFunctionRulePriorityRead,
-		Update: resourceTencentCloudTeoFunctionRulePriorityUpdate,
-		Delete: resourceTencentCloudTeoFunctionRulePriorityDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoFunctionRulePriorityCreate,
+		Read:     resourceTencentCloudTeoFunctionRulePriorityRead,
+		Update:   resourceTencentCloudTeoFunctionRulePriorityUpdate,
+		Delete:   resourceTencentCloudTeoFunctionRulePriorityDelete,
+		Importer: helper.IdSpec{"zone_id", "function_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTeoFunctionRuntimeEnvironment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoFunctionRuntimeEnvironmentCreate,
-		Read:   resourceTencentCloudTeoFunctionRuntimeEnvironmentRead,
-		Update: resourceTencentCloudTeoFunctionRuntimeEnvironmentUpdate,
-		Delete: resourceTencentCloudTeoFunctionRuntimeEnvironmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoFunctionRuntimeEnvironmentCreate,
+		Read:     resourceTencentCloudTeoFunctionRuntimeEnvironmentRead,
+		Update:   resourceTencentCloudTeoFunctionRuntimeEnvironmentUpdate,
+		Delete:   resourceTencentCloudTeoFunctionRuntimeEnvironmentDelete,
+		Importer: helper.IdSpec{"zone_id", "function_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTeoL4Proxy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoL4ProxyCreate,
-		Read:   resourceTencentCloudTeoL4ProxyRead,
-		Update: resourceTencentCloudTeoL4ProxyUpdate,
-		Delete: resourceTencentCloudTeoL4ProxyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoL4ProxyCreate,
+		Read:     resourceTencentCloudTeoL4ProxyRead,
+		Update:   resourceTencentCloudTeoL4ProxyUpdate,
+		Delete:   resourceTencentCloudTeoL4ProxyDelete,
+		Importer: helper.IdSpec{"zone_id", "proxy_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
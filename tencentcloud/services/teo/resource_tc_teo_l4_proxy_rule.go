@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudTeoL4ProxyRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoL4ProxyRuleCreate,
-		Read:   resourceTencentCloudTeoL4ProxyRuleRead,
-		Update: resourceTencentCloudTeoL4ProxyRuleUpdate,
-		Delete: resourceTencentCloudTeoL4ProxyRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoL4ProxyRuleCreate,
+		Read:     resourceTencentCloudTeoL4ProxyRuleRead,
+		Update:   resourceTencentCloudTeoL4ProxyRuleUpdate,
+		Delete:   resourceTencentCloudTeoL4ProxyRuleDelete,
+		Importer: helper.IdSpec{"zone_id", "proxy_id", "rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
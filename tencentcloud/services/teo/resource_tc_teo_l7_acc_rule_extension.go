@@ -386,6 +386,7 @@ func TencentTeoL7RuleBranchBasicInfo(depth int) map[string]*schema.Schema {
 								},
 								"secret_key": {
 									Type:        schema.TypeString,
+									Sensitive:   true,
 									Optional:    true,
 									Description: "The primary authentication key consists of 6-40 uppercase and lowercase english letters or digits, and cannot contain \" and $.",
 								},
@@ -396,6 +397,7 @@ func TencentTeoL7RuleBranchBasicInfo(depth int) map[string]*schema.Schema {
 								},
 								"backup_secret_key": {
 									Type:        schema.TypeString,
+									Sensitive:   true,
 									Optional:    true,
 									Description: "The backup authentication key consists of 6-40 uppercase and lowercase english letters or digits, and cannot contain \" and $.",
 								},
@@ -868,6 +870,7 @@ func TencentTeoL7RuleBranchBasicInfo(depth int) map[string]*schema.Schema {
 											},
 											"secret_access_key": {
 												Type:        schema.TypeString,
+												Sensitive:   true,
 												Required:    true,
 												Description: "Authentication parameter secret access key.",
 											},
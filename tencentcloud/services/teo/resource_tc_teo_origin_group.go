@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTeoOriginGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoOriginGroupCreate,
-		Read:   resourceTencentCloudTeoOriginGroupRead,
-		Update: resourceTencentCloudTeoOriginGroupUpdate,
-		Delete: resourceTencentCloudTeoOriginGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoOriginGroupCreate,
+		Read:     resourceTencentCloudTeoOriginGroupRead,
+		Update:   resourceTencentCloudTeoOriginGroupUpdate,
+		Delete:   resourceTencentCloudTeoOriginGroupDelete,
+		Importer: helper.IdSpec{"zone_id", "origin_group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
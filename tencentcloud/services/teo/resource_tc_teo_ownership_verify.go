@@ -36,6 +36,8 @@ func ResourceTencentCloudTeoOwnershipVerify() *schema.Resource {
 				Computed:    true,
 				Description: "When the verification result is failed, this field will return the reason.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
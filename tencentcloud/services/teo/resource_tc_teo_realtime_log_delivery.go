@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTeoRealtimeLogDelivery() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoRealtimeLogDeliveryCreate,
-		Read:   resourceTencentCloudTeoRealtimeLogDeliveryRead,
-		Update: resourceTencentCloudTeoRealtimeLogDeliveryUpdate,
-		Delete: resourceTencentCloudTeoRealtimeLogDeliveryDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoRealtimeLogDeliveryCreate,
+		Read:     resourceTencentCloudTeoRealtimeLogDeliveryRead,
+		Update:   resourceTencentCloudTeoRealtimeLogDeliveryUpdate,
+		Delete:   resourceTencentCloudTeoRealtimeLogDeliveryDelete,
+		Importer: helper.IdSpec{"zone_id", "task_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
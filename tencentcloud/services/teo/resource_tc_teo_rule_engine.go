@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudTeoRuleEngine() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoRuleEngineCreate,
-		Read:   resourceTencentCloudTeoRuleEngineRead,
-		Update: resourceTencentCloudTeoRuleEngineUpdate,
-		Delete: resourceTencentCloudTeoRuleEngineDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoRuleEngineCreate,
+		Read:     resourceTencentCloudTeoRuleEngineRead,
+		Update:   resourceTencentCloudTeoRuleEngineUpdate,
+		Delete:   resourceTencentCloudTeoRuleEngineDelete,
+		Importer: helper.IdSpec{"zone_id", "rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
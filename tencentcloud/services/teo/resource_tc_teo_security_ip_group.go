@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTeoSecurityIpGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTeoSecurityIpGroupCreate,
-		Read:   resourceTencentCloudTeoSecurityIpGroupRead,
-		Update: resourceTencentCloudTeoSecurityIpGroupUpdate,
-		Delete: resourceTencentCloudTeoSecurityIpGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTeoSecurityIpGroupCreate,
+		Read:     resourceTencentCloudTeoSecurityIpGroupRead,
+		Update:   resourceTencentCloudTeoSecurityIpGroupUpdate,
+		Delete:   resourceTencentCloudTeoSecurityIpGroupDelete,
+		Importer: helper.IdSpec{"zone_id", "group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:        schema.TypeString,
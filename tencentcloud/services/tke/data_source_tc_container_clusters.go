@@ -68,6 +68,7 @@ func DataSourceTencentCloudContainerClusters() *schema.Resource {
 						},
 						"security_password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "Describe the password needed for using kubectl to access to kubernetes.",
 						},
@@ -157,6 +157,7 @@ func DataSourceTencentCloudEksClusterCredential() *schema.Resource {
 			},
 			"kube_config": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "EKS cluster kubeconfig.",
 			},
@@ -246,7 +247,7 @@ func datasourceTencentCloudEksClusterCredentialRead(d *schema.ResourceData, meta
 	}
 
 	if output, ok := d.GetOk("result_output_file"); ok {
-		return tccommon.WriteToFile(output.(string), result)
+		return tccommon.WriteToFile(output.(string), result, tccommon.GetResultOutputOptions(d))
 	}
 
 	return nil
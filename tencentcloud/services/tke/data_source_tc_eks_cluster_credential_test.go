@@ -15,7 +15,7 @@ func TestAccTencentCloudEksClusterCredentialDataSource(t *testing.T) {
 		Providers: tcacctest.AccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTencentCloudEksClusterCredentialBasic,
+				Config: tcacctest.FixtureVpcVariable(t) + testAccTencentCloudEksClusterCredentialBasic,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.tencentcloud_eks_cluster_credential.cred", "addresses.#"),
 					resource.TestCheckResourceAttrSet("data.tencentcloud_eks_cluster_credential.cred", "credential.ca_cert"),
@@ -32,7 +32,7 @@ func TestAccTencentCloudEksClusterCredentialDataSource(t *testing.T) {
 	})
 }
 
-const testAccTencentCloudEksClusterForCred = tcacctest.DefaultVpcVariable + `
+const testAccTencentCloudEksClusterForCred = `
 resource "tencentcloud_eks_cluster" "foo" {
   cluster_name = "tf-eks-test"
   k8s_version = "1.18.4"
@@ -17,7 +17,7 @@ func TestAccTencentCloudEksClustersDataSource(t *testing.T) {
 		CheckDestroy: testAccTencentCloudEKSClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccEksClusterDataSource,
+				Config: tcacctest.FixtureVpcVariable(t) + testAccEksClusterDataSource,
 				Check: resource.ComposeTestCheckFunc(
 					tcacctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_eks_clusters.foo"),
 					resource.TestCheckResourceAttrSet("data.tencentcloud_eks_clusters.foo", "cluster_id"),
@@ -42,7 +42,7 @@ func TestAccTencentCloudEksClustersDataSource(t *testing.T) {
 	})
 }
 
-const testAccEksClusterDataSource = tcacctest.DefaultVpcVariable + `
+const testAccEksClusterDataSource = `
 resource "tencentcloud_eks_cluster" "foo" {
   cluster_name = "tf-eks-test"
   k8s_version = "1.18.4"
@@ -150,7 +150,7 @@ func dataSourceTencentCloudKubernetesChartsRead(d *schema.ResourceData, meta int
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), appChartsList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), appChartsList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -176,7 +176,7 @@ func dataSourceTencentCloudKubernetesClusterAuthenticationOptionsRead(d *schema.
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), dataSourceTencentCloudKubernetesClusterAuthenticationOptionsReadOutputContent(ctx)); e != nil {
+		if e := tccommon.WriteToFile(output.(string), dataSourceTencentCloudKubernetesClusterAuthenticationOptionsReadOutputContent(ctx), tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
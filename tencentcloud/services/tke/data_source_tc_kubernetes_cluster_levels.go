@@ -166,7 +166,7 @@ func dataSourceTencentCloudKubernetesClusterLevelsRead(d *schema.ResourceData, m
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), itemsList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), itemsList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
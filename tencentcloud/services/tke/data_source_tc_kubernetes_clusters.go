@@ -256,11 +256,13 @@ func DataSourceTencentCloudKubernetesClusters() *schema.Resource {
 						},
 						"kube_config": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "Kubernetes config.",
 						},
 						"kube_config_intranet": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "Kubernetes config of private network.",
 						},
@@ -271,6 +273,7 @@ func DataSourceTencentCloudKubernetesClusters() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "Password of account.",
 						},
@@ -444,7 +447,7 @@ func dataSourceTencentCloudKubernetesClustersRead(d *schema.ResourceData, meta i
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), dataSourceTencentCloudKubernetesClustersReadOutputContent(ctx)); e != nil {
+		if e := tccommon.WriteToFile(output.(string), dataSourceTencentCloudKubernetesClustersReadOutputContent(ctx), tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
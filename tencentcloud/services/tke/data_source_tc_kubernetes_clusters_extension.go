@@ -216,13 +216,13 @@ func dataSourceTencentCloudKubernetesClustersReadPostHandleResponse0(ctx context
 			kubeConfigFilePrefix := v.(string)
 			if clusterInternet {
 				kubeConfigFile := kubeConfigFilePrefix + fmt.Sprintf("-%s-kubeconfig", clsData)
-				if err = tccommon.WriteToFile(kubeConfigFile, config); err != nil {
+				if err = tccommon.WriteToFile(kubeConfigFile, config, tccommon.GetResultOutputOptions(d)); err != nil {
 					return err
 				}
 			}
 			if clusterIntranet {
 				kubeConfigIntranetFile := kubeConfigFilePrefix + fmt.Sprintf("-%s-kubeconfig-intranet", clusterID)
-				if err = tccommon.WriteToFile(kubeConfigIntranetFile, intranetConfig); err != nil {
+				if err = tccommon.WriteToFile(kubeConfigIntranetFile, intranetConfig, tccommon.GetResultOutputOptions(d)); err != nil {
 					return err
 				}
 			}
@@ -79,6 +79,16 @@ const (
 	TkeInternetStatusNotfound      = "NotFound"
 )
 
+// life states of GetUpgradeInstanceProgress
+const (
+	TKE_UPGRADE_STATE_PENDING = "pending"
+	TKE_UPGRADE_STATE_PROCESS = "process"
+	TKE_UPGRADE_STATE_DONE    = "done"
+	TKE_UPGRADE_STATE_FAILED  = "failed"
+)
+
+var TKE_UPGRADE_FAILED_STATES = []string{TKE_UPGRADE_STATE_FAILED, "paused", "pauing", "timeout", "aborted"}
+
 const (
 	TKE_CLUSTER_NETWORK_TYPE_GR             = "GR"
 	TKE_CLUSTER_NETWORK_TYPE_VPC_CNI        = "VPC-CNI"
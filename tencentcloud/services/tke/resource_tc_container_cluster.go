@@ -100,6 +100,7 @@ func ResourceTencentCloudContainerCluster() *schema.Resource {
 			},
 			"password": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Optional:    true,
 				Description: "The password of each node.",
 			},
@@ -91,6 +91,7 @@ func ResourceTencentCloudContainerClusterInstance() *schema.Resource {
 			},
 			"password": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Optional:    true,
 				Description: "The password of each node.",
 			},
@@ -223,6 +223,7 @@ func ResourceTencentCloudEksCluster() *schema.Resource {
 			// computed
 			"kube_config": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "EKS cluster kubeconfig.",
 			},
@@ -55,7 +55,7 @@ func TestAccTencentCloudEKSCluster_basic(t *testing.T) {
 		CheckDestroy: testAccTencentCloudEKSClusterDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccEksCluster,
+				Config: tcacctest.FixtureVpcVariable(t) + testAccEksCluster,
 				Check: resource.ComposeTestCheckFunc(
 					testAccTencentCloudEKSClusterExists("tencentcloud_eks_cluster.foo"),
 					resource.TestCheckResourceAttr("tencentcloud_eks_cluster.foo", "cluster_name", "tf-eks-test"),
@@ -76,7 +76,7 @@ func TestAccTencentCloudEKSCluster_basic(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccEksClusterUpdate,
+				Config: tcacctest.FixtureVpcVariable(t) + testAccEksClusterUpdate,
 				Check: resource.ComposeTestCheckFunc(
 					testAccTencentCloudEKSClusterExists("tencentcloud_eks_cluster.foo"),
 					resource.TestCheckResourceAttr("tencentcloud_eks_cluster.foo", "cluster_name", "tf-eks-test2"),
@@ -181,7 +181,7 @@ func testAccTencentCloudEKSClusterDestroy(s *terraform.State) error {
 	return nil
 }
 
-const testAccEksCluster = tcacctest.DefaultVpcVariable + `
+const testAccEksCluster = `
 resource "tencentcloud_eks_cluster" "foo" {
   cluster_name = "tf-eks-test"
   k8s_version = "1.18.4"
@@ -203,7 +203,7 @@ resource "tencentcloud_eks_cluster" "foo" {
 }
 `
 
-const testAccEksClusterUpdate = tcacctest.DefaultVpcVariable + `
+const testAccEksClusterUpdate = `
 resource "tencentcloud_eks_cluster" "foo" {
   cluster_name = "tf-eks-test2"
   k8s_version = "1.18.4"
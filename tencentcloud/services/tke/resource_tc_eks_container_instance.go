@@ -802,6 +802,7 @@ func ResourceTencentCloudEksContainerInstance() *schema.Resource {
 						},
 						"password": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Optional:    true,
 							Description: "Password.",
 						},
@@ -63,7 +63,7 @@ func TestAccTencentCloudEKSContainerInstance_basic(t *testing.T) {
 		CheckDestroy: testAccCheckEksCiDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccEksCi,
+				Config: tcacctest.FixtureVpcVariable(t) + testAccEksCi,
 				Check: resource.ComposeTestCheckFunc(
 					tcacctest.AccCheckTencentCloudDataSourceID("tencentcloud_eks_container_instance.foo"),
 					resource.TestCheckResourceAttr("tencentcloud_eks_container_instance.foo", "name", "foo"),
@@ -132,7 +132,7 @@ func testAccCheckEksCiDestroy(s *terraform.State) error {
 	return nil
 }
 
-const testAccEksCi = tcacctest.DefaultVpcVariable + `
+const testAccEksCi = `
 data "tencentcloud_security_groups" "group" {
   name = "default"
 }
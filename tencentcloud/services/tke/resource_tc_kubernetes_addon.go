@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudKubernetesAddon() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudKubernetesAddonCreate,
-		Read:   resourceTencentCloudKubernetesAddonRead,
-		Update: resourceTencentCloudKubernetesAddonUpdate,
-		Delete: resourceTencentCloudKubernetesAddonDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudKubernetesAddonCreate,
+		Read:     resourceTencentCloudKubernetesAddonRead,
+		Update:   resourceTencentCloudKubernetesAddonUpdate,
+		Delete:   resourceTencentCloudKubernetesAddonDelete,
+		Importer: helper.IdSpec{"cluster_id", "addon_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
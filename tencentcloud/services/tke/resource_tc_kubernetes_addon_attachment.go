@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudKubernetesAddonAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudKubernetesAddonAttachmentCreate,
-		Read:   resourceTencentCloudKubernetesAddonAttachmentRead,
-		Update: resourceTencentCloudKubernetesAddonAttachmentUpdate,
-		Delete: resourceTencentCloudKubernetesAddonAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudKubernetesAddonAttachmentCreate,
+		Read:     resourceTencentCloudKubernetesAddonAttachmentRead,
+		Update:   resourceTencentCloudKubernetesAddonAttachmentUpdate,
+		Delete:   resourceTencentCloudKubernetesAddonAttachmentDelete,
+		Importer: helper.IdSpec{"cluster_id", "name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
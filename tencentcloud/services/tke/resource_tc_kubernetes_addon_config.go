@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudKubernetesAddonConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudKubernetesAddonConfigCreate,
-		Read:   resourceTencentCloudKubernetesAddonConfigRead,
-		Update: resourceTencentCloudKubernetesAddonConfigUpdate,
-		Delete: resourceTencentCloudKubernetesAddonConfigDelete,
+		Create:   resourceTencentCloudKubernetesAddonConfigCreate,
+		Read:     resourceTencentCloudKubernetesAddonConfigRead,
+		Update:   resourceTencentCloudKubernetesAddonConfigUpdate,
+		Delete:   resourceTencentCloudKubernetesAddonConfigDelete,
+		Importer: helper.IdSpec{"cluster_id", "addon_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
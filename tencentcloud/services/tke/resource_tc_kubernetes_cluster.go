@@ -1507,12 +1507,14 @@ func ResourceTencentCloudKubernetesCluster() *schema.Resource {
 
 			"kube_config": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "Kubernetes config.",
 			},
 
 			"kube_config_intranet": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "Kubernetes config of private network.",
 			},
@@ -1525,6 +1527,7 @@ func ResourceTencentCloudKubernetesCluster() *schema.Resource {
 
 			"password": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "Password of account.",
 			},
@@ -20,6 +20,10 @@ func ResourceTencentCloudKubernetesClusterAttachment() *schema.Resource {
 		Create: resourceTencentCloudKubernetesClusterAttachmentCreate,
 		Read:   resourceTencentCloudKubernetesClusterAttachmentRead,
 		Delete: resourceTencentCloudKubernetesClusterAttachmentDelete,
+		// the ID of an attachment joins the instance ID and the cluster ID with `_`
+		Importer: helper.IdSpec{"instance_id", "cluster_id"}.ImporterWithIdFunc(func(parts []string) string {
+			return strings.Join(parts, "_")
+		}),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
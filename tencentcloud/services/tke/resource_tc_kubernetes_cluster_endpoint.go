@@ -6,6 +6,7 @@ import (
 	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	tke "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/tke/v20180525"
 
@@ -17,10 +18,11 @@ import (
 
 func ResourceTencentCloudTkeClusterEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTkeClusterEndpointRead,
-		Create: resourceTencentCloudTkeClusterEndpointCreate,
-		Update: resourceTencentCloudTkeClusterEndpointUpdate,
-		Delete: resourceTencentCloudTkeClusterEndpointDelete,
+		Read:     resourceTencentCloudTkeClusterEndpointRead,
+		Create:   resourceTencentCloudTkeClusterEndpointCreate,
+		Update:   resourceTencentCloudTkeClusterEndpointUpdate,
+		Delete:   resourceTencentCloudTkeClusterEndpointDelete,
+		Importer: helper.IdSpec{"cluster_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -117,11 +119,13 @@ func ResourceTencentCloudTkeClusterEndpoint() *schema.Resource {
 			},
 			"kube_config": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "The Intranet address used for access.",
 			},
 			"kube_config_intranet": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "Kubernetes config of private network.",
 			},
@@ -262,7 +266,7 @@ func resourceTencentCloudTkeClusterEndpointCreate(d *schema.ResourceData, meta i
 		if err != nil {
 			return err
 		}
-		err = waitForClusterEndpointFinish(ctx, &service, id, true, false)
+		err = service.WaitForClusterEndpoint(ctx, id, true, false, 2*tccommon.ReadRetryTimeout)
 		if err != nil {
 			return err
 		}
@@ -274,7 +278,7 @@ func resourceTencentCloudTkeClusterEndpointCreate(d *schema.ResourceData, meta i
 		if err != nil {
 			return err
 		}
-		err = waitForClusterEndpointFinish(ctx, &service, id, true, true)
+		err = service.WaitForClusterEndpoint(ctx, id, true, true, 2*tccommon.ReadRetryTimeout)
 		if err != nil {
 			return err
 		}
@@ -323,7 +327,7 @@ func resourceTencentCloudTkeClusterEndpointUpdate(d *schema.ResourceData, meta i
 		if err != nil {
 			return err
 		}
-		err = waitForClusterEndpointFinish(ctx, &service, id, clusterInternet, true)
+		err = service.WaitForClusterEndpoint(ctx, id, clusterInternet, true, 2*tccommon.ReadRetryTimeout)
 		if err != nil {
 			return err
 		}
@@ -334,7 +338,7 @@ func resourceTencentCloudTkeClusterEndpointUpdate(d *schema.ResourceData, meta i
 		if err != nil {
 			return err
 		}
-		err = waitForClusterEndpointFinish(ctx, &service, id, false, true)
+		err = service.WaitForClusterEndpoint(ctx, id, false, true, 2*tccommon.ReadRetryTimeout)
 		if err != nil {
 			return err
 		}
@@ -343,7 +347,7 @@ func resourceTencentCloudTkeClusterEndpointUpdate(d *schema.ResourceData, meta i
 		if err != nil {
 			return err
 		}
-		err = waitForClusterEndpointFinish(ctx, &service, id, true, true)
+		err = service.WaitForClusterEndpoint(ctx, id, true, true, 2*tccommon.ReadRetryTimeout)
 		if err != nil {
 			return err
 		}
@@ -354,7 +358,7 @@ func resourceTencentCloudTkeClusterEndpointUpdate(d *schema.ResourceData, meta i
 		if err != nil {
 			return err
 		}
-		err = waitForClusterEndpointFinish(ctx, &service, id, clusterIntranet, false)
+		err = service.WaitForClusterEndpoint(ctx, id, clusterIntranet, false, 2*tccommon.ReadRetryTimeout)
 		if err != nil {
 			return err
 		}
@@ -365,7 +369,7 @@ func resourceTencentCloudTkeClusterEndpointUpdate(d *schema.ResourceData, meta i
 		if err != nil {
 			return err
 		}
-		err = waitForClusterEndpointFinish(ctx, &service, id, false, false)
+		err = service.WaitForClusterEndpoint(ctx, id, false, false, 2*tccommon.ReadRetryTimeout)
 		if err != nil {
 			return err
 		}
@@ -374,7 +378,7 @@ func resourceTencentCloudTkeClusterEndpointUpdate(d *schema.ResourceData, meta i
 		if err != nil {
 			return err
 		}
-		err = waitForClusterEndpointFinish(ctx, &service, id, true, false)
+		err = service.WaitForClusterEndpoint(ctx, id, true, false, 2*tccommon.ReadRetryTimeout)
 		if err != nil {
 			return err
 		}
@@ -413,7 +417,7 @@ func resourceTencentCloudTkeClusterEndpointDelete(d *schema.ResourceData, meta i
 		if err != nil {
 			errs = *multierror.Append(err)
 		} else {
-			taskErr := waitForClusterEndpointFinish(ctx, &service, id, false, true)
+			taskErr := service.WaitForClusterEndpoint(ctx, id, false, true, 2*tccommon.ReadRetryTimeout)
 			if taskErr != nil {
 				errs = *multierror.Append(taskErr)
 			}
@@ -425,7 +429,7 @@ func resourceTencentCloudTkeClusterEndpointDelete(d *schema.ResourceData, meta i
 		if err != nil {
 			errs = *multierror.Append(err)
 		} else {
-			taskErr := waitForClusterEndpointFinish(ctx, &service, id, false, false)
+			taskErr := service.WaitForClusterEndpoint(ctx, id, false, false, 2*tccommon.ReadRetryTimeout)
 			if taskErr != nil {
 				errs = *multierror.Append(taskErr)
 			}
@@ -435,38 +439,6 @@ func resourceTencentCloudTkeClusterEndpointDelete(d *schema.ResourceData, meta i
 	return errs.ErrorOrNil()
 }
 
-func waitForClusterEndpointFinish(ctx context.Context, service *TkeService, id string, enabled bool, isInternet bool) (err error) {
-	return resource.Retry(2*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		var (
-			status         string
-			message        string
-			inErr          error
-			retryableState = TkeInternetStatusCreating
-			finishStates   = []string{TkeInternetStatusNotfound, TkeInternetStatusCreated}
-		)
-
-		if !enabled {
-			retryableState = TkeInternetStatusDeleting
-			finishStates = []string{TkeInternetStatusNotfound, TkeInternetStatusDeleted}
-		}
-
-		status, message, inErr = service.DescribeClusterEndpointStatus(ctx, id, isInternet)
-
-		if inErr != nil {
-			return tccommon.RetryError(inErr)
-		}
-		if status == retryableState {
-			return resource.RetryableError(
-				fmt.Errorf("%s create cluster internet endpoint status still is %s", id, status))
-		}
-		if tccommon.IsContains(finishStates, status) {
-			return nil
-		}
-		return resource.NonRetryableError(
-			fmt.Errorf("%s create cluster internet endpoint error ,status is %s,message is %s", id, status, message))
-	})
-}
-
 func tencentCloudClusterInternetSwitch(ctx context.Context, service *TkeService, id string, enable bool, sg string, domain string, extensiveParameters string) (err error) {
 	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
 		if enable {
@@ -425,21 +425,7 @@ func resourceTencentCloudKubernetesClusterCreatePostHandleResponse0(ctx context.
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(2*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			status, message, inErr := service.DescribeClusterEndpointStatus(ctx, id, false)
-			if inErr != nil {
-				return tccommon.RetryError(inErr)
-			}
-			if status == TkeInternetStatusCreating {
-				return resource.RetryableError(
-					fmt.Errorf("%s create intranet cluster endpoint status still is %s", id, status))
-			}
-			if status == TkeInternetStatusNotfound || status == TkeInternetStatusCreated {
-				return nil
-			}
-			return resource.NonRetryableError(
-				fmt.Errorf("%s create intranet cluster endpoint error ,status is %s,message is %s", id, status, message))
-		})
+		err = service.WaitForClusterEndpoint(ctx, id, true, false, 2*tccommon.ReadRetryTimeout)
 		if err != nil {
 			return err
 		}
@@ -456,21 +442,7 @@ func resourceTencentCloudKubernetesClusterCreatePostHandleResponse0(ctx context.
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(2*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			status, message, inErr := service.DescribeClusterEndpointStatus(ctx, id, true)
-			if inErr != nil {
-				return tccommon.RetryError(inErr)
-			}
-			if status == TkeInternetStatusCreating {
-				return resource.RetryableError(
-					fmt.Errorf("%s create cluster internet endpoint status still is %s", id, status))
-			}
-			if status == TkeInternetStatusNotfound || status == TkeInternetStatusCreated {
-				return nil
-			}
-			return resource.NonRetryableError(
-				fmt.Errorf("%s create cluster internet endpoint error ,status is %s,message is %s", id, status, message))
-		})
+		err = service.WaitForClusterEndpoint(ctx, id, true, true, 2*tccommon.ReadRetryTimeout)
 		if err != nil {
 			return err
 		}
@@ -1687,17 +1659,7 @@ func upgradeClusterInstances(tkeService TkeService, ctx context.Context, id stri
 
 	// check update status: upgrade instance one by one, so timeout depend on instance number.
 	timeout := tccommon.ReadRetryTimeout * time.Duration(instNum)
-	err = resource.Retry(timeout, func() *resource.RetryError {
-		done, inErr := tkeService.GetUpgradeInstanceResult(ctx, id)
-		if inErr != nil {
-			return tccommon.RetryError(inErr)
-		}
-		if done {
-			return nil
-		} else {
-			return resource.RetryableError(fmt.Errorf("cluster %s, retry...", id))
-		}
-	})
+	err = tkeService.WaitForUpgradeInstances(ctx, id, timeout)
 	if err != nil {
 		return err
 	}
@@ -17,9 +17,10 @@ import (
 
 func ResourceTencentCloudKubernetesClusterMasterAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudKubernetesClusterMasterAttachmentCreate,
-		Read:   resourceTencentCloudKubernetesClusterMasterAttachmentRead,
-		Delete: resourceTencentCloudKubernetesClusterMasterAttachmentDelete,
+		Create:   resourceTencentCloudKubernetesClusterMasterAttachmentCreate,
+		Read:     resourceTencentCloudKubernetesClusterMasterAttachmentRead,
+		Delete:   resourceTencentCloudKubernetesClusterMasterAttachmentDelete,
+		Importer: helper.IdSpec{"cluster_id", "instance_id", "node_role"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
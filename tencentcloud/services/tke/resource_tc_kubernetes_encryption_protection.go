@@ -15,9 +15,10 @@ import (
 
 func ResourceTencentCloudKubernetesEncryptionProtection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudKubernetesEncryptionProtectionCreate,
-		Read:   resourceTencentCloudKubernetesEncryptionProtectionRead,
-		Delete: resourceTencentCloudKubernetesEncryptionProtectionDelete,
+		Create:   resourceTencentCloudKubernetesEncryptionProtectionCreate,
+		Read:     resourceTencentCloudKubernetesEncryptionProtectionRead,
+		Delete:   resourceTencentCloudKubernetesEncryptionProtectionDelete,
+		Importer: helper.IdSpec{"cluster_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
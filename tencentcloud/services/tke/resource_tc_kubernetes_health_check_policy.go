@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudKubernetesHealthCheckPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudKubernetesHealthCheckPolicyCreate,
-		Read:   resourceTencentCloudKubernetesHealthCheckPolicyRead,
-		Update: resourceTencentCloudKubernetesHealthCheckPolicyUpdate,
-		Delete: resourceTencentCloudKubernetesHealthCheckPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudKubernetesHealthCheckPolicyCreate,
+		Read:     resourceTencentCloudKubernetesHealthCheckPolicyRead,
+		Update:   resourceTencentCloudKubernetesHealthCheckPolicyUpdate,
+		Delete:   resourceTencentCloudKubernetesHealthCheckPolicyDelete,
+		Importer: helper.IdSpec{"cluster_id", "name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -17,9 +17,10 @@ import (
 
 func ResourceTencentCloudKubernetesLogConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudKubernetesLogConfigCreate,
-		Read:   resourceTencentCloudKubernetesLogConfigRead,
-		Delete: resourceTencentCloudKubernetesLogConfigDelete,
+		Create:   resourceTencentCloudKubernetesLogConfigCreate,
+		Read:     resourceTencentCloudKubernetesLogConfigRead,
+		Delete:   resourceTencentCloudKubernetesLogConfigDelete,
+		Importer: helper.IdSpec{"cluster_id", "log_config_name", "cluster_type"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"log_config": {
 				Type:        schema.TypeString,
@@ -20,13 +20,11 @@ import (
 
 func ResourceTencentCloudKubernetesNativeNodePool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudKubernetesNativeNodePoolCreate,
-		Read:   resourceTencentCloudKubernetesNativeNodePoolRead,
-		Update: resourceTencentCloudKubernetesNativeNodePoolUpdate,
-		Delete: resourceTencentCloudKubernetesNativeNodePoolDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudKubernetesNativeNodePoolCreate,
+		Read:     resourceTencentCloudKubernetesNativeNodePoolRead,
+		Update:   resourceTencentCloudKubernetesNativeNodePoolUpdate,
+		Delete:   resourceTencentCloudKubernetesNativeNodePoolDelete,
+		Importer: helper.IdSpec{"cluster_id", "node_pool_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudKubernetesServerlessNodePool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudKubernetesServerlessNodePoolCreate,
-		Read:   resourceTencentCloudKubernetesServerlessNodePoolRead,
-		Update: resourceTencentCloudKubernetesServerlessNodePoolUpdate,
-		Delete: resourceTencentCloudKubernetesServerlessNodePoolDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudKubernetesServerlessNodePoolCreate,
+		Read:     resourceTencentCloudKubernetesServerlessNodePoolRead,
+		Update:   resourceTencentCloudKubernetesServerlessNodePoolUpdate,
+		Delete:   resourceTencentCloudKubernetesServerlessNodePoolDelete,
+		Importer: helper.IdSpec{"cluster_id", "node_pool_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
 	svccvm "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/cvm"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/waiter"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -575,59 +577,95 @@ func (me *TkeService) DescribeClusterConfig(ctx context.Context, id string, isPu
 	return
 }
 
-func (me *TkeService) GetUpgradeInstanceResult(ctx context.Context, id string) (
-	done bool,
-	errRet error,
-) {
-
-	logId := tccommon.GetLogId(ctx)
-	request := tke.NewGetUpgradeInstanceProgressRequest()
+// UpgradeInstanceRefreshFunc reports the instance upgrade of the cluster as a task, whose progress is the share of
+// upgraded instances. The status is `failed` when any instance fails, with the instance as the reason.
+func (me *TkeService) UpgradeInstanceRefreshFunc(id string) waiter.RefreshFunc {
+	return func(ctx context.Context) (*waiter.Task, error) {
+		logId := tccommon.GetLogId(ctx)
+		request := tke.NewGetUpgradeInstanceProgressRequest()
+		request.ClusterId = &id
 
-	defer func() {
-		if errRet != nil {
+		ratelimit.Check(request.GetAction())
+		response, err := me.client.UseTkeClient().GetUpgradeInstanceProgress(request)
+		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
-				logId, request.GetAction(), request.ToJsonString(), errRet.Error())
+				logId, request.GetAction(), request.ToJsonString(), err.Error())
+			return nil, err
+		}
+		if response.Response == nil || response.Response.LifeState == nil {
+			return nil, nil
 		}
-	}()
-
-	request.ClusterId = &id
 
-	ratelimit.Check(request.GetAction())
-	response, err := me.client.UseTkeClient().GetUpgradeInstanceProgress(request)
+		task := &waiter.Task{
+			Status:   *response.Response.LifeState,
+			Progress: waiter.Percent(helper.PInt64(response.Response.Done), helper.PInt64(response.Response.Total)),
+			Result:   response.Response,
+		}
+		if task.Status != TKE_UPGRADE_STATE_PROCESS {
+			return task, nil
+		}
 
-	if err != nil {
-		errRet = err
-		return
+		// the upgrade is in process, check whether any instance fails
+		for _, inst := range response.Response.Instances {
+			lifeState := helper.PString(inst.LifeState)
+			if lifeState == TKE_UPGRADE_STATE_DONE || lifeState == TKE_UPGRADE_STATE_PENDING {
+				continue
+			}
+			if lifeState != TKE_UPGRADE_STATE_PROCESS {
+				task.Status = TKE_UPGRADE_STATE_FAILED
+				task.Reason = fmt.Sprintf("instanceId:%s, lifeState is:%s", helper.PString(inst.InstanceID), lifeState)
+				return task, nil
+			}
+			for _, detail := range inst.Detail {
+				if helper.PString(detail.LifeState) == TKE_UPGRADE_STATE_FAILED {
+					task.Status = TKE_UPGRADE_STATE_FAILED
+					task.Reason = fmt.Sprintf("instanceId:%s, detail.lifeState is:%s", helper.PString(inst.InstanceID), TKE_UPGRADE_STATE_FAILED)
+					return task, nil
+				}
+			}
+		}
+		return task, nil
 	}
+}
 
-	lifeState := *response.Response.LifeState
+// WaitForUpgradeInstances waits for the instance upgrade of the cluster to be done, and fails when it stops,
+// times out on the server, or any instance fails.
+func (me *TkeService) WaitForUpgradeInstances(ctx context.Context, id string, timeout time.Duration) error {
+	_, err := waiter.NewWaiter(fmt.Sprintf("cluster %s instance upgrade", id), []string{TKE_UPGRADE_STATE_PROCESS},
+		[]string{TKE_UPGRADE_STATE_DONE}, TKE_UPGRADE_FAILED_STATES, timeout, me.UpgradeInstanceRefreshFunc(id)).WaitForState(ctx)
+	return err
+}
 
-	// all instances success, lifeState=done
-	if lifeState == "done" {
-		return true, nil
-	} else if lifeState != "process" {
-		return false, fmt.Errorf("upgrade instances failed, tke response lifeState is:%s", lifeState)
+// ClusterEndpointRefreshFunc reports the internet or intranet endpoint of the cluster as a task.
+func (me *TkeService) ClusterEndpointRefreshFunc(id string, isInternet bool) waiter.RefreshFunc {
+	return func(ctx context.Context) (*waiter.Task, error) {
+		status, message, err := me.DescribeClusterEndpointStatus(ctx, id, isInternet)
+		if err != nil {
+			return nil, err
+		}
+		return &waiter.Task{Status: status, Reason: message}, nil
 	}
+}
 
-	// parent lifeState=process, check whether all instances in processing.
-	for _, inst := range response.Response.Instances {
-		if *inst.LifeState == "done" || *inst.LifeState == "pending" {
-			continue
-		}
-		if *inst.LifeState != "process" {
-			return false, fmt.Errorf("upgrade instances failed, "+
-				"instanceId:%s, lifeState is:%s", *inst.InstanceID, *inst.LifeState)
-		}
-		// instance lifeState=process, check whether failed or not.
-		for _, detail := range inst.Detail {
-			if *detail.LifeState == "failed" {
-				return false, fmt.Errorf("upgrade instances failed, "+
-					"instanceId:%s, detail.lifeState is:%s", *inst.InstanceID, *detail.LifeState)
-			}
-		}
+// WaitForClusterEndpoint waits for the internet or intranet endpoint of the cluster to be opened, or to be closed
+// when enabled is false.
+func (me *TkeService) WaitForClusterEndpoint(ctx context.Context, id string, enabled, isInternet bool, timeout time.Duration) error {
+	accessType := "intranet"
+	if isInternet {
+		accessType = "internet"
+	}
+	action := "open"
+	pending := []string{TkeInternetStatusCreating}
+	target := []string{TkeInternetStatusNotfound, TkeInternetStatusCreated}
+	if !enabled {
+		action = "close"
+		pending = []string{TkeInternetStatusDeleting, TkeInternetStatusCreated}
+		target = []string{TkeInternetStatusNotfound, TkeInternetStatusDeleted}
 	}
 
-	return
+	_, err := waiter.NewWaiter(fmt.Sprintf("%s cluster %s %s endpoint", action, id, accessType), pending, target,
+		[]string{TkeInternetStatusCreateFailed, TkeInternetStatusDeletedFailed}, timeout, me.ClusterEndpointRefreshFunc(id, isInternet)).WaitForState(ctx)
+	return err
 }
 
 func (me *TkeService) CreateCluster(ctx context.Context,
@@ -1050,6 +1088,9 @@ func (me *TkeService) DescribeClusterEndpointStatus(ctx context.Context, id stri
 	}
 	status = *response.Response.Status
 	message = status
+	if response.Response.ErrorMsg != nil && *response.Response.ErrorMsg != "" {
+		message = *response.Response.ErrorMsg
+	}
 	return
 }
 func (me *TkeService) DescribeClusterEndpoints(ctx context.Context, id string) (response tke.DescribeClusterEndpointsResponseParams, errRet error) {
@@ -2500,12 +2541,6 @@ func ModifyClusterInternetOrIntranetAccess(ctx context.Context, d *schema.Resour
 	isInternet bool, enable bool, sg string, subnetId string, domain string) error {
 
 	id := d.Id()
-	var accessType string
-	if isInternet {
-		accessType = "cluster internet"
-	} else {
-		accessType = "cluster intranet"
-	}
 	// open access
 	if enable {
 		err := resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
@@ -2518,21 +2553,7 @@ func ModifyClusterInternetOrIntranetAccess(ctx context.Context, d *schema.Resour
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(2*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			status, message, inErr := tkeSvc.DescribeClusterEndpointStatus(ctx, id, isInternet)
-			if inErr != nil {
-				return tccommon.RetryError(inErr)
-			}
-			if status == TkeInternetStatusCreating {
-				return resource.RetryableError(
-					fmt.Errorf("%s create %s endpoint status still is %s", id, accessType, status))
-			}
-			if status == TkeInternetStatusNotfound || status == TkeInternetStatusCreated {
-				return nil
-			}
-			return resource.NonRetryableError(
-				fmt.Errorf("%s create %s endpoint error ,status is %s,message is %s", id, accessType, status, message))
-		})
+		err = tkeSvc.WaitForClusterEndpoint(ctx, id, true, isInternet, 2*tccommon.ReadRetryTimeout)
 		if err != nil {
 			return err
 		}
@@ -2547,21 +2568,7 @@ func ModifyClusterInternetOrIntranetAccess(ctx context.Context, d *schema.Resour
 		if err != nil {
 			return err
 		}
-		err = resource.Retry(2*tccommon.ReadRetryTimeout, func() *resource.RetryError {
-			status, message, inErr := tkeSvc.DescribeClusterEndpointStatus(ctx, id, isInternet)
-			if inErr != nil {
-				return tccommon.RetryError(inErr)
-			}
-			if status == TkeInternetStatusDeleting {
-				return resource.RetryableError(
-					fmt.Errorf("%s close %s endpoint status still is %s", id, accessType, status))
-			}
-			if status == TkeInternetStatusNotfound || status == TkeInternetStatusDeleted || status == TkeInternetStatusCreated {
-				return nil
-			}
-			return resource.NonRetryableError(
-				fmt.Errorf("%s close %s endpoint error ,status is %s,message is %s", id, accessType, status, message))
-		})
+		err = tkeSvc.WaitForClusterEndpoint(ctx, id, false, isInternet, 2*tccommon.ReadRetryTimeout)
 		if err != nil {
 			return err
 		}
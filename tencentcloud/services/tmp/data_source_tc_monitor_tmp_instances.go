@@ -233,6 +233,7 @@ func DataSourceTencentCloudMonitorTmpInstances() *schema.Resource {
 						},
 						"auth_token": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "Token required for data writing.",
 						},
@@ -597,7 +598,7 @@ func dataSourceTencentCloudMonitorTmpInstancesRead(d *schema.ResourceData, meta
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tmpList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tmpList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudMonitorTmpAlertGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMonitorTmpAlertGroupCreate,
-		Read:   resourceTencentCloudMonitorTmpAlertGroupRead,
-		Update: resourceTencentCloudMonitorTmpAlertGroupUpdate,
-		Delete: resourceTencentCloudMonitorTmpAlertGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudMonitorTmpAlertGroupCreate,
+		Read:     resourceTencentCloudMonitorTmpAlertGroupRead,
+		Update:   resourceTencentCloudMonitorTmpAlertGroupUpdate,
+		Delete:   resourceTencentCloudMonitorTmpAlertGroupDelete,
+		Importer: helper.IdSpec{"[instance_id]", "group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Optional:    true,
@@ -19,13 +19,11 @@ import (
 
 func ResourceTencentCloudMonitorTmpAlertRule() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpAlertRuleRead,
-		Create: resourceTencentCloudMonitorTmpAlertRuleCreate,
-		Update: resourceTencentCloudMonitorTmpAlertRuleUpdate,
-		Delete: resourceTencentCloudMonitorTmpAlertRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudMonitorTmpAlertRuleRead,
+		Create:   resourceTencentCloudMonitorTmpAlertRuleCreate,
+		Update:   resourceTencentCloudMonitorTmpAlertRuleUpdate,
+		Delete:   resourceTencentCloudMonitorTmpAlertRuleDelete,
+		Importer: helper.IdSpec{"instance_id", "rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
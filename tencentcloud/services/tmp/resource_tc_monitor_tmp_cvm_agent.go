@@ -21,10 +21,8 @@ func ResourceTencentCloudMonitorTmpCvmAgent() *schema.Resource {
 		Read:   resourceTencentCloudMonitorTmpCvmAgentRead,
 		Create: resourceTencentCloudMonitorTmpCvmAgentCreate,
 		//Update: resourceTencentCloudMonitorTmpCvmAgentUpdate,
-		Delete: resourceTencentCloudMonitorTmpCvmAgentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Delete:   resourceTencentCloudMonitorTmpCvmAgentDelete,
+		Importer: helper.IdSpec{"instance_id", "agent_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -24,6 +24,9 @@ func ResourceTencentCloudMonitorTmpExporterIntegration() *schema.Resource {
 		Create:             resourceTencentCloudMonitorTmpExporterIntegrationCreate,
 		Update:             resourceTencentCloudMonitorTmpExporterIntegrationUpdate,
 		Delete:             resourceTencentCloudMonitorTmpExporterIntegrationDelete,
+		Importer: helper.IdSpec{"name", "instance_id", "kube_type", "[cluster_id]", "kind"}.ImporterWithIdFunc(func(parts []string) string {
+			return strings.Join(parts, tccommon.FILED_SP)
+		}, "instance_id", "kube_type", "cluster_id"),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
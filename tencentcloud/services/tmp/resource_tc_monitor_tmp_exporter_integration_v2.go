@@ -24,6 +24,9 @@ func ResourceTencentCloudMonitorTmpExporterIntegrationV2() *schema.Resource {
 		Read:   resourceTencentCloudMonitorTmpExporterIntegrationV2Read,
 		Update: resourceTencentCloudMonitorTmpExporterIntegrationV2Update,
 		Delete: resourceTencentCloudMonitorTmpExporterIntegrationV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTencentCloudMonitorTmpExporterIntegrationV2Import,
+		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -59,6 +62,22 @@ func ResourceTencentCloudMonitorTmpExporterIntegrationV2() *schema.Resource {
 	}
 }
 
+// the integrations of a cluster are identified with the kube type and cluster ID as well
+var (
+	tmpExporterIntegrationIdSpec        = helper.IdSpec{"[name]", "instance_id", "kind"}
+	tmpExporterIntegrationClusterIdSpec = helper.IdSpec{"[name]", "instance_id", "kube_type", "cluster_id", "kind"}
+)
+
+func resourceTencentCloudMonitorTmpExporterIntegrationV2Import(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := tmpExporterIntegrationClusterIdSpec.Parse(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+	if _, err := tmpExporterIntegrationIdSpec.Parse(d.Id()); err != nil {
+		return nil, fmt.Errorf("invalid ID `%s`, expected format `%s` or `%s`", d.Id(), tmpExporterIntegrationClusterIdSpec, tmpExporterIntegrationIdSpec)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTencentCloudMonitorTmpExporterIntegrationV2Create(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_monitor_tmp_exporter_integration_v2.create")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -63,6 +63,7 @@ func ResourceTencentCloudMonitorTmpMultipleWrites() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Password.",
 									},
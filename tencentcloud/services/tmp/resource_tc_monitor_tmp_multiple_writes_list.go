@@ -60,6 +60,7 @@ func ResourceTencentCloudMonitorTmpMultipleWritesList() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Optional:    true,
 										Description: "Password.",
 									},
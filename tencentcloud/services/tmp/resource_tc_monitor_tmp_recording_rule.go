@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudMonitorTmpRecordingRule() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpRecordingRuleRead,
-		Create: resourceTencentCloudMonitorTmpRecordingRuleCreate,
-		Update: resourceTencentCloudMonitorTmpRecordingRuleUpdate,
-		Delete: resourceTencentCloudMonitorTmpRecordingRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudMonitorTmpRecordingRuleRead,
+		Create:   resourceTencentCloudMonitorTmpRecordingRuleCreate,
+		Update:   resourceTencentCloudMonitorTmpRecordingRuleUpdate,
+		Delete:   resourceTencentCloudMonitorTmpRecordingRuleDelete,
+		Importer: helper.IdSpec{"instance_id", "recording_rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
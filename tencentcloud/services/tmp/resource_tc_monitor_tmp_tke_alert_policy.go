@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudMonitorTmpTkeAlertPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTkeTmpAlertPolicyRead,
-		Create: resourceTencentCloudTkeTmpAlertPolicyCreate,
-		Update: resourceTencentCloudTkeTmpAlertPolicyUpdate,
-		Delete: resourceTencentCloudTkeTmpAlertPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTkeTmpAlertPolicyRead,
+		Create:   resourceTencentCloudTkeTmpAlertPolicyCreate,
+		Update:   resourceTencentCloudTkeTmpAlertPolicyUpdate,
+		Delete:   resourceTencentCloudTkeTmpAlertPolicyDelete,
+		Importer: helper.IdSpec{"instance_id", "tmp_alert_policy_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
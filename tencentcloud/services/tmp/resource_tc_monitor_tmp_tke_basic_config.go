@@ -2,6 +2,7 @@ package tmp
 
 import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	svcmonitor "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/monitor"
 
 	"context"
@@ -22,10 +23,11 @@ const (
 
 func ResourceTencentCloudMonitorTmpTkeBasicConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudMonitorTmpTkeBasicConfigCreate,
-		Read:   resourceTencentCloudMonitorTmpTkeBasicConfigRead,
-		Update: resourceTencentCloudMonitorTmpTkeBasicConfigUpdate,
-		Delete: resourceTencentCloudMonitorTmpTkeBasicConfigDelete,
+		Create:   resourceTencentCloudMonitorTmpTkeBasicConfigCreate,
+		Read:     resourceTencentCloudMonitorTmpTkeBasicConfigRead,
+		Update:   resourceTencentCloudMonitorTmpTkeBasicConfigUpdate,
+		Delete:   resourceTencentCloudMonitorTmpTkeBasicConfigDelete,
+		Importer: helper.IdSpec{"instance_id", "cluster_type", "cluster_id", "name"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
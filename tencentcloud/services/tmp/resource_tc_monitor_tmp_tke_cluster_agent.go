@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudMonitorTmpTkeClusterAgent() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpTkeClusterAgentRead,
-		Create: resourceTencentCloudMonitorTmpTkeClusterAgentCreate,
-		Update: resourceTencentCloudMonitorTmpTkeClusterAgentUpdate,
-		Delete: resourceTencentCloudMonitorTmpTkeClusterAgentDelete,
+		Read:     resourceTencentCloudMonitorTmpTkeClusterAgentRead,
+		Create:   resourceTencentCloudMonitorTmpTkeClusterAgentCreate,
+		Update:   resourceTencentCloudMonitorTmpTkeClusterAgentUpdate,
+		Delete:   resourceTencentCloudMonitorTmpTkeClusterAgentDelete,
+		Importer: helper.IdSpec{"instance_id", "cluster_id", "cluster_type"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
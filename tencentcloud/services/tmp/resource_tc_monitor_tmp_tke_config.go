@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudMonitorTmpTkeConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTkeTmpConfigCreate,
-		Read:   resourceTencentCloudTkeTmpConfigRead,
-		Update: resourceTencentCloudTkeTmpConfigUpdate,
-		Delete: resourceTencentCloudTkeTmpConfigDelete,
+		Create:   resourceTencentCloudTkeTmpConfigCreate,
+		Read:     resourceTencentCloudTkeTmpConfigRead,
+		Update:   resourceTencentCloudTkeTmpConfigUpdate,
+		Delete:   resourceTencentCloudTkeTmpConfigDelete,
+		Importer: helper.IdSpec{"instance_id", "cluster_type", "cluster_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudMonitorTmpTkeRecordRuleYaml() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTkeTmpRecordRuleYamlRead,
-		Create: resourceTencentCloudTkeTmpRecordRuleYamlCreate,
-		Update: resourceTencentCloudTkeTmpRecordRuleYamlUpdate,
-		Delete: resourceTencentCloudTkeTmpRecordRuleYamlDelete,
-		//Importer: &schema.ResourceImporter{
-		//	State: schema.ImportStatePassthrough,
-		//},
+		Read:     resourceTencentCloudTkeTmpRecordRuleYamlRead,
+		Create:   resourceTencentCloudTkeTmpRecordRuleYamlCreate,
+		Update:   resourceTencentCloudTkeTmpRecordRuleYamlUpdate,
+		Delete:   resourceTencentCloudTkeTmpRecordRuleYamlDelete,
+		Importer: helper.IdSpec{"instance_id", "name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
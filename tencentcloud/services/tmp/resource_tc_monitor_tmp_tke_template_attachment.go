@@ -18,9 +18,10 @@ import (
 
 func ResourceTencentCloudMonitorTmpTkeTemplateAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudMonitorTmpTkeTemplateAttachmentRead,
-		Create: resourceTencentCloudMonitorTmpTkeTemplateAttachmentCreate,
-		Delete: resourceTencentCloudMonitorTmpTkeTemplateAttachmentDelete,
+		Read:     resourceTencentCloudMonitorTmpTkeTemplateAttachmentRead,
+		Create:   resourceTencentCloudMonitorTmpTkeTemplateAttachmentCreate,
+		Delete:   resourceTencentCloudMonitorTmpTkeTemplateAttachmentDelete,
+		Importer: helper.IdSpec{"template_id", "instance_id", "region"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"template_id": {
 				Type:        schema.TypeString,
@@ -2,6 +2,7 @@ package tpulsar
 
 import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	svctdmq "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tdmq"
 	svcvpc "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
 
@@ -17,13 +18,11 @@ import (
 
 func ResourceTencentCloudTdmqNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqNamespaceCreate,
-		Read:   resourceTencentCloudTdmqNamespaceRead,
-		Update: resourceTencentCloudTdmqNamespaceUpdate,
-		Delete: resourceTencentCloudTdmqNamespaceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTdmqNamespaceCreate,
+		Read:     resourceTencentCloudTdmqNamespaceRead,
+		Update:   resourceTencentCloudTdmqNamespaceUpdate,
+		Delete:   resourceTencentCloudTdmqNamespaceDelete,
+		Importer: helper.IdSpec{"environ_id", "cluster_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"environ_name": {
@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudTdmqNamespaceRoleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqNamespaceRoleAttachmentCreate,
-		Read:   resourceTencentCloudTdmqNamespaceRoleAttachmentRead,
-		Update: resourceTencentCloudTdmqNamespaceRoleAttachmentUpdate,
-		Delete: resourceTencentCloudTdmqNamespaceRoleAttachmentDelete,
+		Create:   resourceTencentCloudTdmqNamespaceRoleAttachmentCreate,
+		Read:     resourceTencentCloudTdmqNamespaceRoleAttachmentRead,
+		Update:   resourceTencentCloudTdmqNamespaceRoleAttachmentUpdate,
+		Delete:   resourceTencentCloudTdmqNamespaceRoleAttachmentDelete,
+		Importer: helper.IdSpec{"cluster_id", "environ_id", "role_name"}.ImporterWithArguments("cluster_id"),
 
 		Schema: map[string]*schema.Schema{
 			"environ_id": {
@@ -11,15 +11,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
 
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	svctdmq "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tdmq"
 )
 
 func ResourceTencentCloudTdmqRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqRoleCreate,
-		Read:   resourceTencentCloudTdmqRoleRead,
-		Update: resourceTencentCloudTdmqRoleUpdate,
-		Delete: resourceTencentCloudTdmqRoleDelete,
+		Create:   resourceTencentCloudTdmqRoleCreate,
+		Read:     resourceTencentCloudTdmqRoleRead,
+		Update:   resourceTencentCloudTdmqRoleUpdate,
+		Delete:   resourceTencentCloudTdmqRoleDelete,
+		Importer: helper.IdSpec{"cluster_id", "role_name"}.ImporterWithArguments("cluster_id"),
 
 		Schema: map[string]*schema.Schema{
 			"role_name": {
@@ -19,12 +19,10 @@ import (
 
 func ResourceTencentCloudTdmqSubscription() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqSubscriptionCreate,
-		Read:   resourceTencentCloudTdmqSubscriptionRead,
-		Delete: resourceTencentCloudTdmqSubscriptionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTdmqSubscriptionCreate,
+		Read:     resourceTencentCloudTdmqSubscriptionRead,
+		Delete:   resourceTencentCloudTdmqSubscriptionDelete,
+		Importer: helper.IdSpec{"cluster_id", "environment_id", "topic_name", "subscription_name", "auto_delete_policy_topic"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
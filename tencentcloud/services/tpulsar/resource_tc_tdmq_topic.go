@@ -2,6 +2,7 @@ package tpulsar
 
 import (
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	svctdmq "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tdmq"
 	svcvpc "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
 
@@ -15,10 +16,11 @@ import (
 
 func ResourceTencentCloudTdmqTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqTopicCreate,
-		Read:   resourceTencentCloudTdmqTopicRead,
-		Update: resourceTencentCloudTdmqTopicUpdate,
-		Delete: resourceTencentCloudTdmqTopicDelete,
+		Create:   resourceTencentCloudTdmqTopicCreate,
+		Read:     resourceTencentCloudTdmqTopicRead,
+		Update:   resourceTencentCloudTdmqTopicUpdate,
+		Delete:   resourceTencentCloudTdmqTopicDelete,
+		Importer: helper.IdSpec{"cluster_id", "environ_id", "topic_name"}.ImporterWithArguments("cluster_id", "environ_id"),
 
 		Schema: map[string]*schema.Schema{
 			"environ_id": {
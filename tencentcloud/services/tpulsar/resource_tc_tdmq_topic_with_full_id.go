@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 	svctdmq "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/tdmq"
 	svcvpc "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/services/vpc"
 
@@ -17,13 +18,11 @@ import (
 
 func ResourceTencentCloudTdmqTopicWithFullId() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqTopicWithFullIdCreate,
-		Read:   resourceTencentCloudTdmqTopicWithFullIdRead,
-		Update: resourceTencentCloudTdmqTopicWithFullIdUpdate,
-		Delete: resourceTencentCloudTdmqTopicWithFullIdDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTdmqTopicWithFullIdCreate,
+		Read:     resourceTencentCloudTdmqTopicWithFullIdRead,
+		Update:   resourceTencentCloudTdmqTopicWithFullIdUpdate,
+		Delete:   resourceTencentCloudTdmqTopicWithFullIdDelete,
+		Importer: helper.IdSpec{"cluster_id", "environ_id", "topic_name"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"environ_id": {
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudTdmqRabbitmqUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqRabbitmqUserCreate,
-		Read:   resourceTencentCloudTdmqRabbitmqUserRead,
-		Update: resourceTencentCloudTdmqRabbitmqUserUpdate,
-		Delete: resourceTencentCloudTdmqRabbitmqUserDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTdmqRabbitmqUserCreate,
+		Read:     resourceTencentCloudTdmqRabbitmqUserRead,
+		Update:   resourceTencentCloudTdmqRabbitmqUserUpdate,
+		Delete:   resourceTencentCloudTdmqRabbitmqUserDelete,
+		Importer: helper.IdSpec{"instance_id", "user"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudTdmqRabbitmqVirtualHost() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqRabbitmqVirtualHostCreate,
-		Read:   resourceTencentCloudTdmqRabbitmqVirtualHostRead,
-		Update: resourceTencentCloudTdmqRabbitmqVirtualHostUpdate,
-		Delete: resourceTencentCloudTdmqRabbitmqVirtualHostDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTdmqRabbitmqVirtualHostCreate,
+		Read:     resourceTencentCloudTdmqRabbitmqVirtualHostRead,
+		Update:   resourceTencentCloudTdmqRabbitmqVirtualHostUpdate,
+		Delete:   resourceTencentCloudTdmqRabbitmqVirtualHostDelete,
+		Importer: helper.IdSpec{"instance_id", "virtual_host"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -226,7 +226,7 @@ func dataSourceTencentCloudTdmqRocketmqGroupRead(d *schema.ResourceData, meta in
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), groupList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), groupList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -139,7 +139,7 @@ func dataSourceTencentCloudTdmqRocketmqNamespaceRead(d *schema.ResourceData, met
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), namespaceList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), namespaceList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
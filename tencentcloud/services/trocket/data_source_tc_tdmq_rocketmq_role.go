@@ -42,6 +42,7 @@ func DataSourceTencentCloudTdmqRocketmqRole() *schema.Resource {
 						},
 						"token": {
 							Type:        schema.TypeString,
+							Sensitive:   true,
 							Computed:    true,
 							Description: "Value of the role token.",
 						},
@@ -131,7 +132,7 @@ func dataSourceTencentCloudTdmqRocketmqRoleRead(d *schema.ResourceData, meta int
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), roleSetList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), roleSetList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
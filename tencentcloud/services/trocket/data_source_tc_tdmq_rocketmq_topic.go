@@ -160,7 +160,7 @@ func dataSourceTencentCloudTdmqRocketmqTopicRead(d *schema.ResourceData, meta in
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), topicList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), topicList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTdmqRocketmqEnvironmentRole() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdmqRocketmqEnvironmentRoleRead,
-		Create: resourceTencentCloudTdmqRocketmqEnvironmentRoleCreate,
-		Update: resourceTencentCloudTdmqRocketmqEnvironmentRoleUpdate,
-		Delete: resourceTencentCloudTdmqRocketmqEnvironmentRoleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTdmqRocketmqEnvironmentRoleRead,
+		Create:   resourceTencentCloudTdmqRocketmqEnvironmentRoleCreate,
+		Update:   resourceTencentCloudTdmqRocketmqEnvironmentRoleUpdate,
+		Delete:   resourceTencentCloudTdmqRocketmqEnvironmentRoleDelete,
+		Importer: helper.IdSpec{"cluster_id", "role_name", "environment_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"environment_name": {
 				Type:        schema.TypeString,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTdmqRocketmqGroup() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdmqRocketmqGroupRead,
-		Create: resourceTencentCloudTdmqRocketmqGroupCreate,
-		Update: resourceTencentCloudTdmqRocketmqGroupUpdate,
-		Delete: resourceTencentCloudTdmqRocketmqGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTdmqRocketmqGroupRead,
+		Create:   resourceTencentCloudTdmqRocketmqGroupCreate,
+		Update:   resourceTencentCloudTdmqRocketmqGroupUpdate,
+		Delete:   resourceTencentCloudTdmqRocketmqGroupDelete,
+		Importer: helper.IdSpec{"cluster_id", "namespace_name", "group_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"group_name": {
 				Type:        schema.TypeString,
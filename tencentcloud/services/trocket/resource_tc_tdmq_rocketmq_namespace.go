@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTdmqRocketmqNamespace() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdmqRocketmqNamespaceRead,
-		Create: resourceTencentCloudTdmqRocketmqNamespaceCreate,
-		Update: resourceTencentCloudTdmqRocketmqNamespaceUpdate,
-		Delete: resourceTencentCloudTdmqRocketmqNamespaceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTdmqRocketmqNamespaceRead,
+		Create:   resourceTencentCloudTdmqRocketmqNamespaceCreate,
+		Update:   resourceTencentCloudTdmqRocketmqNamespaceUpdate,
+		Delete:   resourceTencentCloudTdmqRocketmqNamespaceDelete,
+		Importer: helper.IdSpec{"cluster_id", "namespace_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:        schema.TypeString,
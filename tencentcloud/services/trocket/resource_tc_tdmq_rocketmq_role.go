@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTdmqRocketmqRole() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdmqRocketmqRoleRead,
-		Create: resourceTencentCloudTdmqRocketmqRoleCreate,
-		Update: resourceTencentCloudTdmqRocketmqRoleUpdate,
-		Delete: resourceTencentCloudTdmqRocketmqRoleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTdmqRocketmqRoleRead,
+		Create:   resourceTencentCloudTdmqRocketmqRoleCreate,
+		Update:   resourceTencentCloudTdmqRocketmqRoleUpdate,
+		Delete:   resourceTencentCloudTdmqRocketmqRoleDelete,
+		Importer: helper.IdSpec{"cluster_id", "role_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:        schema.TypeString,
@@ -45,6 +43,7 @@ func ResourceTencentCloudTdmqRocketmqRole() *schema.Resource {
 
 			"token": {
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Computed:    true,
 				Description: "Value of the role token.",
 			},
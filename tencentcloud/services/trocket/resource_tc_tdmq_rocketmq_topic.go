@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTdmqRocketmqTopic() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudTdmqRocketmqTopicRead,
-		Create: resourceTencentCloudTdmqRocketmqTopicCreate,
-		Update: resourceTencentCloudTdmqRocketmqTopicUpdate,
-		Delete: resourceTencentCloudTdmqRocketmqTopicDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudTdmqRocketmqTopicRead,
+		Create:   resourceTencentCloudTdmqRocketmqTopicCreate,
+		Update:   resourceTencentCloudTdmqRocketmqTopicUpdate,
+		Delete:   resourceTencentCloudTdmqRocketmqTopicDelete,
+		Importer: helper.IdSpec{"cluster_id", "namespace_name", "topic_type", "topic_name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"topic_name": {
 				Type:        schema.TypeString,
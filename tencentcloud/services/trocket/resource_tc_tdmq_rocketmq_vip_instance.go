@@ -19,10 +19,11 @@ import (
 
 func ResourceTencentCloudTdmqRocketmqVipInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTdmqRocketmqVipInstanceCreate,
-		Read:   resourceTencentCloudTdmqRocketmqVipInstanceRead,
-		Update: resourceTencentCloudTdmqRocketmqVipInstanceUpdate,
-		Delete: resourceTencentCloudTdmqRocketmqVipInstanceDelete,
+		Create:   resourceTencentCloudTdmqRocketmqVipInstanceCreate,
+		Read:     resourceTencentCloudTdmqRocketmqVipInstanceRead,
+		Update:   resourceTencentCloudTdmqRocketmqVipInstanceUpdate,
+		Delete:   resourceTencentCloudTdmqRocketmqVipInstanceDelete,
+		Importer: helper.IdSpec{"cluster_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -56,6 +56,8 @@ func ResourceTencentCloudTdmqSendRocketmqMessage() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Message tag information.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTrocketRocketmqConsumerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTrocketRocketmqConsumerGroupCreate,
-		Read:   resourceTencentCloudTrocketRocketmqConsumerGroupRead,
-		Update: resourceTencentCloudTrocketRocketmqConsumerGroupUpdate,
-		Delete: resourceTencentCloudTrocketRocketmqConsumerGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTrocketRocketmqConsumerGroupCreate,
+		Read:     resourceTencentCloudTrocketRocketmqConsumerGroupRead,
+		Update:   resourceTencentCloudTrocketRocketmqConsumerGroupUpdate,
+		Delete:   resourceTencentCloudTrocketRocketmqConsumerGroupDelete,
+		Importer: helper.IdSpec{"instance_id", "consumer_group"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTrocketRocketmqRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTrocketRocketmqRoleCreate,
-		Read:   resourceTencentCloudTrocketRocketmqRoleRead,
-		Update: resourceTencentCloudTrocketRocketmqRoleUpdate,
-		Delete: resourceTencentCloudTrocketRocketmqRoleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTrocketRocketmqRoleCreate,
+		Read:     resourceTencentCloudTrocketRocketmqRoleRead,
+		Update:   resourceTencentCloudTrocketRocketmqRoleUpdate,
+		Delete:   resourceTencentCloudTrocketRocketmqRoleDelete,
+		Importer: helper.IdSpec{"instance_id", "role"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -66,6 +64,7 @@ func ResourceTencentCloudTrocketRocketmqRole() *schema.Resource {
 			"secret_key": {
 				Computed:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Secret key.",
 			},
 
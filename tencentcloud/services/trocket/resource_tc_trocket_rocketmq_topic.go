@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTrocketRocketmqTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTrocketRocketmqTopicCreate,
-		Read:   resourceTencentCloudTrocketRocketmqTopicRead,
-		Update: resourceTencentCloudTrocketRocketmqTopicUpdate,
-		Delete: resourceTencentCloudTrocketRocketmqTopicDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTrocketRocketmqTopicCreate,
+		Read:     resourceTencentCloudTrocketRocketmqTopicRead,
+		Update:   resourceTencentCloudTrocketRocketmqTopicUpdate,
+		Delete:   resourceTencentCloudTrocketRocketmqTopicDelete,
+		Importer: helper.IdSpec{"instance_id", "topic"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -250,7 +250,7 @@ func dataSourceTencentCloudTseGatewayCertificatesRead(d *schema.ResourceData, me
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), kongCertificatesListMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), kongCertificatesListMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
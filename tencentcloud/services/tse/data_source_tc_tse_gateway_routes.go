@@ -308,7 +308,7 @@ func dataSourceTencentCloudTseGatewayRoutesRead(d *schema.ResourceData, meta int
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), kongServiceRouteListMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), kongServiceRouteListMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -455,7 +455,7 @@ func dataSourceTencentCloudTseGatewayServicesRead(d *schema.ResourceData, meta i
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), kongServicesMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), kongServicesMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -584,7 +584,7 @@ func dataSourceTencentCloudTseGroupsRead(d *schema.ResourceData, meta interface{
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), nativeGatewayServerGroupsMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), nativeGatewayServerGroupsMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -19,13 +19,11 @@ import (
 
 func ResourceTencentCloudTseCngwCanaryRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwCanaryRuleCreate,
-		Read:   resourceTencentCloudTseCngwCanaryRuleRead,
-		Update: resourceTencentCloudTseCngwCanaryRuleUpdate,
-		Delete: resourceTencentCloudTseCngwCanaryRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTseCngwCanaryRuleCreate,
+		Read:     resourceTencentCloudTseCngwCanaryRuleRead,
+		Update:   resourceTencentCloudTseCngwCanaryRuleUpdate,
+		Delete:   resourceTencentCloudTseCngwCanaryRuleDelete,
+		Importer: helper.IdSpec{"gateway_id", "service_id", "priority"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
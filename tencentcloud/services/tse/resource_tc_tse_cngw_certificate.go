@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTseCngwCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwCertificateCreate,
-		Read:   resourceTencentCloudTseCngwCertificateRead,
-		Update: resourceTencentCloudTseCngwCertificateUpdate,
-		Delete: resourceTencentCloudTseCngwCertificateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTseCngwCertificateCreate,
+		Read:     resourceTencentCloudTseCngwCertificateRead,
+		Update:   resourceTencentCloudTseCngwCertificateUpdate,
+		Delete:   resourceTencentCloudTseCngwCertificateDelete,
+		Importer: helper.IdSpec{"gateway_id", "certificate_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTseCngwGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwGroupCreate,
-		Read:   resourceTencentCloudTseCngwGroupRead,
-		Update: resourceTencentCloudTseCngwGroupUpdate,
-		Delete: resourceTencentCloudTseCngwGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTseCngwGroupCreate,
+		Read:     resourceTencentCloudTseCngwGroupRead,
+		Update:   resourceTencentCloudTseCngwGroupUpdate,
+		Delete:   resourceTencentCloudTseCngwGroupDelete,
+		Importer: helper.IdSpec{"gateway_id", "group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
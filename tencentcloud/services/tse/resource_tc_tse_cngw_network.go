@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudTseCngwNetwork() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwNetworkCreate,
-		Read:   resourceTencentCloudTseCngwNetworkRead,
-		Update: resourceTencentCloudTseCngwNetworkUpdate,
-		Delete: resourceTencentCloudTseCngwNetworkDelete,
+		Create:   resourceTencentCloudTseCngwNetworkCreate,
+		Read:     resourceTencentCloudTseCngwNetworkRead,
+		Update:   resourceTencentCloudTseCngwNetworkUpdate,
+		Delete:   resourceTencentCloudTseCngwNetworkDelete,
+		Importer: helper.IdSpec{"gateway_id", "group_id", "network_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
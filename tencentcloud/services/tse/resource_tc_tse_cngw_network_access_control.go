@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudTseCngwNetworkAccessControl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwNetworkAccessControlCreate,
-		Read:   resourceTencentCloudTseCngwNetworkAccessControlRead,
-		Update: resourceTencentCloudTseCngwNetworkAccessControlUpdate,
-		Delete: resourceTencentCloudTseCngwNetworkAccessControlDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTseCngwNetworkAccessControlCreate,
+		Read:     resourceTencentCloudTseCngwNetworkAccessControlRead,
+		Update:   resourceTencentCloudTseCngwNetworkAccessControlUpdate,
+		Delete:   resourceTencentCloudTseCngwNetworkAccessControlDelete,
+		Importer: helper.IdSpec{"gateway_id", "group_id", "network_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
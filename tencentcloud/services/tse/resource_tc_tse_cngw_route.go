@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTseCngwRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwRouteCreate,
-		Read:   resourceTencentCloudTseCngwRouteRead,
-		Update: resourceTencentCloudTseCngwRouteUpdate,
-		Delete: resourceTencentCloudTseCngwRouteDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTseCngwRouteCreate,
+		Read:     resourceTencentCloudTseCngwRouteRead,
+		Update:   resourceTencentCloudTseCngwRouteUpdate,
+		Delete:   resourceTencentCloudTseCngwRouteDelete,
+		Importer: helper.IdSpec{"gateway_id", "service_id", "[route_name]"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
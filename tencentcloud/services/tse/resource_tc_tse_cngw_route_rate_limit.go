@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTseCngwRouteRateLimit() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwRouteRateLimitCreate,
-		Read:   resourceTencentCloudTseCngwRouteRateLimitRead,
-		Update: resourceTencentCloudTseCngwRouteRateLimitUpdate,
-		Delete: resourceTencentCloudTseCngwRouteRateLimitDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTseCngwRouteRateLimitCreate,
+		Read:     resourceTencentCloudTseCngwRouteRateLimitRead,
+		Update:   resourceTencentCloudTseCngwRouteRateLimitUpdate,
+		Delete:   resourceTencentCloudTseCngwRouteRateLimitDelete,
+		Importer: helper.IdSpec{"gateway_id", "route_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
@@ -112,6 +110,7 @@ func ResourceTencentCloudTseCngwRouteRateLimit() *schema.Resource {
 									},
 									"redis_password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Required:    true,
 										Description: "redis password, maybe null.",
 									},
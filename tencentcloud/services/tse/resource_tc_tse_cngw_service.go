@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudTseCngwService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwServiceCreate,
-		Read:   resourceTencentCloudTseCngwServiceRead,
-		Update: resourceTencentCloudTseCngwServiceUpdate,
-		Delete: resourceTencentCloudTseCngwServiceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTseCngwServiceCreate,
+		Read:     resourceTencentCloudTseCngwServiceRead,
+		Update:   resourceTencentCloudTseCngwServiceUpdate,
+		Delete:   resourceTencentCloudTseCngwServiceDelete,
+		Importer: helper.IdSpec{"gateway_id", "name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTseCngwServiceRateLimit() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwServiceRateLimitCreate,
-		Read:   resourceTencentCloudTseCngwServiceRateLimitRead,
-		Update: resourceTencentCloudTseCngwServiceRateLimitUpdate,
-		Delete: resourceTencentCloudTseCngwServiceRateLimitDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTseCngwServiceRateLimitCreate,
+		Read:     resourceTencentCloudTseCngwServiceRateLimitRead,
+		Update:   resourceTencentCloudTseCngwServiceRateLimitUpdate,
+		Delete:   resourceTencentCloudTseCngwServiceRateLimitDelete,
+		Importer: helper.IdSpec{"gateway_id", "name"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
@@ -112,6 +110,7 @@ func ResourceTencentCloudTseCngwServiceRateLimit() *schema.Resource {
 									},
 									"redis_password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Required:    true,
 										Description: "redis password, maybe null.",
 									},
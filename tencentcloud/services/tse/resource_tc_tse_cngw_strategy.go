@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudTseCngwStrategy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseCngwStrategyCreate,
-		Read:   resourceTencentCloudTseCngwStrategyRead,
-		Update: resourceTencentCloudTseCngwStrategyUpdate,
-		Delete: resourceTencentCloudTseCngwStrategyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTseCngwStrategyCreate,
+		Read:     resourceTencentCloudTseCngwStrategyRead,
+		Update:   resourceTencentCloudTseCngwStrategyUpdate,
+		Delete:   resourceTencentCloudTseCngwStrategyDelete,
+		Importer: helper.IdSpec{"gateway_id", "strategy_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
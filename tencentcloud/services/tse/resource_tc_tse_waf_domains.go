@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudTseWafDomains() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseWafDomainsCreate,
-		Read:   resourceTencentCloudTseWafDomainsRead,
-		Delete: resourceTencentCloudTseWafDomainsDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTseWafDomainsCreate,
+		Read:     resourceTencentCloudTseWafDomainsRead,
+		Delete:   resourceTencentCloudTseWafDomainsDelete,
+		Importer: helper.IdSpec{"gateway_id", "domain"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
 				Required:    true,
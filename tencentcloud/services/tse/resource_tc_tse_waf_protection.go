@@ -6,6 +6,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,10 +15,11 @@ import (
 
 func ResourceTencentCloudTseWafProtection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTseWafProtectionCreate,
-		Read:   resourceTencentCloudTseWafProtectionRead,
-		Update: resourceTencentCloudTseWafProtectionUpdate,
-		Delete: resourceTencentCloudTseWafProtectionDelete,
+		Create:   resourceTencentCloudTseWafProtectionCreate,
+		Read:     resourceTencentCloudTseWafProtectionRead,
+		Update:   resourceTencentCloudTseWafProtectionUpdate,
+		Delete:   resourceTencentCloudTseWafProtectionDelete,
+		Importer: helper.IdSpec{"gateway_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"gateway_id": {
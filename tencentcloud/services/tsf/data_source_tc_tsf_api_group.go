@@ -405,7 +405,7 @@ func dataSourceTencentCloudTsfApiGroupRead(d *schema.ResourceData, meta interfac
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tsfPageApiGroupInfoMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tsfPageApiGroupInfoMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -354,7 +354,7 @@ func dataSourceTencentCloudTsfApplicationRead(d *schema.ResourceData, meta inter
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tsfPageApplicationMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tsfPageApplicationMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
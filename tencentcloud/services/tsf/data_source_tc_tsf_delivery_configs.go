@@ -153,6 +153,7 @@ func DataSourceTencentCloudTsfDeliveryConfigs() *schema.Resource {
 									},
 									"password": {
 										Type:        schema.TypeString,
+										Sensitive:   true,
 										Computed:    true,
 										Description: "Password. Note: This field may return null, which means that no valid value was obtained.",
 									},
@@ -380,7 +381,7 @@ func dataSourceTencentCloudTsfDeliveryConfigsRead(d *schema.ResourceData, meta i
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), deliveryConfigBindGroupsMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), deliveryConfigBindGroupsMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
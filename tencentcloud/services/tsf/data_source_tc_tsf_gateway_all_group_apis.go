@@ -246,7 +246,7 @@ func dataSourceTencentCloudTsfGatewayAllGroupApisRead(d *schema.ResourceData, me
 	d.SetId(helper.DataResourceIdsHash([]string{gatewayDeployGroupId}))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), gatewayVoMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), gatewayVoMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
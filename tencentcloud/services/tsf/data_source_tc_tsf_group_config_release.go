@@ -525,7 +525,7 @@ func dataSourceTencentCloudTsfGroupConfigReleaseRead(d *schema.ResourceData, met
 	d.SetId(helper.DataResourceIdsHash([]string{groupId}))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), groupReleaseMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), groupReleaseMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -355,7 +355,7 @@ func dataSourceTencentCloudTsfGroupGatewaysRead(d *schema.ResourceData, meta int
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), tsfPageApiGroupInfoMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), tsfPageApiGroupInfoMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -247,7 +247,7 @@ func dataSourceTencentCloudTsfPodInstancesRead(d *schema.ResourceData, meta inte
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), groupPodResultMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), groupPodResultMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
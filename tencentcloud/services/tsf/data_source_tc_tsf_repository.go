@@ -199,7 +199,7 @@ func dataSourceTencentCloudTsfRepositoryRead(d *schema.ResourceData, meta interf
 	d.SetId(helper.DataResourceIdsHash(ids))
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), repositoryListMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), repositoryListMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTsfApiRateLimitRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApiRateLimitRuleCreate,
-		Read:   resourceTencentCloudTsfApiRateLimitRuleRead,
-		Update: resourceTencentCloudTsfApiRateLimitRuleUpdate,
-		Delete: resourceTencentCloudTsfApiRateLimitRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTsfApiRateLimitRuleCreate,
+		Read:     resourceTencentCloudTsfApiRateLimitRuleRead,
+		Update:   resourceTencentCloudTsfApiRateLimitRuleUpdate,
+		Delete:   resourceTencentCloudTsfApiRateLimitRuleDelete,
+		Importer: helper.IdSpec{"api_id", "rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"api_id": {
 				Required:    true,
@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudTsfApplication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationCreate,
-		Read:   resourceTencentCloudTsfApplicationRead,
-		Update: resourceTencentCloudTsfApplicationUpdate,
-		Delete: resourceTencentCloudTsfApplicationDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Create:   resourceTencentCloudTsfApplicationCreate,
+		Read:     resourceTencentCloudTsfApplicationRead,
+		Update:   resourceTencentCloudTsfApplicationUpdate,
+		Delete:   resourceTencentCloudTsfApplicationDelete,
+		Importer: helper.IdSpec{"application_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"application_name": {
 				Required:    true,
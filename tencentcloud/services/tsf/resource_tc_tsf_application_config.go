@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudTsfApplicationConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationConfigCreate,
-		Read:   resourceTencentCloudTsfApplicationConfigRead,
-		Update: resourceTencentCloudTsfApplicationConfigUpdate,
-		Delete: resourceTencentCloudTsfApplicationConfigDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Create:   resourceTencentCloudTsfApplicationConfigCreate,
+		Read:     resourceTencentCloudTsfApplicationConfigRead,
+		Update:   resourceTencentCloudTsfApplicationConfigUpdate,
+		Delete:   resourceTencentCloudTsfApplicationConfigDelete,
+		Importer: helper.IdSpec{"config_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"config_name": {
 				Required:    true,
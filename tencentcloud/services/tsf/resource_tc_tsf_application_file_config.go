@@ -15,9 +15,10 @@ import (
 
 func ResourceTencentCloudTsfApplicationFileConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationFileConfigCreate,
-		Read:   resourceTencentCloudTsfApplicationFileConfigRead,
-		Delete: resourceTencentCloudTsfApplicationFileConfigDelete,
+		Create:   resourceTencentCloudTsfApplicationFileConfigCreate,
+		Read:     resourceTencentCloudTsfApplicationFileConfigRead,
+		Delete:   resourceTencentCloudTsfApplicationFileConfigDelete,
+		Importer: helper.IdSpec{"config_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"config_name": {
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudTsfApplicationFileConfigRelease() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationFileConfigReleaseCreate,
-		Read:   resourceTencentCloudTsfApplicationFileConfigReleaseRead,
-		Delete: resourceTencentCloudTsfApplicationFileConfigReleaseDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTsfApplicationFileConfigReleaseCreate,
+		Read:     resourceTencentCloudTsfApplicationFileConfigReleaseRead,
+		Delete:   resourceTencentCloudTsfApplicationFileConfigReleaseDelete,
+		Importer: helper.IdSpec{"config_id", "group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"config_id": {
 				Required:    true,
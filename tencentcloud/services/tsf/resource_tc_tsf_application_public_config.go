@@ -15,12 +15,10 @@ import (
 
 func ResourceTencentCloudTsfApplicationPublicConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationPublicConfigCreate,
-		Read:   resourceTencentCloudTsfApplicationPublicConfigRead,
-		Delete: resourceTencentCloudTsfApplicationPublicConfigDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Create:   resourceTencentCloudTsfApplicationPublicConfigCreate,
+		Read:     resourceTencentCloudTsfApplicationPublicConfigRead,
+		Delete:   resourceTencentCloudTsfApplicationPublicConfigDelete,
+		Importer: helper.IdSpec{"config_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"config_name": {
 				Required:    true,
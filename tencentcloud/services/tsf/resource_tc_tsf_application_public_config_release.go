@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudTsfApplicationPublicConfigRelease() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationPublicConfigReleaseCreate,
-		Read:   resourceTencentCloudTsfApplicationPublicConfigReleaseRead,
-		Delete: resourceTencentCloudTsfApplicationPublicConfigReleaseDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTsfApplicationPublicConfigReleaseCreate,
+		Read:     resourceTencentCloudTsfApplicationPublicConfigReleaseRead,
+		Delete:   resourceTencentCloudTsfApplicationPublicConfigReleaseDelete,
+		Importer: helper.IdSpec{"config_id", "namespace_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"config_id": {
 				Required:    true,
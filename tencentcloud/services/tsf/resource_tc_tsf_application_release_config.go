@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudTsfApplicationReleaseConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfApplicationReleaseConfigCreate,
-		Read:   resourceTencentCloudTsfApplicationReleaseConfigRead,
-		Delete: resourceTencentCloudTsfApplicationReleaseConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTsfApplicationReleaseConfigCreate,
+		Read:     resourceTencentCloudTsfApplicationReleaseConfigRead,
+		Delete:   resourceTencentCloudTsfApplicationReleaseConfigDelete,
+		Importer: helper.IdSpec{"config_id", "group_id", "config_release_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"config_id": {
 				Required:    true,
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,12 +16,10 @@ import (
 
 func ResourceTencentCloudTsfBindApiGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfBindApiGroupCreate,
-		Read:   resourceTencentCloudTsfBindApiGroupRead,
-		Delete: resourceTencentCloudTsfBindApiGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTsfBindApiGroupCreate,
+		Read:     resourceTencentCloudTsfBindApiGroupRead,
+		Delete:   resourceTencentCloudTsfBindApiGroupDelete,
+		Importer: helper.IdSpec{"group_id", "gateway_deploy_group_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"gateway_deploy_group_id": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudTsfCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfClusterCreate,
-		Read:   resourceTencentCloudTsfClusterRead,
-		Update: resourceTencentCloudTsfClusterUpdate,
-		Delete: resourceTencentCloudTsfClusterDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Create:   resourceTencentCloudTsfClusterCreate,
+		Read:     resourceTencentCloudTsfClusterRead,
+		Update:   resourceTencentCloudTsfClusterUpdate,
+		Delete:   resourceTencentCloudTsfClusterDelete,
+		Importer: helper.IdSpec{"cluster_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Computed:    true,
@@ -119,6 +117,7 @@ func ResourceTencentCloudTsfCluster() *schema.Resource {
 			"kubernete_native_secret": {
 				Optional:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "native secret.",
 			},
 
@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudTsfConfigTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfConfigTemplateCreate,
-		Read:   resourceTencentCloudTsfConfigTemplateRead,
-		Update: resourceTencentCloudTsfConfigTemplateUpdate,
-		Delete: resourceTencentCloudTsfConfigTemplateDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Create:   resourceTencentCloudTsfConfigTemplateCreate,
+		Read:     resourceTencentCloudTsfConfigTemplateRead,
+		Update:   resourceTencentCloudTsfConfigTemplateUpdate,
+		Delete:   resourceTencentCloudTsfConfigTemplateDelete,
+		Importer: helper.IdSpec{"template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"config_template_name": {
 				Required:    true,
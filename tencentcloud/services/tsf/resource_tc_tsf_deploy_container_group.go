@@ -16,10 +16,11 @@ import (
 
 func ResourceTencentCloudTsfDeployContainerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfDeployContainerGroupCreate,
-		Read:   resourceTencentCloudTsfDeployContainerGroupRead,
-		Update: resourceTencentCloudTsfDeployContainerGroupUpdate,
-		Delete: resourceTencentCloudTsfDeployContainerGroupDelete,
+		Create:   resourceTencentCloudTsfDeployContainerGroupCreate,
+		Read:     resourceTencentCloudTsfDeployContainerGroupRead,
+		Update:   resourceTencentCloudTsfDeployContainerGroupUpdate,
+		Delete:   resourceTencentCloudTsfDeployContainerGroupDelete,
+		Importer: helper.IdSpec{"group_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
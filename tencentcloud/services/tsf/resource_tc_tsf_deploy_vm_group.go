@@ -18,6 +18,7 @@ func ResourceTencentCloudTsfDeployVmGroup() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTencentCloudTsfDeployVmGroupCreate,
 		Read:   resourceTencentCloudTsfDeployVmGroupRead,
+		Update: resourceTencentCloudTsfDeployVmGroupUpdate,
 		Delete: resourceTencentCloudTsfDeployVmGroupDelete,
 
 		Schema: map[string]*schema.Schema{
@@ -381,6 +382,10 @@ func ResourceTencentCloudTsfDeployVmGroup() *schema.Resource {
 					},
 				},
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
+
+			tccommon.OPERATION_WAIT_FOR_COMPLETION: tccommon.OperationWaitForCompletionSchema(),
 		},
 	}
 }
@@ -599,29 +604,31 @@ func resourceTencentCloudTsfDeployVmGroupCreate(d *schema.ResourceData, meta int
 
 	d.SetId(groupId)
 
-	service := TsfService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
-	err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
-		groupInfo, err := service.DescribeTsfStartGroupById(ctx, groupId)
-		if err != nil {
-			return tccommon.RetryError(err)
-		}
-		if groupInfo == nil {
-			err = fmt.Errorf("group %s not exists", groupId)
+	if tccommon.OperationWaitForCompletion(d) {
+		service := TsfService{client: meta.(tccommon.ProviderMeta).GetAPIV3Conn()}
+		err = resource.Retry(tccommon.WriteRetryTimeout, func() *resource.RetryError {
+			groupInfo, err := service.DescribeTsfStartGroupById(ctx, groupId)
+			if err != nil {
+				return tccommon.RetryError(err)
+			}
+			if groupInfo == nil {
+				err = fmt.Errorf("group %s not exists", groupId)
+				return resource.NonRetryableError(err)
+			}
+			if *groupInfo.GroupStatus == "Running" {
+				return nil
+			}
+			if *groupInfo.GroupStatus == "Waiting" || *groupInfo.GroupStatus == "Updating" {
+				return resource.RetryableError(fmt.Errorf("deploy vm group status is %s", *groupInfo.GroupStatus))
+			}
+			err = fmt.Errorf("deploy vm group status is %v, we won't wait for it finish", *groupInfo.GroupStatus)
 			return resource.NonRetryableError(err)
-		}
-		if *groupInfo.GroupStatus == "Running" {
-			return nil
-		}
-		if *groupInfo.GroupStatus == "Waiting" || *groupInfo.GroupStatus == "Updating" {
-			return resource.RetryableError(fmt.Errorf("deploy vm group status is %s", *groupInfo.GroupStatus))
-		}
-		err = fmt.Errorf("deploy vm group status is %v, we won't wait for it finish", *groupInfo.GroupStatus)
-		return resource.NonRetryableError(err)
-	})
+		})
 
-	if err != nil {
-		log.Printf("[CRITAL]%s deploy vm group, reason:%s\n ", logId, err.Error())
-		return err
+		if err != nil {
+			log.Printf("[CRITAL]%s deploy vm group, reason:%s\n ", logId, err.Error())
+			return err
+		}
 	}
 
 	return resourceTencentCloudTsfDeployVmGroupRead(d, meta)
@@ -849,6 +856,14 @@ func resourceTencentCloudTsfDeployVmGroupRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
+func resourceTencentCloudTsfDeployVmGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	defer tccommon.LogElapsed("resource.tencentcloud_tsf_deploy_vm_group.update")()
+	defer tccommon.InconsistentCheck(d, meta)()
+
+	// only `wait_for_completion` can change without replacing the resource, and it only applies to create
+	return resourceTencentCloudTsfDeployVmGroupRead(d, meta)
+}
+
 func resourceTencentCloudTsfDeployVmGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	defer tccommon.LogElapsed("resource.tencentcloud_tsf_deploy_vm_group.delete")()
 	defer tccommon.InconsistentCheck(d, meta)()
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudTsfInstancesAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfInstancesAttachmentCreate,
-		Read:   resourceTencentCloudTsfInstancesAttachmentRead,
-		Delete: resourceTencentCloudTsfInstancesAttachmentDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Create:   resourceTencentCloudTsfInstancesAttachmentCreate,
+		Read:     resourceTencentCloudTsfInstancesAttachmentRead,
+		Delete:   resourceTencentCloudTsfInstancesAttachmentDelete,
+		Importer: helper.IdSpec{"cluster_id", "instance_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Required:    true,
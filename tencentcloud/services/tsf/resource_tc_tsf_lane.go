@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudTsfLane() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfLaneCreate,
-		Read:   resourceTencentCloudTsfLaneRead,
-		Update: resourceTencentCloudTsfLaneUpdate,
-		Delete: resourceTencentCloudTsfLaneDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Create:   resourceTencentCloudTsfLaneCreate,
+		Read:     resourceTencentCloudTsfLaneRead,
+		Update:   resourceTencentCloudTsfLaneUpdate,
+		Delete:   resourceTencentCloudTsfLaneDelete,
+		Importer: helper.IdSpec{"lane_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"lane_id": {
 				Type:        schema.TypeString,
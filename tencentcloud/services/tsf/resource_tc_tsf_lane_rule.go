@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudTsfLaneRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfLaneRuleCreate,
-		Read:   resourceTencentCloudTsfLaneRuleRead,
-		Update: resourceTencentCloudTsfLaneRuleUpdate,
-		Delete: resourceTencentCloudTsfLaneRuleDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Create:   resourceTencentCloudTsfLaneRuleCreate,
+		Read:     resourceTencentCloudTsfLaneRuleRead,
+		Update:   resourceTencentCloudTsfLaneRuleUpdate,
+		Delete:   resourceTencentCloudTsfLaneRuleDelete,
+		Importer: helper.IdSpec{"rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"rule_id": {
 				Computed:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudTsfMicroservice() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfMicroserviceCreate,
-		Read:   resourceTencentCloudTsfMicroserviceRead,
-		Update: resourceTencentCloudTsfMicroserviceUpdate,
-		Delete: resourceTencentCloudTsfMicroserviceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTsfMicroserviceCreate,
+		Read:     resourceTencentCloudTsfMicroserviceRead,
+		Update:   resourceTencentCloudTsfMicroserviceUpdate,
+		Delete:   resourceTencentCloudTsfMicroserviceDelete,
+		Importer: helper.IdSpec{"namespace_id", "microservice_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"namespace_id": {
 				Required:    true,
@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudTsfNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfNamespaceCreate,
-		Read:   resourceTencentCloudTsfNamespaceRead,
-		Update: resourceTencentCloudTsfNamespaceUpdate,
-		Delete: resourceTencentCloudTsfNamespaceDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Create:   resourceTencentCloudTsfNamespaceCreate,
+		Read:     resourceTencentCloudTsfNamespaceRead,
+		Update:   resourceTencentCloudTsfNamespaceUpdate,
+		Delete:   resourceTencentCloudTsfNamespaceDelete,
+		Importer: helper.IdSpec{"namespace_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"namespace_name": {
 				Required:    true,
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	tccommon "github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/common"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,10 +15,11 @@ import (
 
 func ResourceTencentCloudTsfOperateContainerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfOperateContainerGroupCreate,
-		Read:   resourceTencentCloudTsfOperateContainerGroupRead,
-		Update: resourceTencentCloudTsfOperateContainerGroupUpdate,
-		Delete: resourceTencentCloudTsfOperateContainerGroupDelete,
+		Create:   resourceTencentCloudTsfOperateContainerGroupCreate,
+		Read:     resourceTencentCloudTsfOperateContainerGroupRead,
+		Update:   resourceTencentCloudTsfOperateContainerGroupUpdate,
+		Delete:   resourceTencentCloudTsfOperateContainerGroupDelete,
+		Importer: helper.IdSpec{"group_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -27,6 +27,8 @@ func ResourceTencentCloudTsfReleaseApiGroup() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "api group Id.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudTsfTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfTaskCreate,
-		Read:   resourceTencentCloudTsfTaskRead,
-		Update: resourceTencentCloudTsfTaskUpdate,
-		Delete: resourceTencentCloudTsfTaskDelete,
-		// Importer: &schema.ResourceImporter{
-		// 	State: schema.ImportStatePassthrough,
-		// },
+		Create:   resourceTencentCloudTsfTaskCreate,
+		Read:     resourceTencentCloudTsfTaskRead,
+		Update:   resourceTencentCloudTsfTaskUpdate,
+		Delete:   resourceTencentCloudTsfTaskDelete,
+		Importer: helper.IdSpec{"task_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"task_id": {
 				Computed:    true,
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudTsfUnitNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudTsfUnitNamespaceCreate,
-		Read:   resourceTencentCloudTsfUnitNamespaceRead,
-		Delete: resourceTencentCloudTsfUnitNamespaceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudTsfUnitNamespaceCreate,
+		Read:     resourceTencentCloudTsfUnitNamespaceRead,
+		Delete:   resourceTencentCloudTsfUnitNamespaceDelete,
+		Importer: helper.IdSpec{"gateway_instance_id", "namespace_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"gateway_instance_id": {
 				Required:    true,
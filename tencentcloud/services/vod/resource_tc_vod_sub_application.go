@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudVodSubApplication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVodSubApplicationCreate,
-		Read:   resourceTencentCloudVodSubApplicationRead,
-		Update: resourceTencentCloudVodSubApplicationUpdate,
-		Delete: resourceTencentCloudVodSubApplicationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudVodSubApplicationCreate,
+		Read:     resourceTencentCloudVodSubApplicationRead,
+		Update:   resourceTencentCloudVodSubApplicationUpdate,
+		Delete:   resourceTencentCloudVodSubApplicationDelete,
+		Importer: helper.IdSpec{"sub_app_name", "sub_app_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudVodTranscodeTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVodTranscodeTemplateCreate,
-		Read:   resourceTencentCloudVodTranscodeTemplateRead,
-		Update: resourceTencentCloudVodTranscodeTemplateUpdate,
-		Delete: resourceTencentCloudVodTranscodeTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudVodTranscodeTemplateCreate,
+		Read:     resourceTencentCloudVodTranscodeTemplateRead,
+		Update:   resourceTencentCloudVodTranscodeTemplateUpdate,
+		Delete:   resourceTencentCloudVodTranscodeTemplateDelete,
+		Importer: helper.IdSpec{"[sub_app_id]", "definition"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"container": {
 				Required:    true,
@@ -19,13 +19,11 @@ import (
 
 func ResourceTencentCloudVodWatermarkTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVodWatermarkTemplateCreate,
-		Read:   resourceTencentCloudVodWatermarkTemplateRead,
-		Update: resourceTencentCloudVodWatermarkTemplateUpdate,
-		Delete: resourceTencentCloudVodWatermarkTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudVodWatermarkTemplateCreate,
+		Read:     resourceTencentCloudVodWatermarkTemplateRead,
+		Update:   resourceTencentCloudVodWatermarkTemplateUpdate,
+		Delete:   resourceTencentCloudVodWatermarkTemplateDelete,
+		Importer: helper.IdSpec{"[sub_app_id]", "definition"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Required:    true,
@@ -435,7 +435,7 @@ func dataSourceTencentCloudClassicElasticPublicIpv6sRead(d *schema.ResourceData,
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), addressSetList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), addressSetList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
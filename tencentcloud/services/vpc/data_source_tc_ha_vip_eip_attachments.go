@@ -104,7 +104,7 @@ func dataSourceTencentCloudHaVipEipAttachmentsRead(d *schema.ResourceData, meta
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), haVipEipAttachmentList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), haVipEipAttachmentList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -16,7 +16,7 @@ func TestAccTencentCloudHaVipEipAttachmentsDataSource_basic(t *testing.T) {
 		CheckDestroy: testAccCheckHaVipEipAttachmentDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccHaVipEipAttachmentsDataSource_basic,
+				Config: tcacctest.FixtureVpcVariable(t) + testAccHaVipEipAttachmentsDataSource_basic,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckHaVipEipAttachmentExists("tencentcloud_ha_vip_eip_attachment.ha_vip_eip_attachment"),
 					resource.TestCheckResourceAttr("data.tencentcloud_ha_vip_eip_attachments.ha_vip_eip_attachments", "ha_vip_eip_attachment_list.#", "1"),
@@ -27,7 +27,7 @@ func TestAccTencentCloudHaVipEipAttachmentsDataSource_basic(t *testing.T) {
 	})
 }
 
-const testAccHaVipEipAttachmentsDataSource_basic = tcacctest.DefaultVpcVariable + `
+const testAccHaVipEipAttachmentsDataSource_basic = `
 #Create EIP
 resource "tencentcloud_eip" "eip" {
   name = "havip_eip"
@@ -205,7 +205,7 @@ func dataSourceTencentCloudHaVipsRead(d *schema.ResourceData, meta interface{})
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), haVipList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), haVipList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -15,7 +15,7 @@ func TestAccTencentCloudHaVipsDataSource(t *testing.T) {
 		Providers: tcacctest.AccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTencentCloudHaVipsDataSourceConfig_basic,
+				Config: tcacctest.FixtureVpcVariable(t) + testAccTencentCloudHaVipsDataSourceConfig_basic,
 				Check: resource.ComposeTestCheckFunc(
 					tcacctest.AccCheckTencentCloudDataSourceID("data.tencentcloud_ha_vips.havips"),
 					resource.TestCheckResourceAttr("data.tencentcloud_ha_vips.havips", "ha_vip_list.#", "1"),
@@ -31,7 +31,7 @@ func TestAccTencentCloudHaVipsDataSource(t *testing.T) {
 	})
 }
 
-const testAccTencentCloudHaVipsDataSourceConfig_basic = tcacctest.DefaultVpcVariable + `
+const testAccTencentCloudHaVipsDataSourceConfig_basic = `
 resource "tencentcloud_ha_vip" "havip" {
   name      = "terraform_test"
   vpc_id    = var.vpc_id
@@ -120,7 +120,7 @@ func dataSourceTencentCloudProtocolTemplateGroupsRead(d *schema.ResourceData, me
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), templateGroupList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), templateGroupList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -120,7 +120,7 @@ func dataSourceTencentCloudProtocolTemplatesRead(d *schema.ResourceData, meta in
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), templateList); e != nil {
+		if e := tccommon.WriteToFile(output.(string), templateList, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
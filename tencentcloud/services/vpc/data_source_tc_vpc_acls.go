@@ -265,7 +265,7 @@ func dataSourceTencentCloudVpcACLRead(d *schema.ResourceData, meta interface{})
 
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if err := tccommon.WriteToFile(output.(string), aclList); err != nil {
+		if err := tccommon.WriteToFile(output.(string), aclList, tccommon.GetResultOutputOptions(d)); err != nil {
 			return err
 		}
 	}
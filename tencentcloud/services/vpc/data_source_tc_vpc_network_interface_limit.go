@@ -133,7 +133,7 @@ func dataSourceTencentCloudVpcNetworkInterfaceLimitRead(d *schema.ResourceData,
 	d.SetId(instanceId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), limitMap); e != nil {
+		if e := tccommon.WriteToFile(output.(string), limitMap, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -21,7 +21,7 @@ func TestAccTencentCloudHaVipEipAttachment_basic(t *testing.T) {
 		CheckDestroy: testAccCheckHaVipEipAttachmentDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccHaVipEipAttachment_basic,
+				Config: tcacctest.FixtureVpcVariable(t) + testAccHaVipEipAttachment_basic,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckHaVipEipAttachmentExists("tencentcloud_ha_vip_eip_attachment.ha_vip_eip_attachment_basic"),
 					resource.TestCheckResourceAttrSet("tencentcloud_ha_vip_eip_attachment.ha_vip_eip_attachment_basic", "havip_id"),
@@ -79,7 +79,7 @@ func testAccCheckHaVipEipAttachmentExists(n string) resource.TestCheckFunc {
 	}
 }
 
-const testAccHaVipEipAttachment_basic = tcacctest.DefaultVpcVariable + `
+const testAccHaVipEipAttachment_basic = `
 #Create EIP
 resource "tencentcloud_eip" "eip" {
   name = "havip_eip"
@@ -92,7 +92,7 @@ func TestAccTencentCloudHaVip_basic(t *testing.T) {
 		CheckDestroy: testAccCheckHaVipDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccHaVipConfig,
+				Config: tcacctest.FixtureVpcVariable(t) + testAccHaVipConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckHaVipExists("tencentcloud_ha_vip.havip"),
 					resource.TestCheckResourceAttr("tencentcloud_ha_vip.havip", "name", "terraform_test"),
@@ -104,7 +104,7 @@ func TestAccTencentCloudHaVip_basic(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccHaVipConfigUpdate,
+				Config: tcacctest.FixtureVpcVariable(t) + testAccHaVipConfigUpdate,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckHaVipExists("tencentcloud_ha_vip.havip"),
 					resource.TestCheckResourceAttr("tencentcloud_ha_vip.havip", "name", "terraform_update"),
@@ -127,7 +127,7 @@ func TestAccTencentCloudHaVip_assigned(t *testing.T) {
 		CheckDestroy: testAccCheckHaVipDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccHaVipConfigAssigned,
+				Config: tcacctest.FixtureVpcVariable(t) + testAccHaVipConfigAssigned,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckHaVipExists("tencentcloud_ha_vip.havip"),
 					resource.TestCheckResourceAttr("tencentcloud_ha_vip.havip", "name", "terraform_test"),
@@ -225,14 +225,14 @@ func testAccCheckHaVipExists(n string) resource.TestCheckFunc {
 	}
 }
 
-const testAccHaVipConfig = tcacctest.DefaultVpcVariable + `
+const testAccHaVipConfig = `
 resource "tencentcloud_ha_vip" "havip" {
   name      = "terraform_test"
   vpc_id    = var.vpc_id
   subnet_id = var.subnet_id
 }
 `
-const testAccHaVipConfigUpdate = tcacctest.DefaultVpcVariable + `
+const testAccHaVipConfigUpdate = `
 resource "tencentcloud_ha_vip" "havip" {
   name      = "terraform_update"
   vpc_id    = var.vpc_id
@@ -240,7 +240,7 @@ resource "tencentcloud_ha_vip" "havip" {
 }
 `
 
-const testAccHaVipConfigAssigned = tcacctest.DefaultVpcVariable + `
+const testAccHaVipConfigAssigned = `
 resource "tencentcloud_ha_vip" "havip" {
   name      = "terraform_test"
   vpc_id    = var.vpc_id
@@ -17,14 +17,12 @@ import (
 
 func ResourceTencentCloudIpv6AddressBandwidth() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudIpv6AddressBandwidthCreate,
-		Read:   resourceTencentCloudIpv6AddressBandwidthRead,
-		Update: resourceTencentCloudIpv6AddressBandwidthUpdate,
-		Delete: resourceTencentCloudIpv6AddressBandwidthDelete,
+		Create:   resourceTencentCloudIpv6AddressBandwidthCreate,
+		Read:     resourceTencentCloudIpv6AddressBandwidthRead,
+		Update:   resourceTencentCloudIpv6AddressBandwidthUpdate,
+		Delete:   resourceTencentCloudIpv6AddressBandwidthDelete,
+		Importer: helper.IdSpec{"ipv6_address_id"}.Importer(),
 		// it can support import because
-		//Importer: &schema.ResourceImporter{
-		//	State: schema.ImportStatePassthrough,
-		//},
 		Schema: map[string]*schema.Schema{
 			"ipv6_address": {
 				Required:    true,
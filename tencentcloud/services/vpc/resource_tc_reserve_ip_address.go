@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudReserveIpAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudReserveIpAddressCreate,
-		Read:   resourceTencentCloudReserveIpAddressRead,
-		Update: resourceTencentCloudReserveIpAddressUpdate,
-		Delete: resourceTencentCloudReserveIpAddressDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudReserveIpAddressCreate,
+		Read:     resourceTencentCloudReserveIpAddressRead,
+		Update:   resourceTencentCloudReserveIpAddressUpdate,
+		Delete:   resourceTencentCloudReserveIpAddressDelete,
+		Importer: helper.IdSpec{"vpc_id", "reserve_ip_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:        schema.TypeString,
@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudVpcBandwidthPackageAttachment() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceTencentCloudVpcBandwidthPackageAttachmentRead,
-		Create: resourceTencentCloudVpcBandwidthPackageAttachmentCreate,
-		Delete: resourceTencentCloudVpcBandwidthPackageAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Read:     resourceTencentCloudVpcBandwidthPackageAttachmentRead,
+		Create:   resourceTencentCloudVpcBandwidthPackageAttachmentCreate,
+		Delete:   resourceTencentCloudVpcBandwidthPackageAttachmentDelete,
+		Importer: helper.IdSpec{"bandwidth_package_id", "resource_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"resource_id": {
 				Type:        schema.TypeString,
@@ -85,12 +85,10 @@ import (
 
 func ResourceTencentCloudVpcClassicLinkAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcClassicLinkAttachmentCreate,
-		Read:   resourceTencentCloudVpcClassicLinkAttachmentRead,
-		Delete: resourceTencentCloudVpcClassicLinkAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudVpcClassicLinkAttachmentCreate,
+		Read:     resourceTencentCloudVpcClassicLinkAttachmentRead,
+		Delete:   resourceTencentCloudVpcClassicLinkAttachmentDelete,
+		Importer: helper.IdSpec{"vpc_id", "instance_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Required:    true,
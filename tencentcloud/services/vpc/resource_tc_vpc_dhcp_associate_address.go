@@ -63,12 +63,10 @@ import (
 
 func ResourceTencentCloudVpcDhcpAssociateAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcDhcpAssociateAddressCreate,
-		Read:   resourceTencentCloudVpcDhcpAssociateAddressRead,
-		Delete: resourceTencentCloudVpcDhcpAssociateAddressDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudVpcDhcpAssociateAddressCreate,
+		Read:     resourceTencentCloudVpcDhcpAssociateAddressRead,
+		Delete:   resourceTencentCloudVpcDhcpAssociateAddressDelete,
+		Importer: helper.IdSpec{"dhcp_ip_id", "address_ip"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"dhcp_ip_id": {
 				Required:    true,
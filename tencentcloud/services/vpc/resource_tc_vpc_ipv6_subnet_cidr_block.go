@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudVpcIpv6SubnetCidrBlock() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcIpv6SubnetCidrBlockCreate,
-		Read:   resourceTencentCloudVpcIpv6SubnetCidrBlockRead,
-		Delete: resourceTencentCloudVpcIpv6SubnetCidrBlockDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudVpcIpv6SubnetCidrBlockCreate,
+		Read:     resourceTencentCloudVpcIpv6SubnetCidrBlockRead,
+		Delete:   resourceTencentCloudVpcIpv6SubnetCidrBlockDelete,
+		Importer: helper.IdSpec{"vpc_id", "subnet_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Required:    true,
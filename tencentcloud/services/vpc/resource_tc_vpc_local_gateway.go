@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudVpcLocalGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcLocalGatewayCreate,
-		Read:   resourceTencentCloudVpcLocalGatewayRead,
-		Update: resourceTencentCloudVpcLocalGatewayUpdate,
-		Delete: resourceTencentCloudVpcLocalGatewayDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudVpcLocalGatewayCreate,
+		Read:     resourceTencentCloudVpcLocalGatewayRead,
+		Update:   resourceTencentCloudVpcLocalGatewayUpdate,
+		Delete:   resourceTencentCloudVpcLocalGatewayDelete,
+		Importer: helper.IdSpec{"cdc_id", "local_gateway_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"local_gateway_name": {
 				Required:    true,
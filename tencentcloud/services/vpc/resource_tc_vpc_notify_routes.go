@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudVpcNotifyRoutes() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpcNotifyRoutesCreate,
-		Read:   resourceTencentCloudVpcNotifyRoutesRead,
-		Delete: resourceTencentCloudVpcNotifyRoutesDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudVpcNotifyRoutesCreate,
+		Read:     resourceTencentCloudVpcNotifyRoutesRead,
+		Delete:   resourceTencentCloudVpcNotifyRoutesDelete,
+		Importer: helper.IdSpec{"route_table_id", "route_item_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
 				Required:    true,
@@ -23,6 +23,8 @@ func ResourceTencentCloudVpcPeerConnectAcceptOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Peer connection unique ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
@@ -23,6 +23,8 @@ func ResourceTencentCloudVpcPeerConnectRejectOperation() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "Peer connection unique ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
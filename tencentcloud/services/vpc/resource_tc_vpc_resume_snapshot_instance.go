@@ -38,6 +38,8 @@ func ResourceTencentCloudVpcResumeSnapshotInstance() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "InstanceId.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
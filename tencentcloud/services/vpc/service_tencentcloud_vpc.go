@@ -22,7 +22,9 @@ import (
 	vpc "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/vpc/v20170312"
 
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/connectivity"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/batcher"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/helper"
+	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/internal/paginator"
 	"github.com/tencentcloudstack/terraform-provider-tencentcloud/tencentcloud/ratelimit"
 )
 
@@ -359,9 +361,6 @@ func (me *VpcService) DescribeVpcs(ctx context.Context,
 	infos = make([]VpcBasicInfo, 0, 100)
 
 	var (
-		offset  = 0
-		limit   = 100
-		total   = -1
 		hasVpc  = map[string]bool{}
 		filters []*vpc.Filter
 	)
@@ -394,52 +393,46 @@ func (me *VpcService) DescribeVpcs(ctx context.Context,
 		request.Filters = filters
 	}
 
-getMoreData:
-
-	if total >= 0 {
-		if offset >= total {
-			return
-		}
-	}
-	var strLimit = fmt.Sprintf("%d", limit)
-	request.Limit = &strLimit
-
-	var strOffset = fmt.Sprintf("%d", offset)
-	request.Offset = &strOffset
-	var response *vpc.DescribeVpcsResponse
 	var iacExtInfo connectivity.IacExtInfo
 	iacExtInfo.InstanceId = vpcId
-	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		var result *vpc.DescribeVpcsResponse
-		var err error
-		if vpcId != "" {
-			result, err = me.client.UseVpcClient(iacExtInfo).DescribeVpcs(request)
-		} else {
-			result, err = me.client.UseVpcClient().DescribeVpcs(request)
-		}
+	vpcs, err := paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.Vpc], error) {
+		request.Offset = helper.String(helper.Int64ToStr(offset))
+		request.Limit = helper.String(helper.Int64ToStr(limit))
+		var response *vpc.DescribeVpcsResponse
+		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			var result *vpc.DescribeVpcsResponse
+			var err error
+			if vpcId != "" {
+				ratelimit.Check(request.GetAction())
+				result, err = me.client.UseVpcClient(iacExtInfo).DescribeVpcs(request)
+			} else {
+				// list queries are shared by data sources within a run, single vpc reads always go to the API
+				var cached interface{}
+				cached, err = me.client.CachedDescribe(request, func() (interface{}, error) {
+					ratelimit.Check(request.GetAction())
+					return me.client.UseVpcClient().DescribeVpcs(request)
+				})
+				if err == nil {
+					result = cached.(*vpc.DescribeVpcsResponse)
+				}
+			}
 
-		if err != nil {
-			return tccommon.RetryError(err, tccommon.InternalError)
+			if err != nil {
+				return tccommon.RetryError(err, tccommon.InternalError)
+			}
+			response = result
+			return nil
+		}); err != nil {
+			log.Printf("[CRITAL]%s read vpc failed, reason: %v", logId, err)
+			return nil, err
 		}
-		response = result
-		return nil
-	}); err != nil {
-		log.Printf("[CRITAL]%s read vpc failed, reason: %v", logId, err)
+		return &paginator.Page[*vpc.Vpc]{Items: response.Response.VpcSet}, nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	if total < 0 {
-		total = int(*response.Response.TotalCount)
-	}
-
-	if len(response.Response.VpcSet) > 0 {
-		offset += limit
-	} else {
-		// get empty VpcInfo, we're done
-		return
-	}
-	for _, item := range response.Response.VpcSet {
+	for _, item := range vpcs {
 		var basicInfo VpcBasicInfo
 		basicInfo.cidr = *item.CidrBlock
 		basicInfo.createTime = *item.CreatedTime
@@ -477,8 +470,7 @@ getMoreData:
 
 		infos = append(infos, basicInfo)
 	}
-	goto getMoreData
-
+	return
 }
 func (me *VpcService) DescribeSubnet(ctx context.Context,
 	subnetId string,
@@ -518,9 +510,6 @@ func (me *VpcService) DescribeSubnets(ctx context.Context,
 	}()
 
 	var (
-		offset    = 0
-		limit     = 100
-		total     = -1
 		hasSubnet = map[string]bool{}
 		filters   []*vpc.Filter
 	)
@@ -564,44 +553,31 @@ func (me *VpcService) DescribeSubnets(ctx context.Context,
 		request.Filters = filters
 	}
 
-getMoreData:
-	if total >= 0 {
-		if offset >= total {
-			return
-		}
-	}
-	var strLimit = fmt.Sprintf("%d", limit)
-	request.Limit = &strLimit
-
-	var strOffset = fmt.Sprintf("%d", offset)
-	request.Offset = &strOffset
-	var response *vpc.DescribeSubnetsResponse
-	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-		result, err := me.client.UseVpcClient().DescribeSubnets(request)
-		if err != nil {
-			return tccommon.RetryError(err, tccommon.InternalError)
+	subnets, err := paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.Subnet], error) {
+		request.Offset = helper.String(helper.Int64ToStr(offset))
+		request.Limit = helper.String(helper.Int64ToStr(limit))
+		var response *vpc.DescribeSubnetsResponse
+		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+			ratelimit.Check(request.GetAction())
+			result, err := me.client.UseVpcClient().DescribeSubnets(request)
+			if err != nil {
+				return tccommon.RetryError(err, tccommon.InternalError)
+			}
+			response = result
+			return nil
+		}); err != nil {
+			log.Printf("[CRITAL]%s read subnets failed, reason: %v", logId, err)
+			return nil, err
 		}
-		response = result
-		return nil
-	}); err != nil {
-		log.Printf("[CRITAL]%s read subnets failed, reason: %v", logId, err)
+		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		return &paginator.Page[*vpc.Subnet]{Items: response.Response.SubnetSet}, nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
-
-	if total < 0 {
-		total = int(*response.Response.TotalCount)
-	}
 
-	if len(response.Response.SubnetSet) > 0 {
-		offset += limit
-	} else {
-		// get empty subnet, we're done
-		return
-	}
-	for _, item := range response.Response.SubnetSet {
+	for _, item := range subnets {
 		var basicInfo VpcSubnetBasicInfo
 
 		basicInfo.cidr = *item.CidrBlock
@@ -625,7 +601,7 @@ getMoreData:
 		hasSubnet[basicInfo.subnetId] = true
 		infos = append(infos, basicInfo)
 	}
-	goto getMoreData
+	return
 }
 
 func (me *VpcService) ModifyVpcAttribute(ctx context.Context, vpcId, name string, isMulticast bool, dnsServers []string) (errRet error) {
@@ -875,9 +851,6 @@ func (me *VpcService) DescribeRouteTables(ctx context.Context,
 	}()
 
 	infos = make([]VpcRouteTableBasicInfo, 0, 100)
-	var offset = 0
-	var limit = 100
-	var total = -1
 	var hasTableMap = map[string]bool{}
 
 	var filters []*vpc.Filter
@@ -903,38 +876,24 @@ func (me *VpcService) DescribeRouteTables(ctx context.Context,
 		request.Filters = filters
 	}
 
-getMoreData:
-	if total >= 0 {
-		if offset >= total {
-			return
+	routeTables, err := paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.RouteTable], error) {
+		request.Offset = helper.String(helper.Int64ToStr(offset))
+		request.Limit = helper.String(helper.Int64ToStr(limit))
+		ratelimit.Check(request.GetAction())
+		response, err := me.client.UseVpcClient().DescribeRouteTables(request)
+		if err != nil {
+			return nil, err
 		}
-	}
-	var strLimit = fmt.Sprintf("%d", limit)
-	request.Limit = &strLimit
-
-	var strOffset = fmt.Sprintf("%d", offset)
-	request.Offset = &strOffset
-	ratelimit.Check(request.GetAction())
-
-	response, err := me.client.UseVpcClient().DescribeRouteTables(request)
+		log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
+			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
+		return &paginator.Page[*vpc.RouteTable]{Items: response.Response.RouteTableSet}, nil
+	})
 	if err != nil {
 		errRet = err
 		return
 	}
-	log.Printf("[DEBUG]%s api[%s] , request body [%s], response body[%s]\n",
-		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
-
-	if total < 0 {
-		total = int(*response.Response.TotalCount)
-	}
 
-	if len(response.Response.RouteTableSet) > 0 {
-		offset += limit
-	} else {
-		// get empty Vpcinfo, we're done
-		return
-	}
-	for _, item := range response.Response.RouteTableSet {
+	for _, item := range routeTables {
 		var basicInfo VpcRouteTableBasicInfo
 		basicInfo.createTime = *item.CreatedTime
 		basicInfo.isDefault = *item.Main
@@ -968,8 +927,7 @@ getMoreData:
 		hasTableMap[basicInfo.routeTableId] = true
 		infos = append(infos, basicInfo)
 	}
-	goto getMoreData
-
+	return
 }
 
 func (me *VpcService) CreateRouteTable(ctx context.Context, name, vpcId string, tags map[string]string) (routeTableId string, errRet error) {
@@ -1320,31 +1278,16 @@ func (me *VpcService) CreateSecurityGroup(ctx context.Context, name, desc string
 func (me *VpcService) DescribeSecurityGroup(ctx context.Context, id string) (sg *vpc.SecurityGroup, err error) {
 	logId := tccommon.GetLogId(ctx)
 
-	request := vpc.NewDescribeSecurityGroupsRequest()
-	request.SecurityGroupIds = []*string{&id}
-
 	if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
-		ratelimit.Check(request.GetAction())
-
-		response, err := me.client.UseVpcClient().DescribeSecurityGroups(request)
+		result, err := batcher.Shared(me.client, "vpc.DescribeSecurityGroups", me.describeSecurityGroupsByIds).Get(ctx, id)
 		if err != nil {
-			if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
-				if sdkError.Code == "ResourceNotFound" {
-					return nil
-				}
-			}
-
-			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
-				logId, request.GetAction(), request.ToJsonString(), err)
 			return tccommon.RetryError(err, tccommon.InternalError)
 		}
 
-		if len(response.Response.SecurityGroupSet) == 0 {
-			return nil
+		if result != nil {
+			sg = result.(*vpc.SecurityGroup)
 		}
 
-		sg = response.Response.SecurityGroupSet[0]
-
 		return nil
 	}); err != nil {
 		log.Printf("[CRITAL]%s read security group failed, reason: %v", logId, err)
@@ -1354,6 +1297,40 @@ func (me *VpcService) DescribeSecurityGroup(ctx context.Context, id string) (sg
 	return
 }
 
+// describeSecurityGroupsByIds backs DescribeSecurityGroup, describing every coalesced security group id in one call.
+func (me *VpcService) describeSecurityGroupsByIds(ctx context.Context, ids []string) (sgs map[string]interface{}, errRet error) {
+	logId := tccommon.GetLogId(ctx)
+
+	request := vpc.NewDescribeSecurityGroupsRequest()
+	request.SecurityGroupIds = helper.Strings(ids)
+	request.Limit = helper.String("100")
+
+	ratelimit.Check(request.GetAction())
+	response, err := me.client.UseVpcClient().DescribeSecurityGroups(request)
+	if err != nil {
+		if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
+			// a batch fails as a whole when one id is gone, let the batcher split it
+			if sdkError.Code == "ResourceNotFound" && len(ids) == 1 {
+				return map[string]interface{}{}, nil
+			}
+		}
+
+		log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
+			logId, request.GetAction(), request.ToJsonString(), err)
+		errRet = err
+		return
+	}
+
+	sgs = make(map[string]interface{}, len(response.Response.SecurityGroupSet))
+	for _, sg := range response.Response.SecurityGroupSet {
+		if sg.SecurityGroupId != nil {
+			sgs[*sg.SecurityGroupId] = sg
+		}
+	}
+
+	return
+}
+
 func (me *VpcService) ModifySecurityGroup(ctx context.Context, id string, newName, newDesc *string) error {
 	logId := tccommon.GetLogId(ctx)
 
@@ -1868,20 +1845,17 @@ func (me *VpcService) DescribeSecurityGroups(ctx context.Context, sgId, sgName *
 		}
 	}
 
-	request.Limit = helper.String(strconv.Itoa(DESCRIBE_SECURITY_GROUP_LIMIT))
-
-	offset := 0
-	count := DESCRIBE_SECURITY_GROUP_LIMIT
-	// run loop at least once
-	for count == DESCRIBE_SECURITY_GROUP_LIMIT {
-		request.Offset = helper.String(strconv.Itoa(offset))
+	sgs, err = paginator.Offset(ctx, DESCRIBE_SECURITY_GROUP_LIMIT, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.SecurityGroup], error) {
+		request.Offset = helper.String(helper.Int64ToStr(offset))
+		request.Limit = helper.String(helper.Int64ToStr(limit))
 
+		var set []*vpc.SecurityGroup
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 			ratelimit.Check(request.GetAction())
 
 			response, err := me.client.UseVpcClient().DescribeSecurityGroups(request)
 			if err != nil {
-				count = 0
+				set = nil
 
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 					if sdkError.Code == "ResourceNotFound" {
@@ -1894,18 +1868,14 @@ func (me *VpcService) DescribeSecurityGroups(ctx context.Context, sgId, sgName *
 				return tccommon.RetryError(err, tccommon.InternalError)
 			}
 
-			set := response.Response.SecurityGroupSet
-			count = len(set)
-			sgs = append(sgs, set...)
-
+			set = response.Response.SecurityGroupSet
 			return nil
 		}); err != nil {
 			log.Printf("[CRITAL]%s read security groups failed, reason: %v", logId, err)
 			return nil, err
 		}
-
-		offset += count
-	}
+		return &paginator.Page[*vpc.SecurityGroup]{Items: set}, nil
+	})
 
 	return
 }
@@ -2378,13 +2348,31 @@ func parseRule(str string) (liteRule VpcSecurityGroupLiteRule, err error) {
 EIP
 */
 func (me *VpcService) DescribeEipById(ctx context.Context, eipId string) (eip *vpc.Address, errRet error) {
+	result, err := batcher.Shared(me.client, "vpc.DescribeAddresses", me.describeEipsByIds).Get(ctx, eipId)
+	if err != nil {
+		errRet = err
+		return
+	}
+
+	if result == nil {
+		return me.DescribeEipByIdCdc(ctx, eipId)
+	}
+	eip = result.(*vpc.Address)
+	return
+}
+
+// describeEipsByIds backs DescribeEipById, describing every coalesced EIP id in one call.
+func (me *VpcService) describeEipsByIds(ctx context.Context, eipIds []string) (eips map[string]interface{}, errRet error) {
 	logId := tccommon.GetLogId(ctx)
 	request := vpc.NewDescribeAddressesRequest()
-	request.AddressIds = []*string{&eipId}
+	request.AddressIds = helper.Strings(eipIds)
+	request.Limit = helper.IntInt64(100)
 	ratelimit.Check(request.GetAction())
 
 	var specArgs connectivity.IacExtInfo
-	specArgs.InstanceId = eipId
+	if len(eipIds) == 1 {
+		specArgs.InstanceId = eipIds[0]
+	}
 
 	response, err := me.client.UseVpcClient(specArgs).DescribeAddresses(request)
 	if err != nil {
@@ -2396,10 +2384,12 @@ func (me *VpcService) DescribeEipById(ctx context.Context, eipId string) (eip *v
 	log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 		logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-	if len(response.Response.AddressSet) < 1 {
-		return me.DescribeEipByIdCdc(ctx, eipId)
+	eips = make(map[string]interface{}, len(response.Response.AddressSet))
+	for _, eip := range response.Response.AddressSet {
+		if eip.AddressId != nil {
+			eips[*eip.AddressId] = eip
+		}
 	}
-	eip = response.Response.AddressSet[0]
 	return
 }
 
@@ -2633,33 +2623,24 @@ func (me *VpcService) DescribeNatGatewayByFilter(ctx context.Context, filters ma
 		request.Filters = reqFilters
 	}
 
-	var offset uint64 = 0
-	var pageSize uint64 = 100
-	instances = make([]*vpc.NatGateway, 0)
-
-	for {
-		request.Offset = &offset
-		request.Limit = &pageSize
+	instances, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.NatGateway], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeNatGateways(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.NatGatewaySet) < 1 {
-			break
-		}
-		instances = append(instances, response.Response.NatGatewaySet...)
-		if len(response.Response.NatGatewaySet) < int(pageSize) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		offset += pageSize
-	}
+		return &paginator.Page[*vpc.NatGateway]{Items: response.Response.NatGatewaySet}, nil
+	})
 	return
 }
 
@@ -3026,12 +3007,11 @@ func (me *VpcService) describeEnis(
 		})
 	}
 
-	var offset uint64
-	request.Offset = &offset
-	request.Limit = helper.IntUint64(ENI_DESCRIBE_LIMIT)
+	enis, err = paginator.Offset(ctx, ENI_DESCRIBE_LIMIT, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.NetworkInterface], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 
-	count := ENI_DESCRIBE_LIMIT
-	for count == ENI_DESCRIBE_LIMIT {
+		var eniSet []*vpc.NetworkInterface
 		if err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 			ratelimit.Check(request.GetAction())
 			if len(ids) > 0 {
@@ -3043,7 +3023,7 @@ func (me *VpcService) describeEnis(
 				response, err = me.client.UseVpcClient().DescribeNetworkInterfaces(request)
 			}
 			if err != nil {
-				count = 0
+				eniSet = nil
 
 				if sdkError, ok := err.(*sdkErrors.TencentCloudSDKError); ok {
 					if sdkError.Code == "ResourceNotFound" {
@@ -3060,18 +3040,15 @@ func (me *VpcService) describeEnis(
 				return resource.NonRetryableError(fmt.Errorf("Read eni list failed, Response is nil."))
 			}
 
-			eniSet := response.Response.NetworkInterfaceSet
-			count = len(eniSet)
-			enis = append(enis, eniSet...)
+			eniSet = response.Response.NetworkInterfaceSet
 
 			return nil
 		}); err != nil {
 			log.Printf("[CRITAL]%s read eni list failed, reason: %v", logId, err)
 			return nil, err
 		}
-
-		offset += uint64(count)
-	}
+		return &paginator.Page[*vpc.NetworkInterface]{Items: eniSet}, nil
+	})
 
 	return
 }
@@ -3529,33 +3506,24 @@ func (me *VpcService) DescribeHaVipByFilter(ctx context.Context, filters map[str
 		request.Filters = reqFilters
 	}
 
-	var offset uint64 = 0
-	var pageSize uint64 = 100
-	instances = make([]*vpc.HaVip, 0)
-
-	for {
-		request.Offset = &offset
-		request.Limit = &pageSize
+	instances, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.HaVip], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeHaVips(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.HaVipSet) < 1 {
-			break
-		}
-		instances = append(instances, response.Response.HaVipSet...)
-		if len(response.Response.HaVipSet) < int(pageSize) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		offset += pageSize
-	}
+		return &paginator.Page[*vpc.HaVip]{Items: response.Response.HaVipSet}, nil
+	})
 	return
 }
 
@@ -4125,12 +4093,11 @@ func (me *VpcService) AssociateAclSubnets(ctx context.Context, aclId string, sub
 
 func (me *VpcService) DescribeNetWorkAcls(ctx context.Context, aclID, vpcID, name string) (info []*vpc.NetworkAcl, errRet error) {
 	var (
-		logId            = tccommon.GetLogId(ctx)
-		request          = vpc.NewDescribeNetworkAclsRequest()
-		response         *vpc.DescribeNetworkAclsResponse
-		err              error
-		filters          []*vpc.Filter
-		offset, pageSize uint64 = 0, 100
+		logId    = tccommon.GetLogId(ctx)
+		request  = vpc.NewDescribeNetworkAclsRequest()
+		response *vpc.DescribeNetworkAclsResponse
+		err      error
+		filters  []*vpc.Filter
 	)
 
 	if vpcID != "" {
@@ -4147,9 +4114,10 @@ func (me *VpcService) DescribeNetWorkAcls(ctx context.Context, aclID, vpcID, nam
 		request.Filters = filters
 	}
 
-	request.Offset = &offset
-	request.Limit = &pageSize
-	for {
+	info, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.NetworkAcl], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
+		response = nil
 		err = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 			ratelimit.Check(request.GetAction())
 			response, err = me.client.UseVpcClient().DescribeNetworkAcls(request)
@@ -4167,20 +4135,13 @@ func (me *VpcService) DescribeNetWorkAcls(ctx context.Context, aclID, vpcID, nam
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%v]",
 				logId, request.GetAction(), request.ToJsonString(), err)
-			errRet = err
-			return
-		}
-		if response.Response == nil {
-			return
+			return nil, err
 		}
-
-		info = append(info, response.Response.NetworkAclSet...)
-		if len(response.Response.NetworkAclSet) < int(pageSize) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += pageSize
-	}
+		return &paginator.Page[*vpc.NetworkAcl]{Items: response.Response.NetworkAclSet}, nil
+	})
 
 	return
 }
@@ -4307,33 +4268,24 @@ func (me *VpcService) DescribeVpnGwByFilter(ctx context.Context, filters map[str
 		request.Filters = reqFilters
 	}
 
-	var offset uint64 = 0
-	var pageSize uint64 = 100
-	instances = make([]*vpc.VpnGateway, 0)
-
-	for {
-		request.Offset = &offset
-		request.Limit = &pageSize
+	instances, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.VpnGateway], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpnGateways(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.VpnGatewaySet) < 1 {
-			break
-		}
-		instances = append(instances, response.Response.VpnGatewaySet...)
-		if len(response.Response.VpnGatewaySet) < int(pageSize) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		offset += pageSize
-	}
+		return &paginator.Page[*vpc.VpnGateway]{Items: response.Response.VpnGatewaySet}, nil
+	})
 	return
 }
 
@@ -4375,33 +4327,24 @@ func (me *VpcService) DescribeCustomerGatewayByFilter(ctx context.Context, filte
 		request.Filters = reqFilters
 	}
 
-	var offset uint64 = 0
-	var pageSize uint64 = 100
-	instances = make([]*vpc.CustomerGateway, 0)
-
-	for {
-		request.Offset = &offset
-		request.Limit = &pageSize
+	instances, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.CustomerGateway], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeCustomerGateways(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.CustomerGatewaySet) < 1 {
-			break
-		}
-		instances = append(instances, response.Response.CustomerGatewaySet...)
-		if len(response.Response.CustomerGatewaySet) < int(pageSize) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		offset += pageSize
-	}
+		return &paginator.Page[*vpc.CustomerGateway]{Items: response.Response.CustomerGatewaySet}, nil
+	})
 	return
 }
 
@@ -4486,28 +4429,23 @@ func (me *VpcService) DescribeAddressTemplates(ctx context.Context, filter []*vp
 		}
 	}()
 
-	var offset, limit = 0, 100
 	request.Filters = filter
 
-	for {
-		request.Offset = helper.String(strconv.Itoa(offset))
-		request.Limit = helper.String(strconv.Itoa(limit))
+	templateList, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.AddressTemplate], error) {
+		request.Offset = helper.String(strconv.FormatInt(offset, 10))
+		request.Limit = helper.String(strconv.FormatInt(limit, 10))
 
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeAddressTemplates(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		if response == nil || response.Response == nil {
-			errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
-		}
-		templateList = append(templateList, response.Response.AddressTemplateSet...)
-		if len(response.Response.AddressTemplateSet) < limit {
-			return
+			return nil, fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
 		}
-		offset += limit
-	}
+		return &paginator.Page[*vpc.AddressTemplate]{Items: response.Response.AddressTemplateSet}, nil
+	})
+	return
 }
 
 func (me *VpcService) ModifyAddressTemplate(ctx context.Context, templateId string, name string, addresses []interface{}) (errRet error) {
@@ -4629,28 +4567,23 @@ func (me *VpcService) DescribeAddressTemplateGroups(ctx context.Context, filter
 		}
 	}()
 
-	var offset, limit = 0, 100
 	request.Filters = filter
 
-	for {
-		request.Offset = helper.String(strconv.Itoa(offset))
-		request.Limit = helper.String(strconv.Itoa(limit))
+	templateList, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.AddressTemplateGroup], error) {
+		request.Offset = helper.String(strconv.FormatInt(offset, 10))
+		request.Limit = helper.String(strconv.FormatInt(limit, 10))
 
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeAddressTemplateGroups(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		if response == nil || response.Response == nil {
-			errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
-		}
-		templateList = append(templateList, response.Response.AddressTemplateGroupSet...)
-		if len(response.Response.AddressTemplateGroupSet) < limit {
-			return
+			return nil, fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
 		}
-		offset += limit
-	}
+		return &paginator.Page[*vpc.AddressTemplateGroup]{Items: response.Response.AddressTemplateGroupSet}, nil
+	})
+	return
 }
 
 func (me *VpcService) DeleteAddressTemplateGroup(ctx context.Context, templateGroupId string) (errRet error) {
@@ -4750,28 +4683,23 @@ func (me *VpcService) DescribeServiceTemplates(ctx context.Context, filter []*vp
 		}
 	}()
 
-	var offset, limit = 0, 100
 	request.Filters = filter
 
-	for {
-		request.Offset = helper.String(strconv.Itoa(offset))
-		request.Limit = helper.String(strconv.Itoa(limit))
+	templateList, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.ServiceTemplate], error) {
+		request.Offset = helper.String(strconv.FormatInt(offset, 10))
+		request.Limit = helper.String(strconv.FormatInt(limit, 10))
 
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeServiceTemplates(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		if response == nil || response.Response == nil {
-			errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
-		}
-		templateList = append(templateList, response.Response.ServiceTemplateSet...)
-		if len(response.Response.ServiceTemplateSet) < limit {
-			return
+			return nil, fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
 		}
-		offset += limit
-	}
+		return &paginator.Page[*vpc.ServiceTemplate]{Items: response.Response.ServiceTemplateSet}, nil
+	})
+	return
 }
 
 func (me *VpcService) DeleteServiceTemplate(ctx context.Context, templateId string) (errRet error) {
@@ -4849,28 +4777,23 @@ func (me *VpcService) DescribeServiceTemplateGroups(ctx context.Context, filter
 		}
 	}()
 
-	var offset, limit = 0, 100
 	request.Filters = filter
 
-	for {
-		request.Offset = helper.String(strconv.Itoa(offset))
-		request.Limit = helper.String(strconv.Itoa(limit))
+	templateList, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.ServiceTemplateGroup], error) {
+		request.Offset = helper.String(strconv.FormatInt(offset, 10))
+		request.Limit = helper.String(strconv.FormatInt(limit, 10))
 
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeServiceTemplateGroups(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		if response == nil || response.Response == nil {
-			errRet = fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
+			return nil, fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
 		}
-		templateList = append(templateList, response.Response.ServiceTemplateGroupSet...)
-		if len(response.Response.ServiceTemplateGroupSet) < limit {
-			return
-		}
-		offset += limit
-	}
+		return &paginator.Page[*vpc.ServiceTemplateGroup]{Items: response.Response.ServiceTemplateGroupSet}, nil
+	})
+	return
 }
 
 func (me *VpcService) ModifyServiceTemplateGroup(ctx context.Context, serviceGroupId string, name string, templateIds []interface{}) (errRet error) {
@@ -5013,33 +4936,29 @@ func (me *VpcService) DescribeVpnGatewayRoutes(ctx context.Context, vpnGatewayId
 		request.Filters = filters
 	}
 
-	offset := int64(0)
-	limit := int64(VPN_DESCRIBE_LIMIT)
-	for {
+	result, errRet = paginator.Offset(ctx, VPN_DESCRIBE_LIMIT, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.VpnGatewayRoute], error) {
 		request.Offset = &offset
 		request.Limit = &limit
 		var response *vpc.DescribeVpnGatewayRoutesResponse
-		errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 			ratelimit.Check(request.GetAction())
-			response, errRet = me.client.UseVpcClient().DescribeVpnGatewayRoutes(request)
-			if errRet != nil {
-				return tccommon.RetryError(errRet, tccommon.InternalError)
+			result, e := me.client.UseVpcClient().DescribeVpnGatewayRoutes(request)
+			if e != nil {
+				return tccommon.RetryError(e, tccommon.InternalError)
 			}
+			response = result
 			return nil
 		})
-		if errRet != nil {
-			return errRet, nil
+		if err != nil {
+			return nil, err
 		}
 
 		if response == nil || response.Response == nil {
-			return fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction()), nil
-		} else if len(response.Response.Routes) > 0 {
-			result = append(result, response.Response.Routes...)
-		} else {
-			return
+			return nil, fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
 		}
-		offset = offset + limit
-	}
+		return &paginator.Page[*vpc.VpnGatewayRoute]{Items: response.Response.Routes}, nil
+	})
+	return
 }
 
 func (me *VpcService) DescribeVpcTaskResult(ctx context.Context, taskId *string) (err error) {
@@ -5148,33 +5067,24 @@ func (me *VpcService) DescribeVpnGwSslServerByFilter(ctx context.Context, filter
 		request.Filters = append(request.Filters, &filter)
 	}
 
-	var offset uint64 = 0
-	var pageSize uint64 = 100
-	instances = make([]*vpc.SslVpnSever, 0)
-
-	for {
-		request.Offset = &offset
-		request.Limit = &pageSize
+	instances, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.SslVpnSever], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpnGatewaySslServers(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.SslVpnSeverSet) < 1 {
-			break
-		}
-		instances = append(instances, response.Response.SslVpnSeverSet...)
-		if len(response.Response.SslVpnSeverSet) < int(pageSize) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		offset += pageSize
-	}
+		return &paginator.Page[*vpc.SslVpnSever]{Items: response.Response.SslVpnSeverSet}, nil
+	})
 	return
 }
 
@@ -5250,33 +5160,24 @@ func (me *VpcService) DescribeVpnGwSslClientByFilter(ctx context.Context, filter
 		request.Filters = append(request.Filters, &filter)
 	}
 
-	var offset uint64 = 0
-	var pageSize uint64 = 100
-	instances = make([]*vpc.SslVpnClient, 0)
-
-	for {
-		request.Offset = &offset
-		request.Limit = &pageSize
+	instances, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.SslVpnClient], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		ratelimit.Check(request.GetAction())
 		response, err := me.client.UseVpcClient().DescribeVpnGatewaySslClients(request)
 		if err != nil {
 			log.Printf("[CRITAL]%s api[%s] fail, request body [%s], reason[%s]\n",
 				logId, request.GetAction(), request.ToJsonString(), err.Error())
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n",
 			logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.SslVpnClientSet) < 1 {
-			break
-		}
-		instances = append(instances, response.Response.SslVpnClientSet...)
-		if len(response.Response.SslVpnClientSet) < int(pageSize) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		offset += pageSize
-	}
+		return &paginator.Page[*vpc.SslVpnClient]{Items: response.Response.SslVpnClientSet}, nil
+	})
 	return
 }
 
@@ -5398,33 +5299,29 @@ func (me *VpcService) DescribeNatGatewaySnats(ctx context.Context, natGatewayId
 		request.Filters = filters
 	}
 
-	offset := int64(0)
-	limit := int64(VPN_DESCRIBE_LIMIT)
-	for {
+	result, errRet = paginator.Offset(ctx, VPN_DESCRIBE_LIMIT, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.SourceIpTranslationNatRule], error) {
 		request.Offset = &offset
 		request.Limit = &limit
 		var response *vpc.DescribeNatGatewaySourceIpTranslationNatRulesResponse
-		errRet = resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
+		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 			ratelimit.Check(request.GetAction())
-			response, errRet = me.client.UseVpcClient().DescribeNatGatewaySourceIpTranslationNatRules(request)
-			if errRet != nil {
-				return tccommon.RetryError(errRet, tccommon.InternalError)
+			result, e := me.client.UseVpcClient().DescribeNatGatewaySourceIpTranslationNatRules(request)
+			if e != nil {
+				return tccommon.RetryError(e, tccommon.InternalError)
 			}
+			response = result
 			return nil
 		})
-		if errRet != nil {
-			return errRet, nil
+		if err != nil {
+			return nil, err
 		}
 
 		if response == nil || response.Response == nil {
-			return fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction()), nil
-		} else if len(response.Response.SourceIpTranslationNatRuleSet) > 0 {
-			result = append(result, response.Response.SourceIpTranslationNatRuleSet...)
-		} else {
-			return
+			return nil, fmt.Errorf("TencentCloud SDK return nil response, %s", request.GetAction())
 		}
-		offset = offset + limit
-	}
+		return &paginator.Page[*vpc.SourceIpTranslationNatRule]{Items: response.Response.SourceIpTranslationNatRuleSet}, nil
+	})
+	return
 }
 
 func (me *VpcService) DescribeAssistantCidr(ctx context.Context, vpcId string) (info []*vpc.AssistantCidr, errRet error) {
@@ -5842,15 +5739,9 @@ func (me *VpcService) DescribeVpcEndPointServiceById(ctx context.Context, endPoi
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset    uint64 = 0
-		limit     uint64 = 20
-		instances        = make([]*vpc.EndPointService, 0)
-	)
-
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	instances, err := paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.EndPointService], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 			result, e := me.client.UseVpcClient().DescribeVpcEndPointService(request)
@@ -5867,22 +5758,14 @@ func (me *VpcService) DescribeVpcEndPointServiceById(ctx context.Context, endPoi
 			response = result
 			return nil
 		})
-
 		if err != nil {
-			errRet = err
-			return
-		}
-
-		if response == nil || len(response.Response.EndPointServiceSet) < 1 {
-			break
-		}
-
-		instances = append(instances, response.Response.EndPointServiceSet...)
-		if len(response.Response.EndPointServiceSet) < int(limit) {
-			break
+			return nil, err
 		}
-
-		offset += limit
+		return &paginator.Page[*vpc.EndPointService]{Items: response.Response.EndPointServiceSet}, nil
+	})
+	if err != nil {
+		errRet = err
+		return
 	}
 
 	if len(instances) < 1 {
@@ -5940,15 +5823,9 @@ func (me *VpcService) DescribeVpcEndPointById(ctx context.Context, endPointId st
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	instances := make([]*vpc.EndPoint, 0)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
-
+	instances, err := paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.EndPoint], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
 			ratelimit.Check(request.GetAction())
 			result, e := me.client.UseVpcClient().DescribeVpcEndPoint(request)
@@ -5967,19 +5844,17 @@ func (me *VpcService) DescribeVpcEndPointById(ctx context.Context, endPointId st
 		})
 
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 
-		if response == nil || len(response.Response.EndPointSet) < 1 {
-			break
-		}
-		instances = append(instances, response.Response.EndPointSet...)
-		if len(response.Response.EndPointSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
+		return &paginator.Page[*vpc.EndPoint]{Items: response.Response.EndPointSet}, nil
+	})
+	if err != nil {
+		errRet = err
+		return
 	}
 
 	if len(instances) < 1 {
@@ -6042,30 +5917,23 @@ func (me *VpcService) DescribeVpcEndPointServiceWhiteListById(ctx context.Contex
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	instances := make([]*vpc.VpcEndPointServiceUser, 0)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	instances, err := paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.VpcEndPointServiceUser], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseVpcClient().DescribeVpcEndPointServiceWhiteList(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.VpcEndpointServiceUserSet) < 1 {
-			break
-		}
-		instances = append(instances, response.Response.VpcEndpointServiceUserSet...)
-		if len(response.Response.VpcEndpointServiceUserSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
+		return &paginator.Page[*vpc.VpcEndPointServiceUser]{Items: response.Response.VpcEndpointServiceUserSet}, nil
+	})
+	if err != nil {
+		errRet = err
+		return
 	}
 
 	if len(instances) < 1 {
@@ -6293,30 +6161,20 @@ func (me *VpcService) DescribeNatDcRouteByFilter(ctx context.Context, param map[
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset int64 = 0
-		limit  int64 = 20
-	)
-	for {
+	natDcRoute, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.NatDirectConnectGatewayRoute], error) {
 		request.Offset = &offset
 		request.Limit = &limit
 		response, err := me.client.UseVpcClient().DescribeNatGatewayDirectConnectGatewayRoute(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.NatDirectConnectGatewayRouteSet) < 1 {
-			break
-		}
-		natDcRoute = append(natDcRoute, response.Response.NatDirectConnectGatewayRouteSet...)
-		if len(response.Response.NatDirectConnectGatewayRouteSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*vpc.NatDirectConnectGatewayRoute]{Items: response.Response.NatDirectConnectGatewayRouteSet}, nil
+	})
 
 	return
 }
@@ -6664,29 +6522,23 @@ func (me *VpcService) DescribeVpcClassicLinkAttachmentById(ctx context.Context,
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset int64 = 0
-		limit  int64 = 20
-	)
-	instances := make([]*vpc.ClassicLinkInstance, 0)
-	for {
+	instances, err := paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.ClassicLinkInstance], error) {
 		request.Offset = helper.Int64ToStrPoint(offset)
 		request.Limit = helper.Int64ToStrPoint(limit)
 		response, err := me.client.UseVpcClient().DescribeClassicLinkInstances(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.ClassicLinkInstanceSet) < 1 {
-			break
-		}
-		instances = append(instances, response.Response.ClassicLinkInstanceSet...)
-		if len(response.Response.ClassicLinkInstanceSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-		offset += limit
+		return &paginator.Page[*vpc.ClassicLinkInstance]{Items: response.Response.ClassicLinkInstanceSet}, nil
+	})
+	if err != nil {
+		errRet = err
+		return
 	}
 
 	if len(instances) < 1 {
@@ -6853,12 +6705,7 @@ func (me *VpcService) DescribeVpcById(ctx context.Context, vpcId string) (instan
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset int64 = 0
-		limit  int64 = 20
-	)
-	instances := make([]*vpc.Vpc, 0)
-	for {
+	instances, err := paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.Vpc], error) {
 		request.Offset = helper.Int64ToStrPoint(offset)
 		request.Limit = helper.Int64ToStrPoint(limit)
 		err := resource.Retry(tccommon.ReadRetryTimeout, func() *resource.RetryError {
@@ -6874,20 +6721,17 @@ func (me *VpcService) DescribeVpcById(ctx context.Context, vpcId string) (instan
 		})
 
 		if err != nil {
-			errRet = err
-			return
-		}
-
-		if response == nil || len(response.Response.VpcSet) < 1 {
-			break
+			return nil, err
 		}
 
-		instances = append(instances, response.Response.VpcSet...)
-		if len(response.Response.VpcSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
+		return &paginator.Page[*vpc.Vpc]{Items: response.Response.VpcSet}, nil
+	})
+	if err != nil {
+		errRet = err
+		return
 	}
 
 	if len(instances) < 1 {
@@ -6943,30 +6787,23 @@ func (me *VpcService) DescribeSubnetById(ctx context.Context, subnetId string) (
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset int64 = 0
-		limit  int64 = 20
-	)
-	instances := make([]*vpc.Subnet, 0)
-	for {
+	instances, err := paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.Subnet], error) {
 		request.Offset = helper.Int64ToStrPoint(offset)
 		request.Limit = helper.Int64ToStrPoint(limit)
 		response, err := me.client.UseVpcClient().DescribeSubnets(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.SubnetSet) < 1 {
-			break
-		}
-		instances = append(instances, response.Response.SubnetSet...)
-		if len(response.Response.SubnetSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
+		return &paginator.Page[*vpc.Subnet]{Items: response.Response.SubnetSet}, nil
+	})
+	if err != nil {
+		errRet = err
+		return
 	}
 
 	if len(instances) < 1 {
@@ -7275,30 +7112,20 @@ func (me *VpcService) DescribeVpcClassicLinkInstancesByFilter(ctx context.Contex
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset int64 = 0
-		limit  int64 = 20
-	)
-	for {
+	classicLinkInstances, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.ClassicLinkInstance], error) {
 		request.Offset = helper.Int64ToStrPoint(offset)
 		request.Limit = helper.Int64ToStrPoint(limit)
 		response, err := me.client.UseVpcClient().DescribeClassicLinkInstances(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.ClassicLinkInstanceSet) < 1 {
-			break
-		}
-		classicLinkInstances = append(classicLinkInstances, response.Response.ClassicLinkInstanceSet...)
-		if len(response.Response.ClassicLinkInstanceSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*vpc.ClassicLinkInstance]{Items: response.Response.ClassicLinkInstanceSet}, nil
+	})
 
 	return
 }
@@ -7341,30 +7168,20 @@ func (me *VpcService) DescribeVpcGatewayFlowMonitorDetailByFilter(ctx context.Co
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	GatewayFlowMonitorDetail, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.GatewayFlowMonitorDetail], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseVpcClient().DescribeGatewayFlowMonitorDetail(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.GatewayFlowMonitorDetailSet) < 1 {
-			break
-		}
-		GatewayFlowMonitorDetail = append(GatewayFlowMonitorDetail, response.Response.GatewayFlowMonitorDetailSet...)
-		if len(response.Response.GatewayFlowMonitorDetailSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*vpc.GatewayFlowMonitorDetail]{Items: response.Response.GatewayFlowMonitorDetailSet}, nil
+	})
 
 	return
 }
@@ -7392,30 +7209,20 @@ func (me *VpcService) DescribeVpcGatewayFlowQosByFilter(ctx context.Context, par
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	GatewayFlowQos, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.GatewayQos], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseVpcClient().DescribeGatewayFlowQos(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.GatewayQosSet) < 1 {
-			break
-		}
-		GatewayFlowQos = append(GatewayFlowQos, response.Response.GatewayQosSet...)
-		if len(response.Response.GatewayQosSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*vpc.GatewayQos]{Items: response.Response.GatewayQosSet}, nil
+	})
 
 	return
 }
@@ -7440,30 +7247,20 @@ func (me *VpcService) DescribeVpcCvmInstancesByFilter(ctx context.Context, param
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	CvmInstances, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.CvmInstance], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseVpcClient().DescribeVpcInstances(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.InstanceSet) < 1 {
-			break
-		}
-		CvmInstances = append(CvmInstances, response.Response.InstanceSet...)
-		if len(response.Response.InstanceSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*vpc.CvmInstance]{Items: response.Response.InstanceSet}, nil
+	})
 
 	return
 }
@@ -7491,30 +7288,20 @@ func (me *VpcService) DescribeVpcNetDetectStatesByFilter(ctx context.Context, pa
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	NetDetectStates, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.NetDetectState], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseVpcClient().DescribeNetDetectStates(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.NetDetectStateSet) < 1 {
-			break
-		}
-		NetDetectStates = append(NetDetectStates, response.Response.NetDetectStateSet...)
-		if len(response.Response.NetDetectStateSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*vpc.NetDetectState]{Items: response.Response.NetDetectStateSet}, nil
+	})
 
 	return
 }
@@ -7810,30 +7597,20 @@ func (me *VpcService) DescribeVpcSnapshotFilesByFilter(ctx context.Context, para
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	SnapshotFiles, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.SnapshotFileInfo], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseVpcClient().DescribeSnapshotFiles(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.SnapshotFileSet) < 1 {
-			break
-		}
-		SnapshotFiles = append(SnapshotFiles, response.Response.SnapshotFileSet...)
-		if len(response.Response.SnapshotFileSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*vpc.SnapshotFileInfo]{Items: response.Response.SnapshotFileSet}, nil
+	})
 
 	return
 }
@@ -7922,30 +7699,20 @@ func (me *VpcService) DescribeVpcUsedIpAddressByFilter(ctx context.Context, para
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset uint64 = 0
-		limit  uint64 = 20
-	)
-	for {
-		request.Offset = &offset
-		request.Limit = &limit
+	UsedIpAddress, errRet = paginator.Offset(ctx, 20, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.IpAddressStates], error) {
+		request.Offset = helper.Int64Uint64(offset)
+		request.Limit = helper.Int64Uint64(limit)
 		response, err := me.client.UseVpcClient().DescribeUsedIpAddress(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.IpAddressStates) < 1 {
-			break
-		}
-		UsedIpAddress = append(UsedIpAddress, response.Response.IpAddressStates...)
-		if len(response.Response.IpAddressStates) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*vpc.IpAddressStates]{Items: response.Response.IpAddressStates}, nil
+	})
 
 	return
 }
@@ -8452,30 +8219,20 @@ func (me *VpcService) DescribeClassicElasticPublicIpv6sByFilter(ctx context.Cont
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset int64 = 0
-		limit  int64 = 100
-	)
-	for {
+	ret, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.Address], error) {
 		request.Offset = &offset
 		request.Limit = &limit
 		response, err := me.client.UseVpcClient().DescribeIp6Addresses(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.AddressSet) < 1 {
-			break
-		}
-		ret = append(ret, response.Response.AddressSet...)
-		if len(response.Response.AddressSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*vpc.Address]{Items: response.Response.AddressSet}, nil
+	})
 
 	return
 }
@@ -8506,30 +8263,20 @@ func (me *VpcService) DescribeElasticPublicIpv6sByFilter(ctx context.Context, pa
 
 	ratelimit.Check(request.GetAction())
 
-	var (
-		offset int64 = 0
-		limit  int64 = 100
-	)
-	for {
+	ret, errRet = paginator.Offset(ctx, paginator.DefaultLimit, func(ctx context.Context, offset, limit int64) (*paginator.Page[*vpc.Address], error) {
 		request.Offset = &offset
 		request.Limit = &limit
 		response, err := me.client.UseVpcClient().DescribeIPv6Addresses(request)
 		if err != nil {
-			errRet = err
-			return
+			return nil, err
 		}
 		log.Printf("[DEBUG]%s api[%s] success, request body [%s], response body [%s]\n", logId, request.GetAction(), request.ToJsonString(), response.ToJsonString())
 
-		if response == nil || len(response.Response.AddressSet) < 1 {
-			break
-		}
-		ret = append(ret, response.Response.AddressSet...)
-		if len(response.Response.AddressSet) < int(limit) {
-			break
+		if response == nil || response.Response == nil {
+			return nil, nil
 		}
-
-		offset += limit
-	}
+		return &paginator.Page[*vpc.Address]{Items: response.Response.AddressSet}, nil
+	})
 
 	return
 }
@@ -90,7 +90,7 @@ func dataSourceTencentCloudVpnDefaultHealthCheckIpRead(d *schema.ResourceData, m
 	d.SetId(vpnGwId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), res); e != nil {
+		if e := tccommon.WriteToFile(output.(string), res, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
@@ -31,6 +31,8 @@ func ResourceTencentCloudVpnConnectionReset() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "VPN CONNECTION INSTANCE ID.",
 			},
+
+			tccommon.OPERATION_TRIGGERS: tccommon.OperationTriggersSchema(),
 		},
 	}
 }
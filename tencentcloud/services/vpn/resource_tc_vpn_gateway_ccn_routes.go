@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudVpnGatewayCcnRoutes() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudVpnGatewayCcnRoutesCreate,
-		Read:   resourceTencentCloudVpnGatewayCcnRoutesRead,
-		Update: resourceTencentCloudVpnGatewayCcnRoutesUpdate,
-		Delete: resourceTencentCloudVpnGatewayCcnRoutesDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudVpnGatewayCcnRoutesCreate,
+		Read:     resourceTencentCloudVpnGatewayCcnRoutesRead,
+		Update:   resourceTencentCloudVpnGatewayCcnRoutesUpdate,
+		Delete:   resourceTencentCloudVpnGatewayCcnRoutesDelete,
+		Importer: helper.IdSpec{"vpn_gateway_id", "route_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
 				Required:    true,
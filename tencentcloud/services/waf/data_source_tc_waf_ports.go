@@ -92,7 +92,7 @@ func dataSourceTencentCloudWafPortsRead(d *schema.ResourceData, meta interface{}
 	d.SetId(*ports.RequestId)
 	output, ok := d.GetOk("result_output_file")
 	if ok && output.(string) != "" {
-		if e := tccommon.WriteToFile(output.(string), d); e != nil {
+		if e := tccommon.WriteToFile(output.(string), d, tccommon.GetResultOutputOptions(d)); e != nil {
 			return e
 		}
 	}
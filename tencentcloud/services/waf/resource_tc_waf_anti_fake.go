@@ -19,13 +19,11 @@ import (
 
 func ResourceTencentCloudWafAntiFake() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafAntiFakeCreate,
-		Read:   resourceTencentCloudWafAntiFakeRead,
-		Update: resourceTencentCloudWafAntiFakeUpdate,
-		Delete: resourceTencentCloudWafAntiFakeDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWafAntiFakeCreate,
+		Read:     resourceTencentCloudWafAntiFakeRead,
+		Update:   resourceTencentCloudWafAntiFakeUpdate,
+		Delete:   resourceTencentCloudWafAntiFakeDelete,
+		Importer: helper.IdSpec{"rule_id", "domain"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudWafAntiInfoLeak() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafAntiInfoLeakCreate,
-		Read:   resourceTencentCloudWafAntiInfoLeakRead,
-		Update: resourceTencentCloudWafAntiInfoLeakUpdate,
-		Delete: resourceTencentCloudWafAntiInfoLeakDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWafAntiInfoLeakCreate,
+		Read:     resourceTencentCloudWafAntiInfoLeakRead,
+		Update:   resourceTencentCloudWafAntiInfoLeakUpdate,
+		Delete:   resourceTencentCloudWafAntiInfoLeakDelete,
+		Importer: helper.IdSpec{"rule_id", "domain"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Required:    true,
@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudWafCc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafCcCreate,
-		Read:   resourceTencentCloudWafCcRead,
-		Update: resourceTencentCloudWafCcUpdate,
-		Delete: resourceTencentCloudWafCcDelete,
+		Create:   resourceTencentCloudWafCcCreate,
+		Read:     resourceTencentCloudWafCcRead,
+		Update:   resourceTencentCloudWafCcUpdate,
+		Delete:   resourceTencentCloudWafCcDelete,
+		Importer: helper.IdSpec{"domain", "rule_id", "name"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"domain": {
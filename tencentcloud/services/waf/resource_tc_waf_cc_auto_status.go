@@ -17,12 +17,10 @@ import (
 
 func ResourceTencentCloudWafCcAutoStatus() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafCcAutoStatusCreate,
-		Read:   resourceTencentCloudWafCcAutoStatusRead,
-		Delete: resourceTencentCloudWafCcAutoStatusDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWafCcAutoStatusCreate,
+		Read:     resourceTencentCloudWafCcAutoStatusRead,
+		Delete:   resourceTencentCloudWafCcAutoStatusDelete,
+		Importer: helper.IdSpec{"domain", "edition"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Required:    true,
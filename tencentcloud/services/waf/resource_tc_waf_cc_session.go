@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudWafCcSession() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafCcSessionCreate,
-		Read:   resourceTencentCloudWafCcSessionRead,
-		Update: resourceTencentCloudWafCcSessionUpdate,
-		Delete: resourceTencentCloudWafCcSessionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWafCcSessionCreate,
+		Read:     resourceTencentCloudWafCcSessionRead,
+		Update:   resourceTencentCloudWafCcSessionUpdate,
+		Delete:   resourceTencentCloudWafCcSessionDelete,
+		Importer: helper.IdSpec{"domain", "edition", "session_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Required:    true,
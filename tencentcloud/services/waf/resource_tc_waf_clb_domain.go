@@ -19,13 +19,11 @@ import (
 
 func ResourceTencentCloudWafClbDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafClbDomainCreate,
-		Read:   resourceTencentCloudWafClbDomainRead,
-		Update: resourceTencentCloudWafClbDomainUpdate,
-		Delete: resourceTencentCloudWafClbDomainDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWafClbDomainCreate,
+		Read:     resourceTencentCloudWafClbDomainRead,
+		Update:   resourceTencentCloudWafClbDomainUpdate,
+		Delete:   resourceTencentCloudWafClbDomainDelete,
+		Importer: helper.IdSpec{"instance_id", "domain", "domain_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudWafClbInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafClbInstanceCreate,
-		Read:   resourceTencentCloudWafClbInstanceRead,
-		Update: resourceTencentCloudWafClbInstanceUpdate,
-		Delete: resourceTencentCloudWafClbInstanceDelete,
+		Create:   resourceTencentCloudWafClbInstanceCreate,
+		Read:     resourceTencentCloudWafClbInstanceRead,
+		Update:   resourceTencentCloudWafClbInstanceUpdate,
+		Delete:   resourceTencentCloudWafClbInstanceDelete,
+		Importer: helper.IdSpec{"instance_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"goods_category": {
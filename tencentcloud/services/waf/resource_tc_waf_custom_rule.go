@@ -19,13 +19,11 @@ import (
 
 func ResourceTencentCloudWafCustomRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafCustomRuleCreate,
-		Read:   resourceTencentCloudWafCustomRuleRead,
-		Update: resourceTencentCloudWafCustomRuleUpdate,
-		Delete: resourceTencentCloudWafCustomRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWafCustomRuleCreate,
+		Read:     resourceTencentCloudWafCustomRuleRead,
+		Update:   resourceTencentCloudWafCustomRuleUpdate,
+		Delete:   resourceTencentCloudWafCustomRuleDelete,
+		Importer: helper.IdSpec{"domain", "rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Required:    true,
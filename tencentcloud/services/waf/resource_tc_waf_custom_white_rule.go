@@ -19,13 +19,11 @@ import (
 
 func ResourceTencentCloudWafCustomWhiteRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafCustomWhiteRuleCreate,
-		Read:   resourceTencentCloudWafCustomWhiteRuleRead,
-		Update: resourceTencentCloudWafCustomWhiteRuleUpdate,
-		Delete: resourceTencentCloudWafCustomWhiteRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWafCustomWhiteRuleCreate,
+		Read:     resourceTencentCloudWafCustomWhiteRuleRead,
+		Update:   resourceTencentCloudWafCustomWhiteRuleUpdate,
+		Delete:   resourceTencentCloudWafCustomWhiteRuleDelete,
+		Importer: helper.IdSpec{"domain", "rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudWafIpAccessControl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafIpAccessControlCreate,
-		Read:   resourceTencentCloudWafIpAccessControlRead,
-		Update: resourceTencentCloudWafIpAccessControlUpdate,
-		Delete: resourceTencentCloudWafIpAccessControlDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWafIpAccessControlCreate,
+		Read:     resourceTencentCloudWafIpAccessControlRead,
+		Update:   resourceTencentCloudWafIpAccessControlUpdate,
+		Delete:   resourceTencentCloudWafIpAccessControlDelete,
+		Importer: helper.IdSpec{"instance_id", "domain", "edition"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
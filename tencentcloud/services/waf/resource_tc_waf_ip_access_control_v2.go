@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudWafIpAccessControlV2() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafIpAccessControlV2Create,
-		Read:   resourceTencentCloudWafIpAccessControlV2Read,
-		Update: resourceTencentCloudWafIpAccessControlV2Update,
-		Delete: resourceTencentCloudWafIpAccessControlV2Delete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWafIpAccessControlV2Create,
+		Read:     resourceTencentCloudWafIpAccessControlV2Read,
+		Update:   resourceTencentCloudWafIpAccessControlV2Update,
+		Delete:   resourceTencentCloudWafIpAccessControlV2Delete,
+		Importer: helper.IdSpec{"instance_id", "domain", "rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"domain": {
 				Type:        schema.TypeString,
@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudWafLogPostCkafkaFlow() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafLogPostCkafkaFlowCreate,
-		Read:   resourceTencentCloudWafLogPostCkafkaFlowRead,
-		Update: resourceTencentCloudWafLogPostCkafkaFlowUpdate,
-		Delete: resourceTencentCloudWafLogPostCkafkaFlowDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWafLogPostCkafkaFlowCreate,
+		Read:     resourceTencentCloudWafLogPostCkafkaFlowRead,
+		Update:   resourceTencentCloudWafLogPostCkafkaFlowUpdate,
+		Delete:   resourceTencentCloudWafLogPostCkafkaFlowDelete,
+		Importer: helper.IdSpec{"flow_id", "log_type"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"ckafka_region": {
 				Type:        schema.TypeString,
@@ -16,13 +16,11 @@ import (
 
 func ResourceTencentCloudWafLogPostClsFlow() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafLogPostClsFlowCreate,
-		Read:   resourceTencentCloudWafLogPostClsFlowRead,
-		Update: resourceTencentCloudWafLogPostClsFlowUpdate,
-		Delete: resourceTencentCloudWafLogPostClsFlowDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWafLogPostClsFlowCreate,
+		Read:     resourceTencentCloudWafLogPostClsFlowRead,
+		Update:   resourceTencentCloudWafLogPostClsFlowUpdate,
+		Delete:   resourceTencentCloudWafLogPostClsFlowDelete,
+		Importer: helper.IdSpec{"flow_id", "log_type"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"cls_region": {
 				Type:        schema.TypeString,
@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudWafProtectionMode() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafProtectionModeCreate,
-		Read:   resourceTencentCloudWafProtectionModeRead,
-		Update: resourceTencentCloudWafProtectionModeUpdate,
-		Delete: resourceTencentCloudWafProtectionModeDelete,
+		Create:   resourceTencentCloudWafProtectionModeCreate,
+		Read:     resourceTencentCloudWafProtectionModeRead,
+		Update:   resourceTencentCloudWafProtectionModeUpdate,
+		Delete:   resourceTencentCloudWafProtectionModeDelete,
+		Importer: helper.IdSpec{"domain", "edition"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"domain": {
@@ -19,13 +19,11 @@ import (
 
 func ResourceTencentCloudWafSaasDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafSaasDomainCreate,
-		Read:   resourceTencentCloudWafSaasDomainRead,
-		Update: resourceTencentCloudWafSaasDomainUpdate,
-		Delete: resourceTencentCloudWafSaasDomainDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWafSaasDomainCreate,
+		Read:     resourceTencentCloudWafSaasDomainRead,
+		Update:   resourceTencentCloudWafSaasDomainUpdate,
+		Delete:   resourceTencentCloudWafSaasDomainDelete,
+		Importer: helper.IdSpec{"instance_id", "domain", "domain_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Required:    true,
@@ -52,6 +50,7 @@ func ResourceTencentCloudWafSaasDomain() *schema.Resource {
 			"private_key": {
 				Optional:    true,
 				Type:        schema.TypeString,
+				Sensitive:   true,
 				Description: "Certificate key, When CertType=1, this parameter needs to be filled.",
 			},
 			"ssl_id": {
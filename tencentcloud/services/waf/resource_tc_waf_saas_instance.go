@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudWafSaasInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWafSaasInstanceCreate,
-		Read:   resourceTencentCloudWafSaasInstanceRead,
-		Update: resourceTencentCloudWafSaasInstanceUpdate,
-		Delete: resourceTencentCloudWafSaasInstanceDelete,
+		Create:   resourceTencentCloudWafSaasInstanceCreate,
+		Read:     resourceTencentCloudWafSaasInstanceRead,
+		Update:   resourceTencentCloudWafSaasInstanceUpdate,
+		Delete:   resourceTencentCloudWafSaasInstanceDelete,
+		Importer: helper.IdSpec{"instance_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"goods_category": {
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudWedataBaseline() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWedataBaselineCreate,
-		Read:   resourceTencentCloudWedataBaselineRead,
-		Update: resourceTencentCloudWedataBaselineUpdate,
-		Delete: resourceTencentCloudWedataBaselineDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWedataBaselineCreate,
+		Read:     resourceTencentCloudWedataBaselineRead,
+		Update:   resourceTencentCloudWedataBaselineUpdate,
+		Delete:   resourceTencentCloudWedataBaselineDelete,
+		Importer: helper.IdSpec{"project_id", "baseline_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Required:    true,
@@ -18,10 +18,11 @@ import (
 
 func ResourceTencentCloudWedataDatasource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWedataDatasourceCreate,
-		Read:   resourceTencentCloudWedataDatasourceRead,
-		Update: resourceTencentCloudWedataDatasourceUpdate,
-		Delete: resourceTencentCloudWedataDatasourceDelete,
+		Create:   resourceTencentCloudWedataDatasourceCreate,
+		Read:     resourceTencentCloudWedataDatasourceRead,
+		Update:   resourceTencentCloudWedataDatasourceUpdate,
+		Delete:   resourceTencentCloudWedataDatasourceDelete,
+		Importer: helper.IdSpec{"owner_project_id", "datasource_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudWedataDqRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWedataDqRuleCreate,
-		Read:   resourceTencentCloudWedataDqRuleRead,
-		Update: resourceTencentCloudWedataDqRuleUpdate,
-		Delete: resourceTencentCloudWedataDqRuleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWedataDqRuleCreate,
+		Read:     resourceTencentCloudWedataDqRuleRead,
+		Update:   resourceTencentCloudWedataDqRuleUpdate,
+		Delete:   resourceTencentCloudWedataDqRuleDelete,
+		Importer: helper.IdSpec{"project_id", "rule_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Required:    true,
@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudWedataFunction() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWedataFunctionCreate,
-		Read:   resourceTencentCloudWedataFunctionRead,
-		Update: resourceTencentCloudWedataFunctionUpdate,
-		Delete: resourceTencentCloudWedataFunctionDelete,
+		Create:   resourceTencentCloudWedataFunctionCreate,
+		Read:     resourceTencentCloudWedataFunctionRead,
+		Update:   resourceTencentCloudWedataFunctionUpdate,
+		Delete:   resourceTencentCloudWedataFunctionDelete,
+		Importer: helper.IdSpec{"function_id", "func_type", "func_name", "project_id", "cluster_identifier"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			"type": {
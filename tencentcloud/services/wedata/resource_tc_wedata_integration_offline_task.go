@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudWedataIntegrationOfflineTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWedataIntegrationOfflineTaskCreate,
-		Read:   resourceTencentCloudWedataIntegrationOfflineTaskRead,
-		Update: resourceTencentCloudWedataIntegrationOfflineTaskUpdate,
-		Delete: resourceTencentCloudWedataIntegrationOfflineTaskDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWedataIntegrationOfflineTaskCreate,
+		Read:     resourceTencentCloudWedataIntegrationOfflineTaskRead,
+		Update:   resourceTencentCloudWedataIntegrationOfflineTaskUpdate,
+		Delete:   resourceTencentCloudWedataIntegrationOfflineTaskDelete,
+		Importer: helper.IdSpec{"project_id", "task_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			// OfflineTask
 			"project_id": {
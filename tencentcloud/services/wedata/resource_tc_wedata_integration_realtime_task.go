@@ -17,13 +17,11 @@ import (
 
 func ResourceTencentCloudWedataIntegrationRealtimeTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWedataIntegrationRealtimeTaskCreate,
-		Read:   resourceTencentCloudWedataIntegrationRealtimeTaskRead,
-		Update: resourceTencentCloudWedataIntegrationRealtimeTaskUpdate,
-		Delete: resourceTencentCloudWedataIntegrationRealtimeTaskDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWedataIntegrationRealtimeTaskCreate,
+		Read:     resourceTencentCloudWedataIntegrationRealtimeTaskRead,
+		Update:   resourceTencentCloudWedataIntegrationRealtimeTaskUpdate,
+		Delete:   resourceTencentCloudWedataIntegrationRealtimeTaskDelete,
+		Importer: helper.IdSpec{"project_id", "task_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			// createTask
 			"project_id": {
@@ -17,10 +17,11 @@ import (
 
 func ResourceTencentCloudWedataIntegrationTaskNode() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWedataIntegrationTaskNodeCreate,
-		Read:   resourceTencentCloudWedataIntegrationTaskNodeRead,
-		Update: resourceTencentCloudWedataIntegrationTaskNodeUpdate,
-		Delete: resourceTencentCloudWedataIntegrationTaskNodeDelete,
+		Create:   resourceTencentCloudWedataIntegrationTaskNodeCreate,
+		Read:     resourceTencentCloudWedataIntegrationTaskNodeRead,
+		Update:   resourceTencentCloudWedataIntegrationTaskNodeUpdate,
+		Delete:   resourceTencentCloudWedataIntegrationTaskNodeDelete,
+		Importer: helper.IdSpec{"project_id", "node_id"}.Importer(),
 
 		Schema: map[string]*schema.Schema{
 			// create
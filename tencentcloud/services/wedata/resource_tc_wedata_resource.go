@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudWedataResource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWedataResourceCreate,
-		Read:   resourceTencentCloudWedataResourceRead,
-		Update: resourceTencentCloudWedataResourceUpdate,
-		Delete: resourceTencentCloudWedataResourceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWedataResourceCreate,
+		Read:     resourceTencentCloudWedataResourceRead,
+		Update:   resourceTencentCloudWedataResourceUpdate,
+		Delete:   resourceTencentCloudWedataResourceDelete,
+		Importer: helper.IdSpec{"project_id", "file_path", "resource_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudWedataRuleTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWedataRuleTemplateCreate,
-		Read:   resourceTencentCloudWedataRuleTemplateRead,
-		Update: resourceTencentCloudWedataRuleTemplateUpdate,
-		Delete: resourceTencentCloudWedataRuleTemplateDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWedataRuleTemplateCreate,
+		Read:     resourceTencentCloudWedataRuleTemplateRead,
+		Update:   resourceTencentCloudWedataRuleTemplateUpdate,
+		Delete:   resourceTencentCloudWedataRuleTemplateDelete,
+		Importer: helper.IdSpec{"project_id", "rule_template_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Required:    true,
@@ -18,13 +18,11 @@ import (
 
 func ResourceTencentCloudWedataScript() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTencentCloudWedataScriptCreate,
-		Read:   resourceTencentCloudWedataScriptRead,
-		Update: resourceTencentCloudWedataScriptUpdate,
-		Delete: resourceTencentCloudWedataScriptDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceTencentCloudWedataScriptCreate,
+		Read:     resourceTencentCloudWedataScriptRead,
+		Update:   resourceTencentCloudWedataScriptUpdate,
+		Delete:   resourceTencentCloudWedataScriptDelete,
+		Importer: helper.IdSpec{"project_id", "file_path", "resource_id"}.Importer(),
 		Schema: map[string]*schema.Schema{
 			"file_path": {
 				Type:        schema.TypeString,